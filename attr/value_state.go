@@ -0,0 +1,35 @@
+package attr
+
+import "fmt"
+
+const (
+	// ValueStateNull represents a value which is null.
+	//
+	// This value is 0 so it is the zero-value for types implementations.
+	ValueStateNull ValueState = 0
+
+	// ValueStateUnknown represents a value which is unknown.
+	ValueStateUnknown ValueState = 1
+
+	// ValueStateKnown represents a value which is known (not null or unknown).
+	ValueStateKnown ValueState = 2
+)
+
+// ValueState represents the current state of a Value. This is an internal
+// detail of Value implementations, such as the types package, which is used
+// to ensure a value is only ever null, unknown, or known.
+type ValueState uint8
+
+// String returns a human-readable representation of the ValueState.
+func (s ValueState) String() string {
+	switch s {
+	case ValueStateKnown:
+		return "known"
+	case ValueStateNull:
+		return "null"
+	case ValueStateUnknown:
+		return "unknown"
+	default:
+		panic(fmt.Sprintf("unhandled ValueState in String: %d", s))
+	}
+}
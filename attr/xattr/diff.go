@@ -0,0 +1,270 @@
+package xattr
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/valuehash"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// ValueChange is a difference between two values at a path.
+type ValueChange struct {
+	// Path is the path to the value, relative to the values passed to Diff.
+	Path path.Path
+
+	// Old is the prior value, or nil if the value was added, such as a new
+	// list element, map key, or set element.
+	Old attr.Value
+
+	// New is the new value, or nil if the value was removed.
+	New attr.Value
+}
+
+// ValueDiff contains the differences between two values, as returned by
+// Diff.
+type ValueDiff struct {
+	// Changes contains the values which are known and differ.
+	Changes []ValueChange
+
+	// Unknowns contains the values which are unknown or contain unknown
+	// values, so cannot be compared yet.
+	Unknowns []ValueChange
+}
+
+// ChangedPaths returns the paths of all Changes.
+func (d ValueDiff) ChangedPaths() path.Paths {
+	return valueChangePaths(d.Changes)
+}
+
+// UnknownPaths returns the paths of all Unknowns.
+func (d ValueDiff) UnknownPaths() path.Paths {
+	return valueChangePaths(d.Unknowns)
+}
+
+// HasChanges returns true if there are any Changes or Unknowns.
+func (d ValueDiff) HasChanges() bool {
+	return len(d.Changes) > 0 || len(d.Unknowns) > 0
+}
+
+func valueChangePaths(changes []ValueChange) path.Paths {
+	if len(changes) == 0 {
+		return nil
+	}
+
+	paths := make(path.Paths, 0, len(changes))
+
+	for _, change := range changes {
+		paths = append(paths, change.Path)
+	}
+
+	return paths
+}
+
+// Diff returns the differences between `oldValue` and `newValue`, at the
+// most specific paths where they differ. Values are traversed in the same
+// way as Walk.
+//
+// List and tuple elements are compared by index, so reordering a list reports
+// each changed index. Set elements are compared by membership, so reordering
+// a set is not a change, while each added or removed element is reported
+// with a nil Old or New value. Map elements are compared by key.
+//
+// Values which are unknown in `newValue`, or contain unknown values, are
+// reported in Unknowns instead of Changes. Values implementing
+// ValueWithSemanticEquals which are semantically equal are not reported.
+func Diff(ctx context.Context, oldValue attr.Value, newValue attr.Value) (ValueDiff, diag.Diagnostics) {
+	var result ValueDiff
+
+	diags := diff(ctx, path.Empty(), oldValue, newValue, &result)
+
+	return result, diags
+}
+
+func diff(ctx context.Context, p path.Path, oldValue attr.Value, newValue attr.Value, result *ValueDiff) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if oldValue == nil && newValue == nil {
+		return diags
+	}
+
+	if oldValue != nil && newValue != nil && oldValue.Equal(newValue) {
+		return diags
+	}
+
+	if oldValue == nil || newValue == nil || oldValue.IsNull() || newValue.IsNull() || oldValue.IsUnknown() || newValue.IsUnknown() || !oldValue.Type(ctx).Equal(newValue.Type(ctx)) {
+		return addValueChange(ctx, p, oldValue, newValue, result)
+	}
+
+	if v, ok := newValue.(ValueWithSemanticEquals); ok {
+		equal, semanticEqualsDiags := v.SemanticEquals(ctx, oldValue)
+
+		diags.Append(semanticEqualsDiags...)
+
+		if diags.HasError() || equal {
+			return diags
+		}
+	}
+
+	oldElements, elementsDiags := valueElements(ctx, p, oldValue)
+
+	diags.Append(elementsDiags...)
+
+	newElements, elementsDiags := valueElements(ctx, p, newValue)
+
+	diags.Append(elementsDiags...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	tfType := newValue.Type(ctx).TerraformType(ctx)
+
+	switch {
+	case tfType.Is(tftypes.List{}), tfType.Is(tftypes.Tuple{}):
+		for i := 0; i < len(oldElements) || i < len(newElements); i++ {
+			switch {
+			case i >= len(newElements):
+				diags.Append(diff(ctx, oldElements[i].path, oldElements[i].value, nil, result)...)
+			case i >= len(oldElements):
+				diags.Append(diff(ctx, newElements[i].path, nil, newElements[i].value, result)...)
+			default:
+				diags.Append(diff(ctx, newElements[i].path, oldElements[i].value, newElements[i].value, result)...)
+			}
+		}
+	case tfType.Is(tftypes.Set{}):
+		diags.Append(diffSetElements(ctx, oldElements, newElements, result)...)
+	case tfType.Is(tftypes.Map{}), tfType.Is(tftypes.Object{}):
+		oldByKey := make(map[string]element, len(oldElements))
+
+		for _, e := range oldElements {
+			oldByKey[elementKey(e.step)] = e
+		}
+
+		newKeys := make(map[string]struct{}, len(newElements))
+
+		for _, e := range newElements {
+			key := elementKey(e.step)
+			newKeys[key] = struct{}{}
+
+			var oldElementValue attr.Value
+
+			if oldElement, ok := oldByKey[key]; ok {
+				oldElementValue = oldElement.value
+			}
+
+			diags.Append(diff(ctx, e.path, oldElementValue, e.value, result)...)
+		}
+
+		for _, e := range oldElements {
+			if _, ok := newKeys[elementKey(e.step)]; ok {
+				continue
+			}
+
+			diags.Append(diff(ctx, e.path, e.value, nil, result)...)
+		}
+	default:
+		return addValueChange(ctx, p, oldValue, newValue, result)
+	}
+
+	return diags
+}
+
+// diffSetElements adds each element of the new set which is not in the old
+// set, followed by each element of the old set which is not in the new set.
+func diffSetElements(ctx context.Context, oldElements []element, newElements []element, result *ValueDiff) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	oldTfValues := make([]tftypes.Value, 0, len(oldElements))
+
+	for _, e := range oldElements {
+		oldTfValues = append(oldTfValues, setElementTerraformValue(e))
+	}
+
+	oldIndex := valuehash.NewIndex(oldTfValues)
+	oldFound := make([]bool, len(oldElements))
+
+	for _, e := range newElements {
+		found := false
+
+		for _, candidate := range oldIndex.Candidates(setElementTerraformValue(e)) {
+			if oldElements[candidate].value.Equal(e.value) {
+				oldFound[candidate] = true
+				found = true
+			}
+		}
+
+		if !found {
+			diags.Append(addValueChange(ctx, e.path, nil, e.value, result)...)
+		}
+	}
+
+	for i, e := range oldElements {
+		if !oldFound[i] {
+			diags.Append(addValueChange(ctx, e.path, e.value, nil, result)...)
+		}
+	}
+
+	return diags
+}
+
+// addValueChange adds the change to the Unknowns of the result if the new
+// value is not fully known, otherwise to the Changes.
+func addValueChange(ctx context.Context, p path.Path, oldValue attr.Value, newValue attr.Value, result *ValueDiff) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	change := ValueChange{
+		Path: p,
+		Old:  oldValue,
+		New:  newValue,
+	}
+
+	if newValue == nil {
+		result.Changes = append(result.Changes, change)
+
+		return diags
+	}
+
+	tfValue, err := newValue.ToTerraformValue(ctx)
+
+	if err != nil {
+		diags.AddAttributeError(
+			p,
+			"Value Diff Error",
+			"An unexpected error was encountered trying to compare values. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				"Unable to convert value to tftypes.Value: "+err.Error(),
+		)
+
+		return diags
+	}
+
+	if !tfValue.IsFullyKnown() {
+		result.Unknowns = append(result.Unknowns, change)
+
+		return diags
+	}
+
+	result.Changes = append(result.Changes, change)
+
+	return diags
+}
+
+// elementKey returns the map key or attribute name of an element step.
+func elementKey(step tftypes.AttributePathStep) string {
+	switch s := step.(type) {
+	case tftypes.ElementKeyString:
+		return string(s)
+	case tftypes.AttributeName:
+		return string(s)
+	default:
+		return ""
+	}
+}
+
+// setElementTerraformValue returns the Terraform value of a set element, which
+// is always stepped to by an ElementKeyValue.
+func setElementTerraformValue(e element) tftypes.Value {
+	return tftypes.Value(e.step.(tftypes.ElementKeyValue))
+}
@@ -0,0 +1,270 @@
+package xattr_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/jsontypes"
+)
+
+func TestDiff(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		oldValue      attr.Value
+		newValue      attr.Value
+		expected      xattr.ValueDiff
+		expectedDiags diag.Diagnostics
+	}{
+		"equal": {
+			oldValue: testObjectValue([]string{"first"}, map[string]string{"a": "one"}, []string{"x"}),
+			newValue: testObjectValue([]string{"first"}, map[string]string{"a": "one"}, []string{"x"}),
+			expected: xattr.ValueDiff{},
+		},
+		"primitive": {
+			oldValue: types.StringValue("old"),
+			newValue: types.StringValue("new"),
+			expected: xattr.ValueDiff{
+				Changes: []xattr.ValueChange{
+					{
+						Path: path.Empty(),
+						Old:  types.StringValue("old"),
+						New:  types.StringValue("new"),
+					},
+				},
+			},
+		},
+		"null-to-known": {
+			oldValue: types.ListNull(types.StringType),
+			newValue: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("first")}),
+			expected: xattr.ValueDiff{
+				Changes: []xattr.ValueChange{
+					{
+						Path: path.Empty(),
+						Old:  types.ListNull(types.StringType),
+						New:  types.ListValueMust(types.StringType, []attr.Value{types.StringValue("first")}),
+					},
+				},
+			},
+		},
+		"unknown": {
+			oldValue: types.StringValue("old"),
+			newValue: types.StringUnknown(),
+			expected: xattr.ValueDiff{
+				Unknowns: []xattr.ValueChange{
+					{
+						Path: path.Empty(),
+						Old:  types.StringValue("old"),
+						New:  types.StringUnknown(),
+					},
+				},
+			},
+		},
+		"nested-object-attribute": {
+			oldValue: testObjectValue([]string{"first", "second"}, nil, nil),
+			newValue: testObjectValue([]string{"first", "changed"}, nil, nil),
+			expected: xattr.ValueDiff{
+				Changes: []xattr.ValueChange{
+					{
+						Path: path.Root("list").AtListIndex(1).AtName("name"),
+						Old:  types.StringValue("second"),
+						New:  types.StringValue("changed"),
+					},
+				},
+			},
+		},
+		"list-reordered": {
+			oldValue: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("first"), types.StringValue("second")}),
+			newValue: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("second"), types.StringValue("first")}),
+			expected: xattr.ValueDiff{
+				Changes: []xattr.ValueChange{
+					{
+						Path: path.Empty().AtListIndex(0),
+						Old:  types.StringValue("first"),
+						New:  types.StringValue("second"),
+					},
+					{
+						Path: path.Empty().AtListIndex(1),
+						Old:  types.StringValue("second"),
+						New:  types.StringValue("first"),
+					},
+				},
+			},
+		},
+		"list-element-added": {
+			oldValue: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("first")}),
+			newValue: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("first"), types.StringValue("second")}),
+			expected: xattr.ValueDiff{
+				Changes: []xattr.ValueChange{
+					{
+						Path: path.Empty().AtListIndex(1),
+						New:  types.StringValue("second"),
+					},
+				},
+			},
+		},
+		"list-element-removed": {
+			oldValue: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("first"), types.StringValue("second")}),
+			newValue: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("first")}),
+			expected: xattr.ValueDiff{
+				Changes: []xattr.ValueChange{
+					{
+						Path: path.Empty().AtListIndex(1),
+						Old:  types.StringValue("second"),
+					},
+				},
+			},
+		},
+		"set-reordered": {
+			oldValue: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("first"), types.StringValue("second")}),
+			newValue: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("second"), types.StringValue("first")}),
+			expected: xattr.ValueDiff{},
+		},
+		"set-membership": {
+			oldValue: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("first"), types.StringValue("second")}),
+			newValue: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("third"), types.StringValue("first")}),
+			expected: xattr.ValueDiff{
+				Changes: []xattr.ValueChange{
+					{
+						Path: path.Empty().AtSetValue(types.StringValue("third")),
+						New:  types.StringValue("third"),
+					},
+					{
+						Path: path.Empty().AtSetValue(types.StringValue("second")),
+						Old:  types.StringValue("second"),
+					},
+				},
+			},
+		},
+		"set-unknown-element": {
+			oldValue: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("first")}),
+			newValue: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("first"), types.StringUnknown()}),
+			expected: xattr.ValueDiff{
+				Unknowns: []xattr.ValueChange{
+					{
+						Path: path.Empty().AtSetValue(types.StringUnknown()),
+						New:  types.StringUnknown(),
+					},
+				},
+			},
+		},
+		"map": {
+			oldValue: testObjectValue(nil, map[string]string{"a": "one", "b": "two"}, nil),
+			newValue: testObjectValue(nil, map[string]string{"a": "changed", "c": "three"}, nil),
+			expected: xattr.ValueDiff{
+				Changes: []xattr.ValueChange{
+					{
+						Path: path.Root("map").AtMapKey("a"),
+						Old:  types.StringValue("one"),
+						New:  types.StringValue("changed"),
+					},
+					{
+						Path: path.Root("map").AtMapKey("c"),
+						New:  types.StringValue("three"),
+					},
+					{
+						Path: path.Root("map").AtMapKey("b"),
+						Old:  types.StringValue("two"),
+					},
+				},
+			},
+		},
+		"changes-and-unknowns": {
+			oldValue: types.ObjectValueMust(
+				map[string]attr.Type{
+					"computed": types.StringType,
+					"name":     types.StringType,
+				},
+				map[string]attr.Value{
+					"computed": types.StringValue("id-123"),
+					"name":     types.StringValue("old"),
+				},
+			),
+			newValue: types.ObjectValueMust(
+				map[string]attr.Type{
+					"computed": types.StringType,
+					"name":     types.StringType,
+				},
+				map[string]attr.Value{
+					"computed": types.StringUnknown(),
+					"name":     types.StringValue("new"),
+				},
+			),
+			expected: xattr.ValueDiff{
+				Changes: []xattr.ValueChange{
+					{
+						Path: path.Root("name"),
+						Old:  types.StringValue("old"),
+						New:  types.StringValue("new"),
+					},
+				},
+				Unknowns: []xattr.ValueChange{
+					{
+						Path: path.Root("computed"),
+						Old:  types.StringValue("id-123"),
+						New:  types.StringUnknown(),
+					},
+				},
+			},
+		},
+		"semantic-equality": {
+			oldValue: jsontypes.NormalizedValue(`{"a": 1}`),
+			newValue: jsontypes.NormalizedValue(`{"a":1}`),
+			expected: xattr.ValueDiff{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := xattr.Diff(context.Background(), testCase.oldValue, testCase.newValue)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestValueDiffPaths(t *testing.T) {
+	t.Parallel()
+
+	valueDiff := xattr.ValueDiff{
+		Changes: []xattr.ValueChange{
+			{Path: path.Root("name")},
+			{Path: path.Root("tags").AtMapKey("env")},
+		},
+		Unknowns: []xattr.ValueChange{
+			{Path: path.Root("id")},
+		},
+	}
+
+	if diff := cmp.Diff(valueDiff.ChangedPaths(), path.Paths{path.Root("name"), path.Root("tags").AtMapKey("env")}); diff != "" {
+		t.Errorf("unexpected changed paths difference: %s", diff)
+	}
+
+	if diff := cmp.Diff(valueDiff.UnknownPaths(), path.Paths{path.Root("id")}); diff != "" {
+		t.Errorf("unexpected unknown paths difference: %s", diff)
+	}
+
+	if !valueDiff.HasChanges() {
+		t.Errorf("expected changes")
+	}
+
+	if (xattr.ValueDiff{}).HasChanges() {
+		t.Errorf("expected no changes")
+	}
+}
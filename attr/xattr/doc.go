@@ -1,3 +1,4 @@
-// Package xattr contains additional interfaces for attr types. This package
-// is separate from the core attr package to prevent import cycles.
+// Package xattr contains additional interfaces for attr types, and functions
+// to walk and transform attr values. This package is separate from the core
+// attr package to prevent import cycles.
 package xattr
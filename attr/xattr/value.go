@@ -0,0 +1,28 @@
+package xattr
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// ValueWithSemanticEquals extends the attr.Value interface to include a
+// SemanticEquals method, used to determine whether two values represent the
+// same data even though their Terraform representations differ, such as JSON
+// strings which only differ in whitespace.
+//
+// When the framework receives a new resource state from Create, Read, or
+// Update and a value implementing this interface is semantically equal to the
+// prior value at the same path, the prior value is kept. This prevents
+// differences in formatting returned by an API from being shown as a plan
+// difference or reported as an inconsistent result.
+type ValueWithSemanticEquals interface {
+	attr.Value
+
+	// SemanticEquals returns true if the given value, which is always of the
+	// same type and known and not null, represents the same data as the
+	// receiver. Any returned error diagnostics are surfaced to the
+	// practitioner and the new value is kept.
+	SemanticEquals(context.Context, attr.Value) (bool, diag.Diagnostics)
+}
@@ -0,0 +1,370 @@
+package xattr
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/valuehash"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// WalkFunc is the callback function called by Walk for each value. Returning
+// false prevents Walk from visiting the elements or attributes of the value.
+// Returning an error diagnostic stops the walk.
+type WalkFunc func(path.Path, attr.Value) (bool, diag.Diagnostics)
+
+// TransformFunc is the callback function called by Transform for each value.
+// The returned value replaces the value at the path, and must be of the same
+// type. Returning an error diagnostic stops the transformation.
+type TransformFunc func(path.Path, attr.Value) (attr.Value, diag.Diagnostics)
+
+// Walk calls the callback function for `value` and then, unless the callback
+// function returns false, for each of its elements or attributes, recursively.
+// The path passed to the callback function is relative to `value`, so `value`
+// itself is visited with an empty path.
+//
+// Values are traversed based on their Terraform type, so any attr.Value whose
+// attr.Type returns the element or attribute types via
+// ApplyTerraform5AttributePathStep, such as types.List, types.Set, types.Map,
+// types.Object, and custom types implementing attr.TypeWithElementType or
+// attr.TypeWithAttributeTypes, can be walked. Null and unknown values have no
+// elements or attributes. Map keys and object attribute names are visited in
+// sorted order.
+func Walk(ctx context.Context, value attr.Value, callback WalkFunc) diag.Diagnostics {
+	return walk(ctx, path.Empty(), value, callback)
+}
+
+// Transform calls the callback function for each element or attribute of
+// `value`, recursively, and then for `value` itself, returning the value
+// returned by the last call. Elements and attributes are transformed before
+// the value containing them, so the callback function receives each
+// collection or object with its transformed elements or attributes. The path
+// passed to the callback function is relative to `value`.
+//
+// Values are traversed in the same way as Walk. When any element or attribute
+// is changed, the containing value is recreated by its attr.Type
+// ValueFromTerraform method. Set elements which are transformed into equal
+// values are combined into a single element, so the set remains valid.
+func Transform(ctx context.Context, value attr.Value, callback TransformFunc) (attr.Value, diag.Diagnostics) {
+	return transform(ctx, path.Empty(), value, callback)
+}
+
+// element is an element or attribute of a value.
+type element struct {
+	// path is the path to the element, relative to the value passed to Walk
+	// or Transform.
+	path path.Path
+
+	// step is the step from the containing value to the element.
+	step tftypes.AttributePathStep
+
+	// value is the element value.
+	value attr.Value
+}
+
+func walk(ctx context.Context, p path.Path, value attr.Value, callback WalkFunc) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	descend, callbackDiags := callback(p, value)
+
+	diags.Append(callbackDiags...)
+
+	if !descend || diags.HasError() {
+		return diags
+	}
+
+	elements, elementsDiags := valueElements(ctx, p, value)
+
+	diags.Append(elementsDiags...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	for _, e := range elements {
+		diags.Append(walk(ctx, e.path, e.value, callback)...)
+
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	return diags
+}
+
+func transform(ctx context.Context, p path.Path, value attr.Value, callback TransformFunc) (attr.Value, diag.Diagnostics) {
+	elements, diags := valueElements(ctx, p, value)
+
+	if diags.HasError() {
+		return value, diags
+	}
+
+	var changed bool
+
+	for i, e := range elements {
+		newValue, elementDiags := transform(ctx, e.path, e.value, callback)
+
+		diags.Append(elementDiags...)
+
+		if diags.HasError() {
+			return value, diags
+		}
+
+		if newValue == nil {
+			diags.AddAttributeError(
+				e.path,
+				"Value Transform Error",
+				"An unexpected error was encountered trying to transform a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+					"Transformed value is nil.",
+			)
+
+			return value, diags
+		}
+
+		if !newValue.Equal(e.value) {
+			changed = true
+		}
+
+		elements[i].value = newValue
+	}
+
+	if changed {
+		newValue, err := valueWithElements(ctx, value, elements)
+
+		if err != nil {
+			diags.AddAttributeError(
+				p,
+				"Value Transform Error",
+				"An unexpected error was encountered trying to transform a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+			)
+
+			return value, diags
+		}
+
+		value = newValue
+	}
+
+	newValue, callbackDiags := callback(p, value)
+
+	diags.Append(callbackDiags...)
+
+	return newValue, diags
+}
+
+// valueElements returns the elements or attributes of a known list, set, map,
+// object, or tuple value.
+func valueElements(ctx context.Context, p path.Path, value attr.Value) ([]element, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if value == nil || value.IsNull() || value.IsUnknown() {
+		return nil, diags
+	}
+
+	elements, err := tfValueElements(ctx, p, value)
+
+	if err != nil {
+		diags.AddAttributeError(
+			p,
+			"Value Walk Error",
+			"An unexpected error was encountered trying to walk a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+		)
+
+		return nil, diags
+	}
+
+	return elements, diags
+}
+
+func tfValueElements(ctx context.Context, p path.Path, value attr.Value) ([]element, error) {
+	tfValue, err := value.ToTerraformValue(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert value to tftypes.Value: %w", err)
+	}
+
+	typ := value.Type(ctx)
+	tfType := tfValue.Type()
+
+	switch {
+	case tfType.Is(tftypes.List{}), tfType.Is(tftypes.Tuple{}), tfType.Is(tftypes.Set{}):
+		var tfElements []tftypes.Value
+
+		if err := tfValue.As(&tfElements); err != nil {
+			return nil, err
+		}
+
+		elements := make([]element, 0, len(tfElements))
+
+		for i, tfElement := range tfElements {
+			var step tftypes.AttributePathStep = tftypes.ElementKeyInt(i)
+
+			if tfType.Is(tftypes.Set{}) {
+				step = tftypes.ElementKeyValue(tfElement)
+			}
+
+			elementValue, err := elementValueFromTerraform(ctx, typ, step, tfElement)
+
+			if err != nil {
+				return nil, err
+			}
+
+			elementPath := p.AtListIndex(i)
+
+			if tfType.Is(tftypes.Set{}) {
+				elementPath = p.AtSetValue(elementValue)
+			}
+
+			elements = append(elements, element{
+				path:  elementPath,
+				step:  step,
+				value: elementValue,
+			})
+		}
+
+		return elements, nil
+	case tfType.Is(tftypes.Map{}), tfType.Is(tftypes.Object{}):
+		var tfElements map[string]tftypes.Value
+
+		if err := tfValue.As(&tfElements); err != nil {
+			return nil, err
+		}
+
+		keys := make([]string, 0, len(tfElements))
+
+		for key := range tfElements {
+			keys = append(keys, key)
+		}
+
+		sort.Strings(keys)
+
+		elements := make([]element, 0, len(tfElements))
+
+		for _, key := range keys {
+			var step tftypes.AttributePathStep = tftypes.ElementKeyString(key)
+			elementPath := p.AtMapKey(key)
+
+			if tfType.Is(tftypes.Object{}) {
+				step = tftypes.AttributeName(key)
+				elementPath = p.AtName(key)
+			}
+
+			elementValue, err := elementValueFromTerraform(ctx, typ, step, tfElements[key])
+
+			if err != nil {
+				return nil, err
+			}
+
+			elements = append(elements, element{
+				path:  elementPath,
+				step:  step,
+				value: elementValue,
+			})
+		}
+
+		return elements, nil
+	default:
+		return nil, nil
+	}
+}
+
+// elementValueFromTerraform returns the attr.Value of an element, using the
+// attr.Type returned by the ApplyTerraform5AttributePathStep method of the
+// containing type.
+func elementValueFromTerraform(ctx context.Context, typ attr.Type, step tftypes.AttributePathStep, tfValue tftypes.Value) (attr.Value, error) {
+	elementTypeRaw, err := typ.ApplyTerraform5AttributePathStep(step)
+
+	if err != nil {
+		return nil, fmt.Errorf("unable to get element type of %s: %w", typ, err)
+	}
+
+	elementType, ok := elementTypeRaw.(attr.Type)
+
+	if !ok {
+		return nil, fmt.Errorf("%s returned %T for element type, expected attr.Type", typ, elementTypeRaw)
+	}
+
+	return elementType.ValueFromTerraform(ctx, tfValue)
+}
+
+// valueWithElements returns a copy of `value` with its elements or attributes
+// replaced.
+func valueWithElements(ctx context.Context, value attr.Value, elements []element) (attr.Value, error) {
+	tfType := value.Type(ctx).TerraformType(ctx)
+
+	var tfValue interface{}
+
+	switch {
+	case tfType.Is(tftypes.List{}), tfType.Is(tftypes.Tuple{}), tfType.Is(tftypes.Set{}):
+		tfElements := make([]tftypes.Value, 0, len(elements))
+
+		for _, e := range elements {
+			tfElement, err := e.value.ToTerraformValue(ctx)
+
+			if err != nil {
+				return nil, fmt.Errorf("unable to convert element to tftypes.Value: %w", err)
+			}
+
+			tfElements = append(tfElements, tfElement)
+		}
+
+		if tfType.Is(tftypes.Set{}) {
+			tfElements = removeDuplicates(tfElements)
+		}
+
+		tfValue = tfElements
+	default:
+		tfElements := make(map[string]tftypes.Value, len(elements))
+
+		for _, e := range elements {
+			tfElement, err := e.value.ToTerraformValue(ctx)
+
+			if err != nil {
+				return nil, fmt.Errorf("unable to convert element to tftypes.Value: %w", err)
+			}
+
+			switch step := e.step.(type) {
+			case tftypes.ElementKeyString:
+				tfElements[string(step)] = tfElement
+			case tftypes.AttributeName:
+				tfElements[string(step)] = tfElement
+			}
+		}
+
+		tfValue = tfElements
+	}
+
+	if err := tftypes.ValidateValue(tfType, tfValue); err != nil {
+		return nil, fmt.Errorf("transformed value does not conform to %s: %w", tfType, err)
+	}
+
+	return value.Type(ctx).ValueFromTerraform(ctx, tftypes.NewValue(tfType, tfValue))
+}
+
+// removeDuplicates returns the set elements without any elements which are
+// equal to an earlier element, since transforming different set elements may
+// return equal values.
+func removeDuplicates(tfElements []tftypes.Value) []tftypes.Value {
+	duplicates := valuehash.Duplicates(tfElements)
+
+	if len(duplicates) == 0 {
+		return tfElements
+	}
+
+	result := make([]tftypes.Value, 0, len(tfElements)-len(duplicates))
+
+	for i, tfElement := range tfElements {
+		if len(duplicates) > 0 && duplicates[0] == i {
+			duplicates = duplicates[1:]
+
+			continue
+		}
+
+		result = append(result, tfElement)
+	}
+
+	return result
+}
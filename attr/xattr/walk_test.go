@@ -0,0 +1,385 @@
+package xattr_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	testtypes "github.com/hashicorp/terraform-plugin-framework/internal/testing/types"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	testNestedObjectType = types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"name": types.StringType,
+		},
+	}
+
+	testObjectType = types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"list":   types.ListType{ElemType: testNestedObjectType},
+			"map":    types.MapType{ElemType: types.StringType},
+			"number": types.Int64Type,
+			"set":    types.SetType{ElemType: types.StringType},
+		},
+	}
+)
+
+func testObjectValue(list []string, m map[string]string, set []string) types.Object {
+	listElements := make([]attr.Value, 0, len(list))
+
+	for _, name := range list {
+		listElements = append(listElements, types.ObjectValueMust(
+			testNestedObjectType.AttrTypes,
+			map[string]attr.Value{
+				"name": types.StringValue(name),
+			},
+		))
+	}
+
+	mapElements := make(map[string]attr.Value, len(m))
+
+	for key, value := range m {
+		mapElements[key] = types.StringValue(value)
+	}
+
+	setElements := make([]attr.Value, 0, len(set))
+
+	for _, value := range set {
+		setElements = append(setElements, types.StringValue(value))
+	}
+
+	return types.ObjectValueMust(
+		testObjectType.AttrTypes,
+		map[string]attr.Value{
+			"list":   types.ListValueMust(testNestedObjectType, listElements),
+			"map":    types.MapValueMust(types.StringType, mapElements),
+			"number": types.Int64Value(1),
+			"set":    types.SetValueMust(types.StringType, setElements),
+		},
+	)
+}
+
+func TestWalk(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value         attr.Value
+		descend       func(path.Path, attr.Value) bool
+		expected      []string
+		expectedDiags diag.Diagnostics
+	}{
+		"primitive": {
+			value:    types.StringValue("test"),
+			expected: []string{`: "test"`},
+		},
+		"null": {
+			value:    types.ListNull(types.StringType),
+			expected: []string{`: <null>`},
+		},
+		"unknown": {
+			value:    types.MapUnknown(types.StringType),
+			expected: []string{`: <unknown>`},
+		},
+		"nested": {
+			value: testObjectValue(
+				[]string{"first", "second"},
+				map[string]string{"b": "two", "a": "one"},
+				[]string{"x"},
+			),
+			expected: []string{
+				`: {"list":[{"name":"first"},{"name":"second"}],"map":{"a":"one","b":"two"},"number":1,"set":["x"]}`,
+				`list: [{"name":"first"},{"name":"second"}]`,
+				`list[0]: {"name":"first"}`,
+				`list[0].name: "first"`,
+				`list[1]: {"name":"second"}`,
+				`list[1].name: "second"`,
+				`map: {"a":"one","b":"two"}`,
+				`map["a"]: "one"`,
+				`map["b"]: "two"`,
+				`number: 1`,
+				`set: ["x"]`,
+				`set[Value("x")]: "x"`,
+			},
+		},
+		"skip-descendants": {
+			value: testObjectValue(
+				[]string{"first"},
+				nil,
+				[]string{"x"},
+			),
+			descend: func(p path.Path, _ attr.Value) bool {
+				return !p.Equal(path.Root("list"))
+			},
+			expected: []string{
+				`: {"list":[{"name":"first"}],"map":{},"number":1,"set":["x"]}`,
+				`list: [{"name":"first"}]`,
+				`map: {}`,
+				`number: 1`,
+				`set: ["x"]`,
+				`set[Value("x")]: "x"`,
+			},
+		},
+		"custom-element-type": {
+			value: types.ListValueMust(
+				testtypes.StringType{},
+				[]attr.Value{
+					testtypes.String{InternalString: types.StringValue("custom"), CreatedBy: testtypes.StringType{}},
+				},
+			),
+			expected: []string{
+				`: ["custom"]`,
+				`[0]: "custom"`,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got []string
+
+			diags := xattr.Walk(context.Background(), testCase.value, func(p path.Path, v attr.Value) (bool, diag.Diagnostics) {
+				got = append(got, p.String()+": "+v.String())
+
+				if testCase.descend != nil {
+					return testCase.descend(p, v), nil
+				}
+
+				return true, nil
+			})
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestWalk_error(t *testing.T) {
+	t.Parallel()
+
+	value := types.ListValueMust(types.StringType, []attr.Value{
+		types.StringValue("first"),
+		types.StringValue("second"),
+	})
+	expectedDiags := diag.Diagnostics{
+		diag.NewAttributeErrorDiagnostic(path.Empty().AtListIndex(0), "test summary", "test detail"),
+	}
+
+	var visited int
+
+	diags := xattr.Walk(context.Background(), value, func(p path.Path, v attr.Value) (bool, diag.Diagnostics) {
+		visited++
+
+		if p.Equal(path.Empty().AtListIndex(0)) {
+			return true, diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(p, "test summary", "test detail"),
+			}
+		}
+
+		return true, nil
+	})
+
+	if diff := cmp.Diff(diags, expectedDiags); diff != "" {
+		t.Errorf("unexpected diagnostics difference: %s", diff)
+	}
+
+	if visited != 2 {
+		t.Errorf("expected walk to stop after 2 values, visited %d", visited)
+	}
+}
+
+func TestTransform(t *testing.T) {
+	t.Parallel()
+
+	upper := func(_ path.Path, v attr.Value) (attr.Value, diag.Diagnostics) {
+		s, ok := v.(types.String)
+
+		if !ok || s.IsNull() || s.IsUnknown() {
+			return v, nil
+		}
+
+		return types.StringValue(strings.ToUpper(s.ValueString())), nil
+	}
+
+	testCases := map[string]struct {
+		value         attr.Value
+		callback      xattr.TransformFunc
+		expected      attr.Value
+		expectedDiags diag.Diagnostics
+	}{
+		"primitive": {
+			value:    types.StringValue("test"),
+			callback: upper,
+			expected: types.StringValue("TEST"),
+		},
+		"null": {
+			value:    types.ListNull(types.StringType),
+			callback: upper,
+			expected: types.ListNull(types.StringType),
+		},
+		"nested": {
+			value: testObjectValue(
+				[]string{"first", "second"},
+				map[string]string{"a": "one"},
+				[]string{"x", "y"},
+			),
+			callback: upper,
+			expected: testObjectValue(
+				[]string{"FIRST", "SECOND"},
+				map[string]string{"a": "ONE"},
+				[]string{"X", "Y"},
+			),
+		},
+		"unchanged": {
+			value: testObjectValue(
+				[]string{"first"},
+				map[string]string{"a": "one"},
+				[]string{"x"},
+			),
+			callback: func(_ path.Path, v attr.Value) (attr.Value, diag.Diagnostics) {
+				return v, nil
+			},
+			expected: testObjectValue(
+				[]string{"first"},
+				map[string]string{"a": "one"},
+				[]string{"x"},
+			),
+		},
+		"redact-path": {
+			value: testObjectValue(
+				[]string{"first", "second"},
+				nil,
+				nil,
+			),
+			callback: func(p path.Path, v attr.Value) (attr.Value, diag.Diagnostics) {
+				if p.Equal(path.Root("list").AtListIndex(1).AtName("name")) {
+					return types.StringValue("REDACTED"), nil
+				}
+
+				return v, nil
+			},
+			expected: testObjectValue(
+				[]string{"first", "REDACTED"},
+				nil,
+				nil,
+			),
+		},
+		"children-before-parent": {
+			value: types.ListValueMust(types.StringType, []attr.Value{
+				types.StringValue("first"),
+			}),
+			callback: func(p path.Path, v attr.Value) (attr.Value, diag.Diagnostics) {
+				l, ok := v.(types.List)
+
+				if !ok {
+					return types.StringValue("second"), nil
+				}
+
+				return types.ListValueMust(types.StringType, append(l.Elements(), types.StringValue("third"))), nil
+			},
+			expected: types.ListValueMust(types.StringType, []attr.Value{
+				types.StringValue("second"),
+				types.StringValue("third"),
+			}),
+		},
+		"set-duplicates": {
+			value: types.SetValueMust(types.StringType, []attr.Value{
+				types.StringValue("A"),
+				types.StringValue("b"),
+				types.StringValue("a"),
+			}),
+			callback: func(_ path.Path, v attr.Value) (attr.Value, diag.Diagnostics) {
+				s, ok := v.(types.String)
+
+				if !ok {
+					return v, nil
+				}
+
+				return types.StringValue(strings.ToLower(s.ValueString())), nil
+			},
+			expected: types.SetValueMust(types.StringType, []attr.Value{
+				types.StringValue("a"),
+				types.StringValue("b"),
+			}),
+		},
+		"nil": {
+			value: types.ListValueMust(types.StringType, []attr.Value{
+				types.StringValue("first"),
+			}),
+			callback: func(p path.Path, v attr.Value) (attr.Value, diag.Diagnostics) {
+				if _, ok := v.(types.String); ok {
+					return nil, nil
+				}
+
+				return v, nil
+			},
+			expected: types.ListValueMust(types.StringType, []attr.Value{
+				types.StringValue("first"),
+			}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Empty().AtListIndex(0),
+					"Value Transform Error",
+					"An unexpected error was encountered trying to transform a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Transformed value is nil.",
+				),
+			},
+		},
+		"wrong-type": {
+			value: types.ListValueMust(types.StringType, []attr.Value{
+				types.StringValue("first"),
+			}),
+			callback: func(p path.Path, v attr.Value) (attr.Value, diag.Diagnostics) {
+				if _, ok := v.(types.String); ok {
+					return types.Int64Value(1), nil
+				}
+
+				return v, nil
+			},
+			expected: types.ListValueMust(types.StringType, []attr.Value{
+				types.StringValue("first"),
+			}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Empty(),
+					"Value Transform Error",
+					"An unexpected error was encountered trying to transform a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"transformed value does not conform to tftypes.List[tftypes.String]: ElementKeyInt(0): can't use tftypes.Number as tftypes.String",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := xattr.Transform(context.Background(), testCase.value, testCase.callback)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
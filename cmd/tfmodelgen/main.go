@@ -0,0 +1,164 @@
+// The tfmodelgen command generates Go model structs from a schema in the JSON
+// format produced by tfsdk.Schema.MarshalJSON. It is intended for usage with
+// go generate, for example:
+//
+//	//go:generate go run github.com/hashicorp/terraform-plugin-framework/cmd/tfmodelgen -schema testdata/example_resource_schema.json -struct exampleResourceModel -output example_resource_model_gen.go
+//
+// When run by go generate, the package name defaults to the package of the
+// file containing the directive.
+//
+// Schemas referencing the custom types of the jsontypes, nettypes, or
+// timetypes packages require the -custom-types flag with a comma-separated
+// list of those package names, such as -custom-types nettypes,timetypes.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/modelgen"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/types/nettypes"
+	"github.com/hashicorp/terraform-plugin-framework/types/timetypes"
+)
+
+// customTypePackages are the custom types which can be referenced in the
+// schema JSON, keyed by the package name used in the -custom-types flag.
+var customTypePackages = map[string][]attr.Type{
+	"jsontypes": {
+		jsontypes.NormalizedType{},
+	},
+	"nettypes": {
+		nettypes.CIDRType,
+		nettypes.IPAddressType,
+		nettypes.IPv4AddressType,
+		nettypes.IPv6AddressType,
+		nettypes.MACAddressType,
+	},
+	"timetypes": {
+		timetypes.GoDurationType{},
+		timetypes.RFC3339Type{},
+	},
+}
+
+func main() {
+	if err := run(os.Args[1:]); err != nil {
+		fmt.Fprintf(os.Stderr, "tfmodelgen: %s\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(args []string) error {
+	flags := flag.NewFlagSet("tfmodelgen", flag.ContinueOnError)
+
+	schemaFile := flags.String("schema", "", "path to the schema JSON file (required)")
+	structName := flags.String("struct", "", "name of the generated root struct (required)")
+	packageName := flags.String("package", os.Getenv("GOPACKAGE"), "package name of the generated source, defaults to $GOPACKAGE")
+	output := flags.String("output", "", "path to the generated source file, defaults to standard output")
+	goTypes := flags.Bool("go-types", false, "generate Go built-in types instead of types package values")
+	customTypePackageNames := flags.String("custom-types", "", "comma-separated list of custom type packages referenced in the schema: "+strings.Join(sortedCustomTypePackages(), ", "))
+
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	if *schemaFile == "" {
+		return errors.New("missing -schema flag")
+	}
+
+	if *structName == "" {
+		return errors.New("missing -struct flag")
+	}
+
+	if *packageName == "" {
+		return errors.New("missing -package flag")
+	}
+
+	customTypes, err := customTypes(*customTypePackageNames)
+
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+
+	schemaJSON, err := os.ReadFile(*schemaFile)
+
+	if err != nil {
+		return err
+	}
+
+	schema, err := tfsdk.SchemaFromJSON(ctx, schemaJSON, tfsdk.SchemaJSONOptions{
+		CustomTypes: customTypes,
+	})
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", *schemaFile, err)
+	}
+
+	opts := modelgen.Options{
+		PackageName: *packageName,
+		StructName:  *structName,
+	}
+
+	if *goTypes {
+		opts.ValueTypes = modelgen.ValueTypesGo
+	}
+
+	source, err := modelgen.Generate(ctx, schema, opts)
+
+	if err != nil {
+		return err
+	}
+
+	if *output == "" {
+		_, err = os.Stdout.Write(source)
+
+		return err
+	}
+
+	return os.WriteFile(*output, source, 0644)
+}
+
+// customTypes returns the custom types of the comma-separated package names.
+func customTypes(packageNames string) ([]attr.Type, error) {
+	var result []attr.Type
+
+	if packageNames == "" {
+		return result, nil
+	}
+
+	for _, packageName := range strings.Split(packageNames, ",") {
+		packageName = strings.TrimSpace(packageName)
+		packageTypes, ok := customTypePackages[packageName]
+
+		if !ok {
+			return nil, fmt.Errorf("unknown -custom-types package %q, expected one of: %s", packageName, strings.Join(sortedCustomTypePackages(), ", "))
+		}
+
+		result = append(result, packageTypes...)
+	}
+
+	return result, nil
+}
+
+// sortedCustomTypePackages returns the sorted package names supported by the
+// -custom-types flag.
+func sortedCustomTypePackages() []string {
+	result := make([]string, 0, len(customTypePackages))
+
+	for packageName := range customTypePackages {
+		result = append(result, packageName)
+	}
+
+	sort.Strings(result)
+
+	return result
+}
@@ -0,0 +1,90 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRun(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		args          []string
+		expected      string
+		expectedError string
+	}{
+		"missing-schema": {
+			args:          []string{"-struct", "exampleModel", "-package", "example"},
+			expectedError: "missing -schema flag",
+		},
+		"missing-custom-types": {
+			args:          []string{"-schema", "testdata/example_schema.json", "-struct", "exampleModel", "-package", "example"},
+			expectedError: `testdata/example_schema.json: error decoding address type: unknown custom type "nettypes.IPAddressType", which must be added to SchemaJSONOptions.CustomTypes`,
+		},
+		"unknown-custom-types": {
+			args:          []string{"-schema", "testdata/example_schema.json", "-struct", "exampleModel", "-package", "example", "-custom-types", "nettypes,footypes"},
+			expectedError: `unknown -custom-types package "footypes", expected one of: jsontypes, nettypes, timetypes`,
+		},
+		"custom-types": {
+			args: []string{"-schema", "testdata/example_schema.json", "-struct", "exampleModel", "-package", "example", "-custom-types", "nettypes, timetypes"},
+			expected: `// Code generated from a terraform-plugin-framework schema. DO NOT EDIT.
+
+package example
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/nettypes"
+	"github.com/hashicorp/terraform-plugin-framework/types/timetypes"
+)
+
+// exampleModel describes the data model of the schema.
+type exampleModel struct {
+	Address   nettypes.IPAddress ` + "`" + `tfsdk:"address"` + "`" + `
+	CreatedAt timetypes.RFC3339  ` + "`" + `tfsdk:"created_at"` + "`" + `
+	Name      types.String       ` + "`" + `tfsdk:"name"` + "`" + `
+}
+`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			outputFile := filepath.Join(t.TempDir(), "example_model_gen.go")
+
+			err := run(append(testCase.args, "-output", outputFile))
+
+			if err != nil {
+				if testCase.expectedError == "" {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if diff := cmp.Diff(err.Error(), testCase.expectedError); diff != "" {
+					t.Fatalf("unexpected error difference: %s", diff)
+				}
+
+				return
+			}
+
+			if testCase.expectedError != "" {
+				t.Fatalf("expected error: %s", testCase.expectedError)
+			}
+
+			got, err := os.ReadFile(outputFile)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(string(got), testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -36,6 +36,7 @@ func ApplyResourceChangeRequest(ctx context.Context, proto5 *tfprotov5.ApplyReso
 		PlannedPrivate: proto5.PlannedPrivate,
 		ResourceSchema: *resourceSchema,
 		ResourceType:   resourceType,
+		TypeName:       proto5.TypeName,
 	}
 
 	config, configDiags := Config(ctx, proto5.Config, resourceSchema)
@@ -36,6 +36,7 @@ func PlanResourceChangeRequest(ctx context.Context, proto5 *tfprotov5.PlanResour
 		PriorPrivate:   proto5.PriorPrivate,
 		ResourceSchema: *resourceSchema,
 		ResourceType:   resourceType,
+		TypeName:       proto5.TypeName,
 	}
 
 	config, configDiags := Config(ctx, proto5.Config, resourceSchema)
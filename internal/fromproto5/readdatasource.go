@@ -35,6 +35,7 @@ func ReadDataSourceRequest(ctx context.Context, proto5 *tfprotov5.ReadDataSource
 	fw := &fwserver.ReadDataSourceRequest{
 		DataSourceSchema: *dataSourceSchema,
 		DataSourceType:   dataSourceType,
+		TypeName:         proto5.TypeName,
 	}
 
 	config, configDiags := Config(ctx, proto5.Config, dataSourceSchema)
@@ -21,6 +21,7 @@ func ReadResourceRequest(ctx context.Context, proto5 *tfprotov5.ReadResourceRequ
 	fw := &fwserver.ReadResourceRequest{
 		Private:      proto5.Private,
 		ResourceType: resourceType,
+		TypeName:     proto5.TypeName,
 	}
 
 	currentState, currentStateDiags := State(ctx, proto5.CurrentState, resourceSchema)
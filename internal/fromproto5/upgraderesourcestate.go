@@ -38,6 +38,7 @@ func UpgradeResourceStateRequest(ctx context.Context, proto5 *tfprotov5.UpgradeR
 		ResourceSchema: *resourceSchema,
 		ResourceType:   resourceType,
 		Version:        proto5.Version,
+		TypeName:       proto5.TypeName,
 	}
 
 	return fw, diags
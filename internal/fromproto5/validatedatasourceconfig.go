@@ -16,7 +16,9 @@ func ValidateDataSourceConfigRequest(ctx context.Context, proto5 *tfprotov5.Vali
 		return nil, nil
 	}
 
-	fw := &fwserver.ValidateDataSourceConfigRequest{}
+	fw := &fwserver.ValidateDataSourceConfigRequest{
+		TypeName: proto5.TypeName,
+	}
 
 	config, diags := Config(ctx, proto5.Config, dataSourceSchema)
 
@@ -16,7 +16,9 @@ func ValidateResourceTypeConfigRequest(ctx context.Context, proto5 *tfprotov5.Va
 		return nil, nil
 	}
 
-	fw := &fwserver.ValidateResourceConfigRequest{}
+	fw := &fwserver.ValidateResourceConfigRequest{
+		TypeName: proto5.TypeName,
+	}
 
 	config, diags := Config(ctx, proto5.Config, resourceSchema)
 
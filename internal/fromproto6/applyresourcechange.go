@@ -36,6 +36,7 @@ func ApplyResourceChangeRequest(ctx context.Context, proto6 *tfprotov6.ApplyReso
 		PlannedPrivate: proto6.PlannedPrivate,
 		ResourceSchema: *resourceSchema,
 		ResourceType:   resourceType,
+		TypeName:       proto6.TypeName,
 	}
 
 	config, configDiags := Config(ctx, proto6.Config, resourceSchema)
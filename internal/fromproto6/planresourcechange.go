@@ -36,6 +36,7 @@ func PlanResourceChangeRequest(ctx context.Context, proto6 *tfprotov6.PlanResour
 		PriorPrivate:   proto6.PriorPrivate,
 		ResourceSchema: *resourceSchema,
 		ResourceType:   resourceType,
+		TypeName:       proto6.TypeName,
 	}
 
 	config, configDiags := Config(ctx, proto6.Config, resourceSchema)
@@ -21,6 +21,7 @@ func ReadResourceRequest(ctx context.Context, proto6 *tfprotov6.ReadResourceRequ
 	fw := &fwserver.ReadResourceRequest{
 		Private:      proto6.Private,
 		ResourceType: resourceType,
+		TypeName:     proto6.TypeName,
 	}
 
 	currentState, currentStateDiags := State(ctx, proto6.CurrentState, resourceSchema)
@@ -37,6 +37,7 @@ func UpgradeResourceStateRequest(ctx context.Context, proto6 *tfprotov6.UpgradeR
 		ResourceSchema: *resourceSchema,
 		ResourceType:   resourceType,
 		Version:        proto6.Version,
+		TypeName:       proto6.TypeName,
 	}
 
 	return fw, diags
@@ -16,7 +16,9 @@ func ValidateDataSourceConfigRequest(ctx context.Context, proto6 *tfprotov6.Vali
 		return nil, nil
 	}
 
-	fw := &fwserver.ValidateDataSourceConfigRequest{}
+	fw := &fwserver.ValidateDataSourceConfigRequest{
+		TypeName: proto6.TypeName,
+	}
 
 	config, diags := Config(ctx, proto6.Config, dataSourceSchema)
 
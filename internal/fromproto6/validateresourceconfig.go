@@ -16,7 +16,9 @@ func ValidateResourceConfigRequest(ctx context.Context, proto6 *tfprotov6.Valida
 		return nil, nil
 	}
 
-	fw := &fwserver.ValidateResourceConfigRequest{}
+	fw := &fwserver.ValidateResourceConfigRequest{
+		TypeName: proto6.TypeName,
+	}
 
 	config, diags := Config(ctx, proto6.Config, resourceSchema)
 
@@ -0,0 +1,74 @@
+package fromtftypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// AttributePath returns the path.Path equivalent of a *tftypes.AttributePath.
+// The schema is used to determine the element types of set element steps.
+func AttributePath(ctx context.Context, tfType *tftypes.AttributePath, schema tfsdk.Schema) (path.Path, diag.Diagnostics) {
+	fwPath := path.Empty()
+
+	for idx, tfTypeStep := range tfType.Steps() {
+		currentTfTypeSteps := tftypes.NewAttributePathWithSteps(tfType.Steps()[:idx+1])
+
+		var err error
+
+		fwPath, err = attributePathWithStep(ctx, fwPath, tfTypeStep, currentTfTypeSteps, schema)
+
+		if err != nil {
+			return path.Empty(), diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Unable to Convert Attribute Path",
+					"An unexpected error occurred while trying to convert an attribute path. "+
+						"This is an error in terraform-plugin-framework used by the provider. "+
+						"Please report the following to the provider developers.\n\n"+
+						// Since this is an error with the attribute path
+						// conversion, we cannot return a protocol path-based
+						// diagnostic. Returning a human-readable
+						// representation seems like the next best thing to do.
+						fmt.Sprintf("Attribute Path: %s\n", tfType.String())+
+						fmt.Sprintf("Original Error: %s", err),
+				),
+			}
+		}
+	}
+
+	return fwPath, nil
+}
+
+// attributePathWithStep returns the path.Path with the equivalent of the
+// tftypes.AttributePathStep appended. The stepPath, which includes the step,
+// and the schema are used to determine the attr.Type of set element values.
+func attributePathWithStep(ctx context.Context, fwPath path.Path, tfType tftypes.AttributePathStep, stepPath *tftypes.AttributePath, schema tfsdk.Schema) (path.Path, error) {
+	switch tfType := tfType.(type) {
+	case tftypes.AttributeName:
+		return fwPath.AtName(string(tfType)), nil
+	case tftypes.ElementKeyInt:
+		return fwPath.AtListIndex(int(tfType)), nil
+	case tftypes.ElementKeyString:
+		return fwPath.AtMapKey(string(tfType)), nil
+	case tftypes.ElementKeyValue:
+		attrType, err := schema.AttributeTypeAtPath(stepPath)
+
+		if err != nil {
+			return fwPath, fmt.Errorf("unable to find attr.Type for set element value at %s: %w", stepPath, err)
+		}
+
+		attrValue, err := attrType.ValueFromTerraform(ctx, tftypes.Value(tfType))
+
+		if err != nil {
+			return fwPath, fmt.Errorf("unable to convert tftypes.Value (%s) to attr.Value: %w", tftypes.Value(tfType), err)
+		}
+
+		return fwPath.AtSetValue(attrValue), nil
+	default:
+		return fwPath, fmt.Errorf("unknown tftypes.AttributePathStep: %#v", tfType)
+	}
+}
@@ -0,0 +1,94 @@
+package fromtftypes_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fromtftypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestAttributePath(t *testing.T) {
+	t.Parallel()
+
+	testSchema := tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"test_list": {
+				Optional: true,
+				Type:     types.ListType{ElemType: types.StringType},
+			},
+			"test_map": {
+				Optional: true,
+				Type:     types.MapType{ElemType: types.StringType},
+			},
+			"test_set": {
+				Optional: true,
+				Type:     types.SetType{ElemType: types.StringType},
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		tfType        *tftypes.AttributePath
+		expected      path.Path
+		expectedDiags diag.Diagnostics
+	}{
+		"empty": {
+			tfType:   tftypes.NewAttributePath(),
+			expected: path.Empty(),
+		},
+		"attribute-name": {
+			tfType:   tftypes.NewAttributePath().WithAttributeName("test_list"),
+			expected: path.Root("test_list"),
+		},
+		"element-key-int": {
+			tfType:   tftypes.NewAttributePath().WithAttributeName("test_list").WithElementKeyInt(1),
+			expected: path.Root("test_list").AtListIndex(1),
+		},
+		"element-key-string": {
+			tfType:   tftypes.NewAttributePath().WithAttributeName("test_map").WithElementKeyString("key"),
+			expected: path.Root("test_map").AtMapKey("key"),
+		},
+		"element-key-value": {
+			tfType:   tftypes.NewAttributePath().WithAttributeName("test_set").WithElementKeyValue(tftypes.NewValue(tftypes.String, "value")),
+			expected: path.Root("test_set").AtSetValue(types.StringValue("value")),
+		},
+		"element-key-value-error": {
+			tfType:   tftypes.NewAttributePath().WithAttributeName("test_other").WithElementKeyValue(tftypes.NewValue(tftypes.String, "value")),
+			expected: path.Empty(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Unable to Convert Attribute Path",
+					"An unexpected error occurred while trying to convert an attribute path. "+
+						"This is an error in terraform-plugin-framework used by the provider. "+
+						"Please report the following to the provider developers.\n\n"+
+						"Attribute Path: AttributeName(\"test_other\").ElementKeyValue(tftypes.String<\"value\">)\n"+
+						"Original Error: unable to find attr.Type for set element value at AttributeName(\"test_other\").ElementKeyValue(tftypes.String<\"value\">): AttributeName(\"test_other\").ElementKeyValue(tftypes.String<\"value\">) still remains in the path: could not find attribute or block \"test_other\" in schema",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := fromtftypes.AttributePath(context.Background(), testCase.tfType, testSchema)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
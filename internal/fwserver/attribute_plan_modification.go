@@ -109,7 +109,7 @@ func AttributeModifyPlan(ctx context.Context, a tfsdk.Attribute, req tfsdk.Modif
 			return
 		}
 
-		for idx := range l.Elems {
+		for idx := range l.Elements() {
 			for name, attr := range a.Attributes.GetAttributes() {
 				attrReq := tfsdk.ModifyAttributePlanRequest{
 					AttributePath: req.AttributePath.AtListIndex(idx).AtName(name),
@@ -136,7 +136,7 @@ func AttributeModifyPlan(ctx context.Context, a tfsdk.Attribute, req tfsdk.Modif
 			return
 		}
 
-		for _, value := range s.Elems {
+		for _, value := range s.Elements() {
 			for name, attr := range a.Attributes.GetAttributes() {
 				attrReq := tfsdk.ModifyAttributePlanRequest{
 					AttributePath: req.AttributePath.AtSetValue(value).AtName(name),
@@ -163,7 +163,7 @@ func AttributeModifyPlan(ctx context.Context, a tfsdk.Attribute, req tfsdk.Modif
 			return
 		}
 
-		for key := range m.Elems {
+		for key := range m.Elements() {
 			for name, attr := range a.Attributes.GetAttributes() {
 				attrReq := tfsdk.ModifyAttributePlanRequest{
 					AttributePath: req.AttributePath.AtMapKey(key).AtName(name),
@@ -190,7 +190,7 @@ func AttributeModifyPlan(ctx context.Context, a tfsdk.Attribute, req tfsdk.Modif
 			return
 		}
 
-		if len(o.Attrs) == 0 {
+		if len(o.Attributes()) == 0 {
 			return
 		}
 
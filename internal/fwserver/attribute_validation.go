@@ -3,6 +3,7 @@ package fwserver
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
@@ -74,7 +75,17 @@ func AttributeValidate(ctx context.Context, a tfsdk.Attribute, req tfsdk.Validat
 	// until Terraform CLI versions 0.12 through the release containing the
 	// checks are considered end-of-life.
 	// Reference: https://github.com/hashicorp/terraform/issues/30669
-	if a.Required && attributeConfig.IsNull() {
+	//
+	// Environment variables are only read for top level attributes of the
+	// provider schema.
+	if a.Required && attributeConfig.IsNull() && len(a.EnvVars) > 0 && len(req.AttributePath.Steps()) == 1 {
+		resp.Diagnostics.AddAttributeError(
+			req.AttributePath,
+			"Missing Configuration for Required Attribute",
+			fmt.Sprintf("Must set a configuration value for the %s attribute or the %s environment variable as the provider has marked it as required.\n\n", req.AttributePath.String(), strings.Join(a.EnvVars, " or "))+
+				"Refer to the provider documentation or contact the provider developers for additional information about configurable attributes that are required.",
+		)
+	} else if a.Required && attributeConfig.IsNull() {
 		resp.Diagnostics.AddAttributeError(
 			req.AttributePath,
 			"Missing Configuration for Required Attribute",
@@ -154,7 +165,7 @@ func AttributeValidateNestedAttributes(ctx context.Context, a tfsdk.Attribute, r
 			return
 		}
 
-		for idx := range l.Elems {
+		for idx := range l.Elements() {
 			for nestedName, nestedAttr := range a.Attributes.GetAttributes() {
 				nestedAttrReq := tfsdk.ValidateAttributeRequest{
 					AttributePath: req.AttributePath.AtListIndex(idx).AtName(nestedName),
@@ -183,7 +194,7 @@ func AttributeValidateNestedAttributes(ctx context.Context, a tfsdk.Attribute, r
 			return
 		}
 
-		for _, value := range s.Elems {
+		for _, value := range s.Elements() {
 			for nestedName, nestedAttr := range a.Attributes.GetAttributes() {
 				nestedAttrReq := tfsdk.ValidateAttributeRequest{
 					AttributePath: req.AttributePath.AtSetValue(value).AtName(nestedName),
@@ -212,7 +223,7 @@ func AttributeValidateNestedAttributes(ctx context.Context, a tfsdk.Attribute, r
 			return
 		}
 
-		for key := range m.Elems {
+		for key := range m.Elements() {
 			for nestedName, nestedAttr := range a.Attributes.GetAttributes() {
 				nestedAttrReq := tfsdk.ValidateAttributeRequest{
 					AttributePath: req.AttributePath.AtMapKey(key).AtName(nestedName),
@@ -241,7 +252,7 @@ func AttributeValidateNestedAttributes(ctx context.Context, a tfsdk.Attribute, r
 			return
 		}
 
-		if !o.Null && !o.Unknown {
+		if !o.IsNull() && !o.IsUnknown() {
 			for nestedName, nestedAttr := range a.Attributes.GetAttributes() {
 				nestedAttrReq := tfsdk.ValidateAttributeRequest{
 					AttributePath: req.AttributePath.AtName(nestedName),
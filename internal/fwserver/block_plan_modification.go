@@ -88,7 +88,7 @@ func BlockModifyPlan(ctx context.Context, b tfsdk.Block, req tfsdk.ModifyAttribu
 			return
 		}
 
-		for idx := range l.Elems {
+		for idx := range l.Elements() {
 			for name, attr := range b.Attributes {
 				attrReq := tfsdk.ModifyAttributePlanRequest{
 					AttributePath: req.AttributePath.AtListIndex(idx).AtName(name),
@@ -127,7 +127,7 @@ func BlockModifyPlan(ctx context.Context, b tfsdk.Block, req tfsdk.ModifyAttribu
 			return
 		}
 
-		for _, value := range s.Elems {
+		for _, value := range s.Elements() {
 			for name, attr := range b.Attributes {
 				attrReq := tfsdk.ModifyAttributePlanRequest{
 					AttributePath: req.AttributePath.AtSetValue(value).AtName(name),
@@ -821,14 +821,11 @@ func (t testBlockPlanModifierNullList) Modify(ctx context.Context, req tfsdk.Mod
 		return
 	}
 
-	resp.AttributePlan = types.List{
-		ElemType: types.ObjectType{
-			AttrTypes: map[string]attr.Type{
-				"nested_attr": types.StringType,
-			},
+	resp.AttributePlan = types.ListNull(types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"nested_attr": types.StringType,
 		},
-		Null: true,
-	}
+	})
 }
 
 func (t testBlockPlanModifierNullList) Description(ctx context.Context) string {
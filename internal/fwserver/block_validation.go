@@ -44,7 +44,7 @@ func BlockValidate(ctx context.Context, b tfsdk.Block, req tfsdk.ValidateAttribu
 			return
 		}
 
-		for idx := range l.Elems {
+		for idx := range l.Elements() {
 			for name, attr := range b.Attributes {
 				nestedAttrReq := tfsdk.ValidateAttributeRequest{
 					AttributePath: req.AttributePath.AtListIndex(idx).AtName(name),
@@ -87,7 +87,7 @@ func BlockValidate(ctx context.Context, b tfsdk.Block, req tfsdk.ValidateAttribu
 			return
 		}
 
-		for _, value := range s.Elems {
+		for _, value := range s.Elements() {
 			for name, attr := range b.Attributes {
 				nestedAttrReq := tfsdk.ValidateAttributeRequest{
 					AttributePath: req.AttributePath.AtSetValue(value).AtName(name),
@@ -0,0 +1,151 @@
+package fwserver
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"os"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// ConfigWithEnvVars returns a copy of the provider configuration where null
+// top level attribute values are replaced with the value of the first set
+// environment variable in the tfsdk.Attribute EnvVars field. Environment
+// variables set to an empty string are treated as unset, matching the
+// terraform-plugin-sdk MultiEnvDefaultFunc. Unknown values are not replaced.
+// The lookupEnv function is used to read environment variables, defaulting
+// to os.LookupEnv if nil.
+func ConfigWithEnvVars(ctx context.Context, config tfsdk.Config, lookupEnv func(string) (string, bool)) (tfsdk.Config, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if lookupEnv == nil {
+		lookupEnv = os.LookupEnv
+	}
+
+	var names []string
+
+	for name, attribute := range config.Schema.Attributes {
+		if len(attribute.EnvVars) == 0 {
+			continue
+		}
+
+		names = append(names, name)
+	}
+
+	if len(names) == 0 || config.Raw.IsNull() || !config.Raw.IsKnown() {
+		return config, diags
+	}
+
+	// Sort for consistent diagnostics ordering.
+	sort.Strings(names)
+
+	var rawValues map[string]tftypes.Value
+
+	err := config.Raw.As(&rawValues)
+
+	if err != nil {
+		diags.AddError(
+			"Configuration Read Error",
+			"An unexpected error was encountered trying to read the configuration. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+		)
+
+		return config, diags
+	}
+
+	// The map returned by As shares memory with the original value, so
+	// modifications are made to a copy.
+	values := make(map[string]tftypes.Value, len(rawValues))
+
+	for name, value := range rawValues {
+		values[name] = value
+	}
+
+	var modified bool
+
+	for _, name := range names {
+		attribute := config.Schema.Attributes[name]
+		attributePath := path.Root(name)
+
+		value, ok := values[name]
+
+		if !ok || !value.IsNull() {
+			continue
+		}
+
+		for _, envVar := range attribute.EnvVars {
+			envValue, ok := lookupEnv(envVar)
+
+			if !ok || envValue == "" {
+				continue
+			}
+
+			logging.FrameworkDebug(
+				ctx,
+				"Using environment variable for null provider configuration value",
+				map[string]interface{}{
+					logging.KeyAttributePath: attributePath.String(),
+					logging.KeyEnvVar:        envVar,
+				},
+			)
+
+			newValue, err := envVarValue(value.Type(), envValue)
+
+			if err != nil {
+				diags.AddAttributeError(
+					attributePath,
+					"Invalid Environment Variable Value",
+					fmt.Sprintf("The %s environment variable value cannot be used for the %s attribute: %s", envVar, attributePath, err),
+				)
+
+				break
+			}
+
+			values[name] = newValue
+			modified = true
+
+			break
+		}
+	}
+
+	if diags.HasError() || !modified {
+		return config, diags
+	}
+
+	config.Raw = tftypes.NewValue(config.Raw.Type(), values)
+
+	return config, diags
+}
+
+// envVarValue converts an environment variable value into a tftypes.Value of
+// the given primitive type.
+func envVarValue(typ tftypes.Type, value string) (tftypes.Value, error) {
+	switch {
+	case typ.Is(tftypes.String):
+		return tftypes.NewValue(typ, value), nil
+	case typ.Is(tftypes.Bool):
+		b, err := strconv.ParseBool(value)
+
+		if err != nil {
+			return tftypes.Value{}, fmt.Errorf("unable to convert %q to bool", value)
+		}
+
+		return tftypes.NewValue(typ, b), nil
+	case typ.Is(tftypes.Number):
+		n, _, err := big.ParseFloat(value, 10, 512, big.ToNearestEven)
+
+		if err != nil {
+			return tftypes.Value{}, fmt.Errorf("unable to convert %q to number", value)
+		}
+
+		return tftypes.NewValue(typ, n), nil
+	default:
+		return tftypes.Value{}, fmt.Errorf("environment variables are only supported for bool, number, and string attributes, got: %s", typ)
+	}
+}
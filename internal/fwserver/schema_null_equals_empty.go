@@ -0,0 +1,107 @@
+package fwserver
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/valuehash"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// SchemaNullEqualsEmpty returns a copy of newRaw where any list, set, or map
+// value of an attribute with NullEqualsEmpty enabled is replaced by the value
+// at the same path in priorRaw, if one of the values is null and the other is
+// empty. If the value in priorRaw is unknown, the value at the same path in
+// configRaw is used instead. This is used after Create, Read, and Update so
+// remote systems returning an empty collection instead of null, or vice versa,
+// do not cause plan differences or inconsistent result errors.
+func SchemaNullEqualsEmpty(ctx context.Context, schema tfsdk.Schema, priorRaw tftypes.Value, configRaw tftypes.Value, newRaw tftypes.Value) (tftypes.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if newRaw.IsNull() || !newRaw.IsKnown() {
+		return newRaw, diags
+	}
+
+	priorLookup := valuehash.NewPathLookup(priorRaw)
+	configLookup := valuehash.NewPathLookup(configRaw)
+
+	result, err := tftypes.Transform(newRaw, func(tfPath *tftypes.AttributePath, newValue tftypes.Value) (tftypes.Value, error) {
+		if len(tfPath.Steps()) == 0 || !isNullOrEmptyCollection(newValue) {
+			return newValue, nil
+		}
+
+		attribute, err := schema.AttributeAtPath(tfPath)
+
+		if err != nil || !attribute.NullEqualsEmpty {
+			return newValue, nil //nolint:nilerr // Paths which are not attributes are left unchanged.
+		}
+
+		referenceValue, ok := valueAtPath(priorLookup, tfPath)
+
+		if ok && !referenceValue.IsKnown() {
+			referenceValue, ok = valueAtPath(configLookup, tfPath)
+		}
+
+		if !ok || !referenceValue.IsKnown() || !isNullOrEmptyCollection(referenceValue) {
+			return newValue, nil
+		}
+
+		return referenceValue, nil
+	})
+
+	if err != nil {
+		diags.AddError(
+			"Null Equals Empty Error",
+			"An unexpected error was encountered while comparing the new resource state with the prior value. "+
+				"This is always an issue with terraform-plugin-framework. Please report this to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return newRaw, diags
+	}
+
+	return result, diags
+}
+
+// isNullOrEmptyCollection returns true if the value is a null or known and
+// empty list, set, or map.
+func isNullOrEmptyCollection(value tftypes.Value) bool {
+	typ := value.Type()
+
+	if typ == nil || !value.IsKnown() {
+		return false
+	}
+
+	switch {
+	case typ.Is(tftypes.List{}), typ.Is(tftypes.Set{}):
+		if value.IsNull() {
+			return true
+		}
+
+		var elements []tftypes.Value
+
+		return value.As(&elements) == nil && len(elements) == 0
+	case typ.Is(tftypes.Map{}):
+		if value.IsNull() {
+			return true
+		}
+
+		var elements map[string]tftypes.Value
+
+		return value.As(&elements) == nil && len(elements) == 0
+	default:
+		return false
+	}
+}
+
+// valueAtPath returns the value at the path, if it exists.
+func valueAtPath(lookup *valuehash.PathLookup, tfPath *tftypes.AttributePath) (tftypes.Value, bool) {
+	value, err := lookup.Value(tfPath)
+
+	if err != nil {
+		return tftypes.Value{}, false
+	}
+
+	return value, true
+}
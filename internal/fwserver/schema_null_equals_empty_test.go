@@ -0,0 +1,139 @@
+package fwserver_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestSchemaNullEqualsEmpty(t *testing.T) {
+	t.Parallel()
+
+	testSchema := tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"test_list": {
+				Computed:        true,
+				NullEqualsEmpty: true,
+				Optional:        true,
+				Type:            types.ListType{ElemType: types.StringType},
+			},
+			"test_map": {
+				Computed:        true,
+				NullEqualsEmpty: true,
+				Optional:        true,
+				Type:            types.MapType{ElemType: types.StringType},
+			},
+			"test_set": {
+				Computed: true,
+				Optional: true,
+				Type:     types.SetType{ElemType: types.StringType},
+			},
+		},
+	}
+
+	testType := testSchema.TerraformType(context.Background())
+	testListType := tftypes.List{ElementType: tftypes.String}
+	testMapType := tftypes.Map{ElementType: tftypes.String}
+	testSetType := tftypes.Set{ElementType: tftypes.String}
+
+	testValue := func(list interface{}, m interface{}, set interface{}) tftypes.Value {
+		return tftypes.NewValue(testType, map[string]tftypes.Value{
+			"test_list": tftypes.NewValue(testListType, list),
+			"test_map":  tftypes.NewValue(testMapType, m),
+			"test_set":  tftypes.NewValue(testSetType, set),
+		})
+	}
+
+	testCases := map[string]struct {
+		priorRaw      tftypes.Value
+		configRaw     tftypes.Value
+		newRaw        tftypes.Value
+		expected      tftypes.Value
+		expectedDiags diag.Diagnostics
+	}{
+		"new-null": {
+			priorRaw:  testValue(nil, nil, nil),
+			configRaw: testValue(nil, nil, nil),
+			newRaw:    tftypes.NewValue(testType, nil),
+			expected:  tftypes.NewValue(testType, nil),
+		},
+		"prior-null-new-empty": {
+			priorRaw:  testValue(nil, nil, nil),
+			configRaw: testValue(nil, nil, nil),
+			newRaw:    testValue([]tftypes.Value{}, map[string]tftypes.Value{}, []tftypes.Value{}),
+			expected:  testValue(nil, nil, []tftypes.Value{}),
+		},
+		"prior-empty-new-null": {
+			priorRaw:  testValue([]tftypes.Value{}, map[string]tftypes.Value{}, []tftypes.Value{}),
+			configRaw: testValue([]tftypes.Value{}, map[string]tftypes.Value{}, []tftypes.Value{}),
+			newRaw:    testValue(nil, nil, nil),
+			expected:  testValue([]tftypes.Value{}, map[string]tftypes.Value{}, nil),
+		},
+		"prior-unknown-config-null": {
+			priorRaw:  testValue(tftypes.UnknownValue, tftypes.UnknownValue, tftypes.UnknownValue),
+			configRaw: testValue(nil, nil, nil),
+			newRaw:    testValue([]tftypes.Value{}, map[string]tftypes.Value{}, []tftypes.Value{}),
+			expected:  testValue(nil, nil, []tftypes.Value{}),
+		},
+		"prior-unknown-config-missing": {
+			priorRaw:  testValue(tftypes.UnknownValue, tftypes.UnknownValue, tftypes.UnknownValue),
+			configRaw: tftypes.NewValue(testType, nil),
+			newRaw:    testValue([]tftypes.Value{}, map[string]tftypes.Value{}, []tftypes.Value{}),
+			expected:  testValue([]tftypes.Value{}, map[string]tftypes.Value{}, []tftypes.Value{}),
+		},
+		"prior-missing": {
+			priorRaw:  tftypes.NewValue(testType, nil),
+			configRaw: tftypes.NewValue(testType, nil),
+			newRaw:    testValue([]tftypes.Value{}, map[string]tftypes.Value{}, []tftypes.Value{}),
+			expected:  testValue([]tftypes.Value{}, map[string]tftypes.Value{}, []tftypes.Value{}),
+		},
+		"prior-known-new-empty": {
+			priorRaw: testValue(
+				[]tftypes.Value{tftypes.NewValue(tftypes.String, "test")},
+				map[string]tftypes.Value{"key": tftypes.NewValue(tftypes.String, "test")},
+				nil,
+			),
+			configRaw: testValue(nil, nil, nil),
+			newRaw:    testValue([]tftypes.Value{}, map[string]tftypes.Value{}, nil),
+			expected:  testValue([]tftypes.Value{}, map[string]tftypes.Value{}, nil),
+		},
+		"new-known": {
+			priorRaw:  testValue(nil, nil, nil),
+			configRaw: testValue(nil, nil, nil),
+			newRaw: testValue(
+				[]tftypes.Value{tftypes.NewValue(tftypes.String, "test")},
+				map[string]tftypes.Value{"key": tftypes.NewValue(tftypes.String, "test")},
+				nil,
+			),
+			expected: testValue(
+				[]tftypes.Value{tftypes.NewValue(tftypes.String, "test")},
+				map[string]tftypes.Value{"key": tftypes.NewValue(tftypes.String, "test")},
+				nil,
+			),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := fwserver.SchemaNullEqualsEmpty(context.Background(), testSchema, testCase.priorRaw, testCase.configRaw, testCase.newRaw)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,99 @@
+package fwserver
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fromtftypes"
+	"github.com/hashicorp/terraform-plugin-framework/internal/valuehash"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// SchemaSemanticEquality returns a copy of newRaw where any value that
+// implements xattr.ValueWithSemanticEquals is replaced by the value at the
+// same path in priorRaw, if both are known and not null and the values are
+// semantically equal. This is used after Create, Read, and Update so
+// differences in formatting, such as JSON whitespace, do not cause plan
+// differences or inconsistent result errors.
+func SchemaSemanticEquality(ctx context.Context, schema tfsdk.Schema, priorRaw tftypes.Value, newRaw tftypes.Value) (tftypes.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if priorRaw.IsNull() || !priorRaw.IsKnown() || newRaw.IsNull() || !newRaw.IsKnown() {
+		return newRaw, diags
+	}
+
+	priorLookup := valuehash.NewPathLookup(priorRaw)
+
+	result, err := tftypes.Transform(newRaw, func(tfPath *tftypes.AttributePath, newValue tftypes.Value) (tftypes.Value, error) {
+		if len(tfPath.Steps()) == 0 || newValue.IsNull() || !newValue.IsKnown() {
+			return newValue, nil
+		}
+
+		attrType, err := schema.AttributeTypeAtPath(tfPath)
+
+		if err != nil {
+			return newValue, nil //nolint:nilerr // Paths outside the schema are left unchanged.
+		}
+
+		newAttrValue, err := attrType.ValueFromTerraform(ctx, newValue)
+
+		if err != nil {
+			return newValue, nil //nolint:nilerr // Conversion errors are reported when the state is used.
+		}
+
+		newSemanticValue, ok := newAttrValue.(xattr.ValueWithSemanticEquals)
+
+		if !ok {
+			return newValue, nil
+		}
+
+		priorValue, err := priorLookup.Value(tfPath)
+
+		if err != nil {
+			return newValue, nil //nolint:nilerr // The prior value does not exist at this path.
+		}
+
+		if priorValue.IsNull() || !priorValue.IsKnown() {
+			return newValue, nil
+		}
+
+		priorAttrValue, err := attrType.ValueFromTerraform(ctx, priorValue)
+
+		if err != nil {
+			return newValue, nil //nolint:nilerr // Conversion errors are reported when the state is used.
+		}
+
+		equal, equalDiags := newSemanticValue.SemanticEquals(ctx, priorAttrValue)
+
+		if len(equalDiags) > 0 {
+			attrPath, attrPathDiags := fromtftypes.AttributePath(ctx, tfPath, schema)
+
+			diags.Append(attrPathDiags...)
+
+			for _, equalDiag := range equalDiags {
+				diags.Append(diag.WithPath(attrPath, equalDiag))
+			}
+		}
+
+		if !equal || equalDiags.HasError() {
+			return newValue, nil
+		}
+
+		return priorValue, nil
+	})
+
+	if err != nil {
+		diags.AddError(
+			"Semantic Equality Error",
+			"An unexpected error was encountered while comparing the new resource state with the prior value. "+
+				"This is always an issue with terraform-plugin-framework. Please report this to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return newRaw, diags
+	}
+
+	return result, diags
+}
@@ -0,0 +1,122 @@
+package fwserver_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/jsontypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestSchemaSemanticEquality(t *testing.T) {
+	t.Parallel()
+
+	testSchema := tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"test_json": {
+				Optional: true,
+				Type:     jsontypes.NormalizedType{},
+			},
+			"test_json_list": {
+				Optional: true,
+				Type:     types.ListType{ElemType: jsontypes.NormalizedType{}},
+			},
+			"test_string": {
+				Optional: true,
+				Type:     types.StringType,
+			},
+		},
+	}
+
+	testType := testSchema.TerraformType(context.Background())
+
+	testValue := func(json string, jsonList []string, str string) tftypes.Value {
+		var jsonListValues []tftypes.Value
+
+		for _, element := range jsonList {
+			jsonListValues = append(jsonListValues, tftypes.NewValue(tftypes.String, element))
+		}
+
+		return tftypes.NewValue(testType, map[string]tftypes.Value{
+			"test_json":      tftypes.NewValue(tftypes.String, json),
+			"test_json_list": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, jsonListValues),
+			"test_string":    tftypes.NewValue(tftypes.String, str),
+		})
+	}
+
+	testCases := map[string]struct {
+		priorRaw      tftypes.Value
+		newRaw        tftypes.Value
+		expected      tftypes.Value
+		expectedDiags diag.Diagnostics
+	}{
+		"prior-null": {
+			priorRaw: tftypes.NewValue(testType, nil),
+			newRaw:   testValue(`{"a":1}`, nil, "test"),
+			expected: testValue(`{"a":1}`, nil, "test"),
+		},
+		"new-null": {
+			priorRaw: testValue(`{"a":1}`, nil, "test"),
+			newRaw:   tftypes.NewValue(testType, nil),
+			expected: tftypes.NewValue(testType, nil),
+		},
+		"semantically-equal": {
+			priorRaw: testValue(`{"a": 1, "b": [true]}`, []string{`{"c": "d"}`}, "test"),
+			newRaw:   testValue(`{"b":[true],"a":1}`, []string{`{"c":"d"}`}, "test"),
+			expected: testValue(`{"a": 1, "b": [true]}`, []string{`{"c": "d"}`}, "test"),
+		},
+		"not-semantically-equal": {
+			priorRaw: testValue(`{"a": 1}`, []string{`{"c": "d"}`}, "test"),
+			newRaw:   testValue(`{"a":2}`, []string{`{"c":"e"}`}, "test"),
+			expected: testValue(`{"a":2}`, []string{`{"c":"e"}`}, "test"),
+		},
+		"not-semantic-type": {
+			priorRaw: testValue(`{}`, nil, `{"a": 1}`),
+			newRaw:   testValue(`{}`, nil, `{"a":1}`),
+			expected: testValue(`{}`, nil, `{"a":1}`),
+		},
+		"new-element": {
+			priorRaw: testValue(`{}`, []string{`{"c": "d"}`}, "test"),
+			newRaw:   testValue(`{}`, []string{`{"c":"d"}`, `{"e":"f"}`}, "test"),
+			expected: testValue(`{}`, []string{`{"c": "d"}`, `{"e":"f"}`}, "test"),
+		},
+		"invalid-json": {
+			priorRaw: testValue(`{"a": 1}`, nil, "test"),
+			newRaw:   testValue(`{"a":`, nil, "test"),
+			expected: testValue(`{"a":`, nil, "test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test_json"),
+					"Semantic Equality Check Error",
+					"An unexpected error occurred while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Error: unexpected EOF",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := fwserver.SchemaSemanticEquality(context.Background(), testSchema, testCase.priorRaw, testCase.newRaw)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
@@ -16,6 +16,10 @@ type ValidateSchemaRequest struct {
 	// interpolation or other functionality that would prevent Terraform
 	// from knowing the value at request time.
 	Config tfsdk.Config
+
+	// ProviderSchema is true when validating the provider schema, which is
+	// the only schema where the tfsdk.Attribute EnvVars field is supported.
+	ProviderSchema bool
 }
 
 // ValidateSchemaResponse represents a response to a
@@ -34,6 +38,11 @@ type ValidateSchemaResponse struct {
 // Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/365
 func SchemaValidate(ctx context.Context, s tfsdk.Schema, req ValidateSchemaRequest, resp *ValidateSchemaResponse) {
 	for name, attribute := range s.Attributes {
+		// Environment variables are only read for the provider schema, so
+		// they cannot satisfy a Required attribute elsewhere.
+		if !req.ProviderSchema {
+			attribute.EnvVars = nil
+		}
 
 		attributeReq := tfsdk.ValidateAttributeRequest{
 			AttributePath: path.Root(name),
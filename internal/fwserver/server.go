@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
@@ -16,6 +17,29 @@ import (
 type Server struct {
 	Provider tfsdk.Provider
 
+	// Interceptors wrap each RPC, in order, such as for logging or
+	// modifying diagnostics.
+	Interceptors []Interceptor
+
+	// LookupEnv is used to read the environment variables defined in the
+	// provider schema tfsdk.Attribute EnvVars field. If nil, os.LookupEnv is
+	// used. This allows tests to inject a fake environment.
+	LookupEnv func(string) (string, bool)
+
+	// ShutdownTimeout is the maximum duration to wait for the provider
+	// defined Stop and Close methods. If zero, DefaultShutdownTimeout is used.
+	ShutdownTimeout time.Duration
+
+	// closeProviderOnce ensures the provider defined Close method is only
+	// called once.
+	closeProviderOnce sync.Once
+
+	// dataSourceConfigureData is the
+	// tfsdk.ConfigureProviderResponse.DataSourceData field value which is
+	// passed to the DataSourceWithConfigure Configure method. It is set by
+	// the ConfigureProvider RPC.
+	dataSourceConfigureData interface{}
+
 	// dataSourceSchemas is the cached DataSource Schemas for RPCs that need to
 	// convert configuration data from the protocol. If not found, it will be
 	// fetched from the DataSourceType.GetSchema() method.
@@ -58,6 +82,22 @@ type Server struct {
 	// access from race conditions.
 	providerSchemaMutex sync.Mutex
 
+	// providerConfigureMutex is a mutex to protect concurrent
+	// dataSourceConfigureData, providerConfigured, providerDeferred, and
+	// resourceConfigureData access from race conditions.
+	providerConfigureMutex sync.Mutex
+
+	// providerConfigured is whether the ConfigureProvider RPC completed
+	// without error diagnostics. DataSourceWithConfigure and
+	// ResourceWithConfigure implementations require this to be true.
+	providerConfigured bool
+
+	// providerDeferred is the tfsdk.ConfigureProviderResponse.Deferred field
+	// value, which is passed to the ProviderDeferred field of resource
+	// ModifyPlan and Read requests and data source Read requests. It is set
+	// by the ConfigureProvider RPC.
+	providerDeferred bool
+
 	// providerMetaSchema is the cached Provider Meta Schema for RPCs that need
 	// to convert configuration data from the protocol. If not found, it will
 	// be fetched from the Provider.GetMetaSchema() method.
@@ -72,6 +112,12 @@ type Server struct {
 	// access from race conditions.
 	providerMetaSchemaMutex sync.Mutex
 
+	// resourceConfigureData is the
+	// tfsdk.ConfigureProviderResponse.ResourceData field value which is
+	// passed to the ResourceWithConfigure Configure method. It is set by the
+	// ConfigureProvider RPC.
+	resourceConfigureData interface{}
+
 	// resourceSchemas is the cached Resource Schemas for RPCs that need to
 	// convert configuration data from the protocol. If not found, it will be
 	// fetched from the ResourceType.GetSchema() method.
@@ -178,8 +224,9 @@ func (s *Server) DataSourceType(ctx context.Context, typeName string) (tfsdk.Dat
 	return dataSourceType, diags
 }
 
-// DataSourceTypes returns the map of DataSourceTypes. The results are cached
-// on first use.
+// DataSourceTypes returns the map of DataSourceTypes, including data sources
+// registered through the tfsdk.ProviderWithDataSources interface. The
+// results are cached on first use.
 func (s *Server) DataSourceTypes(ctx context.Context) (map[string]tfsdk.DataSourceType, diag.Diagnostics) {
 	logging.FrameworkTrace(ctx, "Checking DataSourceTypes lock")
 	s.dataSourceTypesMutex.Lock()
@@ -190,9 +237,41 @@ func (s *Server) DataSourceTypes(ctx context.Context) (map[string]tfsdk.DataSour
 	}
 
 	logging.FrameworkDebug(ctx, "Calling provider defined Provider GetDataSources")
-	s.dataSourceTypes, s.dataSourceTypesDiags = s.Provider.GetDataSources(ctx)
+	dataSourceTypes, diags := s.Provider.GetDataSources(ctx)
 	logging.FrameworkDebug(ctx, "Called provider defined Provider GetDataSources")
 
+	s.dataSourceTypes = dataSourceTypes
+	s.dataSourceTypesDiags = diags
+
+	if s.dataSourceTypesDiags.HasError() {
+		return s.dataSourceTypes, s.dataSourceTypesDiags
+	}
+
+	factoryTypes, diags := s.dataSourceFactoryTypes(ctx)
+
+	s.dataSourceTypesDiags.Append(diags...)
+
+	if len(factoryTypes) == 0 {
+		return s.dataSourceTypes, s.dataSourceTypesDiags
+	}
+
+	// Copy to prevent modifying the provider defined map.
+	s.dataSourceTypes = make(map[string]tfsdk.DataSourceType, len(dataSourceTypes)+len(factoryTypes))
+
+	for typeName, dataSourceType := range dataSourceTypes {
+		s.dataSourceTypes[typeName] = dataSourceType
+	}
+
+	for typeName, dataSourceType := range factoryTypes {
+		if _, ok := s.dataSourceTypes[typeName]; ok {
+			s.dataSourceTypesDiags.Append(duplicateDataSourceTypeDiagnostic(typeName))
+
+			continue
+		}
+
+		s.dataSourceTypes[typeName] = dataSourceType
+	}
+
 	return s.dataSourceTypes, s.dataSourceTypesDiags
 }
 
@@ -323,8 +402,9 @@ func (s *Server) ResourceType(ctx context.Context, typeName string) (tfsdk.Resou
 	return resourceType, diags
 }
 
-// ResourceTypes returns the map of ResourceTypes. The results are cached
-// on first use.
+// ResourceTypes returns the map of ResourceTypes, including resources
+// registered through the tfsdk.ProviderWithResources interface. The results
+// are cached on first use.
 func (s *Server) ResourceTypes(ctx context.Context) (map[string]tfsdk.ResourceType, diag.Diagnostics) {
 	logging.FrameworkTrace(ctx, "Checking ResourceTypes lock")
 	s.resourceTypesMutex.Lock()
@@ -335,8 +415,40 @@ func (s *Server) ResourceTypes(ctx context.Context) (map[string]tfsdk.ResourceTy
 	}
 
 	logging.FrameworkDebug(ctx, "Calling provider defined Provider GetResources")
-	s.resourceTypes, s.resourceTypesDiags = s.Provider.GetResources(ctx)
+	resourceTypes, diags := s.Provider.GetResources(ctx)
 	logging.FrameworkDebug(ctx, "Called provider defined Provider GetResources")
 
+	s.resourceTypes = resourceTypes
+	s.resourceTypesDiags = diags
+
+	if s.resourceTypesDiags.HasError() {
+		return s.resourceTypes, s.resourceTypesDiags
+	}
+
+	factoryTypes, diags := s.resourceFactoryTypes(ctx)
+
+	s.resourceTypesDiags.Append(diags...)
+
+	if len(factoryTypes) == 0 {
+		return s.resourceTypes, s.resourceTypesDiags
+	}
+
+	// Copy to prevent modifying the provider defined map.
+	s.resourceTypes = make(map[string]tfsdk.ResourceType, len(resourceTypes)+len(factoryTypes))
+
+	for typeName, resourceType := range resourceTypes {
+		s.resourceTypes[typeName] = resourceType
+	}
+
+	for typeName, resourceType := range factoryTypes {
+		if _, ok := s.resourceTypes[typeName]; ok {
+			s.resourceTypesDiags.Append(duplicateResourceTypeDiagnostic(typeName))
+
+			continue
+		}
+
+		s.resourceTypes[typeName] = resourceType
+	}
+
 	return s.resourceTypes, s.resourceTypesDiags
 }
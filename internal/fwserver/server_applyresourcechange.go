@@ -18,6 +18,10 @@ type ApplyResourceChangeRequest struct {
 	ProviderMeta   *tfsdk.Config
 	ResourceSchema tfsdk.Schema
 	ResourceType   tfsdk.ResourceType
+
+	// TypeName is the resource type name, which is passed to
+	// interceptors.
+	TypeName string
 }
 
 // ApplyResourceChangeResponse is the framework server response for the
@@ -30,6 +34,27 @@ type ApplyResourceChangeResponse struct {
 
 // ApplyResourceChange implements the framework server ApplyResourceChange RPC.
 func (s *Server) ApplyResourceChange(ctx context.Context, req *ApplyResourceChangeRequest, resp *ApplyResourceChangeResponse) {
+	interceptorReq := InterceptorRequest{
+		RPC: RPCApplyResourceChange,
+	}
+
+	if req != nil {
+		interceptorReq.Config = req.Config
+		interceptorReq.Plan = req.PlannedState
+		interceptorReq.State = req.PriorState
+		interceptorReq.TypeName = req.TypeName
+	}
+
+	s.intercept(ctx, interceptorReq, &resp.Diagnostics, func(ctx context.Context, interceptorResp *InterceptorResponse) {
+		s.applyResourceChange(ctx, req, resp)
+
+		interceptorResp.State = resp.NewState
+	})
+}
+
+// applyResourceChange contains the ApplyResourceChange RPC logic, which is
+// called through any Interceptors.
+func (s *Server) applyResourceChange(ctx context.Context, req *ApplyResourceChangeRequest, resp *ApplyResourceChangeResponse) {
 	if req == nil {
 		return
 	}
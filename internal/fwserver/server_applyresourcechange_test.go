@@ -109,8 +109,8 @@ func TestServerApplyResourceChange(t *testing.T) {
 
 								resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 
-								if data.TestRequired.Value != "test-config-value" {
-									resp.Diagnostics.AddError("unexpected req.Config value: %s", data.TestRequired.Value)
+								if data.TestRequired.ValueString() != "test-config-value" {
+									resp.Diagnostics.AddError("unexpected req.Config value: %s", data.TestRequired.ValueString())
 								}
 							},
 							DeleteMethod: func(_ context.Context, _ tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
@@ -153,8 +153,8 @@ func TestServerApplyResourceChange(t *testing.T) {
 
 								resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 
-								if data.TestRequired.Value != "test-plannedstate-value" {
-									resp.Diagnostics.AddError("unexpected req.Plan value: %s", data.TestRequired.Value)
+								if data.TestRequired.ValueString() != "test-plannedstate-value" {
+									resp.Diagnostics.AddError("unexpected req.Plan value: %s", data.TestRequired.ValueString())
 								}
 							},
 							DeleteMethod: func(_ context.Context, _ tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
@@ -190,8 +190,8 @@ func TestServerApplyResourceChange(t *testing.T) {
 
 								resp.Diagnostics.Append(req.ProviderMeta.Get(ctx, &data)...)
 
-								if data.TestProviderMetaAttribute.Value != "test-provider-meta-value" {
-									resp.Diagnostics.AddError("unexpected req.ProviderMeta value: %s", data.TestProviderMetaAttribute.Value)
+								if data.TestProviderMetaAttribute.ValueString() != "test-provider-meta-value" {
+									resp.Diagnostics.AddError("unexpected req.ProviderMeta value: %s", data.TestProviderMetaAttribute.ValueString())
 								}
 							},
 							DeleteMethod: func(_ context.Context, _ tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
@@ -333,8 +333,8 @@ func TestServerApplyResourceChange(t *testing.T) {
 
 								resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 
-								if data.TestRequired.Value != "test-priorstate-value" {
-									resp.Diagnostics.AddError("unexpected req.State value: %s", data.TestRequired.Value)
+								if data.TestRequired.ValueString() != "test-priorstate-value" {
+									resp.Diagnostics.AddError("unexpected req.State value: %s", data.TestRequired.ValueString())
 								}
 							},
 							UpdateMethod: func(_ context.Context, _ tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
@@ -376,8 +376,8 @@ func TestServerApplyResourceChange(t *testing.T) {
 
 								resp.Diagnostics.Append(req.ProviderMeta.Get(ctx, &data)...)
 
-								if data.TestProviderMetaAttribute.Value != "test-provider-meta-value" {
-									resp.Diagnostics.AddError("unexpected req.ProviderMeta value: %s", data.TestProviderMetaAttribute.Value)
+								if data.TestProviderMetaAttribute.ValueString() != "test-provider-meta-value" {
+									resp.Diagnostics.AddError("unexpected req.ProviderMeta value: %s", data.TestProviderMetaAttribute.ValueString())
 								}
 							},
 							UpdateMethod: func(_ context.Context, _ tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
@@ -527,8 +527,8 @@ func TestServerApplyResourceChange(t *testing.T) {
 
 								resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 
-								if data.TestRequired.Value != "test-new-value" {
-									resp.Diagnostics.AddError("Unexpected req.Config Value", "Got: "+data.TestRequired.Value)
+								if data.TestRequired.ValueString() != "test-new-value" {
+									resp.Diagnostics.AddError("Unexpected req.Config Value", "Got: "+data.TestRequired.ValueString())
 								}
 							},
 						}, nil
@@ -590,8 +590,8 @@ func TestServerApplyResourceChange(t *testing.T) {
 
 								resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 
-								if data.TestComputed.Value != "test-plannedstate-value" {
-									resp.Diagnostics.AddError("Unexpected req.Plan Value", "Got: "+data.TestComputed.Value)
+								if data.TestComputed.ValueString() != "test-plannedstate-value" {
+									resp.Diagnostics.AddError("Unexpected req.Plan Value", "Got: "+data.TestComputed.ValueString())
 								}
 							},
 						}, nil
@@ -653,8 +653,8 @@ func TestServerApplyResourceChange(t *testing.T) {
 
 								resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 
-								if data.TestRequired.Value != "test-old-value" {
-									resp.Diagnostics.AddError("Unexpected req.State Value", "Got: "+data.TestRequired.Value)
+								if data.TestRequired.ValueString() != "test-old-value" {
+									resp.Diagnostics.AddError("Unexpected req.State Value", "Got: "+data.TestRequired.ValueString())
 								}
 							},
 						}, nil
@@ -717,8 +717,8 @@ func TestServerApplyResourceChange(t *testing.T) {
 
 								resp.Diagnostics.Append(req.ProviderMeta.Get(ctx, &data)...)
 
-								if data.TestProviderMetaAttribute.Value != "test-provider-meta-value" {
-									resp.Diagnostics.AddError("Unexpected req.ProviderMeta Value", "Got: "+data.TestProviderMetaAttribute.Value)
+								if data.TestProviderMetaAttribute.ValueString() != "test-provider-meta-value" {
+									resp.Diagnostics.AddError("Unexpected req.ProviderMeta Value", "Got: "+data.TestProviderMetaAttribute.ValueString())
 								}
 							},
 						}, nil
@@ -3,19 +3,197 @@ package fwserver
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 )
 
 // ConfigureProvider implements the framework server ConfigureProvider RPC.
 func (s *Server) ConfigureProvider(ctx context.Context, req *tfsdk.ConfigureProviderRequest, resp *tfsdk.ConfigureProviderResponse) {
-	logging.FrameworkDebug(ctx, "Calling provider defined Provider Configure")
+	interceptorReq := InterceptorRequest{
+		RPC: RPCConfigureProvider,
+	}
+
+	if req != nil {
+		interceptorReq.Config = &req.Config
+	}
+
+	s.intercept(ctx, interceptorReq, &resp.Diagnostics, func(ctx context.Context, _ *InterceptorResponse) {
+		s.configureProvider(ctx, req, resp)
+	})
+}
+
+// configureProvider contains the ConfigureProvider RPC logic, which is called
+// through any Interceptors.
+func (s *Server) configureProvider(ctx context.Context, req *tfsdk.ConfigureProviderRequest, resp *tfsdk.ConfigureProviderResponse) {
+	configureReq := tfsdk.ConfigureProviderRequest{}
 
 	if req != nil {
-		s.Provider.Configure(ctx, *req, resp)
-	} else {
-		s.Provider.Configure(ctx, tfsdk.ConfigureProviderRequest{}, resp)
+		configureReq = *req
 	}
 
+	config, diags := ConfigWithEnvVars(ctx, configureReq.Config, s.LookupEnv)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	configureReq.Config = config
+	configureReq.ConfigHasUnknownValues = !configureReq.Config.Raw.IsFullyKnown()
+
+	if configureReq.ConfigHasUnknownValues {
+		logging.FrameworkDebug(ctx, "Provider configuration contains unknown values")
+	}
+
+	logging.FrameworkDebug(ctx, "Calling provider defined Provider Configure")
+	s.Provider.Configure(ctx, configureReq, resp)
 	logging.FrameworkDebug(ctx, "Called provider defined Provider Configure")
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	s.SetProviderConfigured(resp)
+
+	if resp.Deferred {
+		logging.FrameworkDebug(ctx, "Provider defined Provider Configure deferred configuration")
+	}
+}
+
+// DataSourceConfigureData returns the
+// tfsdk.ConfigureProviderResponse.DataSourceData field value which is passed
+// to the DataSourceWithConfigure Configure method.
+func (s *Server) DataSourceConfigureData() interface{} {
+	s.providerConfigureMutex.Lock()
+	defer s.providerConfigureMutex.Unlock()
+
+	return s.dataSourceConfigureData
+}
+
+// ProviderConfigured returns whether the ConfigureProvider RPC completed
+// without error diagnostics.
+func (s *Server) ProviderConfigured() bool {
+	s.providerConfigureMutex.Lock()
+	defer s.providerConfigureMutex.Unlock()
+
+	return s.providerConfigured
+}
+
+// ProviderDeferred returns the tfsdk.ConfigureProviderResponse.Deferred field
+// value.
+func (s *Server) ProviderDeferred() bool {
+	s.providerConfigureMutex.Lock()
+	defer s.providerConfigureMutex.Unlock()
+
+	return s.providerDeferred
+}
+
+// ResourceConfigureData returns the
+// tfsdk.ConfigureProviderResponse.ResourceData field value which is passed to
+// the ResourceWithConfigure Configure method.
+func (s *Server) ResourceConfigureData() interface{} {
+	s.providerConfigureMutex.Lock()
+	defer s.providerConfigureMutex.Unlock()
+
+	return s.resourceConfigureData
+}
+
+// SetProviderConfigured marks the provider as configured and saves the
+// tfsdk.ConfigureProviderResponse DataSourceData, Deferred, and ResourceData
+// field values. It is called by the ConfigureProvider RPC after the provider
+// defined Configure method returns without error diagnostics.
+func (s *Server) SetProviderConfigured(resp *tfsdk.ConfigureProviderResponse) {
+	s.providerConfigureMutex.Lock()
+	defer s.providerConfigureMutex.Unlock()
+
+	s.dataSourceConfigureData = resp.DataSourceData
+	s.providerConfigured = true
+	s.providerDeferred = resp.Deferred
+	s.resourceConfigureData = resp.ResourceData
+}
+
+// configureDataSource calls the provider defined DataSource Configure method,
+// if the DataSource implements tfsdk.DataSourceWithConfigure.
+func (s *Server) configureDataSource(ctx context.Context, dataSource tfsdk.DataSource) diag.Diagnostics {
+	dataSourceWithConfigure, ok := dataSource.(tfsdk.DataSourceWithConfigure)
+
+	if !ok {
+		return nil
+	}
+
+	logging.FrameworkTrace(ctx, "DataSource implements DataSourceWithConfigure")
+
+	return s.callConfigure(ctx, "DataSource", "data source", true, func() diag.Diagnostics {
+		configureReq := tfsdk.ConfigureDataSourceRequest{
+			ProviderData: s.DataSourceConfigureData(),
+		}
+		configureResp := tfsdk.ConfigureDataSourceResponse{}
+
+		dataSourceWithConfigure.Configure(ctx, configureReq, &configureResp)
+
+		return configureResp.Diagnostics
+	})
+}
+
+// configureResource calls the provider defined Resource Configure method, if
+// the Resource implements tfsdk.ResourceWithConfigure.
+func (s *Server) configureResource(ctx context.Context, resource tfsdk.Resource) diag.Diagnostics {
+	return s.callResourceConfigure(ctx, resource, true)
+}
+
+// configureResourceOptionalProvider calls the provider defined Resource
+// Configure method, if the Resource implements tfsdk.ResourceWithConfigure,
+// even if the provider has not been configured. The ProviderData is nil in
+// that case.
+func (s *Server) configureResourceOptionalProvider(ctx context.Context, resource tfsdk.Resource) diag.Diagnostics {
+	return s.callResourceConfigure(ctx, resource, false)
+}
+
+// callResourceConfigure calls the provider defined Resource Configure method
+// with the ResourceConfigureData of the Server, if the Resource implements
+// tfsdk.ResourceWithConfigure.
+func (s *Server) callResourceConfigure(ctx context.Context, resource tfsdk.Resource, requireProvider bool) diag.Diagnostics {
+	resourceWithConfigure, ok := resource.(tfsdk.ResourceWithConfigure)
+
+	if !ok {
+		return nil
+	}
+
+	logging.FrameworkTrace(ctx, "Resource implements ResourceWithConfigure")
+
+	return s.callConfigure(ctx, "Resource", "resource", requireProvider, func() diag.Diagnostics {
+		configureReq := tfsdk.ConfigureResourceRequest{
+			ProviderData: s.ResourceConfigureData(),
+		}
+		configureResp := tfsdk.ConfigureResourceResponse{}
+
+		resourceWithConfigure.Configure(ctx, configureReq, &configureResp)
+
+		return configureResp.Diagnostics
+	})
+}
+
+// callConfigure calls a provider defined DataSource or Resource Configure
+// method through the configure function. If requireProvider is true and the
+// provider has not been configured, an error diagnostic is returned instead.
+func (s *Server) callConfigure(ctx context.Context, kind string, description string, requireProvider bool, configure func() diag.Diagnostics) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if requireProvider && !s.ProviderConfigured() {
+		diags.AddError(
+			"Provider Not Configured",
+			"The "+description+" requires a configured provider, however the provider has not been configured. "+
+				"This is always an issue in the Terraform Provider or Terraform CLI and should be reported to the provider developers.",
+		)
+
+		return diags
+	}
+
+	logging.FrameworkDebug(ctx, "Calling provider defined "+kind+" Configure")
+	diags.Append(configure()...)
+	logging.FrameworkDebug(ctx, "Called provider defined "+kind+" Configure")
+
+	return diags
 }
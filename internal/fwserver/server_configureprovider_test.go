@@ -2,6 +2,7 @@ package fwserver_test
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -42,15 +43,20 @@ func TestServerConfigureProvider(t *testing.T) {
 	}
 
 	testCases := map[string]struct {
-		server           *fwserver.Server
-		request          *tfsdk.ConfigureProviderRequest
-		expectedResponse *tfsdk.ConfigureProviderResponse
+		server                          *fwserver.Server
+		request                         *tfsdk.ConfigureProviderRequest
+		expectedResponse                *tfsdk.ConfigureProviderResponse
+		expectedDataSourceConfigureData interface{}
+		expectedProviderConfigured      bool
+		expectedProviderDeferred        bool
+		expectedResourceConfigureData   interface{}
 	}{
 		"empty-provider": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
 			},
-			expectedResponse: &tfsdk.ConfigureProviderResponse{},
+			expectedResponse:           &tfsdk.ConfigureProviderResponse{},
+			expectedProviderConfigured: true,
 		},
 		"request-config": {
 			server: &fwserver.Server{
@@ -67,8 +73,8 @@ func TestServerConfigureProvider(t *testing.T) {
 							return
 						}
 
-						if got.Value != "test-value" {
-							resp.Diagnostics.AddError("Incorrect req.Config", "expected test-value, got "+got.Value)
+						if got.ValueString() != "test-value" {
+							resp.Diagnostics.AddError("Incorrect req.Config", "expected test-value, got "+got.ValueString())
 						}
 					},
 				},
@@ -76,7 +82,270 @@ func TestServerConfigureProvider(t *testing.T) {
 			request: &tfsdk.ConfigureProviderRequest{
 				Config: testConfig,
 			},
-			expectedResponse: &tfsdk.ConfigureProviderResponse{},
+			expectedResponse:           &tfsdk.ConfigureProviderResponse{},
+			expectedProviderConfigured: true,
+		},
+		"request-config-EnvVars": {
+			server: &fwserver.Server{
+				LookupEnv: func(name string) (string, bool) {
+					if name == "TEST_ENV_VAR" {
+						return "test-env-value", true
+					}
+
+					return "", false
+				},
+				Provider: &testprovider.Provider{
+					ConfigureMethod: func(ctx context.Context, req tfsdk.ConfigureProviderRequest, resp *tfsdk.ConfigureProviderResponse) {
+						var got types.String
+
+						resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("test"), &got)...)
+
+						if resp.Diagnostics.HasError() {
+							return
+						}
+
+						if got.ValueString() != "test-env-value" {
+							resp.Diagnostics.AddError("Incorrect req.Config", "expected test-env-value, got "+got.ValueString())
+						}
+					},
+				},
+			},
+			request: &tfsdk.ConfigureProviderRequest{
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"test": tftypes.String}}, map[string]tftypes.Value{
+						"test": tftypes.NewValue(tftypes.String, nil),
+					}),
+					Schema: tfsdk.Schema{
+						Attributes: map[string]tfsdk.Attribute{
+							"test": {
+								EnvVars:  []string{"TEST_ENV_VAR_UNSET", "TEST_ENV_VAR"},
+								Required: true,
+								Type:     types.StringType,
+							},
+						},
+					},
+				},
+			},
+			expectedResponse:           &tfsdk.ConfigureProviderResponse{},
+			expectedProviderConfigured: true,
+		},
+		"request-config-EnvVars-empty": {
+			server: &fwserver.Server{
+				LookupEnv: func(name string) (string, bool) {
+					switch name {
+					case "TEST_ENV_VAR_EMPTY":
+						return "", true
+					case "TEST_ENV_VAR":
+						return "test-env-value", true
+					}
+
+					return "", false
+				},
+				Provider: &testprovider.Provider{
+					ConfigureMethod: func(ctx context.Context, req tfsdk.ConfigureProviderRequest, resp *tfsdk.ConfigureProviderResponse) {
+						var got types.String
+
+						resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("test"), &got)...)
+
+						if resp.Diagnostics.HasError() {
+							return
+						}
+
+						if got.ValueString() != "test-env-value" {
+							resp.Diagnostics.AddError("Incorrect req.Config", "expected test-env-value, got "+got.ValueString())
+						}
+					},
+				},
+			},
+			request: &tfsdk.ConfigureProviderRequest{
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"test": tftypes.String}}, map[string]tftypes.Value{
+						"test": tftypes.NewValue(tftypes.String, nil),
+					}),
+					Schema: tfsdk.Schema{
+						Attributes: map[string]tfsdk.Attribute{
+							"test": {
+								EnvVars:  []string{"TEST_ENV_VAR_EMPTY", "TEST_ENV_VAR"},
+								Required: true,
+								Type:     types.StringType,
+							},
+						},
+					},
+				},
+			},
+			expectedResponse:           &tfsdk.ConfigureProviderResponse{},
+			expectedProviderConfigured: true,
+		},
+		"request-config-EnvVars-empty-only": {
+			server: &fwserver.Server{
+				LookupEnv: func(name string) (string, bool) {
+					if name == "TEST_ENV_VAR_EMPTY" {
+						return "", true
+					}
+
+					return "", false
+				},
+				Provider: &testprovider.Provider{
+					ConfigureMethod: func(ctx context.Context, req tfsdk.ConfigureProviderRequest, resp *tfsdk.ConfigureProviderResponse) {
+						var got types.Bool
+
+						resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("test"), &got)...)
+
+						if resp.Diagnostics.HasError() {
+							return
+						}
+
+						if !got.IsNull() {
+							resp.Diagnostics.AddError("Incorrect req.Config", fmt.Sprintf("expected null, got %s", got))
+						}
+					},
+				},
+			},
+			request: &tfsdk.ConfigureProviderRequest{
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"test": tftypes.Bool}}, map[string]tftypes.Value{
+						"test": tftypes.NewValue(tftypes.Bool, nil),
+					}),
+					Schema: tfsdk.Schema{
+						Attributes: map[string]tfsdk.Attribute{
+							"test": {
+								EnvVars:  []string{"TEST_ENV_VAR_EMPTY"},
+								Optional: true,
+								Type:     types.BoolType,
+							},
+						},
+					},
+				},
+			},
+			expectedResponse:           &tfsdk.ConfigureProviderResponse{},
+			expectedProviderConfigured: true,
+		},
+		"request-config-EnvVars-number": {
+			server: &fwserver.Server{
+				LookupEnv: func(name string) (string, bool) {
+					if name == "TEST_ENV_VAR" {
+						return "123", true
+					}
+
+					return "", false
+				},
+				Provider: &testprovider.Provider{
+					ConfigureMethod: func(ctx context.Context, req tfsdk.ConfigureProviderRequest, resp *tfsdk.ConfigureProviderResponse) {
+						var got types.Int64
+
+						resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("test"), &got)...)
+
+						if resp.Diagnostics.HasError() {
+							return
+						}
+
+						if got.ValueInt64() != 123 {
+							resp.Diagnostics.AddError("Incorrect req.Config", fmt.Sprintf("expected 123, got %d", got.ValueInt64()))
+						}
+					},
+				},
+			},
+			request: &tfsdk.ConfigureProviderRequest{
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"test": tftypes.Number}}, map[string]tftypes.Value{
+						"test": tftypes.NewValue(tftypes.Number, nil),
+					}),
+					Schema: tfsdk.Schema{
+						Attributes: map[string]tfsdk.Attribute{
+							"test": {
+								EnvVars:  []string{"TEST_ENV_VAR_UNSET", "TEST_ENV_VAR"},
+								Required: true,
+								Type:     types.Int64Type,
+							},
+						},
+					},
+				},
+			},
+			expectedResponse:           &tfsdk.ConfigureProviderResponse{},
+			expectedProviderConfigured: true,
+		},
+		"request-config-EnvVars-invalid": {
+			server: &fwserver.Server{
+				LookupEnv: func(name string) (string, bool) {
+					if name == "TEST_ENV_VAR" {
+						return "not-a-bool", true
+					}
+
+					return "", false
+				},
+				Provider: &testprovider.Provider{
+					ConfigureMethod: func(ctx context.Context, req tfsdk.ConfigureProviderRequest, resp *tfsdk.ConfigureProviderResponse) {
+						resp.Diagnostics.AddError("Unexpected Configure Call", "Configure should not be called with an invalid environment variable value")
+					},
+				},
+			},
+			request: &tfsdk.ConfigureProviderRequest{
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"test": tftypes.Bool}}, map[string]tftypes.Value{
+						"test": tftypes.NewValue(tftypes.Bool, nil),
+					}),
+					Schema: tfsdk.Schema{
+						Attributes: map[string]tfsdk.Attribute{
+							"test": {
+								EnvVars:  []string{"TEST_ENV_VAR_UNSET", "TEST_ENV_VAR"},
+								Required: true,
+								Type:     types.BoolType,
+							},
+						},
+					},
+				},
+			},
+			expectedResponse: &tfsdk.ConfigureProviderResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Invalid Environment Variable Value",
+						"The TEST_ENV_VAR environment variable value cannot be used for the test attribute: unable to convert \"not-a-bool\" to bool",
+					),
+				},
+			},
+		},
+		"request-config-unknown-values": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{
+					GetSchemaMethod: func(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+						return testSchema, nil
+					},
+					ConfigureMethod: func(ctx context.Context, req tfsdk.ConfigureProviderRequest, resp *tfsdk.ConfigureProviderResponse) {
+						if !req.ConfigHasUnknownValues {
+							resp.Diagnostics.AddError("Incorrect req.ConfigHasUnknownValues", "expected true, got false")
+							return
+						}
+
+						resp.Diagnostics.Append(tfsdk.UnknownProviderConfigValueDiagnostic(path.Root("test")))
+						resp.Deferred = true
+					},
+				},
+			},
+			request: &tfsdk.ConfigureProviderRequest{
+				Config: tfsdk.Config{
+					Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+						"test": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+					}),
+					Schema: testSchema,
+				},
+			},
+			expectedResponse: &tfsdk.ConfigureProviderResponse{
+				Deferred: true,
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeWarningDiagnostic(
+						path.Root("test"),
+						"Unknown Provider Configuration Value",
+						"The provider configuration value is not known until apply, because it depends on values from other resources. "+
+							"Resources and data sources which require this provider to communicate with a remote system may not plan accurately "+
+							"or may fail until the value is known.\n\n"+
+							"To prevent this, apply the resources the provider configuration depends on first, such as with the -target option, "+
+							"or set the value to a known value, such as with a variable.",
+					),
+				},
+			},
+			expectedProviderConfigured: true,
+			expectedProviderDeferred:   true,
 		},
 		"request-terraformversion": {
 			server: &fwserver.Server{
@@ -94,7 +363,23 @@ func TestServerConfigureProvider(t *testing.T) {
 			request: &tfsdk.ConfigureProviderRequest{
 				TerraformVersion: "1.0.0",
 			},
-			expectedResponse: &tfsdk.ConfigureProviderResponse{},
+			expectedResponse:           &tfsdk.ConfigureProviderResponse{},
+			expectedProviderConfigured: true,
+		},
+		"response-datasourcedata": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{
+					ConfigureMethod: func(ctx context.Context, req tfsdk.ConfigureProviderRequest, resp *tfsdk.ConfigureProviderResponse) {
+						resp.DataSourceData = "test-provider-configure-value"
+					},
+				},
+			},
+			request: &tfsdk.ConfigureProviderRequest{},
+			expectedResponse: &tfsdk.ConfigureProviderResponse{
+				DataSourceData: "test-provider-configure-value",
+			},
+			expectedDataSourceConfigureData: "test-provider-configure-value",
+			expectedProviderConfigured:      true,
 		},
 		"response-diagnostics": {
 			server: &fwserver.Server{
@@ -122,6 +407,41 @@ func TestServerConfigureProvider(t *testing.T) {
 				},
 			},
 		},
+		"response-diagnostics-resourcedata": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{
+					ConfigureMethod: func(ctx context.Context, req tfsdk.ConfigureProviderRequest, resp *tfsdk.ConfigureProviderResponse) {
+						resp.Diagnostics.AddError("error summary", "error detail")
+						resp.ResourceData = "test-provider-configure-value"
+					},
+				},
+			},
+			request: &tfsdk.ConfigureProviderRequest{},
+			expectedResponse: &tfsdk.ConfigureProviderResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"error summary",
+						"error detail",
+					),
+				},
+				ResourceData: "test-provider-configure-value",
+			},
+		},
+		"response-resourcedata": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{
+					ConfigureMethod: func(ctx context.Context, req tfsdk.ConfigureProviderRequest, resp *tfsdk.ConfigureProviderResponse) {
+						resp.ResourceData = "test-provider-configure-value"
+					},
+				},
+			},
+			request: &tfsdk.ConfigureProviderRequest{},
+			expectedResponse: &tfsdk.ConfigureProviderResponse{
+				ResourceData: "test-provider-configure-value",
+			},
+			expectedProviderConfigured:    true,
+			expectedResourceConfigureData: "test-provider-configure-value",
+		},
 	}
 
 	for name, testCase := range testCases {
@@ -136,6 +456,30 @@ func TestServerConfigureProvider(t *testing.T) {
 			if diff := cmp.Diff(response, testCase.expectedResponse); diff != "" {
 				t.Errorf("unexpected difference: %s", diff)
 			}
+
+			if diff := cmp.Diff(testCase.server.DataSourceConfigureData(), testCase.expectedDataSourceConfigureData); diff != "" {
+				t.Errorf("unexpected server DataSourceConfigureData difference: %s", diff)
+			}
+
+			if testCase.server.ProviderConfigured() != testCase.expectedProviderConfigured {
+				t.Errorf("expected server ProviderConfigured %t, got: %t", testCase.expectedProviderConfigured, testCase.server.ProviderConfigured())
+			}
+
+			if testCase.server.ProviderDeferred() != testCase.expectedProviderDeferred {
+				t.Errorf("expected server ProviderDeferred %t, got: %t", testCase.expectedProviderDeferred, testCase.server.ProviderDeferred())
+			}
+
+			if diff := cmp.Diff(testCase.server.ResourceConfigureData(), testCase.expectedResourceConfigureData); diff != "" {
+				t.Errorf("unexpected server ResourceConfigureData difference: %s", diff)
+			}
 		})
 	}
 }
+
+// testConfiguredServer returns the server after marking the provider as
+// configured with the response, as if the ConfigureProvider RPC was called.
+func testConfiguredServer(server *fwserver.Server, resp *tfsdk.ConfigureProviderResponse) *fwserver.Server {
+	server.SetProviderConfigured(resp)
+
+	return server
+}
@@ -46,6 +46,12 @@ func (s *Server) CreateResource(ctx context.Context, req *CreateResourceRequest,
 		return
 	}
 
+	resp.Diagnostics.Append(s.configureResource(ctx, resource)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	createReq := tfsdk.CreateResourceRequest{
 		Config: tfsdk.Config{
 			Schema: req.ResourceSchema,
@@ -81,4 +87,17 @@ func (s *Server) CreateResource(ctx context.Context, req *CreateResourceRequest,
 
 	resp.Diagnostics = createResp.Diagnostics
 	resp.NewState = &createResp.State
+
+	if resp.Diagnostics.HasError() || req.PlannedState == nil {
+		return
+	}
+
+	newStateRaw, diags := SchemaSemanticEquality(ctx, req.ResourceSchema, req.PlannedState.Raw, resp.NewState.Raw)
+
+	resp.Diagnostics.Append(diags...)
+
+	newStateRaw, diags = SchemaNullEqualsEmpty(ctx, req.ResourceSchema, req.PlannedState.Raw, createReq.Config.Raw, newStateRaw)
+
+	resp.Diagnostics.Append(diags...)
+	resp.NewState.Raw = newStateRaw
 }
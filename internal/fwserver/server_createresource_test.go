@@ -2,6 +2,7 @@ package fwserver_test
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -103,8 +104,8 @@ func TestServerCreateResource(t *testing.T) {
 
 								resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 
-								if data.TestRequired.Value != "test-config-value" {
-									resp.Diagnostics.AddError("Unexpected req.Config Value", "Got: "+data.TestRequired.Value)
+								if data.TestRequired.ValueString() != "test-config-value" {
+									resp.Diagnostics.AddError("Unexpected req.Config Value", "Got: "+data.TestRequired.ValueString())
 								}
 							},
 						}, nil
@@ -140,8 +141,8 @@ func TestServerCreateResource(t *testing.T) {
 
 								resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 
-								if data.TestRequired.Value != "test-plannedstate-value" {
-									resp.Diagnostics.AddError("Unexpected req.Plan Value", "Got: "+data.TestRequired.Value)
+								if data.TestRequired.ValueString() != "test-plannedstate-value" {
+									resp.Diagnostics.AddError("Unexpected req.Plan Value", "Got: "+data.TestRequired.ValueString())
 								}
 							},
 						}, nil
@@ -170,8 +171,8 @@ func TestServerCreateResource(t *testing.T) {
 
 								resp.Diagnostics.Append(req.ProviderMeta.Get(ctx, &data)...)
 
-								if data.TestProviderMetaAttribute.Value != "test-provider-meta-value" {
-									resp.Diagnostics.AddError("Unexpected req.ProviderMeta Value", "Got: "+data.TestProviderMetaAttribute.Value)
+								if data.TestProviderMetaAttribute.ValueString() != "test-provider-meta-value" {
+									resp.Diagnostics.AddError("Unexpected req.ProviderMeta Value", "Got: "+data.TestProviderMetaAttribute.ValueString())
 								}
 							},
 						}, nil
@@ -184,6 +185,110 @@ func TestServerCreateResource(t *testing.T) {
 				NewState: testEmptyState,
 			},
 		},
+		"resource-configure-data": {
+			server: testConfiguredServer(
+				&fwserver.Server{
+					Provider: &testprovider.Provider{},
+				},
+				&tfsdk.ConfigureProviderResponse{
+					ResourceData: "test-provider-configure-value",
+				},
+			),
+			request: &fwserver.CreateResourceRequest{
+				ResourceSchema: testSchema,
+				ResourceType: &testprovider.ResourceType{
+					GetSchemaMethod: func(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+						return testSchema, nil
+					},
+					NewResourceMethod: func(_ context.Context, _ tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+						var providerData interface{}
+
+						return &testprovider.ResourceWithConfigure{
+							ConfigureMethod: func(ctx context.Context, req tfsdk.ConfigureResourceRequest, resp *tfsdk.ConfigureResourceResponse) {
+								providerData = req.ProviderData
+							},
+							Resource: &testprovider.Resource{
+								CreateMethod: func(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+									if providerData != "test-provider-configure-value" {
+										resp.Diagnostics.AddError("Unexpected ProviderData", fmt.Sprintf("Got: %v", providerData))
+									}
+								},
+							},
+						}, nil
+					},
+				},
+			},
+			expectedResponse: &fwserver.CreateResourceResponse{
+				// Intentionally empty, Create implementation does not call resp.State.Set()
+				NewState: testEmptyState,
+			},
+		},
+		"resource-configure-diagnostics": {
+			server: testConfiguredServer(
+				&fwserver.Server{
+					Provider: &testprovider.Provider{},
+				},
+				&tfsdk.ConfigureProviderResponse{},
+			),
+			request: &fwserver.CreateResourceRequest{
+				ResourceSchema: testSchema,
+				ResourceType: &testprovider.ResourceType{
+					GetSchemaMethod: func(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+						return testSchema, nil
+					},
+					NewResourceMethod: func(_ context.Context, _ tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+						return &testprovider.ResourceWithConfigure{
+							ConfigureMethod: func(ctx context.Context, req tfsdk.ConfigureResourceRequest, resp *tfsdk.ConfigureResourceResponse) {
+								resp.Diagnostics.AddError("error summary", "error detail")
+							},
+							Resource: &testprovider.Resource{
+								CreateMethod: func(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+									resp.Diagnostics.AddError("Unexpected Create Call", "Create should not be called after Configure errors.")
+								},
+							},
+						}, nil
+					},
+				},
+			},
+			expectedResponse: &fwserver.CreateResourceResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"error summary",
+						"error detail",
+					),
+				},
+			},
+		},
+		"resource-configure-unconfigured-provider": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.CreateResourceRequest{
+				ResourceSchema: testSchema,
+				ResourceType: &testprovider.ResourceType{
+					GetSchemaMethod: func(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+						return testSchema, nil
+					},
+					NewResourceMethod: func(_ context.Context, _ tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+						return &testprovider.ResourceWithConfigure{
+							ConfigureMethod: func(ctx context.Context, req tfsdk.ConfigureResourceRequest, resp *tfsdk.ConfigureResourceResponse) {
+								resp.Diagnostics.AddError("Unexpected Configure Call", "Configure should not be called before the provider is configured.")
+							},
+							Resource: &testprovider.Resource{},
+						}, nil
+					},
+				},
+			},
+			expectedResponse: &fwserver.CreateResourceResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Provider Not Configured",
+						"The resource requires a configured provider, however the provider has not been configured. "+
+							"This is always an issue in the Terraform Provider or Terraform CLI and should be reported to the provider developers.",
+					),
+				},
+			},
+		},
 		"response-diagnostics": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
@@ -45,6 +45,12 @@ func (s *Server) DeleteResource(ctx context.Context, req *DeleteResourceRequest,
 		return
 	}
 
+	resp.Diagnostics.Append(s.configureResource(ctx, resource)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	deleteReq := tfsdk.DeleteResourceRequest{
 		State: tfsdk.State{
 			Schema: req.ResourceSchema,
@@ -0,0 +1,251 @@
+package fwserver
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// dataSourceFactoryType implements tfsdk.DataSourceType for data sources
+// registered through the tfsdk.ProviderWithDataSources DataSources method.
+type dataSourceFactoryType struct {
+	factory func() tfsdk.DataSource
+}
+
+// GetSchema calls the DataSourceWithMetadata GetSchema method of a new
+// DataSource instance.
+func (t dataSourceFactoryType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	dataSource := t.factory()
+
+	dataSourceWithMetadata, ok := dataSource.(tfsdk.DataSourceWithMetadata)
+
+	if !ok {
+		diags.Append(dataSourceMetadataMissingDiagnostic(dataSource))
+
+		return tfsdk.Schema{}, diags
+	}
+
+	return dataSourceWithMetadata.GetSchema(ctx)
+}
+
+// NewDataSource returns a new DataSource instance from the factory.
+func (t dataSourceFactoryType) NewDataSource(_ context.Context, _ tfsdk.Provider) (tfsdk.DataSource, diag.Diagnostics) {
+	return t.factory(), nil
+}
+
+// resourceFactoryType implements tfsdk.ResourceType for resources registered
+// through the tfsdk.ProviderWithResources Resources method.
+type resourceFactoryType struct {
+	factory func() tfsdk.Resource
+}
+
+// GetSchema calls the ResourceWithMetadata GetSchema method of a new Resource
+// instance.
+func (t resourceFactoryType) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	resource := t.factory()
+
+	resourceWithMetadata, ok := resource.(tfsdk.ResourceWithMetadata)
+
+	if !ok {
+		diags.Append(resourceMetadataMissingDiagnostic(resource))
+
+		return tfsdk.Schema{}, diags
+	}
+
+	return resourceWithMetadata.GetSchema(ctx)
+}
+
+// NewResource returns a new Resource instance from the factory.
+func (t resourceFactoryType) NewResource(_ context.Context, _ tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+	return t.factory(), nil
+}
+
+// dataSourceFactoryTypes returns the DataSourceTypes for data sources
+// registered through the tfsdk.ProviderWithDataSources DataSources method.
+func (s *Server) dataSourceFactoryTypes(ctx context.Context) (map[string]tfsdk.DataSourceType, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	provider, ok := s.Provider.(tfsdk.ProviderWithDataSources)
+
+	if !ok {
+		return nil, diags
+	}
+
+	logging.FrameworkTrace(ctx, "Provider implements ProviderWithDataSources")
+
+	providerTypeName := s.providerTypeName(ctx)
+	dataSourceTypes := map[string]tfsdk.DataSourceType{}
+
+	logging.FrameworkDebug(ctx, "Calling provider defined Provider DataSources")
+	factories := provider.DataSources(ctx)
+	logging.FrameworkDebug(ctx, "Called provider defined Provider DataSources")
+
+	for _, factory := range factories {
+		dataSource := factory()
+
+		dataSourceWithMetadata, ok := dataSource.(tfsdk.DataSourceWithMetadata)
+
+		if !ok {
+			diags.Append(dataSourceMetadataMissingDiagnostic(dataSource))
+
+			continue
+		}
+
+		metadataReq := tfsdk.DataSourceMetadataRequest{
+			ProviderTypeName: providerTypeName,
+		}
+		metadataResp := tfsdk.DataSourceMetadataResponse{}
+
+		dataSourceWithMetadata.Metadata(ctx, metadataReq, &metadataResp)
+
+		if metadataResp.TypeName == "" {
+			diags.AddError(
+				"Data Source Type Name Missing",
+				fmt.Sprintf("The %T DataSource returned an empty string from the Metadata method. ", dataSource)+
+					"This is always an issue with the provider and should be reported to the provider developers.",
+			)
+
+			continue
+		}
+
+		logging.FrameworkTrace(ctx, "Found data source type", map[string]interface{}{logging.KeyDataSourceType: metadataResp.TypeName})
+
+		if _, ok := dataSourceTypes[metadataResp.TypeName]; ok {
+			diags.Append(duplicateDataSourceTypeDiagnostic(metadataResp.TypeName))
+
+			continue
+		}
+
+		dataSourceTypes[metadataResp.TypeName] = dataSourceFactoryType{
+			factory: factory,
+		}
+	}
+
+	return dataSourceTypes, diags
+}
+
+// providerTypeName returns the type name of the Provider, if it implements
+// tfsdk.ProviderWithMetadata.
+func (s *Server) providerTypeName(ctx context.Context) string {
+	provider, ok := s.Provider.(tfsdk.ProviderWithMetadata)
+
+	if !ok {
+		return ""
+	}
+
+	logging.FrameworkTrace(ctx, "Provider implements ProviderWithMetadata")
+
+	metadataReq := tfsdk.ProviderMetadataRequest{}
+	metadataResp := tfsdk.ProviderMetadataResponse{}
+
+	logging.FrameworkDebug(ctx, "Calling provider defined Provider Metadata")
+	provider.Metadata(ctx, metadataReq, &metadataResp)
+	logging.FrameworkDebug(ctx, "Called provider defined Provider Metadata")
+
+	return metadataResp.TypeName
+}
+
+// resourceFactoryTypes returns the ResourceTypes for resources registered
+// through the tfsdk.ProviderWithResources Resources method.
+func (s *Server) resourceFactoryTypes(ctx context.Context) (map[string]tfsdk.ResourceType, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	provider, ok := s.Provider.(tfsdk.ProviderWithResources)
+
+	if !ok {
+		return nil, diags
+	}
+
+	logging.FrameworkTrace(ctx, "Provider implements ProviderWithResources")
+
+	providerTypeName := s.providerTypeName(ctx)
+	resourceTypes := map[string]tfsdk.ResourceType{}
+
+	logging.FrameworkDebug(ctx, "Calling provider defined Provider Resources")
+	factories := provider.Resources(ctx)
+	logging.FrameworkDebug(ctx, "Called provider defined Provider Resources")
+
+	for _, factory := range factories {
+		resource := factory()
+
+		resourceWithMetadata, ok := resource.(tfsdk.ResourceWithMetadata)
+
+		if !ok {
+			diags.Append(resourceMetadataMissingDiagnostic(resource))
+
+			continue
+		}
+
+		metadataReq := tfsdk.ResourceMetadataRequest{
+			ProviderTypeName: providerTypeName,
+		}
+		metadataResp := tfsdk.ResourceMetadataResponse{}
+
+		resourceWithMetadata.Metadata(ctx, metadataReq, &metadataResp)
+
+		if metadataResp.TypeName == "" {
+			diags.AddError(
+				"Resource Type Name Missing",
+				fmt.Sprintf("The %T Resource returned an empty string from the Metadata method. ", resource)+
+					"This is always an issue with the provider and should be reported to the provider developers.",
+			)
+
+			continue
+		}
+
+		logging.FrameworkTrace(ctx, "Found resource type", map[string]interface{}{logging.KeyResourceType: metadataResp.TypeName})
+
+		if _, ok := resourceTypes[metadataResp.TypeName]; ok {
+			diags.Append(duplicateResourceTypeDiagnostic(metadataResp.TypeName))
+
+			continue
+		}
+
+		resourceTypes[metadataResp.TypeName] = resourceFactoryType{
+			factory: factory,
+		}
+	}
+
+	return resourceTypes, diags
+}
+
+func dataSourceMetadataMissingDiagnostic(dataSource tfsdk.DataSource) diag.Diagnostic {
+	return diag.NewErrorDiagnostic(
+		"Data Source Metadata Missing",
+		fmt.Sprintf("The %T DataSource returned by the provider DataSources method does not implement the DataSourceWithMetadata interface. ", dataSource)+
+			"This is always an issue with the provider and should be reported to the provider developers.",
+	)
+}
+
+func duplicateDataSourceTypeDiagnostic(typeName string) diag.Diagnostic {
+	return diag.NewErrorDiagnostic(
+		"Duplicate Data Source Type Defined",
+		fmt.Sprintf("The %s data source type name was returned for multiple data sources. ", typeName)+
+			"Data source type names must be unique. "+
+			"This is always an issue with the provider and should be reported to the provider developers.",
+	)
+}
+
+func duplicateResourceTypeDiagnostic(typeName string) diag.Diagnostic {
+	return diag.NewErrorDiagnostic(
+		"Duplicate Resource Type Defined",
+		fmt.Sprintf("The %s resource type name was returned for multiple resources. ", typeName)+
+			"Resource type names must be unique. "+
+			"This is always an issue with the provider and should be reported to the provider developers.",
+	)
+}
+
+func resourceMetadataMissingDiagnostic(resource tfsdk.Resource) diag.Diagnostic {
+	return diag.NewErrorDiagnostic(
+		"Resource Metadata Missing",
+		fmt.Sprintf("The %T Resource returned by the provider Resources method does not implement the ResourceWithMetadata interface. ", resource)+
+			"This is always an issue with the provider and should be reported to the provider developers.",
+	)
+}
@@ -87,6 +87,435 @@ func TestServerGetProviderSchema(t *testing.T) {
 				ResourceSchemas: map[string]*tfsdk.Schema{},
 			},
 		},
+		"datasourceschemas-factories": {
+			server: &fwserver.Server{
+				Provider: &testprovider.ProviderWithDataSources{
+					ProviderWithMetadata: &testprovider.ProviderWithMetadata{
+						Provider: &testprovider.Provider{},
+						MetadataMethod: func(_ context.Context, _ tfsdk.ProviderMetadataRequest, resp *tfsdk.ProviderMetadataResponse) {
+							resp.TypeName = "test"
+						},
+					},
+					DataSourcesMethod: func(_ context.Context) []func() tfsdk.DataSource {
+						return []func() tfsdk.DataSource{
+							func() tfsdk.DataSource {
+								return &testprovider.DataSourceWithMetadata{
+									GetSchemaMethod: func(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+										return tfsdk.Schema{
+											Attributes: map[string]tfsdk.Attribute{
+												"test1": {
+													Required: true,
+													Type:     types.StringType,
+												},
+											},
+										}, nil
+									},
+									MetadataMethod: func(_ context.Context, req tfsdk.DataSourceMetadataRequest, resp *tfsdk.DataSourceMetadataResponse) {
+										resp.TypeName = req.ProviderTypeName + "_data_source1"
+									},
+								}
+							},
+							func() tfsdk.DataSource {
+								return &testprovider.DataSourceWithMetadata{
+									GetSchemaMethod: func(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+										return tfsdk.Schema{
+											Attributes: map[string]tfsdk.Attribute{
+												"test2": {
+													Required: true,
+													Type:     types.StringType,
+												},
+											},
+										}, nil
+									},
+									MetadataMethod: func(_ context.Context, req tfsdk.DataSourceMetadataRequest, resp *tfsdk.DataSourceMetadataResponse) {
+										resp.TypeName = "test_data_source2"
+									},
+								}
+							},
+						}
+					},
+				},
+			},
+			request: &fwserver.GetProviderSchemaRequest{},
+			expectedResponse: &fwserver.GetProviderSchemaResponse{
+				DataSourceSchemas: map[string]*tfsdk.Schema{
+					"test_data_source1": {
+						Attributes: map[string]tfsdk.Attribute{
+							"test1": {
+								Required: true,
+								Type:     types.StringType,
+							},
+						},
+					},
+					"test_data_source2": {
+						Attributes: map[string]tfsdk.Attribute{
+							"test2": {
+								Required: true,
+								Type:     types.StringType,
+							},
+						},
+					},
+				},
+				Provider:        &tfsdk.Schema{},
+				ResourceSchemas: map[string]*tfsdk.Schema{},
+			},
+		},
+		"datasourceschemas-factories-duplicate": {
+			server: &fwserver.Server{
+				Provider: &testprovider.ProviderWithDataSources{
+					ProviderWithMetadata: &testprovider.ProviderWithMetadata{
+						Provider: &testprovider.Provider{},
+						MetadataMethod: func(_ context.Context, _ tfsdk.ProviderMetadataRequest, resp *tfsdk.ProviderMetadataResponse) {
+							resp.TypeName = "test"
+						},
+					},
+					DataSourcesMethod: func(_ context.Context) []func() tfsdk.DataSource {
+						return []func() tfsdk.DataSource{
+							func() tfsdk.DataSource {
+								return &testprovider.DataSourceWithMetadata{
+									GetSchemaMethod: func(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+										return tfsdk.Schema{
+											Attributes: map[string]tfsdk.Attribute{
+												"test1": {
+													Required: true,
+													Type:     types.StringType,
+												},
+											},
+										}, nil
+									},
+									MetadataMethod: func(_ context.Context, req tfsdk.DataSourceMetadataRequest, resp *tfsdk.DataSourceMetadataResponse) {
+										resp.TypeName = req.ProviderTypeName + "_data_source1"
+									},
+								}
+							},
+							func() tfsdk.DataSource {
+								return &testprovider.DataSourceWithMetadata{
+									GetSchemaMethod: func(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+										return tfsdk.Schema{
+											Attributes: map[string]tfsdk.Attribute{
+												"test2": {
+													Required: true,
+													Type:     types.StringType,
+												},
+											},
+										}, nil
+									},
+									MetadataMethod: func(_ context.Context, req tfsdk.DataSourceMetadataRequest, resp *tfsdk.DataSourceMetadataResponse) {
+										resp.TypeName = "test_data_source1"
+									},
+								}
+							},
+						}
+					},
+				},
+			},
+			request: &fwserver.GetProviderSchemaRequest{},
+			expectedResponse: &fwserver.GetProviderSchemaResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Duplicate Data Source Type Defined",
+						"The test_data_source1 data source type name was returned for multiple data sources. "+
+							"Data source type names must be unique. "+
+							"This is always an issue with the provider and should be reported to the provider developers.",
+					),
+				},
+				Provider:        &tfsdk.Schema{},
+				ResourceSchemas: map[string]*tfsdk.Schema{},
+			},
+		},
+		"datasourceschemas-factories-duplicate-GetDataSources": {
+			server: &fwserver.Server{
+				Provider: &testprovider.ProviderWithDataSources{
+					ProviderWithMetadata: &testprovider.ProviderWithMetadata{
+						Provider: &testprovider.Provider{
+							GetDataSourcesMethod: func(_ context.Context) (map[string]tfsdk.DataSourceType, diag.Diagnostics) {
+								return map[string]tfsdk.DataSourceType{
+									"test_data_source1": &testprovider.DataSourceType{},
+								}, nil
+							},
+						},
+						MetadataMethod: func(_ context.Context, _ tfsdk.ProviderMetadataRequest, resp *tfsdk.ProviderMetadataResponse) {
+							resp.TypeName = "test"
+						},
+					},
+					DataSourcesMethod: func(_ context.Context) []func() tfsdk.DataSource {
+						return []func() tfsdk.DataSource{
+							func() tfsdk.DataSource {
+								return &testprovider.DataSourceWithMetadata{
+									GetSchemaMethod: func(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+										return tfsdk.Schema{
+											Attributes: map[string]tfsdk.Attribute{
+												"test1": {
+													Required: true,
+													Type:     types.StringType,
+												},
+											},
+										}, nil
+									},
+									MetadataMethod: func(_ context.Context, req tfsdk.DataSourceMetadataRequest, resp *tfsdk.DataSourceMetadataResponse) {
+										resp.TypeName = req.ProviderTypeName + "_data_source1"
+									},
+								}
+							},
+						}
+					},
+				},
+			},
+			request: &fwserver.GetProviderSchemaRequest{},
+			expectedResponse: &fwserver.GetProviderSchemaResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Duplicate Data Source Type Defined",
+						"The test_data_source1 data source type name was returned for multiple data sources. "+
+							"Data source type names must be unique. "+
+							"This is always an issue with the provider and should be reported to the provider developers.",
+					),
+				},
+				Provider:        &tfsdk.Schema{},
+				ResourceSchemas: map[string]*tfsdk.Schema{},
+			},
+		},
+		"datasourceschemas-factories-metadata-missing": {
+			server: &fwserver.Server{
+				Provider: &testprovider.ProviderWithDataSources{
+					ProviderWithMetadata: &testprovider.ProviderWithMetadata{
+						Provider: &testprovider.Provider{},
+					},
+					DataSourcesMethod: func(_ context.Context) []func() tfsdk.DataSource {
+						return []func() tfsdk.DataSource{
+							func() tfsdk.DataSource {
+								return &testprovider.DataSource{}
+							},
+						}
+					},
+				},
+			},
+			request: &fwserver.GetProviderSchemaRequest{},
+			expectedResponse: &fwserver.GetProviderSchemaResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Data Source Metadata Missing",
+						"The *testprovider.DataSource DataSource returned by the provider DataSources method does not implement the DataSourceWithMetadata interface. "+
+							"This is always an issue with the provider and should be reported to the provider developers.",
+					),
+				},
+				Provider:        &tfsdk.Schema{},
+				ResourceSchemas: map[string]*tfsdk.Schema{},
+			},
+		},
+		"resourceschemas-factories": {
+			server: &fwserver.Server{
+				Provider: &testprovider.ProviderWithResources{
+					ProviderWithMetadata: &testprovider.ProviderWithMetadata{
+						Provider: &testprovider.Provider{},
+						MetadataMethod: func(_ context.Context, _ tfsdk.ProviderMetadataRequest, resp *tfsdk.ProviderMetadataResponse) {
+							resp.TypeName = "test"
+						},
+					},
+					ResourcesMethod: func(_ context.Context) []func() tfsdk.Resource {
+						return []func() tfsdk.Resource{
+							func() tfsdk.Resource {
+								return &testprovider.ResourceWithMetadata{
+									GetSchemaMethod: func(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+										return tfsdk.Schema{
+											Attributes: map[string]tfsdk.Attribute{
+												"test1": {
+													Required: true,
+													Type:     types.StringType,
+												},
+											},
+										}, nil
+									},
+									MetadataMethod: func(_ context.Context, req tfsdk.ResourceMetadataRequest, resp *tfsdk.ResourceMetadataResponse) {
+										resp.TypeName = req.ProviderTypeName + "_resource1"
+									},
+								}
+							},
+							func() tfsdk.Resource {
+								return &testprovider.ResourceWithMetadata{
+									GetSchemaMethod: func(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+										return tfsdk.Schema{
+											Attributes: map[string]tfsdk.Attribute{
+												"test2": {
+													Required: true,
+													Type:     types.StringType,
+												},
+											},
+										}, nil
+									},
+									MetadataMethod: func(_ context.Context, req tfsdk.ResourceMetadataRequest, resp *tfsdk.ResourceMetadataResponse) {
+										resp.TypeName = "test_resource2"
+									},
+								}
+							},
+						}
+					},
+				},
+			},
+			request: &fwserver.GetProviderSchemaRequest{},
+			expectedResponse: &fwserver.GetProviderSchemaResponse{
+				ResourceSchemas: map[string]*tfsdk.Schema{
+					"test_resource1": {
+						Attributes: map[string]tfsdk.Attribute{
+							"test1": {
+								Required: true,
+								Type:     types.StringType,
+							},
+						},
+					},
+					"test_resource2": {
+						Attributes: map[string]tfsdk.Attribute{
+							"test2": {
+								Required: true,
+								Type:     types.StringType,
+							},
+						},
+					},
+				},
+				Provider:          &tfsdk.Schema{},
+				DataSourceSchemas: map[string]*tfsdk.Schema{},
+			},
+		},
+		"resourceschemas-factories-duplicate": {
+			server: &fwserver.Server{
+				Provider: &testprovider.ProviderWithResources{
+					ProviderWithMetadata: &testprovider.ProviderWithMetadata{
+						Provider: &testprovider.Provider{},
+						MetadataMethod: func(_ context.Context, _ tfsdk.ProviderMetadataRequest, resp *tfsdk.ProviderMetadataResponse) {
+							resp.TypeName = "test"
+						},
+					},
+					ResourcesMethod: func(_ context.Context) []func() tfsdk.Resource {
+						return []func() tfsdk.Resource{
+							func() tfsdk.Resource {
+								return &testprovider.ResourceWithMetadata{
+									GetSchemaMethod: func(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+										return tfsdk.Schema{
+											Attributes: map[string]tfsdk.Attribute{
+												"test1": {
+													Required: true,
+													Type:     types.StringType,
+												},
+											},
+										}, nil
+									},
+									MetadataMethod: func(_ context.Context, req tfsdk.ResourceMetadataRequest, resp *tfsdk.ResourceMetadataResponse) {
+										resp.TypeName = req.ProviderTypeName + "_resource1"
+									},
+								}
+							},
+							func() tfsdk.Resource {
+								return &testprovider.ResourceWithMetadata{
+									GetSchemaMethod: func(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+										return tfsdk.Schema{
+											Attributes: map[string]tfsdk.Attribute{
+												"test2": {
+													Required: true,
+													Type:     types.StringType,
+												},
+											},
+										}, nil
+									},
+									MetadataMethod: func(_ context.Context, req tfsdk.ResourceMetadataRequest, resp *tfsdk.ResourceMetadataResponse) {
+										resp.TypeName = "test_resource1"
+									},
+								}
+							},
+						}
+					},
+				},
+			},
+			request: &fwserver.GetProviderSchemaRequest{},
+			expectedResponse: &fwserver.GetProviderSchemaResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Duplicate Resource Type Defined",
+						"The test_resource1 resource type name was returned for multiple resources. "+
+							"Resource type names must be unique. "+
+							"This is always an issue with the provider and should be reported to the provider developers.",
+					),
+				},
+				Provider: &tfsdk.Schema{},
+			},
+		},
+		"resourceschemas-factories-duplicate-GetResources": {
+			server: &fwserver.Server{
+				Provider: &testprovider.ProviderWithResources{
+					ProviderWithMetadata: &testprovider.ProviderWithMetadata{
+						Provider: &testprovider.Provider{
+							GetResourcesMethod: func(_ context.Context) (map[string]tfsdk.ResourceType, diag.Diagnostics) {
+								return map[string]tfsdk.ResourceType{
+									"test_resource1": &testprovider.ResourceType{},
+								}, nil
+							},
+						},
+						MetadataMethod: func(_ context.Context, _ tfsdk.ProviderMetadataRequest, resp *tfsdk.ProviderMetadataResponse) {
+							resp.TypeName = "test"
+						},
+					},
+					ResourcesMethod: func(_ context.Context) []func() tfsdk.Resource {
+						return []func() tfsdk.Resource{
+							func() tfsdk.Resource {
+								return &testprovider.ResourceWithMetadata{
+									GetSchemaMethod: func(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+										return tfsdk.Schema{
+											Attributes: map[string]tfsdk.Attribute{
+												"test1": {
+													Required: true,
+													Type:     types.StringType,
+												},
+											},
+										}, nil
+									},
+									MetadataMethod: func(_ context.Context, req tfsdk.ResourceMetadataRequest, resp *tfsdk.ResourceMetadataResponse) {
+										resp.TypeName = req.ProviderTypeName + "_resource1"
+									},
+								}
+							},
+						}
+					},
+				},
+			},
+			request: &fwserver.GetProviderSchemaRequest{},
+			expectedResponse: &fwserver.GetProviderSchemaResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Duplicate Resource Type Defined",
+						"The test_resource1 resource type name was returned for multiple resources. "+
+							"Resource type names must be unique. "+
+							"This is always an issue with the provider and should be reported to the provider developers.",
+					),
+				},
+				Provider: &tfsdk.Schema{},
+			},
+		},
+		"resourceschemas-factories-metadata-missing": {
+			server: &fwserver.Server{
+				Provider: &testprovider.ProviderWithResources{
+					ProviderWithMetadata: &testprovider.ProviderWithMetadata{
+						Provider: &testprovider.Provider{},
+					},
+					ResourcesMethod: func(_ context.Context) []func() tfsdk.Resource {
+						return []func() tfsdk.Resource{
+							func() tfsdk.Resource {
+								return &testprovider.Resource{}
+							},
+						}
+					},
+				},
+			},
+			request: &fwserver.GetProviderSchemaRequest{},
+			expectedResponse: &fwserver.GetProviderSchemaResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Resource Metadata Missing",
+						"The *testprovider.Resource Resource returned by the provider Resources method does not implement the ResourceWithMetadata interface. "+
+							"This is always an issue with the provider and should be reported to the provider developers.",
+					),
+				},
+				Provider: &tfsdk.Schema{},
+			},
+		},
 		"provider": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{
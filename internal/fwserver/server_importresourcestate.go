@@ -41,6 +41,26 @@ type ImportResourceStateResponse struct {
 
 // ImportResourceState implements the framework server ImportResourceState RPC.
 func (s *Server) ImportResourceState(ctx context.Context, req *ImportResourceStateRequest, resp *ImportResourceStateResponse) {
+	interceptorReq := InterceptorRequest{
+		RPC: RPCImportResourceState,
+	}
+
+	if req != nil {
+		interceptorReq.TypeName = req.TypeName
+	}
+
+	s.intercept(ctx, interceptorReq, &resp.Diagnostics, func(ctx context.Context, interceptorResp *InterceptorResponse) {
+		s.importResourceState(ctx, req, resp)
+
+		if len(resp.ImportedResources) == 1 {
+			interceptorResp.State = &resp.ImportedResources[0].State
+		}
+	})
+}
+
+// importResourceState contains the ImportResourceState RPC logic, which is
+// called through any Interceptors.
+func (s *Server) importResourceState(ctx context.Context, req *ImportResourceStateRequest, resp *ImportResourceStateResponse) {
 	if req == nil {
 		return
 	}
@@ -56,6 +76,12 @@ func (s *Server) ImportResourceState(ctx context.Context, req *ImportResourceSta
 		return
 	}
 
+	resp.Diagnostics.Append(s.configureResource(ctx, resource)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resourceWithImportState, ok := resource.(tfsdk.ResourceWithImportState)
 
 	if !ok {
@@ -0,0 +1,94 @@
+package fwserver
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// Interceptor wraps a framework server RPC. Calling next continues to the
+// next Interceptor or the RPC itself, after which the InterceptorResponse
+// contains the RPC response data. Not calling next skips the RPC.
+type Interceptor func(ctx context.Context, req InterceptorRequest, resp *InterceptorResponse, next func(context.Context))
+
+// InterceptorRequest is the request data available to an Interceptor.
+type InterceptorRequest struct {
+	// RPC is the framework server RPC name, such as "ReadResource".
+	RPC string
+
+	// TypeName is the resource or data source type name. It is empty for
+	// provider RPCs.
+	TypeName string
+
+	// Config is the configuration, if the RPC has one.
+	Config *tfsdk.Config
+
+	// Plan is the proposed new state during PlanResourceChange or the
+	// planned state during ApplyResourceChange.
+	Plan *tfsdk.Plan
+
+	// State is the prior state during PlanResourceChange and
+	// ApplyResourceChange or the current state during ReadResource.
+	State *tfsdk.State
+}
+
+// InterceptorResponse is the response data available to an Interceptor.
+type InterceptorResponse struct {
+	// Diagnostics is the RPC response diagnostics. Interceptors may modify
+	// these, which are returned in place of the RPC response diagnostics.
+	Diagnostics diag.Diagnostics
+
+	// State is the planned state of PlanResourceChange, the new state of
+	// ApplyResourceChange and ReadResource, the state of ReadDataSource,
+	// the upgraded state of UpgradeResourceState, or the imported state of
+	// ImportResourceState when a single resource was imported.
+	State *tfsdk.State
+}
+
+// Framework server RPC names for InterceptorRequest.
+const (
+	RPCApplyResourceChange      = "ApplyResourceChange"
+	RPCConfigureProvider        = "ConfigureProvider"
+	RPCImportResourceState      = "ImportResourceState"
+	RPCPlanResourceChange       = "PlanResourceChange"
+	RPCReadDataSource           = "ReadDataSource"
+	RPCReadResource             = "ReadResource"
+	RPCUpgradeResourceState     = "UpgradeResourceState"
+	RPCValidateDataSourceConfig = "ValidateDataSourceConfig"
+	RPCValidateProviderConfig   = "ValidateProviderConfig"
+	RPCValidateResourceConfig   = "ValidateResourceConfig"
+)
+
+// intercept calls the RPC handler through the Server Interceptors, in order.
+// The handler must run the RPC and set the InterceptorResponse State field,
+// if applicable. The diags parameter is the RPC response diagnostics, which
+// are replaced by the InterceptorResponse diagnostics afterwards.
+func (s *Server) intercept(ctx context.Context, req InterceptorRequest, diags *diag.Diagnostics, handler func(context.Context, *InterceptorResponse)) {
+	resp := &InterceptorResponse{}
+
+	if len(s.Interceptors) == 0 {
+		handler(ctx, resp)
+
+		return
+	}
+
+	call := func(ctx context.Context) {
+		handler(ctx, resp)
+
+		resp.Diagnostics = *diags
+	}
+
+	for i := len(s.Interceptors) - 1; i >= 0; i-- {
+		interceptor := s.Interceptors[i]
+		next := call
+
+		call = func(ctx context.Context) {
+			interceptor(ctx, req, resp, next)
+		}
+	}
+
+	call(ctx)
+
+	*diags = resp.Diagnostics
+}
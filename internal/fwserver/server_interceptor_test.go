@@ -0,0 +1,202 @@
+package fwserver_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestServerInterceptors(t *testing.T) {
+	t.Parallel()
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test": tftypes.String,
+		},
+	}
+
+	testSchema := tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"test": {
+				Computed: true,
+				Type:     types.StringType,
+			},
+		},
+	}
+
+	testConfig := &tfsdk.Config{
+		Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+			"test": tftypes.NewValue(tftypes.String, nil),
+		}),
+		Schema: testSchema,
+	}
+
+	testState := &tfsdk.State{
+		Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+			"test": tftypes.NewValue(tftypes.String, "test-value"),
+		}),
+		Schema: testSchema,
+	}
+
+	testDataSourceType := &testprovider.DataSourceType{
+		GetSchemaMethod: func(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+			return testSchema, nil
+		},
+		NewDataSourceMethod: func(_ context.Context, _ tfsdk.Provider) (tfsdk.DataSource, diag.Diagnostics) {
+			return &testprovider.DataSource{
+				ReadMethod: func(ctx context.Context, req tfsdk.ReadDataSourceRequest, resp *tfsdk.ReadDataSourceResponse) {
+					resp.Diagnostics.AddWarning("read summary", "read detail")
+					resp.Diagnostics.Append(resp.State.Set(ctx, struct {
+						Test types.String `tfsdk:"test"`
+					}{
+						Test: types.StringValue("test-value"),
+					})...)
+				},
+			}, nil
+		},
+	}
+
+	testCases := map[string]struct {
+		interceptors     func(calls *[]string) []fwserver.Interceptor
+		expectedCalls    []string
+		expectedResponse *fwserver.ReadDataSourceResponse
+	}{
+		"none": {
+			interceptors: func(_ *[]string) []fwserver.Interceptor {
+				return nil
+			},
+			expectedResponse: &fwserver.ReadDataSourceResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewWarningDiagnostic("read summary", "read detail"),
+				},
+				State: testState,
+			},
+		},
+		"order": {
+			interceptors: func(calls *[]string) []fwserver.Interceptor {
+				interceptor := func(name string) fwserver.Interceptor {
+					return func(ctx context.Context, req fwserver.InterceptorRequest, resp *fwserver.InterceptorResponse, next func(context.Context)) {
+						*calls = append(*calls, name+" before "+req.RPC+" "+req.TypeName)
+						next(ctx)
+						*calls = append(*calls, name+" after "+req.RPC+" "+req.TypeName)
+					}
+				}
+
+				return []fwserver.Interceptor{
+					interceptor("first"),
+					interceptor("second"),
+				}
+			},
+			expectedCalls: []string{
+				"first before ReadDataSource test_data_source",
+				"second before ReadDataSource test_data_source",
+				"second after ReadDataSource test_data_source",
+				"first after ReadDataSource test_data_source",
+			},
+			expectedResponse: &fwserver.ReadDataSourceResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewWarningDiagnostic("read summary", "read detail"),
+				},
+				State: testState,
+			},
+		},
+		"request-response": {
+			interceptors: func(_ *[]string) []fwserver.Interceptor {
+				return []fwserver.Interceptor{
+					func(ctx context.Context, req fwserver.InterceptorRequest, resp *fwserver.InterceptorResponse, next func(context.Context)) {
+						if req.Config != testConfig {
+							resp.Diagnostics.AddError("Unexpected req.Config", "before next")
+						}
+
+						next(ctx)
+
+						if diff := cmp.Diff(resp.State, testState); diff != "" {
+							resp.Diagnostics.AddError("Unexpected resp.State", diff)
+						}
+					},
+				}
+			},
+			expectedResponse: &fwserver.ReadDataSourceResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewWarningDiagnostic("read summary", "read detail"),
+				},
+				State: testState,
+			},
+		},
+		"response-diagnostics": {
+			interceptors: func(_ *[]string) []fwserver.Interceptor {
+				return []fwserver.Interceptor{
+					func(ctx context.Context, req fwserver.InterceptorRequest, resp *fwserver.InterceptorResponse, next func(context.Context)) {
+						next(ctx)
+
+						resp.Diagnostics.AddWarning("interceptor summary", "interceptor detail")
+					},
+				}
+			},
+			expectedResponse: &fwserver.ReadDataSourceResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewWarningDiagnostic("read summary", "read detail"),
+					diag.NewWarningDiagnostic("interceptor summary", "interceptor detail"),
+				},
+				State: testState,
+			},
+		},
+		"skip-next": {
+			interceptors: func(calls *[]string) []fwserver.Interceptor {
+				return []fwserver.Interceptor{
+					func(ctx context.Context, req fwserver.InterceptorRequest, resp *fwserver.InterceptorResponse, next func(context.Context)) {
+						resp.Diagnostics.AddError("interceptor summary", "interceptor detail")
+					},
+					func(ctx context.Context, req fwserver.InterceptorRequest, resp *fwserver.InterceptorResponse, next func(context.Context)) {
+						*calls = append(*calls, "unexpected")
+						next(ctx)
+					},
+				}
+			},
+			expectedResponse: &fwserver.ReadDataSourceResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic("interceptor summary", "interceptor detail"),
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var calls []string
+
+			server := &fwserver.Server{
+				Interceptors: testCase.interceptors(&calls),
+				Provider:     &testprovider.Provider{},
+			}
+			request := &fwserver.ReadDataSourceRequest{
+				Config:           testConfig,
+				DataSourceSchema: testSchema,
+				DataSourceType:   testDataSourceType,
+				TypeName:         "test_data_source",
+			}
+			response := &fwserver.ReadDataSourceResponse{}
+
+			server.ReadDataSource(context.Background(), request, response)
+
+			if diff := cmp.Diff(response, testCase.expectedResponse); diff != "" {
+				t.Errorf("unexpected response difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(calls, testCase.expectedCalls); diff != "" {
+				t.Errorf("unexpected calls difference: %s", diff)
+			}
+		})
+	}
+}
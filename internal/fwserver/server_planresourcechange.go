@@ -8,6 +8,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/internal/valuehash"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
@@ -23,6 +24,10 @@ type PlanResourceChangeRequest struct {
 	ProviderMeta     *tfsdk.Config
 	ResourceSchema   tfsdk.Schema
 	ResourceType     tfsdk.ResourceType
+
+	// TypeName is the resource type name, which is passed to
+	// interceptors.
+	TypeName string
 }
 
 // PlanResourceChangeResponse is the framework server response for the
@@ -36,6 +41,27 @@ type PlanResourceChangeResponse struct {
 
 // PlanResourceChange implements the framework server PlanResourceChange RPC.
 func (s *Server) PlanResourceChange(ctx context.Context, req *PlanResourceChangeRequest, resp *PlanResourceChangeResponse) {
+	interceptorReq := InterceptorRequest{
+		RPC: RPCPlanResourceChange,
+	}
+
+	if req != nil {
+		interceptorReq.Config = req.Config
+		interceptorReq.Plan = req.ProposedNewState
+		interceptorReq.State = req.PriorState
+		interceptorReq.TypeName = req.TypeName
+	}
+
+	s.intercept(ctx, interceptorReq, &resp.Diagnostics, func(ctx context.Context, interceptorResp *InterceptorResponse) {
+		s.planResourceChange(ctx, req, resp)
+
+		interceptorResp.State = resp.PlannedState
+	})
+}
+
+// planResourceChange contains the PlanResourceChange RPC logic, which is called
+// through any Interceptors.
+func (s *Server) planResourceChange(ctx context.Context, req *PlanResourceChangeRequest, resp *PlanResourceChangeResponse) {
 	if req == nil {
 		return
 	}
@@ -51,6 +77,12 @@ func (s *Server) PlanResourceChange(ctx context.Context, req *PlanResourceChange
 		return
 	}
 
+	resp.Diagnostics.Append(s.configureResource(ctx, resource)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	nullTfValue := tftypes.NewValue(req.ResourceSchema.TerraformType(ctx), nil)
 
 	// Prevent potential panics by ensuring incoming Config/Plan/State are null
@@ -193,9 +225,10 @@ func (s *Server) PlanResourceChange(ctx context.Context, req *PlanResourceChange
 		logging.FrameworkTrace(ctx, "Resource implements ResourceWithModifyPlan")
 
 		modifyPlanReq := tfsdk.ModifyResourcePlanRequest{
-			Config: *req.Config,
-			Plan:   stateToPlan(*resp.PlannedState),
-			State:  *req.PriorState,
+			Config:           *req.Config,
+			Plan:             stateToPlan(*resp.PlannedState),
+			ProviderDeferred: s.ProviderDeferred(),
+			State:            *req.PriorState,
 		}
 
 		if req.ProviderMeta != nil {
@@ -222,6 +255,8 @@ func (s *Server) PlanResourceChange(ctx context.Context, req *PlanResourceChange
 }
 
 func MarkComputedNilsAsUnknown(ctx context.Context, config tftypes.Value, resourceSchema tfsdk.Schema) func(*tftypes.AttributePath, tftypes.Value) (tftypes.Value, error) {
+	configLookup := valuehash.NewPathLookup(config)
+
 	return func(path *tftypes.AttributePath, val tftypes.Value) (tftypes.Value, error) {
 		ctx = logging.FrameworkWithAttributePath(ctx, path.String())
 
@@ -230,12 +265,12 @@ func MarkComputedNilsAsUnknown(ctx context.Context, config tftypes.Value, resour
 			return val, nil
 		}
 
-		configVal, _, err := tftypes.WalkAttributePath(config, path)
+		configVal, err := configLookup.Value(path)
 
 		if err != tftypes.ErrInvalidStep && err != nil {
 			logging.FrameworkError(ctx, "error walking attribute path")
 			return val, err
-		} else if err != tftypes.ErrInvalidStep && !configVal.(tftypes.Value).IsNull() {
+		} else if err != tftypes.ErrInvalidStep && !configVal.IsNull() {
 			logging.FrameworkTrace(ctx, "attribute not null in config, not marking unknown")
 			return val, nil
 		}
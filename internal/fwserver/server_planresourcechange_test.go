@@ -266,7 +266,7 @@ func TestServerPlanResourceChange(t *testing.T) {
 				PlanModifiers: tfsdk.AttributePlanModifiers{
 					&testprovider.AttributePlanModifier{
 						ModifyMethod: func(ctx context.Context, req tfsdk.ModifyAttributePlanRequest, resp *tfsdk.ModifyAttributePlanResponse) {
-							resp.AttributePlan = types.String{Value: "test-attributeplanmodifier-value"}
+							resp.AttributePlan = types.StringValue("test-attributeplanmodifier-value")
 						},
 					},
 				},
@@ -557,8 +557,59 @@ func TestServerPlanResourceChange(t *testing.T) {
 
 								resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 
-								if data.TestRequired.Value != "test-config-value" {
-									resp.Diagnostics.AddError("Unexpected req.Config Value", "Got: "+data.TestRequired.Value)
+								if data.TestRequired.ValueString() != "test-config-value" {
+									resp.Diagnostics.AddError("Unexpected req.Config Value", "Got: "+data.TestRequired.ValueString())
+								}
+							},
+						}, nil
+					},
+				},
+			},
+			expectedResponse: &fwserver.PlanResourceChangeResponse{
+				PlannedState: &tfsdk.State{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+						"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchema,
+				},
+			},
+		},
+		"create-resourcewithmodifyplan-request-providerdeferred": {
+			server: testConfiguredServer(
+				&fwserver.Server{
+					Provider: &testprovider.Provider{},
+				},
+				&tfsdk.ConfigureProviderResponse{
+					Deferred: true,
+				},
+			),
+			request: &fwserver.PlanResourceChangeRequest{
+				Config: &tfsdk.Config{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchema,
+				},
+				ProposedNewState: &tfsdk.Plan{
+					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
+						"test_computed": tftypes.NewValue(tftypes.String, nil),
+						"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+					}),
+					Schema: testSchema,
+				},
+				PriorState:     testEmptyState,
+				ResourceSchema: testSchema,
+				ResourceType: &testprovider.ResourceType{
+					GetSchemaMethod: func(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+						return testSchema, nil
+					},
+					NewResourceMethod: func(_ context.Context, _ tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+						return &testprovider.ResourceWithModifyPlan{
+							ModifyPlanMethod: func(ctx context.Context, req tfsdk.ModifyResourcePlanRequest, resp *tfsdk.ModifyResourcePlanResponse) {
+								if !req.ProviderDeferred {
+									resp.Diagnostics.AddError("Unexpected req.ProviderDeferred Value", "Got: false")
 								}
 							},
 						}, nil
@@ -607,8 +658,8 @@ func TestServerPlanResourceChange(t *testing.T) {
 
 								resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 
-								if !data.TestComputed.Unknown {
-									resp.Diagnostics.AddError("Unexpected req.Plan Value", "Got: "+data.TestComputed.Value)
+								if !data.TestComputed.IsUnknown() {
+									resp.Diagnostics.AddError("Unexpected req.Plan Value", "Got: "+data.TestComputed.ValueString())
 								}
 							},
 						}, nil
@@ -658,8 +709,8 @@ func TestServerPlanResourceChange(t *testing.T) {
 
 								resp.Diagnostics.Append(req.ProviderMeta.Get(ctx, &data)...)
 
-								if data.TestProviderMetaAttribute.Value != "test-provider-meta-value" {
-									resp.Diagnostics.AddError("Unexpected req.ProviderMeta Value", "Got: "+data.TestProviderMetaAttribute.Value)
+								if data.TestProviderMetaAttribute.ValueString() != "test-provider-meta-value" {
+									resp.Diagnostics.AddError("Unexpected req.ProviderMeta Value", "Got: "+data.TestProviderMetaAttribute.ValueString())
 								}
 							},
 						}, nil
@@ -757,7 +808,7 @@ func TestServerPlanResourceChange(t *testing.T) {
 
 								resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 
-								data.TestComputed = types.String{Value: "test-plannedstate-value"}
+								data.TestComputed = types.StringValue("test-plannedstate-value")
 
 								resp.Diagnostics.Append(resp.Plan.Set(ctx, &data)...)
 							},
@@ -862,8 +913,8 @@ func TestServerPlanResourceChange(t *testing.T) {
 
 								resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 
-								if data.TestRequired.Value != "test-config-value" {
-									resp.Diagnostics.AddError("Unexpected req.Config Value", "Got: "+data.TestRequired.Value)
+								if data.TestRequired.ValueString() != "test-config-value" {
+									resp.Diagnostics.AddError("Unexpected req.Config Value", "Got: "+data.TestRequired.ValueString())
 								}
 							},
 						}, nil
@@ -906,8 +957,8 @@ func TestServerPlanResourceChange(t *testing.T) {
 
 								resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 
-								if data.TestRequired.Value != "test-state-value" {
-									resp.Diagnostics.AddError("Unexpected req.State Value", "Got: "+data.TestRequired.Value)
+								if data.TestRequired.ValueString() != "test-state-value" {
+									resp.Diagnostics.AddError("Unexpected req.State Value", "Got: "+data.TestRequired.ValueString())
 								}
 							},
 						}, nil
@@ -951,8 +1002,8 @@ func TestServerPlanResourceChange(t *testing.T) {
 
 								resp.Diagnostics.Append(req.ProviderMeta.Get(ctx, &data)...)
 
-								if data.TestProviderMetaAttribute.Value != "test-provider-meta-value" {
-									resp.Diagnostics.AddError("Unexpected req.ProviderMeta Value", "Got: "+data.TestProviderMetaAttribute.Value)
+								if data.TestProviderMetaAttribute.ValueString() != "test-provider-meta-value" {
+									resp.Diagnostics.AddError("Unexpected req.ProviderMeta Value", "Got: "+data.TestProviderMetaAttribute.ValueString())
 								}
 							},
 						}, nil
@@ -1044,7 +1095,7 @@ func TestServerPlanResourceChange(t *testing.T) {
 								// anything with the PlannedState that is not equal to the
 								// empty request ProposedNewState should raise an error.
 								// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/364
-								data.TestComputed = types.String{Value: "test-plannedstate-value"}
+								data.TestComputed = types.StringValue("test-plannedstate-value")
 
 								resp.Diagnostics.Append(resp.Plan.Set(ctx, &data)...)
 							},
@@ -1071,7 +1122,7 @@ func TestServerPlanResourceChange(t *testing.T) {
 				PlannedState: &tfsdk.State{
 					Raw: tftypes.NewValue(testSchemaType, map[string]tftypes.Value{
 						"test_computed": tftypes.NewValue(tftypes.String, "test-plannedstate-value"),
-						"test_required": tftypes.NewValue(tftypes.String, ""),
+						"test_required": tftypes.NewValue(tftypes.String, nil),
 					}),
 					Schema: testSchema,
 				},
@@ -1357,8 +1408,8 @@ func TestServerPlanResourceChange(t *testing.T) {
 
 								resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 
-								if data.TestRequired.Value != "test-new-value" {
-									resp.Diagnostics.AddError("Unexpected req.Config Value", "Got: "+data.TestRequired.Value)
+								if data.TestRequired.ValueString() != "test-new-value" {
+									resp.Diagnostics.AddError("Unexpected req.Config Value", "Got: "+data.TestRequired.ValueString())
 								}
 							},
 						}, nil
@@ -1413,8 +1464,8 @@ func TestServerPlanResourceChange(t *testing.T) {
 
 								resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 
-								if !data.TestComputed.Unknown {
-									resp.Diagnostics.AddError("Unexpected req.Plan Value", "Got: "+data.TestComputed.Value)
+								if !data.TestComputed.IsUnknown() {
+									resp.Diagnostics.AddError("Unexpected req.Plan Value", "Got: "+data.TestComputed.ValueString())
 								}
 							},
 						}, nil
@@ -1470,8 +1521,8 @@ func TestServerPlanResourceChange(t *testing.T) {
 
 								resp.Diagnostics.Append(req.ProviderMeta.Get(ctx, &data)...)
 
-								if data.TestProviderMetaAttribute.Value != "test-provider-meta-value" {
-									resp.Diagnostics.AddError("Unexpected req.ProviderMeta Value", "Got: "+data.TestProviderMetaAttribute.Value)
+								if data.TestProviderMetaAttribute.ValueString() != "test-provider-meta-value" {
+									resp.Diagnostics.AddError("Unexpected req.ProviderMeta Value", "Got: "+data.TestProviderMetaAttribute.ValueString())
 								}
 							},
 						}, nil
@@ -1581,7 +1632,7 @@ func TestServerPlanResourceChange(t *testing.T) {
 
 								resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 
-								data.TestComputed = types.String{Value: "test-plannedstate-value"}
+								data.TestComputed = types.StringValue("test-plannedstate-value")
 
 								resp.Diagnostics.Append(resp.Plan.Set(ctx, &data)...)
 							},
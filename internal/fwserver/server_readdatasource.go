@@ -15,6 +15,10 @@ type ReadDataSourceRequest struct {
 	DataSourceSchema tfsdk.Schema
 	DataSourceType   tfsdk.DataSourceType
 	ProviderMeta     *tfsdk.Config
+
+	// TypeName is the data source type name, which is passed to
+	// interceptors.
+	TypeName string
 }
 
 // ReadDataSourceResponse is the framework server response for the
@@ -26,6 +30,25 @@ type ReadDataSourceResponse struct {
 
 // ReadDataSource implements the framework server ReadDataSource RPC.
 func (s *Server) ReadDataSource(ctx context.Context, req *ReadDataSourceRequest, resp *ReadDataSourceResponse) {
+	interceptorReq := InterceptorRequest{
+		RPC: RPCReadDataSource,
+	}
+
+	if req != nil {
+		interceptorReq.Config = req.Config
+		interceptorReq.TypeName = req.TypeName
+	}
+
+	s.intercept(ctx, interceptorReq, &resp.Diagnostics, func(ctx context.Context, interceptorResp *InterceptorResponse) {
+		s.readDataSource(ctx, req, resp)
+
+		interceptorResp.State = resp.State
+	})
+}
+
+// readDataSource contains the ReadDataSource RPC logic, which is called through
+// any Interceptors.
+func (s *Server) readDataSource(ctx context.Context, req *ReadDataSourceRequest, resp *ReadDataSourceResponse) {
 	if req == nil {
 		return
 	}
@@ -41,10 +64,17 @@ func (s *Server) ReadDataSource(ctx context.Context, req *ReadDataSourceRequest,
 		return
 	}
 
+	resp.Diagnostics.Append(s.configureDataSource(ctx, dataSource)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	readReq := tfsdk.ReadDataSourceRequest{
 		Config: tfsdk.Config{
 			Schema: req.DataSourceSchema,
 		},
+		ProviderDeferred: s.ProviderDeferred(),
 	}
 	readResp := tfsdk.ReadDataSourceResponse{
 		State: tfsdk.State{
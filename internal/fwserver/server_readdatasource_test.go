@@ -2,6 +2,7 @@ package fwserver_test
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -93,8 +94,8 @@ func TestServerReadDataSource(t *testing.T) {
 
 								resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
 
-								if config.TestRequired.Value != "test-config-value" {
-									resp.Diagnostics.AddError("unexpected req.Config value: %s", config.TestRequired.Value)
+								if config.TestRequired.ValueString() != "test-config-value" {
+									resp.Diagnostics.AddError("unexpected req.Config value: %s", config.TestRequired.ValueString())
 								}
 							},
 						}, nil
@@ -105,6 +106,115 @@ func TestServerReadDataSource(t *testing.T) {
 				State: testStateUnchanged,
 			},
 		},
+		"request-providerdeferred": {
+			server: testConfiguredServer(
+				&fwserver.Server{
+					Provider: &testprovider.Provider{},
+				},
+				&tfsdk.ConfigureProviderResponse{
+					Deferred: true,
+				},
+			),
+			request: &fwserver.ReadDataSourceRequest{
+				Config:           testConfig,
+				DataSourceSchema: testSchema,
+				DataSourceType: &testprovider.DataSourceType{
+					GetSchemaMethod: func(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+						return testSchema, nil
+					},
+					NewDataSourceMethod: func(_ context.Context, _ tfsdk.Provider) (tfsdk.DataSource, diag.Diagnostics) {
+						return &testprovider.DataSource{
+							ReadMethod: func(ctx context.Context, req tfsdk.ReadDataSourceRequest, resp *tfsdk.ReadDataSourceResponse) {
+								if req.ProviderDeferred {
+									resp.Diagnostics.Append(tfsdk.ProviderDeferredDiagnostic())
+								}
+							},
+						}, nil
+					},
+				},
+			},
+			expectedResponse: &fwserver.ReadDataSourceResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Provider Configuration Not Known",
+						"The provider configuration contains values which are not known until apply, because they depend on values from other resources. "+
+							"The provider cannot complete this operation until the configuration is known.\n\n"+
+							"To prevent this, apply the resources the provider configuration depends on first, such as with the -target option, "+
+							"or set the provider configuration to known values, such as with variables.",
+					),
+				},
+				State: testStateUnchanged,
+			},
+		},
+		"datasource-configure-data": {
+			server: testConfiguredServer(
+				&fwserver.Server{
+					Provider: &testprovider.Provider{},
+				},
+				&tfsdk.ConfigureProviderResponse{
+					DataSourceData: "test-provider-configure-value",
+				},
+			),
+			request: &fwserver.ReadDataSourceRequest{
+				Config:           testConfig,
+				DataSourceSchema: testSchema,
+				DataSourceType: &testprovider.DataSourceType{
+					GetSchemaMethod: func(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+						return testSchema, nil
+					},
+					NewDataSourceMethod: func(_ context.Context, _ tfsdk.Provider) (tfsdk.DataSource, diag.Diagnostics) {
+						var providerData interface{}
+
+						return &testprovider.DataSourceWithConfigure{
+							ConfigureMethod: func(ctx context.Context, req tfsdk.ConfigureDataSourceRequest, resp *tfsdk.ConfigureDataSourceResponse) {
+								providerData = req.ProviderData
+							},
+							DataSource: &testprovider.DataSource{
+								ReadMethod: func(ctx context.Context, req tfsdk.ReadDataSourceRequest, resp *tfsdk.ReadDataSourceResponse) {
+									if providerData != "test-provider-configure-value" {
+										resp.Diagnostics.AddError("Unexpected ProviderData", fmt.Sprintf("Got: %v", providerData))
+									}
+								},
+							},
+						}, nil
+					},
+				},
+			},
+			expectedResponse: &fwserver.ReadDataSourceResponse{
+				State: testStateUnchanged,
+			},
+		},
+		"datasource-configure-unconfigured-provider": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ReadDataSourceRequest{
+				Config:           testConfig,
+				DataSourceSchema: testSchema,
+				DataSourceType: &testprovider.DataSourceType{
+					GetSchemaMethod: func(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+						return testSchema, nil
+					},
+					NewDataSourceMethod: func(_ context.Context, _ tfsdk.Provider) (tfsdk.DataSource, diag.Diagnostics) {
+						return &testprovider.DataSourceWithConfigure{
+							ConfigureMethod: func(ctx context.Context, req tfsdk.ConfigureDataSourceRequest, resp *tfsdk.ConfigureDataSourceResponse) {
+								resp.Diagnostics.AddError("Unexpected Configure Call", "Configure should not be called before the provider is configured.")
+							},
+							DataSource: &testprovider.DataSource{},
+						}, nil
+					},
+				},
+			},
+			expectedResponse: &fwserver.ReadDataSourceResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Provider Not Configured",
+						"The data source requires a configured provider, however the provider has not been configured. "+
+							"This is always an issue in the Terraform Provider or Terraform CLI and should be reported to the provider developers.",
+					),
+				},
+			},
+		},
 		"request-providermeta": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
@@ -126,8 +236,8 @@ func TestServerReadDataSource(t *testing.T) {
 
 								resp.Diagnostics.Append(req.ProviderMeta.Get(ctx, &config)...)
 
-								if config.TestRequired.Value != "test-config-value" {
-									resp.Diagnostics.AddError("unexpected req.ProviderMeta value: %s", config.TestRequired.Value)
+								if config.TestRequired.ValueString() != "test-config-value" {
+									resp.Diagnostics.AddError("unexpected req.ProviderMeta value: %s", config.TestRequired.ValueString())
 								}
 							},
 						}, nil
@@ -195,7 +305,7 @@ func TestServerReadDataSource(t *testing.T) {
 
 								resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 
-								data.TestComputed = types.String{Value: "test-state-value"}
+								data.TestComputed = types.StringValue("test-state-value")
 
 								resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 							},
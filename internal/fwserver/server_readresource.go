@@ -6,6 +6,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
 // ReadResourceRequest is the framework server request for the
@@ -15,6 +16,10 @@ type ReadResourceRequest struct {
 	ResourceType tfsdk.ResourceType
 	Private      []byte
 	ProviderMeta *tfsdk.Config
+
+	// TypeName is the resource type name, which is passed to
+	// interceptors.
+	TypeName string
 }
 
 // ReadResourceResponse is the framework server response for the
@@ -27,6 +32,25 @@ type ReadResourceResponse struct {
 
 // ReadResource implements the framework server ReadResource RPC.
 func (s *Server) ReadResource(ctx context.Context, req *ReadResourceRequest, resp *ReadResourceResponse) {
+	interceptorReq := InterceptorRequest{
+		RPC: RPCReadResource,
+	}
+
+	if req != nil {
+		interceptorReq.State = req.CurrentState
+		interceptorReq.TypeName = req.TypeName
+	}
+
+	s.intercept(ctx, interceptorReq, &resp.Diagnostics, func(ctx context.Context, interceptorResp *InterceptorResponse) {
+		s.readResource(ctx, req, resp)
+
+		interceptorResp.State = resp.NewState
+	})
+}
+
+// readResource contains the ReadResource RPC logic, which is called through any
+// Interceptors.
+func (s *Server) readResource(ctx context.Context, req *ReadResourceRequest, resp *ReadResourceResponse) {
 	if req == nil {
 		return
 	}
@@ -52,7 +76,14 @@ func (s *Server) ReadResource(ctx context.Context, req *ReadResourceRequest, res
 		return
 	}
 
+	resp.Diagnostics.Append(s.configureResource(ctx, resource)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	readReq := tfsdk.ReadResourceRequest{
+		ProviderDeferred: s.ProviderDeferred(),
 		State: tfsdk.State{
 			Schema: req.CurrentState.Schema,
 			Raw:    req.CurrentState.Raw.Copy(),
@@ -75,4 +106,17 @@ func (s *Server) ReadResource(ctx context.Context, req *ReadResourceRequest, res
 
 	resp.Diagnostics = readResp.Diagnostics
 	resp.NewState = &readResp.State
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	newStateRaw, diags := SchemaSemanticEquality(ctx, req.CurrentState.Schema, req.CurrentState.Raw, resp.NewState.Raw)
+
+	resp.Diagnostics.Append(diags...)
+
+	newStateRaw, diags = SchemaNullEqualsEmpty(ctx, req.CurrentState.Schema, req.CurrentState.Raw, tftypes.NewValue(req.CurrentState.Schema.TerraformType(ctx), nil), newStateRaw)
+
+	resp.Diagnostics.Append(diags...)
+	resp.NewState.Raw = newStateRaw
 }
@@ -5,11 +5,14 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/jsontypes"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
@@ -66,6 +69,40 @@ func TestServerReadResource(t *testing.T) {
 		Schema: testSchema,
 	}
 
+	testSemanticSchema := tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"test_json": {
+				Required: true,
+				Type:     jsontypes.NormalizedType{},
+			},
+		},
+	}
+
+	testSemanticCurrentState := &tfsdk.State{
+		Raw: tftypes.NewValue(testSemanticSchema.TerraformType(context.Background()), map[string]tftypes.Value{
+			"test_json": tftypes.NewValue(tftypes.String, `{"b": 2, "a": 1}`),
+		}),
+		Schema: testSemanticSchema,
+	}
+
+	testNullEqualsEmptySchema := tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"test_list": {
+				Computed:        true,
+				NullEqualsEmpty: true,
+				Optional:        true,
+				Type:            types.ListType{ElemType: types.StringType},
+			},
+		},
+	}
+
+	testNullEqualsEmptyCurrentState := &tfsdk.State{
+		Raw: tftypes.NewValue(testNullEqualsEmptySchema.TerraformType(context.Background()), map[string]tftypes.Value{
+			"test_list": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+		}),
+		Schema: testNullEqualsEmptySchema,
+	}
+
 	testCases := map[string]struct {
 		server           *fwserver.Server
 		request          *fwserver.ReadResourceRequest
@@ -112,8 +149,38 @@ func TestServerReadResource(t *testing.T) {
 
 								resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 
-								if data.TestRequired.Value != "test-currentstate-value" {
-									resp.Diagnostics.AddError("unexpected req.State value: %s", data.TestRequired.Value)
+								if data.TestRequired.ValueString() != "test-currentstate-value" {
+									resp.Diagnostics.AddError("unexpected req.State value: %s", data.TestRequired.ValueString())
+								}
+							},
+						}, nil
+					},
+				},
+			},
+			expectedResponse: &fwserver.ReadResourceResponse{
+				NewState: testCurrentState,
+			},
+		},
+		"request-providerdeferred": {
+			server: testConfiguredServer(
+				&fwserver.Server{
+					Provider: &testprovider.Provider{},
+				},
+				&tfsdk.ConfigureProviderResponse{
+					Deferred: true,
+				},
+			),
+			request: &fwserver.ReadResourceRequest{
+				CurrentState: testCurrentState,
+				ResourceType: &testprovider.ResourceType{
+					GetSchemaMethod: func(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+						return testSchema, nil
+					},
+					NewResourceMethod: func(_ context.Context, _ tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+						return &testprovider.Resource{
+							ReadMethod: func(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+								if !req.ProviderDeferred {
+									resp.Diagnostics.AddError("unexpected req.ProviderDeferred value", "got: false")
 								}
 							},
 						}, nil
@@ -144,8 +211,8 @@ func TestServerReadResource(t *testing.T) {
 
 								resp.Diagnostics.Append(req.ProviderMeta.Get(ctx, &config)...)
 
-								if config.TestRequired.Value != "test-currentstate-value" {
-									resp.Diagnostics.AddError("unexpected req.ProviderMeta value: %s", config.TestRequired.Value)
+								if config.TestRequired.ValueString() != "test-currentstate-value" {
+									resp.Diagnostics.AddError("unexpected req.ProviderMeta value: %s", config.TestRequired.ValueString())
 								}
 							},
 						}, nil
@@ -211,7 +278,7 @@ func TestServerReadResource(t *testing.T) {
 
 								resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 
-								data.TestComputed = types.String{Value: "test-newstate-value"}
+								data.TestComputed = types.StringValue("test-newstate-value")
 
 								resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 							},
@@ -246,6 +313,52 @@ func TestServerReadResource(t *testing.T) {
 				NewState: testNewStateRemoved,
 			},
 		},
+		"response-state-semanticequality": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ReadResourceRequest{
+				CurrentState: testSemanticCurrentState,
+				ResourceType: &testprovider.ResourceType{
+					GetSchemaMethod: func(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+						return testSemanticSchema, nil
+					},
+					NewResourceMethod: func(_ context.Context, _ tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+						return &testprovider.Resource{
+							ReadMethod: func(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+								resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("test_json"), jsontypes.NormalizedValue(`{"a":1,"b":2}`))...)
+							},
+						}, nil
+					},
+				},
+			},
+			expectedResponse: &fwserver.ReadResourceResponse{
+				NewState: testSemanticCurrentState,
+			},
+		},
+		"response-state-nullequalsempty": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ReadResourceRequest{
+				CurrentState: testNullEqualsEmptyCurrentState,
+				ResourceType: &testprovider.ResourceType{
+					GetSchemaMethod: func(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+						return testNullEqualsEmptySchema, nil
+					},
+					NewResourceMethod: func(_ context.Context, _ tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+						return &testprovider.Resource{
+							ReadMethod: func(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+								resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("test_list"), types.ListValueMust(types.StringType, []attr.Value{}))...)
+							},
+						}, nil
+					},
+				},
+			},
+			expectedResponse: &fwserver.ReadResourceResponse{
+				NewState: testNullEqualsEmptyCurrentState,
+			},
+		},
 	}
 
 	for name, testCase := range testCases {
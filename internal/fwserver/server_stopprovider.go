@@ -0,0 +1,111 @@
+package fwserver
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// DefaultShutdownTimeout is the default maximum duration the framework waits
+// for the provider defined Stop and Close methods to complete.
+const DefaultShutdownTimeout = 30 * time.Second
+
+// StopProvider implements the framework server StopProvider RPC.
+func (s *Server) StopProvider(ctx context.Context, req *tfsdk.StopProviderRequest, resp *tfsdk.StopProviderResponse) {
+	provider, ok := s.Provider.(tfsdk.ProviderWithStop)
+
+	if !ok {
+		return
+	}
+
+	logging.FrameworkTrace(ctx, "Provider implements ProviderWithStop")
+
+	stopReq := tfsdk.StopProviderRequest{}
+
+	if req != nil {
+		stopReq = *req
+	}
+
+	resp.Diagnostics.Append(s.callWithShutdownTimeout(ctx, "Stop", func(ctx context.Context) diag.Diagnostics {
+		stopResp := tfsdk.StopProviderResponse{}
+
+		logging.FrameworkDebug(ctx, "Calling provider defined Provider Stop")
+		provider.Stop(ctx, stopReq, &stopResp)
+		logging.FrameworkDebug(ctx, "Called provider defined Provider Stop")
+
+		return stopResp.Diagnostics
+	})...)
+}
+
+// CloseProvider calls the provider defined Close method, if the Provider
+// implements tfsdk.ProviderWithClose. It is called when the provider server
+// is shutting down, rather than by Terraform. Only the first call has any
+// effect.
+func (s *Server) CloseProvider(ctx context.Context, req *tfsdk.CloseProviderRequest, resp *tfsdk.CloseProviderResponse) {
+	provider, ok := s.Provider.(tfsdk.ProviderWithClose)
+
+	if !ok {
+		return
+	}
+
+	logging.FrameworkTrace(ctx, "Provider implements ProviderWithClose")
+
+	closeReq := tfsdk.CloseProviderRequest{}
+
+	if req != nil {
+		closeReq = *req
+	}
+
+	s.closeProviderOnce.Do(func() {
+		resp.Diagnostics.Append(s.callWithShutdownTimeout(ctx, "Close", func(ctx context.Context) diag.Diagnostics {
+			closeResp := tfsdk.CloseProviderResponse{}
+
+			logging.FrameworkDebug(ctx, "Calling provider defined Provider Close")
+			provider.Close(ctx, closeReq, &closeResp)
+			logging.FrameworkDebug(ctx, "Called provider defined Provider Close")
+
+			return closeResp.Diagnostics
+		})...)
+	})
+}
+
+// callWithShutdownTimeout calls the given provider defined shutdown logic,
+// returning an error diagnostic if it does not complete within the Server
+// ShutdownTimeout. The logic continues running in the background if it
+// ignores the context cancellation.
+func (s *Server) callWithShutdownTimeout(ctx context.Context, method string, f func(context.Context) diag.Diagnostics) diag.Diagnostics {
+	timeout := s.ShutdownTimeout
+
+	if timeout <= 0 {
+		timeout = DefaultShutdownTimeout
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	result := make(chan diag.Diagnostics, 1)
+
+	go func() {
+		result <- f(ctx)
+	}()
+
+	select {
+	case diags := <-result:
+		return diags
+	case <-ctx.Done():
+		var diags diag.Diagnostics
+
+		diags.AddError(
+			fmt.Sprintf("Provider %s Timeout", method),
+			fmt.Sprintf("The provider %s method did not complete within %s. ", method, timeout)+
+				"Any remaining provider cleanup may not have completed. "+
+				"This is always an issue with the provider and should be reported to the provider developers.",
+		)
+
+		return diags
+	}
+}
@@ -0,0 +1,154 @@
+package fwserver_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+func TestServerStopProvider(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		server           *fwserver.Server
+		request          *tfsdk.StopProviderRequest
+		expectedResponse *tfsdk.StopProviderResponse
+	}{
+		"empty-provider": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			expectedResponse: &tfsdk.StopProviderResponse{},
+		},
+		"response-diagnostics": {
+			server: &fwserver.Server{
+				Provider: &testprovider.ProviderWithStop{
+					Provider: &testprovider.Provider{},
+					StopMethod: func(_ context.Context, _ tfsdk.StopProviderRequest, resp *tfsdk.StopProviderResponse) {
+						resp.Diagnostics.AddWarning("warning summary", "warning detail")
+						resp.Diagnostics.AddError("error summary", "error detail")
+					},
+				},
+			},
+			request: &tfsdk.StopProviderRequest{},
+			expectedResponse: &tfsdk.StopProviderResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewWarningDiagnostic("warning summary", "warning detail"),
+					diag.NewErrorDiagnostic("error summary", "error detail"),
+				},
+			},
+		},
+		"timeout": {
+			server: &fwserver.Server{
+				Provider: &testprovider.ProviderWithStop{
+					Provider: &testprovider.Provider{},
+					StopMethod: func(_ context.Context, _ tfsdk.StopProviderRequest, resp *tfsdk.StopProviderResponse) {
+						time.Sleep(time.Second)
+					},
+				},
+				ShutdownTimeout: time.Millisecond,
+			},
+			request: &tfsdk.StopProviderRequest{},
+			expectedResponse: &tfsdk.StopProviderResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Provider Stop Timeout",
+						"The provider Stop method did not complete within 1ms. "+
+							"Any remaining provider cleanup may not have completed. "+
+							"This is always an issue with the provider and should be reported to the provider developers.",
+					),
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			response := &tfsdk.StopProviderResponse{}
+			testCase.server.StopProvider(context.Background(), testCase.request, response)
+
+			if diff := cmp.Diff(response, testCase.expectedResponse); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestServerCloseProvider(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		server            *fwserver.Server
+		expectedCalls     int
+		expectedResponses []*tfsdk.CloseProviderResponse
+	}{
+		"empty-provider": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			expectedResponses: []*tfsdk.CloseProviderResponse{
+				{},
+				{},
+			},
+		},
+		"once": {
+			server: &fwserver.Server{
+				Provider: &testprovider.ProviderWithClose{
+					Provider: &testprovider.Provider{},
+				},
+			},
+			expectedCalls: 1,
+			expectedResponses: []*tfsdk.CloseProviderResponse{
+				{
+					Diagnostics: diag.Diagnostics{
+						diag.NewErrorDiagnostic("error summary", "error detail"),
+					},
+				},
+				{},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var calls int
+
+			if provider, ok := testCase.server.Provider.(*testprovider.ProviderWithClose); ok {
+				provider.CloseMethod = func(_ context.Context, _ tfsdk.CloseProviderRequest, resp *tfsdk.CloseProviderResponse) {
+					calls++
+					resp.Diagnostics.AddError("error summary", "error detail")
+				}
+			}
+
+			var responses []*tfsdk.CloseProviderResponse
+
+			for i := 0; i < 2; i++ {
+				response := &tfsdk.CloseProviderResponse{}
+				testCase.server.CloseProvider(context.Background(), &tfsdk.CloseProviderRequest{}, response)
+				responses = append(responses, response)
+			}
+
+			if calls != testCase.expectedCalls {
+				t.Errorf("expected %d Close calls, got: %d", testCase.expectedCalls, calls)
+			}
+
+			if diff := cmp.Diff(responses, testCase.expectedResponses); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -47,6 +47,12 @@ func (s *Server) UpdateResource(ctx context.Context, req *UpdateResourceRequest,
 		return
 	}
 
+	resp.Diagnostics.Append(s.configureResource(ctx, resource)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	updateReq := tfsdk.UpdateResourceRequest{
 		Config: tfsdk.Config{
 			Schema: req.ResourceSchema,
@@ -92,4 +98,17 @@ func (s *Server) UpdateResource(ctx context.Context, req *UpdateResourceRequest,
 
 	resp.Diagnostics = updateResp.Diagnostics
 	resp.NewState = &updateResp.State
+
+	if resp.Diagnostics.HasError() || req.PlannedState == nil {
+		return
+	}
+
+	newStateRaw, diags := SchemaSemanticEquality(ctx, req.ResourceSchema, req.PlannedState.Raw, resp.NewState.Raw)
+
+	resp.Diagnostics.Append(diags...)
+
+	newStateRaw, diags = SchemaNullEqualsEmpty(ctx, req.ResourceSchema, req.PlannedState.Raw, updateReq.Config.Raw, newStateRaw)
+
+	resp.Diagnostics.Append(diags...)
+	resp.NewState.Raw = newStateRaw
 }
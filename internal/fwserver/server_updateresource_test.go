@@ -112,8 +112,8 @@ func TestServerUpdateResource(t *testing.T) {
 
 								resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 
-								if data.TestRequired.Value != "test-new-value" {
-									resp.Diagnostics.AddError("Unexpected req.Config Value", "Got: "+data.TestRequired.Value)
+								if data.TestRequired.ValueString() != "test-new-value" {
+									resp.Diagnostics.AddError("Unexpected req.Config Value", "Got: "+data.TestRequired.ValueString())
 								}
 							},
 						}, nil
@@ -169,8 +169,8 @@ func TestServerUpdateResource(t *testing.T) {
 
 								resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 
-								if data.TestComputed.Value != "test-plannedstate-value" {
-									resp.Diagnostics.AddError("Unexpected req.Plan Value", "Got: "+data.TestComputed.Value)
+								if data.TestComputed.ValueString() != "test-plannedstate-value" {
+									resp.Diagnostics.AddError("Unexpected req.Plan Value", "Got: "+data.TestComputed.ValueString())
 								}
 							},
 						}, nil
@@ -226,8 +226,8 @@ func TestServerUpdateResource(t *testing.T) {
 
 								resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 
-								if data.TestRequired.Value != "test-old-value" {
-									resp.Diagnostics.AddError("Unexpected req.State Value", "Got: "+data.TestRequired.Value)
+								if data.TestRequired.ValueString() != "test-old-value" {
+									resp.Diagnostics.AddError("Unexpected req.State Value", "Got: "+data.TestRequired.ValueString())
 								}
 							},
 						}, nil
@@ -284,8 +284,8 @@ func TestServerUpdateResource(t *testing.T) {
 
 								resp.Diagnostics.Append(req.ProviderMeta.Get(ctx, &data)...)
 
-								if data.TestProviderMetaAttribute.Value != "test-provider-meta-value" {
-									resp.Diagnostics.AddError("Unexpected req.ProviderMeta Value", "Got: "+data.TestProviderMetaAttribute.Value)
+								if data.TestProviderMetaAttribute.ValueString() != "test-provider-meta-value" {
+									resp.Diagnostics.AddError("Unexpected req.ProviderMeta Value", "Got: "+data.TestProviderMetaAttribute.ValueString())
 								}
 							},
 						}, nil
@@ -6,8 +6,10 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/internal/rawstate"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
 // UpgradeResourceStateRequest is the framework server request for the
@@ -20,6 +22,10 @@ type UpgradeResourceStateRequest struct {
 	ResourceSchema tfsdk.Schema
 	ResourceType   tfsdk.ResourceType
 	Version        int64
+
+	// TypeName is the resource type name, which is passed to
+	// interceptors.
+	TypeName string
 }
 
 // UpgradeResourceStateResponse is the framework server response for the
@@ -31,6 +37,24 @@ type UpgradeResourceStateResponse struct {
 
 // UpgradeResourceState implements the framework server UpgradeResourceState RPC.
 func (s *Server) UpgradeResourceState(ctx context.Context, req *UpgradeResourceStateRequest, resp *UpgradeResourceStateResponse) {
+	interceptorReq := InterceptorRequest{
+		RPC: RPCUpgradeResourceState,
+	}
+
+	if req != nil {
+		interceptorReq.TypeName = req.TypeName
+	}
+
+	s.intercept(ctx, interceptorReq, &resp.Diagnostics, func(ctx context.Context, interceptorResp *InterceptorResponse) {
+		s.upgradeResourceState(ctx, req, resp)
+
+		interceptorResp.State = resp.UpgradedState
+	})
+}
+
+// upgradeResourceState contains the UpgradeResourceState RPC logic, which is
+// called through any Interceptors.
+func (s *Server) upgradeResourceState(ctx context.Context, req *UpgradeResourceStateRequest, resp *UpgradeResourceStateResponse) {
 	if req == nil {
 		return
 	}
@@ -62,13 +86,12 @@ func (s *Server) UpgradeResourceState(ctx context.Context, req *UpgradeResourceS
 
 		resourceSchemaType := req.ResourceSchema.TerraformType(ctx)
 
-		rawStateValue, err := req.RawState.Unmarshal(resourceSchemaType)
+		rawStateValue, err := rawstate.Unmarshal(req.RawState, resourceSchemaType)
 
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Unable to Read Previously Saved State for UpgradeResourceState",
 				"There was an error reading the saved resource state using the current resource schema.\n\n"+
-					"If this resource state was last refreshed with Terraform CLI 0.11 and earlier, it must be refreshed or applied with an older provider version first. "+
 					"If you manually modified the resource state, you will need to manually modify it to match the current resource schema. "+
 					"Otherwise, please report this to the provider developer:\n\n"+err.Error(),
 			)
@@ -94,9 +117,18 @@ func (s *Server) UpgradeResourceState(ctx context.Context, req *UpgradeResourceS
 		return
 	}
 
+	// Terraform CLI can call UpgradeResourceState before ConfigureProvider,
+	// so the Resource is configured with nil provider data in that case.
+	resp.Diagnostics.Append(s.configureResourceOptionalProvider(ctx, resource)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resourceWithUpgradeState, ok := resource.(tfsdk.ResourceWithUpgradeState)
+	resourceWithUpgradeStateChain, chainOk := resource.(tfsdk.ResourceWithUpgradeStateChain)
 
-	if !ok {
+	if !ok && !chainOk {
 		resp.Diagnostics.AddError(
 			"Unable to Upgrade Resource State",
 			"This resource was implemented without an UpgradeState() method, "+
@@ -106,19 +138,28 @@ func (s *Server) UpgradeResourceState(ctx context.Context, req *UpgradeResourceS
 		return
 	}
 
-	logging.FrameworkTrace(ctx, "Resource implements ResourceWithUpgradeState")
+	resourceStateUpgraders := make(map[int64]tfsdk.ResourceStateUpgrader, 0)
+
+	if ok {
+		logging.FrameworkTrace(ctx, "Resource implements ResourceWithUpgradeState")
 
-	logging.FrameworkDebug(ctx, "Calling provider defined Resource UpgradeState")
-	resourceStateUpgraders := resourceWithUpgradeState.UpgradeState(ctx)
-	logging.FrameworkDebug(ctx, "Called provider defined Resource UpgradeState")
+		logging.FrameworkDebug(ctx, "Calling provider defined Resource UpgradeState")
+		upgradeState := resourceWithUpgradeState.UpgradeState(ctx)
+		logging.FrameworkDebug(ctx, "Called provider defined Resource UpgradeState")
 
-	// Panic prevention
-	if resourceStateUpgraders == nil {
-		resourceStateUpgraders = make(map[int64]tfsdk.ResourceStateUpgrader, 0)
+		// Panic prevention
+		if upgradeState != nil {
+			resourceStateUpgraders = upgradeState
+		}
 	}
 
 	resourceStateUpgrader, ok := resourceStateUpgraders[req.Version]
 
+	if !ok && chainOk {
+		s.upgradeResourceStateChain(ctx, req, resp, resourceWithUpgradeStateChain)
+		return
+	}
+
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unable to Upgrade Resource State",
@@ -138,7 +179,7 @@ func (s *Server) UpgradeResourceState(ctx context.Context, req *UpgradeResourceS
 
 		priorSchemaType := resourceStateUpgrader.PriorSchema.TerraformType(ctx)
 
-		rawStateValue, err := req.RawState.Unmarshal(priorSchemaType)
+		rawStateValue, err := rawstate.Unmarshal(req.RawState, priorSchemaType)
 
 		if err != nil {
 			resp.Diagnostics.AddError(
@@ -211,3 +252,196 @@ func (s *Server) UpgradeResourceState(ctx context.Context, req *UpgradeResourceS
 
 	resp.UpgradedState = &upgradeResourceStateResponse.State
 }
+
+// upgradeResourceStateChain runs each ResourceStateUpgrader of a
+// ResourceWithUpgradeStateChain from the request version to the current
+// schema version, passing the upgraded state of each step to the next.
+func (s *Server) upgradeResourceStateChain(ctx context.Context, req *UpgradeResourceStateRequest, resp *UpgradeResourceStateResponse, resource tfsdk.ResourceWithUpgradeStateChain) {
+	logging.FrameworkTrace(ctx, "Resource implements ResourceWithUpgradeStateChain")
+
+	logging.FrameworkDebug(ctx, "Calling provider defined Resource UpgradeStateChain")
+	resourceStateUpgraders := resource.UpgradeStateChain(ctx)
+	logging.FrameworkDebug(ctx, "Called provider defined Resource UpgradeStateChain")
+
+	if req.Version > req.ResourceSchema.Version {
+		resp.Diagnostics.AddError(
+			"Unable to Upgrade Resource State",
+			fmt.Sprintf("The prior state version %d is greater than the current schema version %d. ", req.Version, req.ResourceSchema.Version)+
+				"This can occur when the resource state was saved by a newer version of the provider. "+
+				"Use a provider version which supports the state version or manually modify the resource state.",
+		)
+		return
+	}
+
+	// Lookup every step up front, so missing implementations are reported
+	// before any provider defined logic is run.
+	for version := req.Version; version < req.ResourceSchema.Version; version++ {
+		resourceStateUpgrader, ok := resourceStateUpgraders[version]
+
+		if !ok {
+			resp.Diagnostics.AddError(
+				"Unable to Upgrade Resource State",
+				"This resource was implemented with an UpgradeStateChain() method, "+
+					fmt.Sprintf("however Terraform was expecting an implementation for version %d to %d upgrade, ", version, version+1)+
+					fmt.Sprintf("to upgrade from prior state version %d to current schema version %d.\n\n", req.Version, req.ResourceSchema.Version)+
+					"This is always an issue with the Terraform Provider and should be reported to the provider developer.",
+			)
+			return
+		}
+
+		if resourceStateUpgrader.PriorSchema == nil {
+			resp.Diagnostics.AddError(
+				"Unable to Upgrade Resource State",
+				fmt.Sprintf("This resource was implemented with an UpgradeStateChain() method, however the version %d to %d upgrade is missing a PriorSchema. ", version, version+1)+
+					"Every ResourceStateUpgrader in the chain must set PriorSchema.\n\n"+
+					"This is always an issue with the Terraform Provider and should be reported to the provider developer.",
+			)
+			return
+		}
+	}
+
+	rawState := req.RawState
+	var upgradedStateValue tftypes.Value
+
+	for version := req.Version; version < req.ResourceSchema.Version; version++ {
+		resourceStateUpgrader := resourceStateUpgraders[version]
+		nextVersion := version + 1
+		nextSchema := req.ResourceSchema
+
+		if nextVersion < req.ResourceSchema.Version {
+			nextSchema = *resourceStateUpgraders[nextVersion].PriorSchema
+		}
+
+		logging.FrameworkTrace(
+			ctx,
+			"Upgrading resource state in chain",
+			map[string]interface{}{
+				logging.KeyStateVersionFrom: version,
+				logging.KeyStateVersionTo:   nextVersion,
+			},
+		)
+
+		stateValue, diags := upgradeResourceStateChainStep(ctx, version, rawState, resourceStateUpgrader, nextSchema)
+
+		resp.Diagnostics.Append(diags...)
+
+		if diags.HasError() {
+			resp.Diagnostics.AddError(
+				"Resource State Upgrade Step Failed",
+				fmt.Sprintf("The resource state upgrade from version %d to %d failed, ", version, nextVersion)+
+					fmt.Sprintf("while upgrading from prior state version %d to current schema version %d. ", req.Version, req.ResourceSchema.Version)+
+					"No further upgrade steps were run. Any step errors are reported separately. "+
+					"Framework trace logging contains the state data after each successful step.",
+			)
+			return
+		}
+
+		logging.FrameworkTrace(
+			ctx,
+			"Upgraded resource state in chain",
+			map[string]interface{}{
+				logging.KeyState:            stateValue.String(),
+				logging.KeyStateVersionFrom: version,
+				logging.KeyStateVersionTo:   nextVersion,
+			},
+		)
+
+		upgradedStateValue = stateValue
+
+		if nextVersion == req.ResourceSchema.Version {
+			break
+		}
+
+		stateJSON, err := rawstate.ValueToJSON(stateValue)
+
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Resource State Upgrade Step Failed",
+				fmt.Sprintf("The resource state upgrade from version %d to %d returned state data that could not be passed to the next upgrade step. ", version, nextVersion)+
+					"This is always an issue with the Terraform Provider and should be reported to the provider developer:\n\n"+err.Error(),
+			)
+			return
+		}
+
+		rawState = &tfprotov6.RawState{
+			JSON: stateJSON,
+		}
+	}
+
+	resp.UpgradedState = &tfsdk.State{
+		Schema: req.ResourceSchema,
+		Raw:    upgradedStateValue,
+	}
+}
+
+// upgradeResourceStateChainStep runs a single ResourceStateUpgrader of a
+// ResourceWithUpgradeStateChain, returning the upgraded state data for the
+// next version schema.
+func upgradeResourceStateChainStep(ctx context.Context, version int64, rawState *tfprotov6.RawState, resourceStateUpgrader tfsdk.ResourceStateUpgrader, nextSchema tfsdk.Schema) (tftypes.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	rawStateValue, err := rawstate.Unmarshal(rawState, resourceStateUpgrader.PriorSchema.TerraformType(ctx))
+
+	if err != nil {
+		diags.AddError(
+			"Unable to Read Previously Saved State for UpgradeResourceState",
+			fmt.Sprintf("There was an error reading the saved resource state using the prior resource schema defined for version %d upgrade.\n\n", version)+
+				"Please report this to the provider developer:\n\n"+err.Error(),
+		)
+		return tftypes.Value{}, diags
+	}
+
+	upgradeResourceStateRequest := tfsdk.UpgradeResourceStateRequest{
+		RawState: rawState,
+		State: &tfsdk.State{
+			Raw:    rawStateValue,
+			Schema: *resourceStateUpgrader.PriorSchema,
+		},
+	}
+
+	upgradeResourceStateResponse := tfsdk.UpgradeResourceStateResponse{
+		State: tfsdk.State{
+			Schema: nextSchema,
+			// Raw is intentionally not set.
+		},
+	}
+
+	logging.FrameworkDebug(ctx, "Calling provider defined StateUpgrader")
+	resourceStateUpgrader.StateUpgrader(ctx, upgradeResourceStateRequest, &upgradeResourceStateResponse)
+	logging.FrameworkDebug(ctx, "Called provider defined StateUpgrader")
+
+	diags.Append(upgradeResourceStateResponse.Diagnostics...)
+
+	if diags.HasError() {
+		return tftypes.Value{}, diags
+	}
+
+	if upgradeResourceStateResponse.DynamicValue != nil {
+		logging.FrameworkTrace(ctx, "UpgradeResourceStateResponse DynamicValue set, overriding State")
+
+		upgradedStateValue, err := upgradeResourceStateResponse.DynamicValue.Unmarshal(nextSchema.TerraformType(ctx))
+
+		if err != nil {
+			diags.AddError(
+				"Unable to Upgrade Resource State",
+				fmt.Sprintf("After attempting a resource state upgrade from version %d to %d, the provider returned state data that was not compatible with the version %d schema.\n\n", version, version+1, version+1)+
+					"This is always an issue with the Terraform Provider and should be reported to the provider developer:\n\n"+err.Error(),
+			)
+			return tftypes.Value{}, diags
+		}
+
+		return upgradedStateValue, diags
+	}
+
+	if upgradeResourceStateResponse.State.Raw.Type() == nil || upgradeResourceStateResponse.State.Raw.IsNull() {
+		diags.AddError(
+			"Missing Upgraded Resource State",
+			fmt.Sprintf("After attempting a resource state upgrade from version %d to %d, the provider did not return any state data. ", version, version+1)+
+				"Preventing the unexpected loss of resource state data. "+
+				"This is always an issue with the Terraform Provider and should be reported to the provider developer.",
+		)
+		return tftypes.Value{}, diags
+	}
+
+	return upgradeResourceStateResponse.State.Raw, diags
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -465,6 +466,95 @@ func TestServerUpgradeResourceState(t *testing.T) {
 				},
 			},
 		},
+		"PriorSchema-and-State-flatmap": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.UpgradeResourceStateRequest{
+				RawState: &tfprotov6.RawState{
+					Flatmap: map[string]string{
+						"id":                 "test-id-value",
+						"required_attribute": "true",
+						"list_attribute.#":   "2",
+						"list_attribute.0":   "first",
+						"list_attribute.1":   "second",
+					},
+				},
+				ResourceSchema: schema,
+				ResourceType: &testprovider.ResourceType{
+					GetSchemaMethod: func(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+						return schema, nil
+					},
+					NewResourceMethod: func(_ context.Context, _ tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+						return &testprovider.ResourceWithUpgradeState{
+							Resource: &testprovider.Resource{},
+							UpgradeStateMethod: func(ctx context.Context) map[int64]tfsdk.ResourceStateUpgrader {
+								return map[int64]tfsdk.ResourceStateUpgrader{
+									0: {
+										PriorSchema: &tfsdk.Schema{
+											Attributes: map[string]tfsdk.Attribute{
+												"id": {
+													Type:     types.StringType,
+													Computed: true,
+												},
+												"list_attribute": {
+													Type:     types.ListType{ElemType: types.StringType},
+													Optional: true,
+												},
+												"required_attribute": {
+													Type:     types.BoolType,
+													Required: true,
+												},
+											},
+										},
+										StateUpgrader: func(ctx context.Context, req tfsdk.UpgradeResourceStateRequest, resp *tfsdk.UpgradeResourceStateResponse) {
+											var priorStateData struct {
+												Id                string   `tfsdk:"id"`
+												ListAttribute     []string `tfsdk:"list_attribute"`
+												RequiredAttribute bool     `tfsdk:"required_attribute"`
+											}
+
+											resp.Diagnostics.Append(req.State.Get(ctx, &priorStateData)...)
+
+											if resp.Diagnostics.HasError() {
+												return
+											}
+
+											upgradedStateData := struct {
+												Id                string  `tfsdk:"id"`
+												OptionalAttribute *string `tfsdk:"optional_attribute"`
+												RequiredAttribute string  `tfsdk:"required_attribute"`
+											}{
+												Id:                priorStateData.Id,
+												RequiredAttribute: fmt.Sprintf("%t", priorStateData.RequiredAttribute),
+											}
+
+											if len(priorStateData.ListAttribute) > 0 {
+												v := strings.Join(priorStateData.ListAttribute, ",")
+												upgradedStateData.OptionalAttribute = &v
+											}
+
+											resp.Diagnostics.Append(resp.State.Set(ctx, upgradedStateData)...)
+										},
+									},
+								}
+							},
+						}, nil
+					},
+				},
+				Version: 0,
+			},
+			expectedResponse: &fwserver.UpgradeResourceStateResponse{
+				UpgradedState: &tfsdk.State{
+					Raw: tftypes.NewValue(schemaType, map[string]tftypes.Value{
+						"id":                 tftypes.NewValue(tftypes.String, "test-id-value"),
+						"optional_attribute": tftypes.NewValue(tftypes.String, "first,second"),
+						"required_attribute": tftypes.NewValue(tftypes.String, "true"),
+					}),
+					Schema: schema,
+				},
+			},
+		},
 		"UpgradedState-missing": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
@@ -524,7 +614,8 @@ func TestServerUpgradeResourceState(t *testing.T) {
 			request: &fwserver.UpgradeResourceStateRequest{
 				RawState: &tfprotov6.RawState{
 					Flatmap: map[string]string{
-						"flatmap": "is not supported",
+						"id":                 "test-id-value",
+						"required_attribute": "true",
 					},
 				},
 				ResourceSchema: schema,
@@ -540,15 +631,13 @@ func TestServerUpgradeResourceState(t *testing.T) {
 				Version: 1, // Must match current tfsdk.Schema version to trigger framework implementation
 			},
 			expectedResponse: &fwserver.UpgradeResourceStateResponse{
-				Diagnostics: diag.Diagnostics{
-					diag.NewErrorDiagnostic(
-						"Unable to Read Previously Saved State for UpgradeResourceState",
-						"There was an error reading the saved resource state using the current resource schema.\n\n"+
-							"If this resource state was last refreshed with Terraform CLI 0.11 and earlier, it must be refreshed or applied with an older provider version first. "+
-							"If you manually modified the resource state, you will need to manually modify it to match the current resource schema. "+
-							"Otherwise, please report this to the provider developer:\n\n"+
-							"flatmap states cannot be unmarshaled, only states written by Terraform 0.12 and higher can be unmarshaled",
-					),
+				UpgradedState: &tfsdk.State{
+					Raw: tftypes.NewValue(schemaType, map[string]tftypes.Value{
+						"id":                 tftypes.NewValue(tftypes.String, "test-id-value"),
+						"optional_attribute": tftypes.NewValue(tftypes.String, nil),
+						"required_attribute": tftypes.NewValue(tftypes.String, "true"),
+					}),
+					Schema: schema,
 				},
 			},
 		},
@@ -619,7 +708,6 @@ func TestServerUpgradeResourceState(t *testing.T) {
 					diag.NewErrorDiagnostic(
 						"Unable to Read Previously Saved State for UpgradeResourceState",
 						"There was an error reading the saved resource state using the current resource schema.\n\n"+
-							"If this resource state was last refreshed with Terraform CLI 0.11 and earlier, it must be refreshed or applied with an older provider version first. "+
 							"If you manually modified the resource state, you will need to manually modify it to match the current resource schema. "+
 							"Otherwise, please report this to the provider developer:\n\n"+
 							"ElementKeyValue(tftypes.String<unknown>): unsupported attribute \"nonexistent_attribute\"",
@@ -680,3 +768,433 @@ func TestServerUpgradeResourceState(t *testing.T) {
 		})
 	}
 }
+
+// testResourceWithConfigureAndUpgradeState is a tfsdk.ResourceWithConfigure
+// and tfsdk.ResourceWithUpgradeState for unit testing.
+type testResourceWithConfigureAndUpgradeState struct {
+	*testprovider.ResourceWithUpgradeState
+
+	ConfigureMethod func(context.Context, tfsdk.ConfigureResourceRequest, *tfsdk.ConfigureResourceResponse)
+}
+
+func (r *testResourceWithConfigureAndUpgradeState) Configure(ctx context.Context, req tfsdk.ConfigureResourceRequest, resp *tfsdk.ConfigureResourceResponse) {
+	r.ConfigureMethod(ctx, req, resp)
+}
+
+func TestServerUpgradeResourceStateConfigure(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	schema := tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+		},
+		Version: 1, // Must be above 0
+	}
+	schemaType := schema.TerraformType(ctx)
+
+	testCases := map[string]struct {
+		server               *fwserver.Server
+		expectedProviderData interface{}
+	}{
+		"provider-configured": {
+			server: testConfiguredServer(
+				&fwserver.Server{
+					Provider: &testprovider.Provider{},
+				},
+				&tfsdk.ConfigureProviderResponse{
+					ResourceData: "test-provider-data",
+				},
+			),
+			expectedProviderData: "test-provider-data",
+		},
+		"provider-not-configured": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			expectedProviderData: nil,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var providerData interface{}
+
+			request := &fwserver.UpgradeResourceStateRequest{
+				RawState: testNewRawState(t, map[string]interface{}{
+					"id": "test-id-value",
+				}),
+				ResourceSchema: schema,
+				ResourceType: &testprovider.ResourceType{
+					GetSchemaMethod: func(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+						return schema, nil
+					},
+					NewResourceMethod: func(_ context.Context, _ tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+						return &testResourceWithConfigureAndUpgradeState{
+							ResourceWithUpgradeState: &testprovider.ResourceWithUpgradeState{
+								Resource: &testprovider.Resource{},
+								UpgradeStateMethod: func(ctx context.Context) map[int64]tfsdk.ResourceStateUpgrader {
+									return map[int64]tfsdk.ResourceStateUpgrader{
+										0: {
+											StateUpgrader: func(ctx context.Context, req tfsdk.UpgradeResourceStateRequest, resp *tfsdk.UpgradeResourceStateResponse) {
+												resp.DynamicValue = &tfprotov6.DynamicValue{
+													JSON: req.RawState.JSON,
+												}
+											},
+										},
+									}
+								},
+							},
+							ConfigureMethod: func(_ context.Context, req tfsdk.ConfigureResourceRequest, _ *tfsdk.ConfigureResourceResponse) {
+								providerData = req.ProviderData
+							},
+						}, nil
+					},
+				},
+				Version: 0,
+			}
+			expectedResponse := &fwserver.UpgradeResourceStateResponse{
+				UpgradedState: &tfsdk.State{
+					Raw: tftypes.NewValue(schemaType, map[string]tftypes.Value{
+						"id": tftypes.NewValue(tftypes.String, "test-id-value"),
+					}),
+					Schema: schema,
+				},
+			}
+
+			response := &fwserver.UpgradeResourceStateResponse{}
+			testCase.server.UpgradeResourceState(ctx, request, response)
+
+			if diff := cmp.Diff(response, expectedResponse); diff != "" {
+				t.Errorf("unexpected response difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(providerData, testCase.expectedProviderData); diff != "" {
+				t.Errorf("unexpected provider data difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestServerUpgradeResourceStateChain(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// Version 0 stores size as a string, version 1 converts size to a
+	// number, and version 2 renames size to size_gb.
+	schemaV0 := &tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"size": {
+				Type:     types.StringType,
+				Required: true,
+			},
+		},
+	}
+	schemaV1 := &tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"size": {
+				Type:     types.Int64Type,
+				Required: true,
+			},
+		},
+		Version: 1,
+	}
+	schema := tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"size_gb": {
+				Type:     types.Int64Type,
+				Required: true,
+			},
+		},
+		Version: 2,
+	}
+	schemaType := schema.TerraformType(ctx)
+
+	upgradeV0 := tfsdk.ResourceStateUpgrader{
+		PriorSchema: schemaV0,
+		StateUpgrader: func(ctx context.Context, req tfsdk.UpgradeResourceStateRequest, resp *tfsdk.UpgradeResourceStateResponse) {
+			var priorStateData struct {
+				Id   string `tfsdk:"id"`
+				Size string `tfsdk:"size"`
+			}
+
+			resp.Diagnostics.Append(req.State.Get(ctx, &priorStateData)...)
+
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			var size int64
+
+			if _, err := fmt.Sscan(priorStateData.Size, &size); err != nil {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("size"),
+					"Invalid Size",
+					"Unable to convert size to a number: "+err.Error(),
+				)
+				return
+			}
+
+			upgradedStateData := struct {
+				Id   string `tfsdk:"id"`
+				Size int64  `tfsdk:"size"`
+			}{
+				Id:   priorStateData.Id,
+				Size: size,
+			}
+
+			resp.Diagnostics.Append(resp.State.Set(ctx, upgradedStateData)...)
+		},
+	}
+	upgradeV1 := tfsdk.ResourceStateUpgrader{
+		PriorSchema: schemaV1,
+		StateUpgrader: func(ctx context.Context, req tfsdk.UpgradeResourceStateRequest, resp *tfsdk.UpgradeResourceStateResponse) {
+			var priorStateData struct {
+				Id   string `tfsdk:"id"`
+				Size int64  `tfsdk:"size"`
+			}
+
+			resp.Diagnostics.Append(req.State.Get(ctx, &priorStateData)...)
+
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			upgradedStateData := struct {
+				Id     string `tfsdk:"id"`
+				SizeGB int64  `tfsdk:"size_gb"`
+			}{
+				Id:     priorStateData.Id,
+				SizeGB: priorStateData.Size,
+			}
+
+			resp.Diagnostics.Append(resp.State.Set(ctx, upgradedStateData)...)
+		},
+	}
+
+	testResourceType := func(upgraders map[int64]tfsdk.ResourceStateUpgrader) *testprovider.ResourceType {
+		return &testprovider.ResourceType{
+			GetSchemaMethod: func(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+				return schema, nil
+			},
+			NewResourceMethod: func(_ context.Context, _ tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+				return &testprovider.ResourceWithUpgradeStateChain{
+					Resource: &testprovider.Resource{},
+					UpgradeStateChainMethod: func(ctx context.Context) map[int64]tfsdk.ResourceStateUpgrader {
+						return upgraders
+					},
+				}, nil
+			},
+		}
+	}
+
+	testCases := map[string]struct {
+		server           *fwserver.Server
+		request          *fwserver.UpgradeResourceStateRequest
+		expectedResponse *fwserver.UpgradeResourceStateResponse
+	}{
+		"all-steps": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.UpgradeResourceStateRequest{
+				RawState: testNewRawState(t, map[string]interface{}{
+					"id":   "test-id-value",
+					"size": "10",
+				}),
+				ResourceSchema: schema,
+				ResourceType: testResourceType(map[int64]tfsdk.ResourceStateUpgrader{
+					0: upgradeV0,
+					1: upgradeV1,
+				}),
+				Version: 0,
+			},
+			expectedResponse: &fwserver.UpgradeResourceStateResponse{
+				UpgradedState: &tfsdk.State{
+					Raw: tftypes.NewValue(schemaType, map[string]tftypes.Value{
+						"id":      tftypes.NewValue(tftypes.String, "test-id-value"),
+						"size_gb": tftypes.NewValue(tftypes.Number, 10),
+					}),
+					Schema: schema,
+				},
+			},
+		},
+		"last-step": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.UpgradeResourceStateRequest{
+				RawState: testNewRawState(t, map[string]interface{}{
+					"id":   "test-id-value",
+					"size": 10,
+				}),
+				ResourceSchema: schema,
+				ResourceType: testResourceType(map[int64]tfsdk.ResourceStateUpgrader{
+					0: upgradeV0,
+					1: upgradeV1,
+				}),
+				Version: 1,
+			},
+			expectedResponse: &fwserver.UpgradeResourceStateResponse{
+				UpgradedState: &tfsdk.State{
+					Raw: tftypes.NewValue(schemaType, map[string]tftypes.Value{
+						"id":      tftypes.NewValue(tftypes.String, "test-id-value"),
+						"size_gb": tftypes.NewValue(tftypes.Number, 10),
+					}),
+					Schema: schema,
+				},
+			},
+		},
+		"step-missing": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.UpgradeResourceStateRequest{
+				RawState: testNewRawState(t, map[string]interface{}{
+					"id":   "test-id-value",
+					"size": "10",
+				}),
+				ResourceSchema: schema,
+				ResourceType: testResourceType(map[int64]tfsdk.ResourceStateUpgrader{
+					0: upgradeV0,
+				}),
+				Version: 0,
+			},
+			expectedResponse: &fwserver.UpgradeResourceStateResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Unable to Upgrade Resource State",
+						"This resource was implemented with an UpgradeStateChain() method, "+
+							"however Terraform was expecting an implementation for version 1 to 2 upgrade, "+
+							"to upgrade from prior state version 0 to current schema version 2.\n\n"+
+							"This is always an issue with the Terraform Provider and should be reported to the provider developer.",
+					),
+				},
+			},
+		},
+		"step-PriorSchema-missing": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.UpgradeResourceStateRequest{
+				RawState: testNewRawState(t, map[string]interface{}{
+					"id":   "test-id-value",
+					"size": "10",
+				}),
+				ResourceSchema: schema,
+				ResourceType: testResourceType(map[int64]tfsdk.ResourceStateUpgrader{
+					0: upgradeV0,
+					1: {
+						StateUpgrader: upgradeV1.StateUpgrader,
+					},
+				}),
+				Version: 0,
+			},
+			expectedResponse: &fwserver.UpgradeResourceStateResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Unable to Upgrade Resource State",
+						"This resource was implemented with an UpgradeStateChain() method, however the version 1 to 2 upgrade is missing a PriorSchema. "+
+							"Every ResourceStateUpgrader in the chain must set PriorSchema.\n\n"+
+							"This is always an issue with the Terraform Provider and should be reported to the provider developer.",
+					),
+				},
+			},
+		},
+		"step-error": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.UpgradeResourceStateRequest{
+				RawState: testNewRawState(t, map[string]interface{}{
+					"id":   "test-id-value",
+					"size": "ten",
+				}),
+				ResourceSchema: schema,
+				ResourceType: testResourceType(map[int64]tfsdk.ResourceStateUpgrader{
+					0: upgradeV0,
+					1: upgradeV1,
+				}),
+				Version: 0,
+			},
+			expectedResponse: &fwserver.UpgradeResourceStateResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("size"),
+						"Invalid Size",
+						"Unable to convert size to a number: expected integer",
+					),
+					diag.NewErrorDiagnostic(
+						"Resource State Upgrade Step Failed",
+						"The resource state upgrade from version 0 to 1 failed, "+
+							"while upgrading from prior state version 0 to current schema version 2. "+
+							"No further upgrade steps were run. Any step errors are reported separately. "+
+							"Framework trace logging contains the state data after each successful step.",
+					),
+				},
+			},
+		},
+		"Version-greater": {
+			server: &fwserver.Server{
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.UpgradeResourceStateRequest{
+				RawState: testNewRawState(t, map[string]interface{}{
+					"id":      "test-id-value",
+					"size_gb": 10,
+				}),
+				ResourceSchema: schema,
+				ResourceType: testResourceType(map[int64]tfsdk.ResourceStateUpgrader{
+					0: upgradeV0,
+					1: upgradeV1,
+				}),
+				Version: 3,
+			},
+			expectedResponse: &fwserver.UpgradeResourceStateResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Unable to Upgrade Resource State",
+						"The prior state version 3 is greater than the current schema version 2. "+
+							"This can occur when the resource state was saved by a newer version of the provider. "+
+							"Use a provider version which supports the state version or manually modify the resource state.",
+					),
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			response := &fwserver.UpgradeResourceStateResponse{}
+			testCase.server.UpgradeResourceState(context.Background(), testCase.request, response)
+
+			if diff := cmp.Diff(response, testCase.expectedResponse); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
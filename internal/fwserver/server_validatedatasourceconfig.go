@@ -13,6 +13,10 @@ import (
 type ValidateDataSourceConfigRequest struct {
 	Config         *tfsdk.Config
 	DataSourceType tfsdk.DataSourceType
+
+	// TypeName is the data source type name, which is passed to
+	// interceptors.
+	TypeName string
 }
 
 // ValidateDataSourceConfigResponse is the framework server response for the
@@ -23,6 +27,23 @@ type ValidateDataSourceConfigResponse struct {
 
 // ValidateDataSourceConfig implements the framework server ValidateDataSourceConfig RPC.
 func (s *Server) ValidateDataSourceConfig(ctx context.Context, req *ValidateDataSourceConfigRequest, resp *ValidateDataSourceConfigResponse) {
+	interceptorReq := InterceptorRequest{
+		RPC: RPCValidateDataSourceConfig,
+	}
+
+	if req != nil {
+		interceptorReq.Config = req.Config
+		interceptorReq.TypeName = req.TypeName
+	}
+
+	s.intercept(ctx, interceptorReq, &resp.Diagnostics, func(ctx context.Context, _ *InterceptorResponse) {
+		s.validateDataSourceConfig(ctx, req, resp)
+	})
+}
+
+// validateDataSourceConfig contains the ValidateDataSourceConfig RPC logic,
+// which is called through any Interceptors.
+func (s *Server) validateDataSourceConfig(ctx context.Context, req *ValidateDataSourceConfigRequest, resp *ValidateDataSourceConfigResponse) {
 	if req == nil || req.Config == nil {
 		return
 	}
@@ -57,8 +57,8 @@ func TestServerValidateDataSourceConfig(t *testing.T) {
 								return
 							}
 
-							if got.Value != "test-value" {
-								resp.Diagnostics.AddError("Incorrect req.AttributeConfig", "expected test-value, got "+got.Value)
+							if got.ValueString() != "test-value" {
+								resp.Diagnostics.AddError("Incorrect req.AttributeConfig", "expected test-value, got "+got.ValueString())
 							}
 						},
 					},
@@ -179,8 +179,8 @@ func TestServerValidateDataSourceConfig(t *testing.T) {
 												return
 											}
 
-											if got.Value != "test-value" {
-												resp.Diagnostics.AddError("Incorrect req.Config", "expected test-value, got "+got.Value)
+											if got.ValueString() != "test-value" {
+												resp.Diagnostics.AddError("Incorrect req.Config", "expected test-value, got "+got.ValueString())
 											}
 										},
 									},
@@ -248,8 +248,8 @@ func TestServerValidateDataSourceConfig(t *testing.T) {
 									return
 								}
 
-								if got.Value != "test-value" {
-									resp.Diagnostics.AddError("Incorrect req.Config", "expected test-value, got "+got.Value)
+								if got.ValueString() != "test-value" {
+									resp.Diagnostics.AddError("Incorrect req.Config", "expected test-value, got "+got.ValueString())
 								}
 							},
 						}, nil
@@ -23,12 +23,36 @@ type ValidateProviderConfigResponse struct {
 
 // ValidateProviderConfig implements the framework server ValidateProviderConfig RPC.
 func (s *Server) ValidateProviderConfig(ctx context.Context, req *ValidateProviderConfigRequest, resp *ValidateProviderConfigResponse) {
+	interceptorReq := InterceptorRequest{
+		RPC: RPCValidateProviderConfig,
+	}
+
+	if req != nil {
+		interceptorReq.Config = req.Config
+	}
+
+	s.intercept(ctx, interceptorReq, &resp.Diagnostics, func(ctx context.Context, _ *InterceptorResponse) {
+		s.validateProviderConfig(ctx, req, resp)
+	})
+}
+
+// validateProviderConfig contains the ValidateProviderConfig RPC logic, which
+// is called through any Interceptors.
+func (s *Server) validateProviderConfig(ctx context.Context, req *ValidateProviderConfigRequest, resp *ValidateProviderConfigResponse) {
 	if req == nil || req.Config == nil {
 		return
 	}
 
+	config, diags := ConfigWithEnvVars(ctx, *req.Config, s.LookupEnv)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	vpcReq := tfsdk.ValidateProviderConfigRequest{
-		Config: *req.Config,
+		Config: config,
 	}
 
 	if provider, ok := s.Provider.(tfsdk.ProviderWithConfigValidators); ok {
@@ -74,13 +98,14 @@ func (s *Server) ValidateProviderConfig(ctx context.Context, req *ValidateProvid
 	}
 
 	validateSchemaReq := ValidateSchemaRequest{
-		Config: *req.Config,
+		Config:         config,
+		ProviderSchema: true,
 	}
 	validateSchemaResp := ValidateSchemaResponse{
 		Diagnostics: resp.Diagnostics,
 	}
 
-	SchemaValidate(ctx, req.Config.Schema, validateSchemaReq, &validateSchemaResp)
+	SchemaValidate(ctx, config.Schema, validateSchemaReq, &validateSchemaResp)
 
 	resp.Diagnostics = validateSchemaResp.Diagnostics
 
@@ -92,5 +117,7 @@ func (s *Server) ValidateProviderConfig(ctx context.Context, req *ValidateProvid
 	// To ensure accuracy going forward, this implementation is opinionated
 	// towards accurate provider schema definitions and optional values
 	// can be filled in or return errors during ConfigureProvider().
+	// Environment variable values are also intentionally not returned, so
+	// they are never saved by Terraform.
 	resp.PreparedConfig = req.Config
 }
@@ -57,8 +57,8 @@ func TestServerValidateProviderConfig(t *testing.T) {
 								return
 							}
 
-							if got.Value != "test-value" {
-								resp.Diagnostics.AddError("Incorrect req.AttributeConfig", "expected test-value, got "+got.Value)
+							if got.ValueString() != "test-value" {
+								resp.Diagnostics.AddError("Incorrect req.AttributeConfig", "expected test-value, got "+got.ValueString())
 							}
 						},
 					},
@@ -93,6 +93,40 @@ func TestServerValidateProviderConfig(t *testing.T) {
 		Schema: testSchemaAttributeValidatorError,
 	}
 
+	testSchemaEnvVars := tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"test": {
+				Required: true,
+				Type:     types.StringType,
+				EnvVars:  []string{"TEST_ENV_VAR"},
+				Validators: []tfsdk.AttributeValidator{
+					&testprovider.AttributeValidator{
+						ValidateMethod: func(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) {
+							var got types.String
+
+							resp.Diagnostics.Append(tfsdk.ValueAs(ctx, req.AttributeConfig, &got)...)
+
+							if resp.Diagnostics.HasError() {
+								return
+							}
+
+							if got.ValueString() != "test-env-value" {
+								resp.Diagnostics.AddError("Incorrect req.AttributeConfig", "expected test-env-value, got "+got.ValueString())
+							}
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testConfigEnvVars := tfsdk.Config{
+		Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+			"test": tftypes.NewValue(tftypes.String, nil),
+		}),
+		Schema: testSchemaEnvVars,
+	}
+
 	testCases := map[string]struct {
 		server           *fwserver.Server
 		request          *fwserver.ValidateProviderConfigRequest
@@ -119,6 +153,54 @@ func TestServerValidateProviderConfig(t *testing.T) {
 				PreparedConfig: &testConfig,
 			},
 		},
+		"request-config-EnvVars": {
+			server: &fwserver.Server{
+				LookupEnv: func(name string) (string, bool) {
+					if name == "TEST_ENV_VAR" {
+						return "test-env-value", true
+					}
+
+					return "", false
+				},
+				Provider: &testprovider.Provider{
+					GetSchemaMethod: func(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+						return testSchemaEnvVars, nil
+					},
+				},
+			},
+			request: &fwserver.ValidateProviderConfigRequest{
+				Config: &testConfigEnvVars,
+			},
+			expectedResponse: &fwserver.ValidateProviderConfigResponse{
+				PreparedConfig: &testConfigEnvVars,
+			},
+		},
+		"request-config-EnvVars-missing": {
+			server: &fwserver.Server{
+				LookupEnv: func(name string) (string, bool) {
+					return "", false
+				},
+				Provider: &testprovider.Provider{
+					GetSchemaMethod: func(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+						return testSchemaEnvVars, nil
+					},
+				},
+			},
+			request: &fwserver.ValidateProviderConfigRequest{
+				Config: &testConfigEnvVars,
+			},
+			expectedResponse: &fwserver.ValidateProviderConfigResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Missing Configuration for Required Attribute",
+						"Must set a configuration value for the test attribute or the TEST_ENV_VAR environment variable as the provider has marked it as required.\n\n"+
+							"Refer to the provider documentation or contact the provider developers for additional information about configurable attributes that are required.",
+					),
+				},
+				PreparedConfig: &testConfigEnvVars,
+			},
+		},
 		"request-config-AttributeValidator": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{
@@ -176,8 +258,8 @@ func TestServerValidateProviderConfig(t *testing.T) {
 										return
 									}
 
-									if got.Value != "test-value" {
-										resp.Diagnostics.AddError("Incorrect req.Config", "expected test-value, got "+got.Value)
+									if got.ValueString() != "test-value" {
+										resp.Diagnostics.AddError("Incorrect req.Config", "expected test-value, got "+got.ValueString())
 									}
 								},
 							},
@@ -241,8 +323,8 @@ func TestServerValidateProviderConfig(t *testing.T) {
 							return
 						}
 
-						if got.Value != "test-value" {
-							resp.Diagnostics.AddError("Incorrect req.Config", "expected test-value, got "+got.Value)
+						if got.ValueString() != "test-value" {
+							resp.Diagnostics.AddError("Incorrect req.Config", "expected test-value, got "+got.ValueString())
 						}
 					},
 				},
@@ -13,6 +13,10 @@ import (
 type ValidateResourceConfigRequest struct {
 	Config       *tfsdk.Config
 	ResourceType tfsdk.ResourceType
+
+	// TypeName is the resource type name, which is passed to
+	// interceptors.
+	TypeName string
 }
 
 // ValidateResourceConfigResponse is the framework server response for the
@@ -23,6 +27,23 @@ type ValidateResourceConfigResponse struct {
 
 // ValidateResourceConfig implements the framework server ValidateResourceConfig RPC.
 func (s *Server) ValidateResourceConfig(ctx context.Context, req *ValidateResourceConfigRequest, resp *ValidateResourceConfigResponse) {
+	interceptorReq := InterceptorRequest{
+		RPC: RPCValidateResourceConfig,
+	}
+
+	if req != nil {
+		interceptorReq.Config = req.Config
+		interceptorReq.TypeName = req.TypeName
+	}
+
+	s.intercept(ctx, interceptorReq, &resp.Diagnostics, func(ctx context.Context, _ *InterceptorResponse) {
+		s.validateResourceConfig(ctx, req, resp)
+	})
+}
+
+// validateResourceConfig contains the ValidateResourceConfig RPC logic, which
+// is called through any Interceptors.
+func (s *Server) validateResourceConfig(ctx context.Context, req *ValidateResourceConfigRequest, resp *ValidateResourceConfigResponse) {
 	if req == nil || req.Config == nil {
 		return
 	}
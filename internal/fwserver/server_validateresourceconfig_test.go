@@ -57,8 +57,8 @@ func TestServerValidateResourceConfig(t *testing.T) {
 								return
 							}
 
-							if got.Value != "test-value" {
-								resp.Diagnostics.AddError("Incorrect req.AttributeConfig", "expected test-value, got "+got.Value)
+							if got.ValueString() != "test-value" {
+								resp.Diagnostics.AddError("Incorrect req.AttributeConfig", "expected test-value, got "+got.ValueString())
 							}
 						},
 					},
@@ -93,6 +93,23 @@ func TestServerValidateResourceConfig(t *testing.T) {
 		Schema: testSchemaAttributeValidatorError,
 	}
 
+	testSchemaEnvVars := tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"test": {
+				Required: true,
+				Type:     types.StringType,
+				EnvVars:  []string{"TEST_ENV_VAR"},
+			},
+		},
+	}
+
+	testConfigEnvVars := tfsdk.Config{
+		Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+			"test": tftypes.NewValue(tftypes.String, nil),
+		}),
+		Schema: testSchemaEnvVars,
+	}
+
 	testCases := map[string]struct {
 		server           *fwserver.Server
 		request          *fwserver.ValidateResourceConfigRequest
@@ -118,6 +135,32 @@ func TestServerValidateResourceConfig(t *testing.T) {
 			},
 			expectedResponse: &fwserver.ValidateResourceConfigResponse{},
 		},
+		"request-config-EnvVars": {
+			server: &fwserver.Server{
+				LookupEnv: func(name string) (string, bool) {
+					return "test-env-value", name == "TEST_ENV_VAR"
+				},
+				Provider: &testprovider.Provider{},
+			},
+			request: &fwserver.ValidateResourceConfigRequest{
+				Config: &testConfigEnvVars,
+				ResourceType: &testprovider.ResourceType{
+					GetSchemaMethod: func(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+						return testSchemaEnvVars, nil
+					},
+				},
+			},
+			expectedResponse: &fwserver.ValidateResourceConfigResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Missing Configuration for Required Attribute",
+						"Must set a configuration value for the test attribute as the provider has marked it as required.\n\n"+
+							"Refer to the provider documentation or contact the provider developers for additional information about configurable attributes that are required.",
+					),
+				},
+			},
+		},
 		"request-config-AttributeValidator": {
 			server: &fwserver.Server{
 				Provider: &testprovider.Provider{},
@@ -179,8 +222,8 @@ func TestServerValidateResourceConfig(t *testing.T) {
 												return
 											}
 
-											if got.Value != "test-value" {
-												resp.Diagnostics.AddError("Incorrect req.Config", "expected test-value, got "+got.Value)
+											if got.ValueString() != "test-value" {
+												resp.Diagnostics.AddError("Incorrect req.Config", "expected test-value, got "+got.ValueString())
 											}
 										},
 									},
@@ -248,8 +291,8 @@ func TestServerValidateResourceConfig(t *testing.T) {
 									return
 								}
 
-								if got.Value != "test-value" {
-									resp.Diagnostics.AddError("Incorrect req.Config", "expected test-value, got "+got.Value)
+								if got.ValueString() != "test-value" {
+									resp.Diagnostics.AddError("Incorrect req.Config", "expected test-value, got "+got.ValueString())
 								}
 							},
 						}, nil
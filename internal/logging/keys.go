@@ -19,9 +19,23 @@ const (
 	// implement the Description() method, such as validators.
 	KeyDescription = "description"
 
+	// The environment variable name used for a provider configuration value,
+	// such as "EXAMPLE_API_TOKEN".
+	KeyEnvVar = "tf_env_var"
+
 	// Underlying Go error string when logging an error.
 	KeyError = "error"
 
 	// The type of resource being operated on, such as "random_pet"
 	KeyResourceType = "tf_resource_type"
+
+	// Human readable string representation of resource state data, such as
+	// intermediate state during chained resource state upgrades.
+	KeyState = "tf_state"
+
+	// The resource state version being upgraded from.
+	KeyStateVersionFrom = "tf_state_version_from"
+
+	// The resource state version being upgraded to.
+	KeyStateVersionTo = "tf_state_version_to"
 )
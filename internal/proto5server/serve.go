@@ -5,6 +5,9 @@ import (
 	"sync"
 
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/internal/toproto5"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 )
 
@@ -39,5 +42,11 @@ func (s *Server) cancelRegisteredContexts(_ context.Context) {
 func (s *Server) StopProvider(ctx context.Context, _ *tfprotov5.StopProviderRequest) (*tfprotov5.StopProviderResponse, error) {
 	s.cancelRegisteredContexts(ctx)
 
-	return &tfprotov5.StopProviderResponse{}, nil
+	ctx = logging.InitContext(ctx)
+
+	fwResp := &tfsdk.StopProviderResponse{}
+
+	s.FrameworkServer.StopProvider(ctx, &tfsdk.StopProviderRequest{}, fwResp)
+
+	return toproto5.StopProviderResponse(ctx, fwResp), nil
 }
@@ -90,8 +90,8 @@ func TestServerApplyResourceChange(t *testing.T) {
 
 												resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 
-												if data.TestRequired.Value != "test-config-value" {
-													resp.Diagnostics.AddError("Unexpected req.Config Value", "Got: "+data.TestRequired.Value)
+												if data.TestRequired.ValueString() != "test-config-value" {
+													resp.Diagnostics.AddError("Unexpected req.Config Value", "Got: "+data.TestRequired.ValueString())
 												}
 											},
 											DeleteMethod: func(_ context.Context, _ tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
@@ -142,8 +142,8 @@ func TestServerApplyResourceChange(t *testing.T) {
 
 												resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 
-												if data.TestComputed.Value != "test-plannedstate-value" {
-													resp.Diagnostics.AddError("Unexpected req.Plan Value", "Got: "+data.TestComputed.Value)
+												if data.TestComputed.ValueString() != "test-plannedstate-value" {
+													resp.Diagnostics.AddError("Unexpected req.Plan Value", "Got: "+data.TestComputed.ValueString())
 												}
 											},
 											DeleteMethod: func(_ context.Context, _ tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
@@ -195,8 +195,8 @@ func TestServerApplyResourceChange(t *testing.T) {
 
 													resp.Diagnostics.Append(req.ProviderMeta.Get(ctx, &data)...)
 
-													if data.TestProviderMetaAttribute.Value != "test-provider-meta-value" {
-														resp.Diagnostics.AddError("Unexpected req.ProviderMeta Value", "Got: "+data.TestProviderMetaAttribute.Value)
+													if data.TestProviderMetaAttribute.ValueString() != "test-provider-meta-value" {
+														resp.Diagnostics.AddError("Unexpected req.ProviderMeta Value", "Got: "+data.TestProviderMetaAttribute.ValueString())
 													}
 												},
 												DeleteMethod: func(_ context.Context, _ tfsdk.DeleteResourceRequest, resp *tfsdk.DeleteResourceResponse) {
@@ -364,8 +364,8 @@ func TestServerApplyResourceChange(t *testing.T) {
 
 												resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 
-												if data.TestRequired.Value != "test-priorstate-value" {
-													resp.Diagnostics.AddError("Unexpected req.State Value", "Got: "+data.TestRequired.Value)
+												if data.TestRequired.ValueString() != "test-priorstate-value" {
+													resp.Diagnostics.AddError("Unexpected req.State Value", "Got: "+data.TestRequired.ValueString())
 												}
 											},
 											UpdateMethod: func(_ context.Context, _ tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
@@ -412,8 +412,8 @@ func TestServerApplyResourceChange(t *testing.T) {
 
 													resp.Diagnostics.Append(req.ProviderMeta.Get(ctx, &data)...)
 
-													if data.TestProviderMetaAttribute.Value != "test-provider-meta-value" {
-														resp.Diagnostics.AddError("Unexpected req.ProviderMeta Value", "Got: "+data.TestProviderMetaAttribute.Value)
+													if data.TestProviderMetaAttribute.ValueString() != "test-provider-meta-value" {
+														resp.Diagnostics.AddError("Unexpected req.ProviderMeta Value", "Got: "+data.TestProviderMetaAttribute.ValueString())
 													}
 												},
 												UpdateMethod: func(_ context.Context, _ tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
@@ -566,8 +566,8 @@ func TestServerApplyResourceChange(t *testing.T) {
 
 												resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 
-												if data.TestRequired.Value != "test-new-value" {
-													resp.Diagnostics.AddError("Unexpected req.Config Value", "Got: "+data.TestRequired.Value)
+												if data.TestRequired.ValueString() != "test-new-value" {
+													resp.Diagnostics.AddError("Unexpected req.Config Value", "Got: "+data.TestRequired.ValueString())
 												}
 											},
 										}, nil
@@ -625,8 +625,8 @@ func TestServerApplyResourceChange(t *testing.T) {
 
 												resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 
-												if data.TestComputed.Value != "test-plannedstate-value" {
-													resp.Diagnostics.AddError("Unexpected req.Plan Value", "Got: "+data.TestComputed.Value)
+												if data.TestComputed.ValueString() != "test-plannedstate-value" {
+													resp.Diagnostics.AddError("Unexpected req.Plan Value", "Got: "+data.TestComputed.ValueString())
 												}
 											},
 										}, nil
@@ -683,8 +683,8 @@ func TestServerApplyResourceChange(t *testing.T) {
 
 												resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 
-												if data.TestRequired.Value != "test-old-value" {
-													resp.Diagnostics.AddError("Unexpected req.State Value", "Got: "+data.TestRequired.Value)
+												if data.TestRequired.ValueString() != "test-old-value" {
+													resp.Diagnostics.AddError("Unexpected req.State Value", "Got: "+data.TestRequired.ValueString())
 												}
 											},
 										}, nil
@@ -742,8 +742,8 @@ func TestServerApplyResourceChange(t *testing.T) {
 
 													resp.Diagnostics.Append(req.ProviderMeta.Get(ctx, &data)...)
 
-													if data.TestProviderMetaAttribute.Value != "test-provider-meta-value" {
-														resp.Diagnostics.AddError("Unexpected req.ProviderMeta Value", "Got: "+data.TestProviderMetaAttribute.Value)
+													if data.TestProviderMetaAttribute.ValueString() != "test-provider-meta-value" {
+														resp.Diagnostics.AddError("Unexpected req.ProviderMeta Value", "Got: "+data.TestProviderMetaAttribute.ValueString())
 													}
 												},
 											}, nil
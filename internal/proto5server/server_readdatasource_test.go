@@ -102,8 +102,8 @@ func TestServerReadDataSource(t *testing.T) {
 
 												resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
 
-												if config.TestRequired.Value != "test-config-value" {
-													resp.Diagnostics.AddError("unexpected req.Config value: %s", config.TestRequired.Value)
+												if config.TestRequired.ValueString() != "test-config-value" {
+													resp.Diagnostics.AddError("unexpected req.Config value: %s", config.TestRequired.ValueString())
 												}
 											},
 										}, nil
@@ -143,8 +143,8 @@ func TestServerReadDataSource(t *testing.T) {
 
 													resp.Diagnostics.Append(req.ProviderMeta.Get(ctx, &config)...)
 
-													if config.TestRequired.Value != "test-config-value" {
-														resp.Diagnostics.AddError("unexpected req.ProviderMeta value: %s", config.TestRequired.Value)
+													if config.TestRequired.ValueString() != "test-config-value" {
+														resp.Diagnostics.AddError("unexpected req.ProviderMeta value: %s", config.TestRequired.ValueString())
 													}
 												},
 											}, nil
@@ -232,7 +232,7 @@ func TestServerReadDataSource(t *testing.T) {
 
 												resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 
-												data.TestComputed = types.String{Value: "test-state-value"}
+												data.TestComputed = types.StringValue("test-state-value")
 
 												resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
 											},
@@ -5,6 +5,9 @@ import (
 	"sync"
 
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/internal/toproto6"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 )
 
@@ -39,5 +42,11 @@ func (s *Server) cancelRegisteredContexts(_ context.Context) {
 func (s *Server) StopProvider(ctx context.Context, _ *tfprotov6.StopProviderRequest) (*tfprotov6.StopProviderResponse, error) {
 	s.cancelRegisteredContexts(ctx)
 
-	return &tfprotov6.StopProviderResponse{}, nil
+	ctx = logging.InitContext(ctx)
+
+	fwResp := &tfsdk.StopProviderResponse{}
+
+	s.FrameworkServer.StopProvider(ctx, &tfsdk.StopProviderRequest{}, fwResp)
+
+	return toproto6.StopProviderResponse(ctx, fwResp), nil
 }
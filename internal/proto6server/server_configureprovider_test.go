@@ -92,8 +92,8 @@ func TestServerConfigureProvider(t *testing.T) {
 								return
 							}
 
-							if got.Value != "test-value" {
-								resp.Diagnostics.AddError("Incorrect req.Config", "expected test-value, got "+got.Value)
+							if got.ValueString() != "test-value" {
+								resp.Diagnostics.AddError("Incorrect req.Config", "expected test-value, got "+got.ValueString())
 							}
 						},
 					},
@@ -91,8 +91,8 @@ func TestServerPlanResourceChange(t *testing.T) {
 
 												resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 
-												if data.TestRequired.Value != "test-config-value" {
-													resp.Diagnostics.AddError("Unexpected req.Config Value", "Got: "+data.TestRequired.Value)
+												if data.TestRequired.ValueString() != "test-config-value" {
+													resp.Diagnostics.AddError("Unexpected req.Config Value", "Got: "+data.TestRequired.ValueString())
 												}
 											},
 										}, nil
@@ -139,8 +139,8 @@ func TestServerPlanResourceChange(t *testing.T) {
 
 												resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 
-												if !data.TestComputed.Unknown {
-													resp.Diagnostics.AddError("Unexpected req.Plan Value", "Got: "+data.TestComputed.Value)
+												if !data.TestComputed.IsUnknown() {
+													resp.Diagnostics.AddError("Unexpected req.Plan Value", "Got: "+data.TestComputed.ValueString())
 												}
 											},
 										}, nil
@@ -188,8 +188,8 @@ func TestServerPlanResourceChange(t *testing.T) {
 
 													resp.Diagnostics.Append(req.ProviderMeta.Get(ctx, &data)...)
 
-													if data.TestProviderMetaAttribute.Value != "test-provider-meta-value" {
-														resp.Diagnostics.AddError("Unexpected req.ProviderMeta Value", "Got: "+data.TestProviderMetaAttribute.Value)
+													if data.TestProviderMetaAttribute.ValueString() != "test-provider-meta-value" {
+														resp.Diagnostics.AddError("Unexpected req.ProviderMeta Value", "Got: "+data.TestProviderMetaAttribute.ValueString())
 													}
 												},
 											}, nil
@@ -296,7 +296,7 @@ func TestServerPlanResourceChange(t *testing.T) {
 
 												resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 
-												data.TestComputed = types.String{Value: "test-plannedstate-value"}
+												data.TestComputed = types.StringValue("test-plannedstate-value")
 
 												resp.Diagnostics.Append(resp.Plan.Set(ctx, &data)...)
 											},
@@ -397,8 +397,8 @@ func TestServerPlanResourceChange(t *testing.T) {
 
 												resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 
-												if data.TestRequired.Value != "test-priorstate-value" {
-													resp.Diagnostics.AddError("Unexpected req.State Value", "Got: "+data.TestRequired.Value)
+												if data.TestRequired.ValueString() != "test-priorstate-value" {
+													resp.Diagnostics.AddError("Unexpected req.State Value", "Got: "+data.TestRequired.ValueString())
 												}
 											},
 										}, nil
@@ -439,8 +439,8 @@ func TestServerPlanResourceChange(t *testing.T) {
 
 													resp.Diagnostics.Append(req.ProviderMeta.Get(ctx, &data)...)
 
-													if data.TestProviderMetaAttribute.Value != "test-provider-meta-value" {
-														resp.Diagnostics.AddError("Unexpected req.ProviderMeta Value", "Got: "+data.TestProviderMetaAttribute.Value)
+													if data.TestProviderMetaAttribute.ValueString() != "test-provider-meta-value" {
+														resp.Diagnostics.AddError("Unexpected req.ProviderMeta Value", "Got: "+data.TestProviderMetaAttribute.ValueString())
 													}
 												},
 											}, nil
@@ -539,7 +539,7 @@ func TestServerPlanResourceChange(t *testing.T) {
 												// anything with the PlannedState that is not equal to the
 												// empty request ProposedNewState should raise an error.
 												// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/364
-												data.TestComputed = types.String{Value: "test-plannedstate-value"}
+												data.TestComputed = types.StringValue("test-plannedstate-value")
 
 												resp.Diagnostics.Append(resp.Plan.Set(ctx, &data)...)
 											},
@@ -575,7 +575,7 @@ func TestServerPlanResourceChange(t *testing.T) {
 				},
 				PlannedState: testNewDynamicValue(t, testSchemaType, map[string]tftypes.Value{
 					"test_computed": tftypes.NewValue(tftypes.String, "test-plannedstate-value"),
-					"test_required": tftypes.NewValue(tftypes.String, ""),
+					"test_required": tftypes.NewValue(tftypes.String, nil),
 				}),
 			},
 		},
@@ -642,8 +642,8 @@ func TestServerPlanResourceChange(t *testing.T) {
 
 												resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 
-												if data.TestRequired.Value != "test-new-value" {
-													resp.Diagnostics.AddError("Unexpected req.Config Value", "Got: "+data.TestRequired.Value)
+												if data.TestRequired.ValueString() != "test-new-value" {
+													resp.Diagnostics.AddError("Unexpected req.Config Value", "Got: "+data.TestRequired.ValueString())
 												}
 											},
 										}, nil
@@ -693,8 +693,8 @@ func TestServerPlanResourceChange(t *testing.T) {
 
 												resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 
-												if !data.TestComputed.Unknown {
-													resp.Diagnostics.AddError("Unexpected req.Plan Value", "Got: "+data.TestComputed.Value)
+												if !data.TestComputed.IsUnknown() {
+													resp.Diagnostics.AddError("Unexpected req.Plan Value", "Got: "+data.TestComputed.ValueString())
 												}
 											},
 										}, nil
@@ -744,8 +744,8 @@ func TestServerPlanResourceChange(t *testing.T) {
 
 												resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 
-												if data.TestRequired.Value != "test-old-value" {
-													resp.Diagnostics.AddError("Unexpected req.State Value", "Got: "+data.TestRequired.Value)
+												if data.TestRequired.ValueString() != "test-old-value" {
+													resp.Diagnostics.AddError("Unexpected req.State Value", "Got: "+data.TestRequired.ValueString())
 												}
 											},
 										}, nil
@@ -796,8 +796,8 @@ func TestServerPlanResourceChange(t *testing.T) {
 
 													resp.Diagnostics.Append(req.ProviderMeta.Get(ctx, &data)...)
 
-													if data.TestProviderMetaAttribute.Value != "test-provider-meta-value" {
-														resp.Diagnostics.AddError("Unexpected req.ProviderMeta Value", "Got: "+data.TestProviderMetaAttribute.Value)
+													if data.TestProviderMetaAttribute.ValueString() != "test-provider-meta-value" {
+														resp.Diagnostics.AddError("Unexpected req.ProviderMeta Value", "Got: "+data.TestProviderMetaAttribute.ValueString())
 													}
 												},
 											}, nil
@@ -910,7 +910,7 @@ func TestServerPlanResourceChange(t *testing.T) {
 
 												resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 
-												data.TestComputed = types.String{Value: "test-plannedstate-value"}
+												data.TestComputed = types.StringValue("test-plannedstate-value")
 
 												resp.Diagnostics.Append(resp.Plan.Set(ctx, &data)...)
 											},
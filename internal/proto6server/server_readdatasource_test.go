@@ -102,8 +102,8 @@ func TestServerReadDataSource(t *testing.T) {
 
 												resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
 
-												if config.TestRequired.Value != "test-config-value" {
-													resp.Diagnostics.AddError("unexpected req.Config value: %s", config.TestRequired.Value)
+												if config.TestRequired.ValueString() != "test-config-value" {
+													resp.Diagnostics.AddError("unexpected req.Config value: %s", config.TestRequired.ValueString())
 												}
 											},
 										}, nil
@@ -122,6 +122,55 @@ func TestServerReadDataSource(t *testing.T) {
 				State: testConfigDynamicValue,
 			},
 		},
+		"request-config-factory": {
+			server: &Server{
+				FrameworkServer: fwserver.Server{
+					Provider: &testprovider.ProviderWithDataSources{
+						ProviderWithMetadata: &testprovider.ProviderWithMetadata{
+							Provider: &testprovider.Provider{},
+							MetadataMethod: func(_ context.Context, _ tfsdk.ProviderMetadataRequest, resp *tfsdk.ProviderMetadataResponse) {
+								resp.TypeName = "test"
+							},
+						},
+						DataSourcesMethod: func(_ context.Context) []func() tfsdk.DataSource {
+							return []func() tfsdk.DataSource{
+								func() tfsdk.DataSource {
+									return &testprovider.DataSourceWithMetadata{
+										DataSource: &testprovider.DataSource{
+											ReadMethod: func(ctx context.Context, req tfsdk.ReadDataSourceRequest, resp *tfsdk.ReadDataSourceResponse) {
+												var config struct {
+													TestComputed types.String `tfsdk:"test_computed"`
+													TestRequired types.String `tfsdk:"test_required"`
+												}
+
+												resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+												if config.TestRequired.ValueString() != "test-config-value" {
+													resp.Diagnostics.AddError("unexpected req.Config value: %s", config.TestRequired.ValueString())
+												}
+											},
+										},
+										GetSchemaMethod: func(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+											return testSchema, nil
+										},
+										MetadataMethod: func(_ context.Context, req tfsdk.DataSourceMetadataRequest, resp *tfsdk.DataSourceMetadataResponse) {
+											resp.TypeName = req.ProviderTypeName + "_data_source"
+										},
+									}
+								},
+							}
+						},
+					},
+				},
+			},
+			request: &tfprotov6.ReadDataSourceRequest{
+				Config:   testConfigDynamicValue,
+				TypeName: "test_data_source",
+			},
+			expectedResponse: &tfprotov6.ReadDataSourceResponse{
+				State: testConfigDynamicValue,
+			},
+		},
 		"request-providermeta": {
 			server: &Server{
 				FrameworkServer: fwserver.Server{
@@ -143,8 +192,8 @@ func TestServerReadDataSource(t *testing.T) {
 
 													resp.Diagnostics.Append(req.ProviderMeta.Get(ctx, &config)...)
 
-													if config.TestRequired.Value != "test-config-value" {
-														resp.Diagnostics.AddError("unexpected req.ProviderMeta value: %s", config.TestRequired.Value)
+													if config.TestRequired.ValueString() != "test-config-value" {
+														resp.Diagnostics.AddError("unexpected req.ProviderMeta value: %s", config.TestRequired.ValueString())
 													}
 												},
 											}, nil
@@ -232,7 +281,7 @@ func TestServerReadDataSource(t *testing.T) {
 
 												resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 
-												data.TestComputed = types.String{Value: "test-state-value"}
+												data.TestComputed = types.StringValue("test-state-value")
 
 												resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
 											},
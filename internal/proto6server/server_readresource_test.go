@@ -104,8 +104,8 @@ func TestServerReadResource(t *testing.T) {
 
 												resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 
-												if data.TestRequired.Value != "test-currentstate-value" {
-													resp.Diagnostics.AddError("unexpected req.State value: %s", data.TestRequired.Value)
+												if data.TestRequired.ValueString() != "test-currentstate-value" {
+													resp.Diagnostics.AddError("unexpected req.State value: %s", data.TestRequired.ValueString())
 												}
 											},
 										}, nil
@@ -145,8 +145,8 @@ func TestServerReadResource(t *testing.T) {
 
 													resp.Diagnostics.Append(req.ProviderMeta.Get(ctx, &data)...)
 
-													if data.TestRequired.Value != "test-currentstate-value" {
-														resp.Diagnostics.AddError("unexpected req.ProviderMeta value: %s", data.TestRequired.Value)
+													if data.TestRequired.ValueString() != "test-currentstate-value" {
+														resp.Diagnostics.AddError("unexpected req.ProviderMeta value: %s", data.TestRequired.ValueString())
 													}
 												},
 											}, nil
@@ -234,7 +234,7 @@ func TestServerReadResource(t *testing.T) {
 
 												resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 
-												data.TestComputed = types.String{Value: "test-newstate-value"}
+												data.TestComputed = types.StringValue("test-newstate-value")
 
 												resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
 											},
@@ -0,0 +1,3 @@
+// Package rawstate contains functionality for working with the Terraform
+// resource state representations used by the UpgradeResourceState RPC.
+package rawstate
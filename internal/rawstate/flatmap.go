@@ -0,0 +1,260 @@
+package rawstate
+
+import (
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// FlatmapUnknownValue is the value Terraform CLI 0.11 and earlier saved in
+// flatmap state for unknown values, including collection counts.
+const FlatmapUnknownValue = "74D93920-ED26-11E3-AC10-0800200C9A66"
+
+// FlatmapToValue returns the tftypes.Value of flatmap state, as saved by
+// Terraform CLI 0.11 and earlier, for the given object type, which is
+// typically the type of a resource schema.
+//
+// Flatmap state is a single level map of dotted keys to string values:
+//
+//   - Primitive values are saved under their attribute name, with numbers
+//     and booleans converted to strings.
+//   - List and set values save their element count under a "#" key, such as
+//     "example.#", with list elements under their index, such as
+//     "example.0", and set elements under a hash, such as "example.1234".
+//   - Map values save their element count under a "%" key, such as
+//     "example.%", with elements under their key, such as "example.key".
+//   - Object values, such as nested blocks, save each attribute under the
+//     object prefix, such as "example.0.nested_attribute".
+//
+// Missing values are null and FlatmapUnknownValue values are unknown. Keys
+// which do not correspond to the type are ignored, matching the behavior of
+// Terraform CLI.
+func FlatmapToValue(flatmap map[string]string, typ tftypes.Type) (tftypes.Value, error) {
+	if !typ.Is(tftypes.Object{}) {
+		return tftypes.Value{}, tftypes.NewAttributePath().NewErrorf("flatmap state requires an object type, got: %s", typ)
+	}
+
+	return flatmapObject(tftypes.NewAttributePath(), flatmap, "", typ.(tftypes.Object))
+}
+
+func flatmapValue(path *tftypes.AttributePath, flatmap map[string]string, key string, typ tftypes.Type) (tftypes.Value, error) {
+	switch {
+	case typ.Is(tftypes.String), typ.Is(tftypes.Number), typ.Is(tftypes.Bool):
+		return flatmapPrimitive(path, flatmap, key, typ)
+	case typ.Is(tftypes.List{}), typ.Is(tftypes.Set{}), typ.Is(tftypes.Tuple{}):
+		return flatmapSequence(path, flatmap, key, typ)
+	case typ.Is(tftypes.Map{}):
+		return flatmapMap(path, flatmap, key, typ.(tftypes.Map))
+	case typ.Is(tftypes.Object{}):
+		// Objects do not save a count, so they are null when no attributes
+		// are saved.
+		if len(flatmapChildKeys(flatmap, key, false)) == 0 {
+			return tftypes.NewValue(typ, nil), nil
+		}
+
+		return flatmapObject(path, flatmap, key+".", typ.(tftypes.Object))
+	default:
+		return tftypes.Value{}, path.NewErrorf("unsupported type %s", typ)
+	}
+}
+
+// flatmapElement returns the collection element value at the key. Unlike
+// attributes, object elements are never null, since an element with only
+// null attributes saves no keys.
+func flatmapElement(path *tftypes.AttributePath, flatmap map[string]string, key string, typ tftypes.Type) (tftypes.Value, error) {
+	if typ.Is(tftypes.Object{}) {
+		return flatmapObject(path, flatmap, key+".", typ.(tftypes.Object))
+	}
+
+	return flatmapValue(path, flatmap, key, typ)
+}
+
+func flatmapPrimitive(path *tftypes.AttributePath, flatmap map[string]string, key string, typ tftypes.Type) (tftypes.Value, error) {
+	raw, ok := flatmap[key]
+
+	if !ok {
+		return tftypes.NewValue(typ, nil), nil
+	}
+
+	if raw == FlatmapUnknownValue {
+		return tftypes.NewValue(typ, tftypes.UnknownValue), nil
+	}
+
+	switch {
+	case typ.Is(tftypes.Number):
+		n, _, err := big.ParseFloat(raw, 10, 512, big.ToNearestEven)
+
+		if err != nil {
+			return tftypes.Value{}, path.NewErrorf("unable to convert %q to number: %w", raw, err)
+		}
+
+		return tftypes.NewValue(typ, n), nil
+	case typ.Is(tftypes.Bool):
+		b, err := strconv.ParseBool(raw)
+
+		if err != nil {
+			return tftypes.Value{}, path.NewErrorf("unable to convert %q to bool: %w", raw, err)
+		}
+
+		return tftypes.NewValue(typ, b), nil
+	default:
+		return tftypes.NewValue(typ, raw), nil
+	}
+}
+
+func flatmapSequence(path *tftypes.AttributePath, flatmap map[string]string, key string, typ tftypes.Type) (tftypes.Value, error) {
+	count, ok := flatmap[key+".#"]
+
+	if !ok {
+		return tftypes.NewValue(typ, nil), nil
+	}
+
+	if count == FlatmapUnknownValue {
+		return tftypes.NewValue(typ, tftypes.UnknownValue), nil
+	}
+
+	length, err := strconv.Atoi(count)
+
+	if err != nil || length < 0 {
+		return tftypes.Value{}, path.NewErrorf("invalid count %q", count)
+	}
+
+	var elemKeys []string
+
+	if typ.Is(tftypes.Set{}) {
+		// Set elements are saved under hashes, rather than indexes.
+		elemKeys = flatmapChildKeys(flatmap, key, false)
+
+		if len(elemKeys) != length {
+			return tftypes.Value{}, path.NewErrorf("count %d does not match the %d set elements", length, len(elemKeys))
+		}
+	} else {
+		for index := 0; index < length; index++ {
+			elemKeys = append(elemKeys, strconv.Itoa(index))
+		}
+	}
+
+	elems := make([]tftypes.Value, 0, length)
+
+	for index, elemKey := range elemKeys {
+		var elemType tftypes.Type
+
+		switch typ := typ.(type) {
+		case tftypes.List:
+			elemType = typ.ElementType
+		case tftypes.Set:
+			elemType = typ.ElementType
+		case tftypes.Tuple:
+			if index >= len(typ.ElementTypes) {
+				return tftypes.Value{}, path.NewErrorf("count %d exceeds the %d tuple element types", length, len(typ.ElementTypes))
+			}
+
+			elemType = typ.ElementTypes[index]
+		}
+
+		elem, err := flatmapElement(path.WithElementKeyInt(index), flatmap, key+"."+elemKey, elemType)
+
+		if err != nil {
+			return tftypes.Value{}, err
+		}
+
+		elems = append(elems, elem)
+	}
+
+	return tftypes.NewValue(typ, elems), nil
+}
+
+func flatmapMap(path *tftypes.AttributePath, flatmap map[string]string, key string, typ tftypes.Map) (tftypes.Value, error) {
+	count, ok := flatmap[key+".%"]
+
+	if !ok {
+		return tftypes.NewValue(typ, nil), nil
+	}
+
+	if count == FlatmapUnknownValue {
+		return tftypes.NewValue(typ, tftypes.UnknownValue), nil
+	}
+
+	length, err := strconv.Atoi(count)
+
+	if err != nil || length < 0 {
+		return tftypes.Value{}, path.NewErrorf("invalid count %q", count)
+	}
+
+	// Primitive map keys may contain periods, since nothing follows them.
+	isPrimitive := typ.ElementType.Is(tftypes.String) || typ.ElementType.Is(tftypes.Number) || typ.ElementType.Is(tftypes.Bool)
+	elemKeys := flatmapChildKeys(flatmap, key, isPrimitive)
+
+	if len(elemKeys) != length {
+		return tftypes.Value{}, path.NewErrorf("count %d does not match the %d map elements", length, len(elemKeys))
+	}
+
+	elems := make(map[string]tftypes.Value, length)
+
+	for _, elemKey := range elemKeys {
+		elem, err := flatmapElement(path.WithElementKeyString(elemKey), flatmap, key+"."+elemKey, typ.ElementType)
+
+		if err != nil {
+			return tftypes.Value{}, err
+		}
+
+		elems[elemKey] = elem
+	}
+
+	return tftypes.NewValue(typ, elems), nil
+}
+
+func flatmapObject(path *tftypes.AttributePath, flatmap map[string]string, prefix string, typ tftypes.Object) (tftypes.Value, error) {
+	attrs := make(map[string]tftypes.Value, len(typ.AttributeTypes))
+
+	for name, attrType := range typ.AttributeTypes {
+		attr, err := flatmapValue(path.WithAttributeName(name), flatmap, prefix+name, attrType)
+
+		if err != nil {
+			return tftypes.Value{}, err
+		}
+
+		attrs[name] = attr
+	}
+
+	return tftypes.NewValue(typ, attrs), nil
+}
+
+// flatmapChildKeys returns the sorted, unique keys directly under the key,
+// excluding the "#" and "%" count keys. If whole is true, the remainder of
+// each flatmap key is returned, rather than only the next segment.
+func flatmapChildKeys(flatmap map[string]string, key string, whole bool) []string {
+	prefix := key + "."
+	seen := map[string]struct{}{}
+
+	for flatmapKey := range flatmap {
+		if !strings.HasPrefix(flatmapKey, prefix) {
+			continue
+		}
+
+		child := flatmapKey[len(prefix):]
+
+		if !whole {
+			child = strings.SplitN(child, ".", 2)[0]
+		}
+
+		if child == "#" || child == "%" {
+			continue
+		}
+
+		seen[child] = struct{}{}
+	}
+
+	keys := make([]string, 0, len(seen))
+
+	for child := range seen {
+		keys = append(keys, child)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
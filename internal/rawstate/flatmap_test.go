@@ -0,0 +1,276 @@
+package rawstate_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/internal/rawstate"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestFlatmapToValue(t *testing.T) {
+	t.Parallel()
+
+	blockType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"name": tftypes.String,
+			"port": tftypes.Number,
+		},
+	}
+
+	testCases := map[string]struct {
+		flatmap       map[string]string
+		typ           tftypes.Type
+		expected      tftypes.Value
+		expectedError string
+	}{
+		"primitives": {
+			flatmap: map[string]string{
+				"bool":   "true",
+				"id":     "test-id",
+				"number": "1.5",
+			},
+			typ: tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"bool":    tftypes.Bool,
+					"id":      tftypes.String,
+					"missing": tftypes.String,
+					"number":  tftypes.Number,
+				},
+			},
+			expected: tftypes.NewValue(
+				tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"bool":    tftypes.Bool,
+						"id":      tftypes.String,
+						"missing": tftypes.String,
+						"number":  tftypes.Number,
+					},
+				},
+				map[string]tftypes.Value{
+					"bool":    tftypes.NewValue(tftypes.Bool, true),
+					"id":      tftypes.NewValue(tftypes.String, "test-id"),
+					"missing": tftypes.NewValue(tftypes.String, nil),
+					"number":  tftypes.NewValue(tftypes.Number, 1.5),
+				},
+			),
+		},
+		"unknown": {
+			flatmap: map[string]string{
+				"id":     rawstate.FlatmapUnknownValue,
+				"list.#": rawstate.FlatmapUnknownValue,
+			},
+			typ: tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"id":   tftypes.String,
+					"list": tftypes.List{ElementType: tftypes.String},
+				},
+			},
+			expected: tftypes.NewValue(
+				tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"id":   tftypes.String,
+						"list": tftypes.List{ElementType: tftypes.String},
+					},
+				},
+				map[string]tftypes.Value{
+					"id":   tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+					"list": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, tftypes.UnknownValue),
+				},
+			),
+		},
+		"collections": {
+			flatmap: map[string]string{
+				"empty.#":         "0",
+				"list.#":          "2",
+				"list.0":          "first",
+				"list.1":          "second",
+				"map.%":           "2",
+				"map.key":         "value",
+				"map.dotted.key":  "dotted",
+				"set.#":           "2",
+				"set.1234567":     "one",
+				"set.7654321":     "two",
+				"unrelated.value": "ignored",
+			},
+			typ: tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"empty":   tftypes.List{ElementType: tftypes.String},
+					"list":    tftypes.List{ElementType: tftypes.String},
+					"map":     tftypes.Map{ElementType: tftypes.String},
+					"missing": tftypes.Set{ElementType: tftypes.String},
+					"set":     tftypes.Set{ElementType: tftypes.String},
+				},
+			},
+			expected: tftypes.NewValue(
+				tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"empty":   tftypes.List{ElementType: tftypes.String},
+						"list":    tftypes.List{ElementType: tftypes.String},
+						"map":     tftypes.Map{ElementType: tftypes.String},
+						"missing": tftypes.Set{ElementType: tftypes.String},
+						"set":     tftypes.Set{ElementType: tftypes.String},
+					},
+				},
+				map[string]tftypes.Value{
+					"empty": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{}),
+					"list": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+						tftypes.NewValue(tftypes.String, "first"),
+						tftypes.NewValue(tftypes.String, "second"),
+					}),
+					"map": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+						"dotted.key": tftypes.NewValue(tftypes.String, "dotted"),
+						"key":        tftypes.NewValue(tftypes.String, "value"),
+					}),
+					"missing": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
+					"set": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+						tftypes.NewValue(tftypes.String, "one"),
+						tftypes.NewValue(tftypes.String, "two"),
+					}),
+				},
+			),
+		},
+		"blocks": {
+			flatmap: map[string]string{
+				"list_block.#":            "1",
+				"list_block.0.name":       "first",
+				"list_block.0.port":       "80",
+				"set_block.#":             "2",
+				"set_block.1111.name":     "one",
+				"set_block.1111.port":     "443",
+				"set_block.2222.name":     "two",
+				"empty_list_block.#":      "1",
+				"map_of_objects.%":        "1",
+				"map_of_objects.key.name": "mapped",
+				"single_nested.name":      "single",
+				"single_nested.port":      "8080",
+			},
+			typ: tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"empty_list_block":   tftypes.List{ElementType: blockType},
+					"list_block":         tftypes.List{ElementType: blockType},
+					"map_of_objects":     tftypes.Map{ElementType: blockType},
+					"set_block":          tftypes.Set{ElementType: blockType},
+					"single_nested":      blockType,
+					"single_nested_null": tftypes.Object{AttributeTypes: map[string]tftypes.Type{"name": tftypes.String}},
+				},
+			},
+			expected: tftypes.NewValue(
+				tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"empty_list_block":   tftypes.List{ElementType: blockType},
+						"list_block":         tftypes.List{ElementType: blockType},
+						"map_of_objects":     tftypes.Map{ElementType: blockType},
+						"set_block":          tftypes.Set{ElementType: blockType},
+						"single_nested":      blockType,
+						"single_nested_null": tftypes.Object{AttributeTypes: map[string]tftypes.Type{"name": tftypes.String}},
+					},
+				},
+				map[string]tftypes.Value{
+					"empty_list_block": tftypes.NewValue(tftypes.List{ElementType: blockType}, []tftypes.Value{
+						tftypes.NewValue(blockType, map[string]tftypes.Value{
+							"name": tftypes.NewValue(tftypes.String, nil),
+							"port": tftypes.NewValue(tftypes.Number, nil),
+						}),
+					}),
+					"list_block": tftypes.NewValue(tftypes.List{ElementType: blockType}, []tftypes.Value{
+						tftypes.NewValue(blockType, map[string]tftypes.Value{
+							"name": tftypes.NewValue(tftypes.String, "first"),
+							"port": tftypes.NewValue(tftypes.Number, 80),
+						}),
+					}),
+					"map_of_objects": tftypes.NewValue(tftypes.Map{ElementType: blockType}, map[string]tftypes.Value{
+						"key": tftypes.NewValue(blockType, map[string]tftypes.Value{
+							"name": tftypes.NewValue(tftypes.String, "mapped"),
+							"port": tftypes.NewValue(tftypes.Number, nil),
+						}),
+					}),
+					"set_block": tftypes.NewValue(tftypes.Set{ElementType: blockType}, []tftypes.Value{
+						tftypes.NewValue(blockType, map[string]tftypes.Value{
+							"name": tftypes.NewValue(tftypes.String, "one"),
+							"port": tftypes.NewValue(tftypes.Number, 443),
+						}),
+						tftypes.NewValue(blockType, map[string]tftypes.Value{
+							"name": tftypes.NewValue(tftypes.String, "two"),
+							"port": tftypes.NewValue(tftypes.Number, nil),
+						}),
+					}),
+					"single_nested": tftypes.NewValue(blockType, map[string]tftypes.Value{
+						"name": tftypes.NewValue(tftypes.String, "single"),
+						"port": tftypes.NewValue(tftypes.Number, 8080),
+					}),
+					"single_nested_null": tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"name": tftypes.String}}, nil),
+				},
+			),
+		},
+		"invalid-bool": {
+			flatmap: map[string]string{
+				"bool": "yes please",
+			},
+			typ: tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"bool": tftypes.Bool,
+				},
+			},
+			expectedError: `AttributeName("bool"): unable to convert "yes please" to bool: strconv.ParseBool: parsing "yes please": invalid syntax`,
+		},
+		"invalid-count": {
+			flatmap: map[string]string{
+				"list.#": "many",
+			},
+			typ: tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"list": tftypes.List{ElementType: tftypes.String},
+				},
+			},
+			expectedError: `AttributeName("list"): invalid count "many"`,
+		},
+		"set-count-mismatch": {
+			flatmap: map[string]string{
+				"set.#":    "2",
+				"set.1234": "one",
+			},
+			typ: tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"set": tftypes.Set{ElementType: tftypes.String},
+				},
+			},
+			expectedError: `AttributeName("set"): count 2 does not match the 1 set elements`,
+		},
+		"non-object": {
+			flatmap:       map[string]string{},
+			typ:           tftypes.String,
+			expectedError: `flatmap state requires an object type, got: tftypes.String`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := rawstate.FlatmapToValue(testCase.flatmap, testCase.typ)
+
+			if err != nil {
+				if testCase.expectedError == "" {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if diff := cmp.Diff(err.Error(), testCase.expectedError); diff != "" {
+					t.Fatalf("unexpected error difference: %s", diff)
+				}
+
+				return
+			}
+
+			if testCase.expectedError != "" {
+				t.Fatalf("expected error: %s", testCase.expectedError)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
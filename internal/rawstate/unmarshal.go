@@ -0,0 +1,17 @@
+package rawstate
+
+import (
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Unmarshal returns the tftypes.Value of the RawState for the given type.
+// Unlike the tfprotov6.RawState type Unmarshal method, flatmap state saved by
+// Terraform CLI 0.11 and earlier is supported via FlatmapToValue.
+func Unmarshal(rawState *tfprotov6.RawState, typ tftypes.Type) (tftypes.Value, error) {
+	if rawState.JSON == nil && rawState.Flatmap != nil {
+		return FlatmapToValue(rawState.Flatmap, typ)
+	}
+
+	return rawState.Unmarshal(typ)
+}
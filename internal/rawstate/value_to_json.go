@@ -0,0 +1,133 @@
+package rawstate
+
+import (
+	"bytes"
+	"encoding/json"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// ValueToJSON returns the JSON representation of a tftypes.Value, as
+// Terraform CLI 0.12 and later would save it in the resource state. The JSON
+// can be read with tftypes.ValueFromJSON or the tfprotov6.RawState type
+// Unmarshal method.
+//
+// Unknown values cannot be saved in the resource state and return an error.
+func ValueToJSON(val tftypes.Value) ([]byte, error) {
+	v, err := jsonValue(tftypes.NewAttributePath(), val)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+
+	if err := encoder.Encode(v); err != nil {
+		return nil, err
+	}
+
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
+
+// jsonValue returns the encoding/json compatible Go value for a
+// tftypes.Value.
+func jsonValue(path *tftypes.AttributePath, val tftypes.Value) (interface{}, error) {
+	if !val.IsKnown() {
+		return nil, path.NewErrorf("unknown values cannot be converted to JSON")
+	}
+
+	if val.IsNull() {
+		return nil, nil
+	}
+
+	typ := val.Type()
+
+	switch {
+	case typ.Is(tftypes.String):
+		var s string
+
+		if err := val.As(&s); err != nil {
+			return nil, path.NewError(err)
+		}
+
+		return s, nil
+	case typ.Is(tftypes.Bool):
+		var b bool
+
+		if err := val.As(&b); err != nil {
+			return nil, path.NewError(err)
+		}
+
+		return b, nil
+	case typ.Is(tftypes.Number):
+		n := big.NewFloat(0)
+
+		if err := val.As(&n); err != nil {
+			return nil, path.NewError(err)
+		}
+
+		if n.IsInt() {
+			return json.Number(n.Text('f', -1)), nil
+		}
+
+		return json.Number(n.Text('g', -1)), nil
+	case typ.Is(tftypes.List{}), typ.Is(tftypes.Set{}), typ.Is(tftypes.Tuple{}):
+		var elems []tftypes.Value
+
+		if err := val.As(&elems); err != nil {
+			return nil, path.NewError(err)
+		}
+
+		result := make([]interface{}, 0, len(elems))
+
+		for index, elem := range elems {
+			elemPath := path.WithElementKeyInt(index)
+
+			if typ.Is(tftypes.Set{}) {
+				elemPath = path.WithElementKeyValue(elem)
+			}
+
+			v, err := jsonValue(elemPath, elem)
+
+			if err != nil {
+				return nil, err
+			}
+
+			result = append(result, v)
+		}
+
+		return result, nil
+	case typ.Is(tftypes.Map{}), typ.Is(tftypes.Object{}):
+		var attrs map[string]tftypes.Value
+
+		if err := val.As(&attrs); err != nil {
+			return nil, path.NewError(err)
+		}
+
+		result := make(map[string]interface{}, len(attrs))
+
+		for name, attr := range attrs {
+			attrPath := path.WithAttributeName(name)
+
+			if typ.Is(tftypes.Map{}) {
+				attrPath = path.WithElementKeyString(name)
+			}
+
+			v, err := jsonValue(attrPath, attr)
+
+			if err != nil {
+				return nil, err
+			}
+
+			result[name] = v
+		}
+
+		return result, nil
+	default:
+		return nil, path.NewErrorf("unsupported type %s", typ)
+	}
+}
@@ -0,0 +1,115 @@
+package rawstate_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/internal/rawstate"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestValueToJSON(t *testing.T) {
+	t.Parallel()
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"bool":    tftypes.Bool,
+			"float":   tftypes.Number,
+			"int":     tftypes.Number,
+			"list":    tftypes.List{ElementType: tftypes.String},
+			"map":     tftypes.Map{ElementType: tftypes.Number},
+			"null":    tftypes.String,
+			"set":     tftypes.Set{ElementType: tftypes.Bool},
+			"string":  tftypes.String,
+			"tuple":   tftypes.Tuple{ElementTypes: []tftypes.Type{tftypes.String, tftypes.Bool}},
+			"objects": tftypes.List{ElementType: tftypes.Object{AttributeTypes: map[string]tftypes.Type{"nested": tftypes.String}}},
+		},
+	}
+
+	testCases := map[string]struct {
+		value         tftypes.Value
+		expected      string
+		expectedError string
+	}{
+		"null": {
+			value:    tftypes.NewValue(testType, nil),
+			expected: `null`,
+		},
+		"object": {
+			value: tftypes.NewValue(testType, map[string]tftypes.Value{
+				"bool":  tftypes.NewValue(tftypes.Bool, true),
+				"float": tftypes.NewValue(tftypes.Number, big.NewFloat(1.5)),
+				"int":   tftypes.NewValue(tftypes.Number, big.NewFloat(12345678)),
+				"list": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "<a&b>"),
+				}),
+				"map": tftypes.NewValue(tftypes.Map{ElementType: tftypes.Number}, map[string]tftypes.Value{
+					"key": tftypes.NewValue(tftypes.Number, big.NewFloat(-2)),
+				}),
+				"null": tftypes.NewValue(tftypes.String, nil),
+				"set": tftypes.NewValue(tftypes.Set{ElementType: tftypes.Bool}, []tftypes.Value{
+					tftypes.NewValue(tftypes.Bool, false),
+				}),
+				"string": tftypes.NewValue(tftypes.String, "test"),
+				"tuple": tftypes.NewValue(tftypes.Tuple{ElementTypes: []tftypes.Type{tftypes.String, tftypes.Bool}}, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "a"),
+					tftypes.NewValue(tftypes.Bool, true),
+				}),
+				"objects": tftypes.NewValue(tftypes.List{ElementType: tftypes.Object{AttributeTypes: map[string]tftypes.Type{"nested": tftypes.String}}}, []tftypes.Value{
+					tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"nested": tftypes.String}}, map[string]tftypes.Value{
+						"nested": tftypes.NewValue(tftypes.String, "value"),
+					}),
+				}),
+			}),
+			expected: `{"bool":true,"float":1.5,"int":12345678,"list":["<a&b>"],"map":{"key":-2},"null":null,"objects":[{"nested":"value"}],"set":[false],"string":"test","tuple":["a",true]}`,
+		},
+		"unknown": {
+			value: tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"test": tftypes.String}}, map[string]tftypes.Value{
+				"test": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			}),
+			expectedError: `AttributeName("test"): unknown values cannot be converted to JSON`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := rawstate.ValueToJSON(testCase.value)
+
+			if err != nil {
+				if testCase.expectedError == "" {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if diff := cmp.Diff(err.Error(), testCase.expectedError); diff != "" {
+					t.Fatalf("unexpected error difference: %s", diff)
+				}
+
+				return
+			}
+
+			if testCase.expectedError != "" {
+				t.Fatalf("expected error: %s", testCase.expectedError)
+			}
+
+			if diff := cmp.Diff(string(got), testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			// Verify the JSON round trips.
+			roundTrip, err := tftypes.ValueFromJSON(got, testCase.value.Type())
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !roundTrip.Equal(testCase.value) {
+				t.Errorf("expected round trip value %s, got %s", testCase.value, roundTrip)
+			}
+		})
+	}
+}
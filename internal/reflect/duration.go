@@ -0,0 +1,55 @@
+package reflect
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+// NewDuration creates a time.Duration by parsing the Go duration string in
+// `val`, such as "1m30s".
+//
+// It is meant to be called through Into, not directly.
+func NewDuration(ctx context.Context, typ attr.Type, val tftypes.Value, target reflect.Value, opts Options, path path.Path) (reflect.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var s string
+
+	err := val.As(&s)
+	if err != nil {
+		diags.Append(diag.WithPath(path, DiagIntoIncompatibleType{
+			Val:        val,
+			TargetType: target.Type(),
+			Err:        err,
+		}))
+		return target, diags
+	}
+
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		err = fmt.Errorf("cannot parse %q as %s: %w", s, target.Type(), err)
+		diags.AddAttributeError(
+			path,
+			"Value Conversion Error",
+			"An unexpected error was encountered trying to convert into a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+		)
+		return target, diags
+	}
+
+	return reflect.ValueOf(d), diags
+}
+
+// FromDuration creates an attr.Value using `typ` from the Go duration string
+// representation of `val`, such as "1m30s".
+//
+// It is meant to be called through FromValue, not directly.
+func FromDuration(ctx context.Context, typ attr.Type, val time.Duration, path path.Path) (attr.Value, diag.Diagnostics) {
+	return FromString(ctx, typ, val.String(), path)
+}
@@ -0,0 +1,105 @@
+package reflect_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	refl "github.com/hashicorp/terraform-plugin-framework/internal/reflect"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestNewDuration(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ           attr.Type
+		val           tftypes.Value
+		expected      time.Duration
+		expectedDiags diag.Diagnostics
+	}{
+		"string": {
+			typ:      types.StringType,
+			val:      tftypes.NewValue(tftypes.String, "1m30s"),
+			expected: 90 * time.Second,
+		},
+		"string-invalid": {
+			typ: types.StringType,
+			val: tftypes.NewValue(tftypes.String, "90"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Empty(),
+					"Value Conversion Error",
+					"An unexpected error was encountered trying to convert into a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						`cannot parse "90" as time.Duration: time: missing unit in duration "90"`,
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var target time.Duration
+
+			got, diags := refl.BuildValue(context.Background(), testCase.typ, testCase.val, reflect.ValueOf(target), refl.Options{}, path.Empty())
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Fatalf("unexpected diagnostics (+wanted, -got): %s", diff)
+			}
+
+			if diags.HasError() {
+				return
+			}
+
+			if diff := cmp.Diff(got.Interface(), testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestFromDuration(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ      attr.Type
+		expected attr.Value
+	}{
+		"string": {
+			typ:      types.StringType,
+			expected: types.StringValue("1m30s"),
+		},
+		"number": {
+			typ:      types.Int64Type,
+			expected: types.Int64Value(int64(90 * time.Second)),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := refl.FromValue(context.Background(), testCase.typ, 90*time.Second, path.Empty())
+
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
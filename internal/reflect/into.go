@@ -16,13 +16,22 @@ import (
 // package to recursively reflect into structs and slices. If `target` is an
 // attr.Value, its assignment method will be used instead of reflecting. If
 // `target` is a tftypes.ValueConverter, the FromTerraformValue method will be
-// used instead of using reflection. Primitives are set using the val.As
-// method. Structs use reflection: each exported struct field must have a
-// "tfsdk" tag with the name of the field in the tftypes.Value, and all fields
-// in the tftypes.Value must have a corresponding property in the struct. Into
-// will be called for each struct field. Slices will have Into called for each
-// element.
+// used instead of using reflection. If `target` is a struct implementing
+// encoding.TextUnmarshaler, such as netip.Addr or time.Time, it is populated
+// from a string value using the UnmarshalText method. If `target` is a
+// time.Duration, it is populated from a string value using
+// time.ParseDuration. Primitives are set using the val.As method. Structs use
+// reflection: each exported struct field must have a "tfsdk" tag with the
+// name of the field in the tftypes.Value, and all fields in the tftypes.Value
+// must have a corresponding property in the struct. Into will be called for
+// each struct field. Slices will have Into called for each element.
 func Into(ctx context.Context, typ attr.Type, val tftypes.Value, target interface{}, opts Options) diag.Diagnostics {
+	return IntoAtPath(ctx, typ, val, target, opts, path.Empty())
+}
+
+// IntoAtPath is the same as Into, except diagnostics are relative to `path`,
+// which should be the path of `val` when it is part of a larger value.
+func IntoAtPath(ctx context.Context, typ attr.Type, val tftypes.Value, target interface{}, opts Options, path path.Path) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	v := reflect.ValueOf(target)
@@ -34,7 +43,7 @@ func Into(ctx context.Context, typ attr.Type, val tftypes.Value, target interfac
 		)
 		return diags
 	}
-	result, diags := BuildValue(ctx, typ, val, v.Elem(), opts, path.Empty())
+	result, diags := BuildValue(ctx, typ, val, v.Elem(), opts, path)
 	if diags.HasError() {
 		return diags
 	}
@@ -145,6 +154,16 @@ func BuildValue(ctx context.Context, typ attr.Type, val tftypes.Value, target re
 	if target.Type() == reflect.TypeOf(big.NewFloat(0)) || target.Type() == reflect.TypeOf(big.NewInt(0)) {
 		return Number(ctx, typ, val, target, opts, path)
 	}
+	// structs such as netip.Addr or time.Time are handled as strings when
+	// they can unmarshal themselves from text
+	if val.Type().Is(tftypes.String) && isTextUnmarshalerStruct(target) {
+		return NewTextUnmarshaler(ctx, typ, val, target, opts, path)
+	}
+	// time.Duration is an int64, but is handled as a Go duration string,
+	// such as "1m30s", when the value is a string
+	if val.Type().Is(tftypes.String) && target.Type() == durationType {
+		return NewDuration(ctx, typ, val, target, opts, path)
+	}
 	switch target.Kind() {
 	case reflect.Struct:
 		val, valDiags := Struct(ctx, typ, val, target, opts, path)
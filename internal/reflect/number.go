@@ -168,18 +168,14 @@ func Number(ctx context.Context, typ attr.Type, val tftypes.Value, target reflec
 		floatResult, acc := result.Float32()
 		if acc != big.Exact && !opts.AllowRoundingNumbers {
 			return target, append(diags, roundingErrorDiag)
-		} else if acc == big.Above {
-			floatResult = math.MaxFloat32
-		} else if acc == big.Below {
-			floatResult = math.SmallestNonzeroFloat32
-		} else if acc != big.Exact {
-			err := fmt.Errorf("unsure how to round %s and %f", acc, floatResult)
-			diags.AddAttributeError(
-				path,
-				"Value Conversion Error",
-				"An unexpected error was encountered trying to convert to number. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
-			)
-			return target, diags
+		}
+		// Values too large for float32 are clamped to its largest finite
+		// value and values too small to be represented are clamped to its
+		// smallest nonzero value, keeping the sign of the number.
+		if math.IsInf(float64(floatResult), 0) {
+			floatResult = float32(result.Sign()) * math.MaxFloat32
+		} else if floatResult == 0 && result.Sign() != 0 {
+			floatResult = float32(result.Sign()) * math.SmallestNonzeroFloat32
 		}
 		return reflect.ValueOf(floatResult), diags
 	case reflect.Float64:
@@ -187,42 +183,13 @@ func Number(ctx context.Context, typ attr.Type, val tftypes.Value, target reflec
 		if acc != big.Exact && !opts.AllowRoundingNumbers {
 			return target, append(diags, roundingErrorDiag)
 		}
-		if acc == big.Above {
-			if floatResult == math.Inf(1) || floatResult == math.MaxFloat64 {
-				floatResult = math.MaxFloat64
-			} else if floatResult == 0.0 || floatResult == math.SmallestNonzeroFloat64 {
-				floatResult = -math.SmallestNonzeroFloat64
-			} else {
-				err := fmt.Errorf("not sure how to round %s and %f", acc, floatResult)
-				diags.AddAttributeError(
-					path,
-					"Value Conversion Error",
-					"An unexpected error was encountered trying to convert to number. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
-				)
-				return target, diags
-			}
-		} else if acc == big.Below {
-			if floatResult == math.Inf(-1) || floatResult == -math.MaxFloat64 {
-				floatResult = -math.MaxFloat64
-			} else if floatResult == -0.0 || floatResult == -math.SmallestNonzeroFloat64 { //nolint:staticcheck
-				floatResult = math.SmallestNonzeroFloat64
-			} else {
-				err := fmt.Errorf("not sure how to round %s and %f", acc, floatResult)
-				diags.AddAttributeError(
-					path,
-					"Value Conversion Error",
-					"An unexpected error was encountered trying to convert to number. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
-				)
-				return target, diags
-			}
-		} else if acc != big.Exact {
-			err := fmt.Errorf("not sure how to round %s and %f", acc, floatResult)
-			diags.AddAttributeError(
-				path,
-				"Value Conversion Error",
-				"An unexpected error was encountered trying to convert to number. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
-			)
-			return target, diags
+		// Values too large for float64 are clamped to its largest finite
+		// value and values too small to be represented are clamped to its
+		// smallest nonzero value, keeping the sign of the number.
+		if math.IsInf(floatResult, 0) {
+			floatResult = float64(result.Sign()) * math.MaxFloat64
+		} else if floatResult == 0 && result.Sign() != 0 {
+			floatResult = float64(result.Sign()) * math.SmallestNonzeroFloat64
 		}
 		return reflect.ValueOf(floatResult), diags
 	}
@@ -362,6 +362,17 @@ func TestNumber_int16UnderflowError(t *testing.T) {
 
 func TestNumber_int32(t *testing.T) {
 	t.Parallel()
+
+	var n int32
+
+	result, diags := refl.Number(context.Background(), types.NumberType, tftypes.NewValue(tftypes.Number, 123), reflect.ValueOf(n), refl.Options{}, path.Empty())
+	if diags.HasError() {
+		t.Errorf("Unexpected error: %v", diags)
+	}
+	reflect.ValueOf(&n).Elem().Set(result)
+	if n != 123 {
+		t.Errorf("Expected %v, got %v", 123, n)
+	}
 }
 
 func TestNumber_int32Overflow(t *testing.T) {
@@ -940,6 +951,102 @@ func TestNumber_uint64UnderflowError(t *testing.T) {
 
 func TestNumber_float32(t *testing.T) {
 	t.Parallel()
+
+	var n float32
+
+	result, diags := refl.Number(context.Background(), types.NumberType, tftypes.NewValue(tftypes.Number, 123.5), reflect.ValueOf(n), refl.Options{}, path.Empty())
+	if diags.HasError() {
+		t.Errorf("Unexpected error: %v", diags)
+	}
+	reflect.ValueOf(&n).Elem().Set(result)
+	if n != 123.5 {
+		t.Errorf("Expected %v, got %v", 123.5, n)
+	}
+}
+
+func TestNumber_float32Rounded(t *testing.T) {
+	t.Parallel()
+
+	var n float32
+
+	result, diags := refl.Number(context.Background(), types.NumberType, tftypes.NewValue(tftypes.Number, 1.1), reflect.ValueOf(n), refl.Options{
+		AllowRoundingNumbers: true,
+	}, path.Empty())
+	if diags.HasError() {
+		t.Errorf("Unexpected error: %v", diags)
+	}
+	reflect.ValueOf(&n).Elem().Set(result)
+	if n != float32(1.1) {
+		t.Errorf("Expected %v, got %v", float32(1.1), n)
+	}
+}
+
+func TestNumber_float32RoundingNumbers(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		input    string
+		expected float32
+	}{
+		"subnormal-rounded-up": {
+			input:    "1.050973848e-45",
+			expected: math.SmallestNonzeroFloat32,
+		},
+		"subnormal-rounded-down": {
+			input:    "1.5e-45",
+			expected: math.SmallestNonzeroFloat32,
+		},
+		"subnormal-negative-rounded-down": {
+			input:    "-1.050973848e-45",
+			expected: -math.SmallestNonzeroFloat32,
+		},
+		"subnormal-negative-rounded-up": {
+			input:    "-1.5e-45",
+			expected: -math.SmallestNonzeroFloat32,
+		},
+		"underflow": {
+			input:    "1e-50",
+			expected: math.SmallestNonzeroFloat32,
+		},
+		"underflow-negative": {
+			input:    "-1e-50",
+			expected: -math.SmallestNonzeroFloat32,
+		},
+		"overflow": {
+			input:    "1e50",
+			expected: math.MaxFloat32,
+		},
+		"overflow-negative": {
+			input:    "-1e50",
+			expected: -math.MaxFloat32,
+		},
+	}
+
+	for name, tc := range cases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var n float32
+
+			value, _, err := big.ParseFloat(tc.input, 10, 512, big.ToNearestEven)
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+
+			result, diags := refl.Number(context.Background(), types.NumberType, tftypes.NewValue(tftypes.Number, value), reflect.ValueOf(n), refl.Options{
+				AllowRoundingNumbers: true,
+			}, path.Empty())
+			if diags.HasError() {
+				t.Fatalf("Unexpected error: %v", diags)
+			}
+			reflect.ValueOf(&n).Elem().Set(result)
+			if n != tc.expected {
+				t.Errorf("Expected %v, got %v", tc.expected, n)
+			}
+		})
+	}
 }
 
 func TestNumber_float32Overflow(t *testing.T) {
@@ -978,6 +1085,23 @@ func TestNumber_float32OverflowError(t *testing.T) {
 	}
 }
 
+func TestNumber_float32OverflowNegative(t *testing.T) {
+	t.Parallel()
+
+	var n float32
+
+	result, diags := refl.Number(context.Background(), types.NumberType, tftypes.NewValue(tftypes.Number, -math.MaxFloat64), reflect.ValueOf(n), refl.Options{
+		AllowRoundingNumbers: true,
+	}, path.Empty())
+	if diags.HasError() {
+		t.Errorf("Unexpected error: %v", diags)
+	}
+	reflect.ValueOf(&n).Elem().Set(result)
+	if n != -math.MaxFloat32 {
+		t.Errorf("Expected %v, got %v", -math.MaxFloat32, n)
+	}
+}
+
 func TestNumber_float32Underflow(t *testing.T) {
 	t.Parallel()
 
@@ -1014,6 +1138,23 @@ func TestNumber_float32UnderflowError(t *testing.T) {
 	}
 }
 
+func TestNumber_float32UnderflowNegative(t *testing.T) {
+	t.Parallel()
+
+	var n float32
+
+	result, diags := refl.Number(context.Background(), types.NumberType, tftypes.NewValue(tftypes.Number, -math.SmallestNonzeroFloat64), reflect.ValueOf(n), refl.Options{
+		AllowRoundingNumbers: true,
+	}, path.Empty())
+	if diags.HasError() {
+		t.Errorf("Unexpected error: %v", diags)
+	}
+	reflect.ValueOf(&n).Elem().Set(result)
+	if n != -math.SmallestNonzeroFloat32 {
+		t.Errorf("Expected %v, got %v", -math.SmallestNonzeroFloat32, n)
+	}
+}
+
 func TestNumber_float64(t *testing.T) {
 	t.Parallel()
 
@@ -1029,6 +1170,78 @@ func TestNumber_float64(t *testing.T) {
 	}
 }
 
+func TestNumber_float64Rounding(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		input    string
+		expected float64
+	}{
+		"inexact": {
+			input:    "0.1",
+			expected: 0.1,
+		},
+		"subnormal-rounded-up": {
+			input:    "4e-324",
+			expected: math.SmallestNonzeroFloat64,
+		},
+		"subnormal-rounded-down": {
+			input:    "6e-324",
+			expected: math.SmallestNonzeroFloat64,
+		},
+		"subnormal-negative-rounded-down": {
+			input:    "-4e-324",
+			expected: -math.SmallestNonzeroFloat64,
+		},
+		"subnormal-negative-rounded-up": {
+			input:    "-6e-324",
+			expected: -math.SmallestNonzeroFloat64,
+		},
+		"underflow": {
+			input:    "1e-330",
+			expected: math.SmallestNonzeroFloat64,
+		},
+		"underflow-negative": {
+			input:    "-1e-330",
+			expected: -math.SmallestNonzeroFloat64,
+		},
+		"overflow": {
+			input:    "1e400",
+			expected: math.MaxFloat64,
+		},
+		"overflow-negative": {
+			input:    "-1e400",
+			expected: -math.MaxFloat64,
+		},
+	}
+
+	for name, tc := range cases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var n float64
+
+			value, _, err := big.ParseFloat(tc.input, 10, 512, big.ToNearestEven)
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+
+			result, diags := refl.Number(context.Background(), types.NumberType, tftypes.NewValue(tftypes.Number, value), reflect.ValueOf(n), refl.Options{
+				AllowRoundingNumbers: true,
+			}, path.Empty())
+			if diags.HasError() {
+				t.Fatalf("Unexpected error: %v", diags)
+			}
+			reflect.ValueOf(&n).Elem().Set(result)
+			if n != tc.expected {
+				t.Errorf("Expected %v, got %v", tc.expected, n)
+			}
+		})
+	}
+}
+
 func TestNumber_float64Overflow(t *testing.T) {
 	t.Parallel()
 
@@ -1183,26 +1396,47 @@ func TestFromInt(t *testing.T) {
 		expectedDiags diag.Diagnostics
 	}{
 		"0": {
-			val: 0,
-			typ: types.NumberType,
-			expected: types.Number{
-				Value: big.NewFloat(0),
-			},
+			val:      0,
+			typ:      types.NumberType,
+			expected: types.NumberValue(big.NewFloat(0)),
 		},
 		"1": {
-			val: 1,
-			typ: types.NumberType,
-			expected: types.Number{
-				Value: big.NewFloat(1),
+			val:      1,
+			typ:      types.NumberType,
+			expected: types.NumberValue(big.NewFloat(1)),
+		},
+		"Int32Type": {
+			val:      math.MaxInt32,
+			typ:      types.Int32Type,
+			expected: types.Int32Value(math.MaxInt32),
+		},
+		"Int32Type-overflow": {
+			val: math.MaxInt32 + 1,
+			typ: types.Int32Type,
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Empty(),
+					"Int32 Type Validation Error",
+					"Value 2147483648 cannot be represented as a 32-bit integer.",
+				),
+			},
+		},
+		"Uint64Type-negative": {
+			val: -1,
+			typ: types.Uint64Type,
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Empty(),
+					"Uint64 Type Validation Error",
+					"Value -1 cannot be represented as an unsigned 64-bit integer.",
+				),
 			},
 		},
 		"WithValidateWarning": {
 			val: 1,
 			typ: testtypes.NumberTypeWithValidateWarning{},
 			expected: testtypes.Number{
-				Number: types.Number{
-					Value: big.NewFloat(1),
-				},
+				Number:    types.NumberValue(big.NewFloat(1)),
 				CreatedBy: testtypes.NumberType{},
 			},
 			expectedDiags: diag.Diagnostics{
@@ -1245,26 +1479,36 @@ func TestFromUint(t *testing.T) {
 		expectedDiags diag.Diagnostics
 	}{
 		"0": {
-			val: 0,
-			typ: types.NumberType,
-			expected: types.Number{
-				Value: big.NewFloat(0),
-			},
+			val:      0,
+			typ:      types.NumberType,
+			expected: types.NumberValue(big.NewFloat(0)),
 		},
 		"1": {
-			val: 1,
-			typ: types.NumberType,
-			expected: types.Number{
-				Value: big.NewFloat(1),
+			val:      1,
+			typ:      types.NumberType,
+			expected: types.NumberValue(big.NewFloat(1)),
+		},
+		"Uint64Type": {
+			val:      math.MaxUint64,
+			typ:      types.Uint64Type,
+			expected: types.Uint64Value(math.MaxUint64),
+		},
+		"Int64Type-overflow": {
+			val: math.MaxUint64,
+			typ: types.Int64Type,
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Empty(),
+					"Int64 Type Validation Error",
+					"Value 18446744073709551615 cannot be represented as a 64-bit integer.",
+				),
 			},
 		},
 		"WithValidateWarning": {
 			val: 1,
 			typ: testtypes.NumberTypeWithValidateWarning{},
 			expected: testtypes.Number{
-				Number: types.Number{
-					Value: big.NewFloat(1),
-				},
+				Number:    types.NumberValue(big.NewFloat(1)),
 				CreatedBy: testtypes.NumberType{},
 			},
 			expectedDiags: diag.Diagnostics{
@@ -1307,33 +1551,41 @@ func TestFromFloat(t *testing.T) {
 		expectedDiags diag.Diagnostics
 	}{
 		"0": {
-			val: 0,
-			typ: types.NumberType,
-			expected: types.Number{
-				Value: big.NewFloat(0),
-			},
+			val:      0,
+			typ:      types.NumberType,
+			expected: types.NumberValue(big.NewFloat(0)),
 		},
 		"1": {
-			val: 1,
-			typ: types.NumberType,
-			expected: types.Number{
-				Value: big.NewFloat(1),
-			},
+			val:      1,
+			typ:      types.NumberType,
+			expected: types.NumberValue(big.NewFloat(1)),
 		},
 		"1.234": {
-			val: 1.234,
-			typ: types.NumberType,
-			expected: types.Number{
-				Value: big.NewFloat(1.234),
+			val:      1.234,
+			typ:      types.NumberType,
+			expected: types.NumberValue(big.NewFloat(1.234)),
+		},
+		"Float32Type": {
+			val:      1.5,
+			typ:      types.Float32Type,
+			expected: types.Float32Value(1.5),
+		},
+		"Float32Type-overflow": {
+			val: math.MaxFloat64,
+			typ: types.Float32Type,
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Empty(),
+					"Float32 Type Validation Error",
+					"Value 1.7976931348623157e+308 cannot be represented as a 32-bit floating point.",
+				),
 			},
 		},
 		"WithValidateWarning": {
 			val: 1,
 			typ: testtypes.NumberTypeWithValidateWarning{},
 			expected: testtypes.Number{
-				Number: types.Number{
-					Value: big.NewFloat(1),
-				},
+				Number:    types.NumberValue(big.NewFloat(1)),
 				CreatedBy: testtypes.NumberType{},
 			},
 			expectedDiags: diag.Diagnostics{
@@ -1376,33 +1628,25 @@ func TestFromBigFloat(t *testing.T) {
 		expectedDiags diag.Diagnostics
 	}{
 		"0": {
-			val: big.NewFloat(0),
-			typ: types.NumberType,
-			expected: types.Number{
-				Value: big.NewFloat(0),
-			},
+			val:      big.NewFloat(0),
+			typ:      types.NumberType,
+			expected: types.NumberValue(big.NewFloat(0)),
 		},
 		"1": {
-			val: big.NewFloat(1),
-			typ: types.NumberType,
-			expected: types.Number{
-				Value: big.NewFloat(1),
-			},
+			val:      big.NewFloat(1),
+			typ:      types.NumberType,
+			expected: types.NumberValue(big.NewFloat(1)),
 		},
 		"1.234": {
-			val: big.NewFloat(1.234),
-			typ: types.NumberType,
-			expected: types.Number{
-				Value: big.NewFloat(1.234),
-			},
+			val:      big.NewFloat(1.234),
+			typ:      types.NumberType,
+			expected: types.NumberValue(big.NewFloat(1.234)),
 		},
 		"WithValidateWarning": {
 			val: big.NewFloat(1),
 			typ: testtypes.NumberTypeWithValidateWarning{},
 			expected: testtypes.Number{
-				Number: types.Number{
-					Value: big.NewFloat(1),
-				},
+				Number:    types.NumberValue(big.NewFloat(1)),
 				CreatedBy: testtypes.NumberType{},
 			},
 			expectedDiags: diag.Diagnostics{
@@ -1445,26 +1689,20 @@ func TestFromBigInt(t *testing.T) {
 		expectedDiags diag.Diagnostics
 	}{
 		"0": {
-			val: big.NewInt(0),
-			typ: types.NumberType,
-			expected: types.Number{
-				Value: big.NewFloat(0),
-			},
+			val:      big.NewInt(0),
+			typ:      types.NumberType,
+			expected: types.NumberValue(big.NewFloat(0)),
 		},
 		"1": {
-			val: big.NewInt(1),
-			typ: types.NumberType,
-			expected: types.Number{
-				Value: big.NewFloat(1),
-			},
+			val:      big.NewInt(1),
+			typ:      types.NumberType,
+			expected: types.NumberValue(big.NewFloat(1)),
 		},
 		"WithValidateWarning": {
 			val: big.NewInt(1),
 			typ: testtypes.NumberTypeWithValidateWarning{},
 			expected: testtypes.Number{
-				Number: types.Number{
-					Value: big.NewFloat(1),
-				},
+				Number:    types.NumberValue(big.NewFloat(1)),
 				CreatedBy: testtypes.NumberTypeWithValidateWarning{},
 			},
 			expectedDiags: diag.Diagnostics{
@@ -15,6 +15,7 @@ type Options struct {
 
 	// AllowRoundingNumbers silently rounds numbers that don't fit
 	// perfectly in the types they're being stored in, rather than
-	// returning errors. Numbers will always be rounded towards 0.
+	// returning errors. Integers are rounded towards 0, floats are rounded
+	// to the nearest value, and numbers out of range are clamped.
 	AllowRoundingNumbers bool
 }
@@ -2,9 +2,11 @@ package reflect
 
 import (
 	"context"
+	"encoding"
 	"fmt"
 	"math/big"
 	"reflect"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -37,8 +39,18 @@ func FromValue(ctx context.Context, typ attr.Type, val interface{}, path path.Pa
 	if bi, ok := val.(*big.Int); ok {
 		return FromBigInt(ctx, typ, bi, path)
 	}
+	// time.Duration is handled as a Go duration string, such as "1m30s",
+	// when the type is a string
+	if d, ok := val.(time.Duration); ok && typ.TerraformType(ctx).Is(tftypes.String) {
+		return FromDuration(ctx, typ, d, path)
+	}
 	value := reflect.ValueOf(val)
 	kind := value.Kind()
+	// structs such as netip.Addr or time.Time are handled as strings when
+	// they can marshal themselves to text
+	if v, ok := val.(encoding.TextMarshaler); ok && kind == reflect.Struct && typ.TerraformType(ctx).Is(tftypes.String) {
+		return FromTextMarshaler(ctx, typ, v, path)
+	}
 	switch kind {
 	case reflect.Struct:
 		t, ok := typ.(attr.TypeWithAttributeTypes)
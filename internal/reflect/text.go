@@ -0,0 +1,78 @@
+package reflect
+
+import (
+	"context"
+	"encoding"
+	"fmt"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+
+// isTextUnmarshalerStruct returns true if `target` is a struct which can be
+// populated from a string by its UnmarshalText method, such as netip.Addr or
+// time.Time.
+func isTextUnmarshalerStruct(target reflect.Value) bool {
+	return target.Kind() == reflect.Struct && reflect.PtrTo(target.Type()).Implements(textUnmarshalerType)
+}
+
+// NewTextUnmarshaler creates a zero value of `target` and populates it by
+// calling its UnmarshalText method with the string in `val`.
+//
+// It is meant to be called through Into, not directly.
+func NewTextUnmarshaler(ctx context.Context, typ attr.Type, val tftypes.Value, target reflect.Value, opts Options, path path.Path) (reflect.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var s string
+
+	err := val.As(&s)
+	if err != nil {
+		diags.Append(diag.WithPath(path, DiagIntoIncompatibleType{
+			Val:        val,
+			TargetType: target.Type(),
+			Err:        err,
+		}))
+		return target, diags
+	}
+
+	receiver := reflect.New(target.Type())
+
+	//nolint:forcetypeassert // Type checked by isTextUnmarshalerStruct
+	err = receiver.Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(s))
+	if err != nil {
+		err = fmt.Errorf("cannot unmarshal %q into %s: %w", s, target.Type(), err)
+		diags.AddAttributeError(
+			path,
+			"Value Conversion Error",
+			"An unexpected error was encountered trying to convert into a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+		)
+		return target, diags
+	}
+
+	return receiver.Elem(), diags
+}
+
+// FromTextMarshaler creates an attr.Value using `typ` from the string
+// returned by the MarshalText method of `val`.
+//
+// It is meant to be called through FromValue, not directly.
+func FromTextMarshaler(ctx context.Context, typ attr.Type, val encoding.TextMarshaler, path path.Path) (attr.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	text, err := val.MarshalText()
+	if err != nil {
+		err = fmt.Errorf("cannot marshal %T to text: %w", val, err)
+		diags.AddAttributeError(
+			path,
+			"Value Conversion Error",
+			"An unexpected error was encountered trying to convert from value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+		)
+		return nil, diags
+	}
+
+	return FromString(ctx, typ, string(text), path)
+}
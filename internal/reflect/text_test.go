@@ -0,0 +1,82 @@
+package reflect_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	refl "github.com/hashicorp/terraform-plugin-framework/internal/reflect"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestNewTextUnmarshaler(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		val           tftypes.Value
+		expected      time.Time
+		expectedDiags diag.Diagnostics
+	}{
+		"valid": {
+			val:      tftypes.NewValue(tftypes.String, "2006-01-02T15:04:05Z"),
+			expected: time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC),
+		},
+		"invalid": {
+			val: tftypes.NewValue(tftypes.String, "not-a-time"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Empty(),
+					"Value Conversion Error",
+					"An unexpected error was encountered trying to convert into a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						`cannot unmarshal "not-a-time" into time.Time: parsing time "not-a-time" as "2006-01-02T15:04:05Z07:00": cannot parse "not-a-time" as "2006"`,
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var target time.Time
+
+			got, diags := refl.BuildValue(context.Background(), types.StringType, testCase.val, reflect.ValueOf(target), refl.Options{}, path.Empty())
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Fatalf("unexpected diagnostics (+wanted, -got): %s", diff)
+			}
+
+			if diags.HasError() {
+				return
+			}
+
+			if diff := cmp.Diff(got.Interface(), testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestFromTextMarshaler(t *testing.T) {
+	t.Parallel()
+
+	var expected attr.Value = types.StringValue("2006-01-02T15:04:05Z")
+
+	got, diags := refl.FromValue(context.Background(), types.StringType, time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC), path.Empty())
+
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
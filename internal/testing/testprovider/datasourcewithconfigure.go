@@ -0,0 +1,27 @@
+package testprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+var _ tfsdk.DataSource = &DataSourceWithConfigure{}
+var _ tfsdk.DataSourceWithConfigure = &DataSourceWithConfigure{}
+
+// Declarative tfsdk.DataSourceWithConfigure for unit testing.
+type DataSourceWithConfigure struct {
+	*DataSource
+
+	// DataSourceWithConfigure interface methods
+	ConfigureMethod func(context.Context, tfsdk.ConfigureDataSourceRequest, *tfsdk.ConfigureDataSourceResponse)
+}
+
+// Configure satisfies the tfsdk.DataSourceWithConfigure interface.
+func (d *DataSourceWithConfigure) Configure(ctx context.Context, req tfsdk.ConfigureDataSourceRequest, resp *tfsdk.ConfigureDataSourceResponse) {
+	if d.ConfigureMethod == nil {
+		return
+	}
+
+	d.ConfigureMethod(ctx, req, resp)
+}
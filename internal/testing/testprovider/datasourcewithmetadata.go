@@ -0,0 +1,38 @@
+package testprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+var _ tfsdk.DataSource = &DataSourceWithMetadata{}
+var _ tfsdk.DataSourceWithMetadata = &DataSourceWithMetadata{}
+
+// Declarative tfsdk.DataSourceWithMetadata for unit testing.
+type DataSourceWithMetadata struct {
+	*DataSource
+
+	// DataSourceWithMetadata interface methods
+	GetSchemaMethod func(context.Context) (tfsdk.Schema, diag.Diagnostics)
+	MetadataMethod  func(context.Context, tfsdk.DataSourceMetadataRequest, *tfsdk.DataSourceMetadataResponse)
+}
+
+// GetSchema satisfies the tfsdk.DataSourceWithMetadata interface.
+func (d *DataSourceWithMetadata) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	if d.GetSchemaMethod == nil {
+		return tfsdk.Schema{}, nil
+	}
+
+	return d.GetSchemaMethod(ctx)
+}
+
+// Metadata satisfies the tfsdk.DataSourceWithMetadata interface.
+func (d *DataSourceWithMetadata) Metadata(ctx context.Context, req tfsdk.DataSourceMetadataRequest, resp *tfsdk.DataSourceMetadataResponse) {
+	if d.MetadataMethod == nil {
+		return
+	}
+
+	d.MetadataMethod(ctx, req, resp)
+}
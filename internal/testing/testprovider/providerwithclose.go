@@ -0,0 +1,27 @@
+package testprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+var _ tfsdk.Provider = &ProviderWithClose{}
+var _ tfsdk.ProviderWithClose = &ProviderWithClose{}
+
+// Declarative tfsdk.ProviderWithClose for unit testing.
+type ProviderWithClose struct {
+	*Provider
+
+	// ProviderWithClose interface methods
+	CloseMethod func(context.Context, tfsdk.CloseProviderRequest, *tfsdk.CloseProviderResponse)
+}
+
+// Close satisfies the tfsdk.ProviderWithClose interface.
+func (p *ProviderWithClose) Close(ctx context.Context, req tfsdk.CloseProviderRequest, resp *tfsdk.CloseProviderResponse) {
+	if p.CloseMethod == nil {
+		return
+	}
+
+	p.CloseMethod(ctx, req, resp)
+}
@@ -0,0 +1,28 @@
+package testprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+var _ tfsdk.Provider = &ProviderWithDataSources{}
+var _ tfsdk.ProviderWithMetadata = &ProviderWithDataSources{}
+var _ tfsdk.ProviderWithDataSources = &ProviderWithDataSources{}
+
+// Declarative tfsdk.ProviderWithDataSources for unit testing.
+type ProviderWithDataSources struct {
+	*ProviderWithMetadata
+
+	// ProviderWithDataSources interface methods
+	DataSourcesMethod func(context.Context) []func() tfsdk.DataSource
+}
+
+// DataSources satisfies the tfsdk.ProviderWithDataSources interface.
+func (p *ProviderWithDataSources) DataSources(ctx context.Context) []func() tfsdk.DataSource {
+	if p.DataSourcesMethod == nil {
+		return nil
+	}
+
+	return p.DataSourcesMethod(ctx)
+}
@@ -0,0 +1,27 @@
+package testprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+var _ tfsdk.Provider = &ProviderWithMetadata{}
+var _ tfsdk.ProviderWithMetadata = &ProviderWithMetadata{}
+
+// Declarative tfsdk.ProviderWithMetadata for unit testing.
+type ProviderWithMetadata struct {
+	*Provider
+
+	// ProviderWithMetadata interface methods
+	MetadataMethod func(context.Context, tfsdk.ProviderMetadataRequest, *tfsdk.ProviderMetadataResponse)
+}
+
+// Metadata satisfies the tfsdk.ProviderWithMetadata interface.
+func (p *ProviderWithMetadata) Metadata(ctx context.Context, req tfsdk.ProviderMetadataRequest, resp *tfsdk.ProviderMetadataResponse) {
+	if p.MetadataMethod == nil {
+		return
+	}
+
+	p.MetadataMethod(ctx, req, resp)
+}
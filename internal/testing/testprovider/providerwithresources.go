@@ -0,0 +1,28 @@
+package testprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+var _ tfsdk.Provider = &ProviderWithResources{}
+var _ tfsdk.ProviderWithMetadata = &ProviderWithResources{}
+var _ tfsdk.ProviderWithResources = &ProviderWithResources{}
+
+// Declarative tfsdk.ProviderWithResources for unit testing.
+type ProviderWithResources struct {
+	*ProviderWithMetadata
+
+	// ProviderWithResources interface methods
+	ResourcesMethod func(context.Context) []func() tfsdk.Resource
+}
+
+// Resources satisfies the tfsdk.ProviderWithResources interface.
+func (p *ProviderWithResources) Resources(ctx context.Context) []func() tfsdk.Resource {
+	if p.ResourcesMethod == nil {
+		return nil
+	}
+
+	return p.ResourcesMethod(ctx)
+}
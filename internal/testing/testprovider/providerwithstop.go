@@ -0,0 +1,27 @@
+package testprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+var _ tfsdk.Provider = &ProviderWithStop{}
+var _ tfsdk.ProviderWithStop = &ProviderWithStop{}
+
+// Declarative tfsdk.ProviderWithStop for unit testing.
+type ProviderWithStop struct {
+	*Provider
+
+	// ProviderWithStop interface methods
+	StopMethod func(context.Context, tfsdk.StopProviderRequest, *tfsdk.StopProviderResponse)
+}
+
+// Stop satisfies the tfsdk.ProviderWithStop interface.
+func (p *ProviderWithStop) Stop(ctx context.Context, req tfsdk.StopProviderRequest, resp *tfsdk.StopProviderResponse) {
+	if p.StopMethod == nil {
+		return
+	}
+
+	p.StopMethod(ctx, req, resp)
+}
@@ -0,0 +1,27 @@
+package testprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+var _ tfsdk.Resource = &ResourceWithConfigure{}
+var _ tfsdk.ResourceWithConfigure = &ResourceWithConfigure{}
+
+// Declarative tfsdk.ResourceWithConfigure for unit testing.
+type ResourceWithConfigure struct {
+	*Resource
+
+	// ResourceWithConfigure interface methods
+	ConfigureMethod func(context.Context, tfsdk.ConfigureResourceRequest, *tfsdk.ConfigureResourceResponse)
+}
+
+// Configure satisfies the tfsdk.ResourceWithConfigure interface.
+func (r *ResourceWithConfigure) Configure(ctx context.Context, req tfsdk.ConfigureResourceRequest, resp *tfsdk.ConfigureResourceResponse) {
+	if r.ConfigureMethod == nil {
+		return
+	}
+
+	r.ConfigureMethod(ctx, req, resp)
+}
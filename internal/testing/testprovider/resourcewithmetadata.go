@@ -0,0 +1,38 @@
+package testprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+var _ tfsdk.Resource = &ResourceWithMetadata{}
+var _ tfsdk.ResourceWithMetadata = &ResourceWithMetadata{}
+
+// Declarative tfsdk.ResourceWithMetadata for unit testing.
+type ResourceWithMetadata struct {
+	*Resource
+
+	// ResourceWithMetadata interface methods
+	GetSchemaMethod func(context.Context) (tfsdk.Schema, diag.Diagnostics)
+	MetadataMethod  func(context.Context, tfsdk.ResourceMetadataRequest, *tfsdk.ResourceMetadataResponse)
+}
+
+// GetSchema satisfies the tfsdk.ResourceWithMetadata interface.
+func (r *ResourceWithMetadata) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	if r.GetSchemaMethod == nil {
+		return tfsdk.Schema{}, nil
+	}
+
+	return r.GetSchemaMethod(ctx)
+}
+
+// Metadata satisfies the tfsdk.ResourceWithMetadata interface.
+func (r *ResourceWithMetadata) Metadata(ctx context.Context, req tfsdk.ResourceMetadataRequest, resp *tfsdk.ResourceMetadataResponse) {
+	if r.MetadataMethod == nil {
+		return
+	}
+
+	r.MetadataMethod(ctx, req, resp)
+}
@@ -0,0 +1,27 @@
+package testprovider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+var _ tfsdk.Resource = &ResourceWithUpgradeStateChain{}
+var _ tfsdk.ResourceWithUpgradeStateChain = &ResourceWithUpgradeStateChain{}
+
+// Declarative tfsdk.ResourceWithUpgradeStateChain for unit testing.
+type ResourceWithUpgradeStateChain struct {
+	*Resource
+
+	// ResourceWithUpgradeStateChain interface methods
+	UpgradeStateChainMethod func(context.Context) map[int64]tfsdk.ResourceStateUpgrader
+}
+
+// UpgradeStateChain satisfies the tfsdk.ResourceWithUpgradeStateChain interface.
+func (p *ResourceWithUpgradeStateChain) UpgradeStateChain(ctx context.Context) map[int64]tfsdk.ResourceStateUpgrader {
+	if p.UpgradeStateChainMethod == nil {
+		return nil
+	}
+
+	return p.UpgradeStateChainMethod(ctx)
+}
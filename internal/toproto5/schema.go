@@ -86,3 +86,33 @@ func Schema(ctx context.Context, s *tfsdk.Schema) (*tfprotov5.Schema, error) {
 
 	return result, nil
 }
+
+// ProviderSchema returns the *tfprotov5.Schema equivalent of a provider
+// Schema. Required top level attributes with environment variables are
+// optional in configuration, as the framework verifies the value is present
+// after reading the environment variables. The environment variable names
+// are added to the attribute description.
+func ProviderSchema(ctx context.Context, s *tfsdk.Schema) (*tfprotov5.Schema, error) {
+	result, err := Schema(ctx, s)
+
+	if err != nil || result == nil {
+		return result, err
+	}
+
+	for _, schemaAttribute := range result.Block.Attributes {
+		a, ok := s.Attributes[schemaAttribute.Name]
+
+		if !ok || len(a.EnvVars) == 0 {
+			continue
+		}
+
+		if a.Required {
+			schemaAttribute.Required = false
+			schemaAttribute.Optional = true
+		}
+
+		schemaAttribute.Description = envVarsDescription(schemaAttribute.Description, a.EnvVars, schemaAttribute.DescriptionKind == tfprotov5.StringKindMarkdown)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,34 @@
+package toproto5
+
+import (
+	"fmt"
+	"strings"
+)
+
+// envVarsDescription returns the attribute description with the environment
+// variable names appended. Names are wrapped in code spans for Markdown.
+func envVarsDescription(description string, envVars []string, markdown bool) string {
+	names := make([]string, len(envVars))
+
+	for i, envVar := range envVars {
+		if markdown {
+			envVar = "`" + envVar + "`"
+		}
+
+		names[i] = envVar
+	}
+
+	var envVarsSentence string
+
+	if len(names) == 1 {
+		envVarsSentence = fmt.Sprintf("Can also be set with the %s environment variable.", names[0])
+	} else {
+		envVarsSentence = fmt.Sprintf("Can also be set with the %s environment variables, in order of precedence.", strings.Join(names, ", "))
+	}
+
+	if description == "" {
+		return envVarsSentence
+	}
+
+	return description + " " + envVarsSentence
+}
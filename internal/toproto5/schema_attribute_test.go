@@ -40,6 +40,23 @@ func TestSchemaAttribute(t *testing.T) {
 				Deprecated: true,
 			},
 		},
+		"envvars": {
+			name: "string",
+			attr: tfsdk.Attribute{
+				Type:        types.StringType,
+				Required:    true,
+				Description: "A string attribute.",
+				EnvVars:     []string{"EXAMPLE_STRING"},
+			},
+			path: tftypes.NewAttributePath(),
+			expected: &tfprotov5.SchemaAttribute{
+				Name:            "string",
+				Type:            tftypes.String,
+				Required:        true,
+				Description:     "A string attribute.",
+				DescriptionKind: tfprotov5.StringKindPlain,
+			},
+		},
 		"description-plain": {
 			name: "string",
 			attr: tfsdk.Attribute{
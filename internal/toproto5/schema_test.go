@@ -479,3 +479,146 @@ func TestSchema(t *testing.T) {
 		})
 	}
 }
+
+func TestProviderSchema(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		input    *tfsdk.Schema
+		expected *tfprotov5.Schema
+	}
+
+	tests := map[string]testCase{
+		"nil": {
+			input:    nil,
+			expected: nil,
+		},
+		"envvars-required": {
+			input: &tfsdk.Schema{
+				Attributes: map[string]tfsdk.Attribute{
+					"string": {
+						Type:     types.StringType,
+						Required: true,
+						EnvVars:  []string{"EXAMPLE_STRING"},
+					},
+				},
+			},
+			expected: &tfprotov5.Schema{
+				Block: &tfprotov5.SchemaBlock{
+					Attributes: []*tfprotov5.SchemaAttribute{
+						{
+							Name:            "string",
+							Type:            tftypes.String,
+							Optional:        true,
+							Description:     "Can also be set with the EXAMPLE_STRING environment variable.",
+							DescriptionKind: tfprotov5.StringKindPlain,
+						},
+					},
+				},
+			},
+		},
+		"envvars-description-plain": {
+			input: &tfsdk.Schema{
+				Attributes: map[string]tfsdk.Attribute{
+					"string": {
+						Type:        types.StringType,
+						Optional:    true,
+						Description: "A string attribute.",
+						EnvVars:     []string{"EXAMPLE_STRING", "EXAMPLE_STRING_LEGACY"},
+					},
+				},
+			},
+			expected: &tfprotov5.Schema{
+				Block: &tfprotov5.SchemaBlock{
+					Attributes: []*tfprotov5.SchemaAttribute{
+						{
+							Name:            "string",
+							Type:            tftypes.String,
+							Optional:        true,
+							Description:     "A string attribute. Can also be set with the EXAMPLE_STRING, EXAMPLE_STRING_LEGACY environment variables, in order of precedence.",
+							DescriptionKind: tfprotov5.StringKindPlain,
+						},
+					},
+				},
+			},
+		},
+		"envvars-description-markdown": {
+			input: &tfsdk.Schema{
+				Attributes: map[string]tfsdk.Attribute{
+					"string": {
+						Type:                types.StringType,
+						Optional:            true,
+						MarkdownDescription: "A string attribute.",
+						EnvVars:             []string{"EXAMPLE_STRING"},
+					},
+				},
+			},
+			expected: &tfprotov5.Schema{
+				Block: &tfprotov5.SchemaBlock{
+					Attributes: []*tfprotov5.SchemaAttribute{
+						{
+							Name:            "string",
+							Type:            tftypes.String,
+							Optional:        true,
+							Description:     "A string attribute. Can also be set with the `EXAMPLE_STRING` environment variable.",
+							DescriptionKind: tfprotov5.StringKindMarkdown,
+						},
+					},
+				},
+			},
+		},
+		"envvars-nested-block": {
+			input: &tfsdk.Schema{
+				Blocks: map[string]tfsdk.Block{
+					"block": {
+						Attributes: map[string]tfsdk.Attribute{
+							"string": {
+								Type:     types.StringType,
+								Required: true,
+								EnvVars:  []string{"EXAMPLE_STRING"},
+							},
+						},
+						NestingMode: tfsdk.BlockNestingModeList,
+					},
+				},
+			},
+			expected: &tfprotov5.Schema{
+				Block: &tfprotov5.SchemaBlock{
+					BlockTypes: []*tfprotov5.SchemaNestedBlock{
+						{
+							Block: &tfprotov5.SchemaBlock{
+								Attributes: []*tfprotov5.SchemaAttribute{
+									{
+										Name:     "string",
+										Type:     tftypes.String,
+										Required: true,
+									},
+								},
+							},
+							Nesting:  tfprotov5.SchemaNestedBlockNestingModeList,
+							TypeName: "block",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := toproto5.ProviderSchema(context.Background(), tc.input)
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, tc.expected); diff != "" {
+				t.Errorf("Unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package toproto5
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+)
+
+// StopProviderResponse returns the *tfprotov5.StopProviderResponse
+// equivalent of a *tfsdk.StopProviderResponse. The protocol only supports a
+// single error message, so error diagnostics are combined into it and
+// warning diagnostics are logged.
+func StopProviderResponse(ctx context.Context, fw *tfsdk.StopProviderResponse) *tfprotov5.StopProviderResponse {
+	if fw == nil {
+		return nil
+	}
+
+	proto5 := &tfprotov5.StopProviderResponse{}
+
+	var errs []string
+
+	for _, diagnostic := range fw.Diagnostics {
+		switch diagnostic.Severity() {
+		case diag.SeverityError:
+			errs = append(errs, diagnostic.Summary()+": "+diagnostic.Detail())
+		case diag.SeverityWarning:
+			logging.FrameworkWarn(ctx, "Provider Stop warning: "+diagnostic.Summary()+": "+diagnostic.Detail())
+		}
+	}
+
+	proto5.Error = strings.Join(errs, "\n\n")
+
+	return proto5
+}
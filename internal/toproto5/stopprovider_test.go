@@ -0,0 +1,56 @@
+package toproto5_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/toproto5"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+)
+
+func TestStopProviderResponse(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    *tfsdk.StopProviderResponse
+		expected *tfprotov5.StopProviderResponse
+	}{
+		"nil": {
+			input:    nil,
+			expected: nil,
+		},
+		"empty": {
+			input:    &tfsdk.StopProviderResponse{},
+			expected: &tfprotov5.StopProviderResponse{},
+		},
+		"diagnostics": {
+			input: &tfsdk.StopProviderResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewErrorDiagnostic("test error summary 1", "test error detail 1"),
+					diag.NewWarningDiagnostic("test warning summary", "test warning detail"),
+					diag.NewErrorDiagnostic("test error summary 2", "test error detail 2"),
+				},
+			},
+			expected: &tfprotov5.StopProviderResponse{
+				Error: "test error summary 1: test error detail 1\n\ntest error summary 2: test error detail 2",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := toproto5.StopProviderResponse(context.Background(), testCase.input)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -22,7 +22,7 @@ func GetProviderSchemaResponse(ctx context.Context, fw *fwserver.GetProviderSche
 
 	var err error
 
-	protov6.Provider, err = Schema(ctx, fw.Provider)
+	protov6.Provider, err = ProviderSchema(ctx, fw.Provider)
 
 	if err != nil {
 		protov6.Diagnostics = append(protov6.Diagnostics, &tfprotov6.Diagnostic{
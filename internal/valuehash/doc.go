@@ -0,0 +1,5 @@
+// Package valuehash contains functionality for hashing tftypes.Value, which
+// is not a comparable Go type, so values can be grouped with Go maps instead
+// of comparing every pair of values, such as for set element equality and
+// duplicate detection.
+package valuehash
@@ -0,0 +1,163 @@
+package valuehash
+
+import (
+	"encoding/binary"
+	"hash"
+	"hash/fnv"
+	"math/big"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Hash returns a hash of the value. Values which are equal according to the
+// tftypes.Value Equal method always have the same hash, however different
+// values may also have the same hash, so values with the same hash must still
+// be compared with Equal.
+//
+// The hash does not include the value type, since it is intended for values
+// of the same type, such as set elements.
+func Hash(value tftypes.Value) uint64 {
+	h := fnv.New64a()
+
+	write(h, value)
+
+	return h.Sum64()
+}
+
+// write writes a canonical encoding of the value. Strings and keys are
+// length prefixed and collections include their length, so the encoding of
+// different values cannot be ambiguous.
+func write(h hash.Hash64, value tftypes.Value) {
+	if value.Type() == nil {
+		_, _ = h.Write([]byte{'x'})
+		return
+	}
+
+	if !value.IsKnown() {
+		_, _ = h.Write([]byte{'u'})
+		return
+	}
+
+	if value.IsNull() {
+		_, _ = h.Write([]byte{'n'})
+		return
+	}
+
+	switch {
+	case value.Type().Is(tftypes.Bool):
+		var b bool
+
+		if err := value.As(&b); err != nil {
+			writeString(h, 'e', value.String())
+			return
+		}
+
+		if b {
+			_, _ = h.Write([]byte{'b', 1})
+		} else {
+			_, _ = h.Write([]byte{'b', 0})
+		}
+	case value.Type().Is(tftypes.Number):
+		n := new(big.Float)
+
+		if err := value.As(&n); err != nil {
+			writeString(h, 'e', value.String())
+			return
+		}
+
+		// The 'p' format is exact and independent of the precision, which
+		// matches the Cmp comparison of the Equal method. Zero is written
+		// separately, since negative zero is equal to zero.
+		if n.Sign() == 0 {
+			writeString(h, '#', "0")
+		} else {
+			writeString(h, '#', n.Text('p', 0))
+		}
+	case value.Type().Is(tftypes.String):
+		var s string
+
+		if err := value.As(&s); err != nil {
+			writeString(h, 'e', value.String())
+			return
+		}
+
+		writeString(h, 's', s)
+	case value.Type().Is(tftypes.List{}), value.Type().Is(tftypes.Tuple{}):
+		var elems []tftypes.Value
+
+		if err := value.As(&elems); err != nil {
+			writeString(h, 'e', value.String())
+			return
+		}
+
+		writeLength(h, '[', len(elems))
+
+		for _, elem := range elems {
+			write(h, elem)
+		}
+	case value.Type().Is(tftypes.Set{}):
+		var elems []tftypes.Value
+
+		if err := value.As(&elems); err != nil {
+			writeString(h, 'e', value.String())
+			return
+		}
+
+		// Set elements are unordered, so the element hashes are sorted.
+		elemHashes := make([]uint64, 0, len(elems))
+
+		for _, elem := range elems {
+			elemHashes = append(elemHashes, Hash(elem))
+		}
+
+		sort.Slice(elemHashes, func(i, j int) bool {
+			return elemHashes[i] < elemHashes[j]
+		})
+
+		writeLength(h, '{', len(elemHashes))
+
+		buf := make([]byte, 8)
+
+		for _, elemHash := range elemHashes {
+			binary.BigEndian.PutUint64(buf, elemHash)
+			_, _ = h.Write(buf)
+		}
+	case value.Type().Is(tftypes.Map{}), value.Type().Is(tftypes.Object{}):
+		var elems map[string]tftypes.Value
+
+		if err := value.As(&elems); err != nil {
+			writeString(h, 'e', value.String())
+			return
+		}
+
+		keys := make([]string, 0, len(elems))
+
+		for key := range elems {
+			keys = append(keys, key)
+		}
+
+		sort.Strings(keys)
+
+		writeLength(h, '(', len(keys))
+
+		for _, key := range keys {
+			writeString(h, 'k', key)
+			write(h, elems[key])
+		}
+	default:
+		writeString(h, 'e', value.String())
+	}
+}
+
+// writeLength writes the prefix byte and length.
+func writeLength(h hash.Hash64, prefix byte, length int) {
+	_, _ = h.Write(append([]byte{prefix}, strconv.Itoa(length)+":"...))
+}
+
+// writeString writes the prefix byte and length prefixed string.
+func writeString(h hash.Hash64, prefix byte, s string) {
+	writeLength(h, prefix, len(s))
+	_, _ = h.Write([]byte(s))
+}
@@ -0,0 +1,130 @@
+package valuehash_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/valuehash"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestHash(t *testing.T) {
+	t.Parallel()
+
+	testObjectType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"a": tftypes.String,
+			"b": tftypes.String,
+		},
+	}
+
+	preciseNumber, _, _ := big.ParseFloat("1.5", 10, 512, big.ToNearestEven)
+
+	testCases := map[string]struct {
+		value1        tftypes.Value
+		value2        tftypes.Value
+		expectedEqual bool
+	}{
+		"bool-equal": {
+			value1:        tftypes.NewValue(tftypes.Bool, true),
+			value2:        tftypes.NewValue(tftypes.Bool, true),
+			expectedEqual: true,
+		},
+		"bool-different": {
+			value1: tftypes.NewValue(tftypes.Bool, true),
+			value2: tftypes.NewValue(tftypes.Bool, false),
+		},
+		"null-unknown": {
+			value1: tftypes.NewValue(tftypes.String, nil),
+			value2: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		},
+		"number-precision": {
+			value1:        tftypes.NewValue(tftypes.Number, big.NewFloat(1.5)),
+			value2:        tftypes.NewValue(tftypes.Number, preciseNumber),
+			expectedEqual: true,
+		},
+		"number-negative-zero": {
+			value1:        tftypes.NewValue(tftypes.Number, big.NewFloat(0)),
+			value2:        tftypes.NewValue(tftypes.Number, new(big.Float).Neg(big.NewFloat(0))),
+			expectedEqual: true,
+		},
+		"number-different": {
+			value1: tftypes.NewValue(tftypes.Number, big.NewFloat(1.5)),
+			value2: tftypes.NewValue(tftypes.Number, big.NewFloat(2.5)),
+		},
+		"string-empty-null": {
+			value1: tftypes.NewValue(tftypes.String, ""),
+			value2: tftypes.NewValue(tftypes.String, nil),
+		},
+		"list-order": {
+			value1: tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "a"),
+				tftypes.NewValue(tftypes.String, "b"),
+			}),
+			value2: tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "b"),
+				tftypes.NewValue(tftypes.String, "a"),
+			}),
+		},
+		"list-ambiguous-strings": {
+			value1: tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "ab"),
+				tftypes.NewValue(tftypes.String, "c"),
+			}),
+			value2: tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "a"),
+				tftypes.NewValue(tftypes.String, "bc"),
+			}),
+		},
+		"set-order": {
+			value1: tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "a"),
+				tftypes.NewValue(tftypes.String, "b"),
+			}),
+			value2: tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "b"),
+				tftypes.NewValue(tftypes.String, "a"),
+			}),
+			expectedEqual: true,
+		},
+		"object-equal": {
+			value1: tftypes.NewValue(testObjectType, map[string]tftypes.Value{
+				"a": tftypes.NewValue(tftypes.String, "a"),
+				"b": tftypes.NewValue(tftypes.String, nil),
+			}),
+			value2: tftypes.NewValue(testObjectType, map[string]tftypes.Value{
+				"a": tftypes.NewValue(tftypes.String, "a"),
+				"b": tftypes.NewValue(tftypes.String, nil),
+			}),
+			expectedEqual: true,
+		},
+		"object-swapped-attributes": {
+			value1: tftypes.NewValue(testObjectType, map[string]tftypes.Value{
+				"a": tftypes.NewValue(tftypes.String, "a"),
+				"b": tftypes.NewValue(tftypes.String, "b"),
+			}),
+			value2: tftypes.NewValue(testObjectType, map[string]tftypes.Value{
+				"a": tftypes.NewValue(tftypes.String, "b"),
+				"b": tftypes.NewValue(tftypes.String, "a"),
+			}),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := valuehash.Hash(testCase.value1) == valuehash.Hash(testCase.value2)
+
+			if got != testCase.expectedEqual {
+				t.Errorf("expected hashes equal to be %t, got %t", testCase.expectedEqual, got)
+			}
+
+			if testCase.expectedEqual != testCase.value1.Equal(testCase.value2) {
+				t.Errorf("expected values equal to be %t", testCase.expectedEqual)
+			}
+		})
+	}
+}
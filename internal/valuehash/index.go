@@ -0,0 +1,65 @@
+package valuehash
+
+import (
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Index is a lookup of values by their Hash, for finding equal values
+// without comparing every pair of values.
+type Index struct {
+	buckets map[uint64][]int
+}
+
+// NewIndex returns an Index of the values.
+func NewIndex(values []tftypes.Value) *Index {
+	index := &Index{
+		buckets: make(map[uint64][]int, len(values)),
+	}
+
+	for i, value := range values {
+		valueHash := Hash(value)
+		index.buckets[valueHash] = append(index.buckets[valueHash], i)
+	}
+
+	return index
+}
+
+// Candidates returns the indices of values with the same Hash as the given
+// value, which must still be compared for equality.
+func (i *Index) Candidates(value tftypes.Value) []int {
+	return i.buckets[Hash(value)]
+}
+
+// Duplicates returns the indices of values which are equal to an earlier
+// value, in order. Values which are not fully known are skipped, since they
+// may become different values once known.
+func Duplicates(values []tftypes.Value) []int {
+	var duplicates []int
+
+	buckets := make(map[uint64][]int, len(values))
+
+	for i, value := range values {
+		if !value.IsFullyKnown() {
+			continue
+		}
+
+		valueHash := Hash(value)
+		duplicate := false
+
+		for _, candidate := range buckets[valueHash] {
+			if values[candidate].Equal(value) {
+				duplicate = true
+				break
+			}
+		}
+
+		if duplicate {
+			duplicates = append(duplicates, i)
+			continue
+		}
+
+		buckets[valueHash] = append(buckets[valueHash], i)
+	}
+
+	return duplicates
+}
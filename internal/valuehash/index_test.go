@@ -0,0 +1,80 @@
+package valuehash_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/internal/valuehash"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestDuplicates(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		values   []tftypes.Value
+		expected []int
+	}{
+		"none": {
+			values: []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "a"),
+				tftypes.NewValue(tftypes.String, "b"),
+			},
+		},
+		"duplicates": {
+			values: []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "a"),
+				tftypes.NewValue(tftypes.String, "b"),
+				tftypes.NewValue(tftypes.String, "a"),
+				tftypes.NewValue(tftypes.String, "a"),
+				tftypes.NewValue(tftypes.String, "b"),
+			},
+			expected: []int{2, 3, 4},
+		},
+		"null": {
+			values: []tftypes.Value{
+				tftypes.NewValue(tftypes.String, nil),
+				tftypes.NewValue(tftypes.String, nil),
+			},
+			expected: []int{1},
+		},
+		"unknown": {
+			values: []tftypes.Value{
+				tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := valuehash.Duplicates(testCase.values)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestIndexCandidates(t *testing.T) {
+	t.Parallel()
+
+	index := valuehash.NewIndex([]tftypes.Value{
+		tftypes.NewValue(tftypes.String, "a"),
+		tftypes.NewValue(tftypes.String, "b"),
+		tftypes.NewValue(tftypes.String, "a"),
+	})
+
+	if diff := cmp.Diff(index.Candidates(tftypes.NewValue(tftypes.String, "a")), []int{0, 2}); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+
+	if got := index.Candidates(tftypes.NewValue(tftypes.String, "c")); len(got) != 0 {
+		t.Errorf("unexpected candidates: %v", got)
+	}
+}
@@ -0,0 +1,100 @@
+package valuehash
+
+import (
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// PathLookup finds values within a root value by attribute path, like
+// tftypes.WalkAttributePath. Set elements are found with an Index of the set,
+// which is created by the first lookup into the set and reused by later
+// lookups, so looking up every element of a set does not compare every pair
+// of elements.
+type PathLookup struct {
+	root tftypes.Value
+	sets map[string]pathLookupSet
+}
+
+// pathLookupSet is the elements and Index of a set within the root value.
+type pathLookupSet struct {
+	elems []tftypes.Value
+	index *Index
+}
+
+// NewPathLookup returns a PathLookup of values within the root value.
+func NewPathLookup(root tftypes.Value) *PathLookup {
+	return &PathLookup{
+		root: root,
+		sets: make(map[string]pathLookupSet),
+	}
+}
+
+// Value returns the value at the path. As with tftypes.WalkAttributePath,
+// tftypes.ErrInvalidStep is returned if no value exists at the path.
+func (l *PathLookup) Value(tfPath *tftypes.AttributePath) (tftypes.Value, error) {
+	value := l.root
+	steps := tfPath.Steps()
+
+	for i, step := range steps {
+		if elem, ok := step.(tftypes.ElementKeyValue); ok {
+			var err error
+
+			value, err = l.setElement(tftypes.NewAttributePathWithSteps(steps[:i]), value, tftypes.Value(elem))
+
+			if err != nil {
+				return tftypes.Value{}, err
+			}
+
+			continue
+		}
+
+		next, err := value.ApplyTerraform5AttributePathStep(step)
+
+		if err != nil {
+			return tftypes.Value{}, err
+		}
+
+		nextValue, ok := next.(tftypes.Value)
+
+		if !ok {
+			return tftypes.Value{}, tftypes.ErrInvalidStep
+		}
+
+		value = nextValue
+	}
+
+	return value, nil
+}
+
+// setElement returns the element of the set at setPath which is equal to
+// elem.
+func (l *PathLookup) setElement(setPath *tftypes.AttributePath, set tftypes.Value, elem tftypes.Value) (tftypes.Value, error) {
+	if set.Type() == nil || !set.Type().Is(tftypes.Set{}) || !set.IsKnown() || set.IsNull() {
+		return tftypes.Value{}, tftypes.ErrInvalidStep
+	}
+
+	key := setPath.String()
+	lookupSet, ok := l.sets[key]
+
+	if !ok {
+		var elems []tftypes.Value
+
+		if err := set.As(&elems); err != nil {
+			return tftypes.Value{}, err
+		}
+
+		lookupSet = pathLookupSet{
+			elems: elems,
+			index: NewIndex(elems),
+		}
+
+		l.sets[key] = lookupSet
+	}
+
+	for _, candidate := range lookupSet.index.Candidates(elem) {
+		if lookupSet.elems[candidate].Equal(elem) {
+			return lookupSet.elems[candidate], nil
+		}
+	}
+
+	return tftypes.Value{}, tftypes.ErrInvalidStep
+}
@@ -0,0 +1,155 @@
+package valuehash_test
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/internal/valuehash"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestPathLookupValue(t *testing.T) {
+	t.Parallel()
+
+	elemType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"name": tftypes.String,
+		},
+	}
+	elem := func(name string) tftypes.Value {
+		return tftypes.NewValue(elemType, map[string]tftypes.Value{
+			"name": tftypes.NewValue(tftypes.String, name),
+		})
+	}
+	rootType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"list":     tftypes.List{ElementType: tftypes.String},
+			"map":      tftypes.Map{ElementType: tftypes.String},
+			"null_set": tftypes.Set{ElementType: elemType},
+			"set":      tftypes.Set{ElementType: elemType},
+		},
+	}
+	root := tftypes.NewValue(rootType, map[string]tftypes.Value{
+		"list": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+			tftypes.NewValue(tftypes.String, "list-value"),
+		}),
+		"map": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+			"key": tftypes.NewValue(tftypes.String, "map-value"),
+		}),
+		"null_set": tftypes.NewValue(tftypes.Set{ElementType: elemType}, nil),
+		"set": tftypes.NewValue(tftypes.Set{ElementType: elemType}, []tftypes.Value{
+			elem("a"),
+			elem("b"),
+		}),
+	})
+
+	testCases := map[string]struct {
+		path          *tftypes.AttributePath
+		expected      tftypes.Value
+		expectedError error
+	}{
+		"root": {
+			path:     tftypes.NewAttributePath(),
+			expected: root,
+		},
+		"list-element": {
+			path:     tftypes.NewAttributePath().WithAttributeName("list").WithElementKeyInt(0),
+			expected: tftypes.NewValue(tftypes.String, "list-value"),
+		},
+		"list-element-missing": {
+			path:          tftypes.NewAttributePath().WithAttributeName("list").WithElementKeyInt(1),
+			expectedError: tftypes.ErrInvalidStep,
+		},
+		"map-element": {
+			path:     tftypes.NewAttributePath().WithAttributeName("map").WithElementKeyString("key"),
+			expected: tftypes.NewValue(tftypes.String, "map-value"),
+		},
+		"set-element": {
+			path:     tftypes.NewAttributePath().WithAttributeName("set").WithElementKeyValue(elem("b")),
+			expected: elem("b"),
+		},
+		"set-element-attribute": {
+			path:     tftypes.NewAttributePath().WithAttributeName("set").WithElementKeyValue(elem("a")).WithAttributeName("name"),
+			expected: tftypes.NewValue(tftypes.String, "a"),
+		},
+		"set-element-missing": {
+			path:          tftypes.NewAttributePath().WithAttributeName("set").WithElementKeyValue(elem("c")),
+			expectedError: tftypes.ErrInvalidStep,
+		},
+		"null-set-element": {
+			path:          tftypes.NewAttributePath().WithAttributeName("null_set").WithElementKeyValue(elem("a")),
+			expectedError: tftypes.ErrInvalidStep,
+		},
+		"attribute-missing": {
+			path:          tftypes.NewAttributePath().WithAttributeName("missing"),
+			expectedError: tftypes.ErrInvalidStep,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			lookup := valuehash.NewPathLookup(root)
+
+			// Look up the path twice, to cover reusing a set Index.
+			for i := 0; i < 2; i++ {
+				got, err := lookup.Value(testCase.path)
+
+				if !errors.Is(err, testCase.expectedError) {
+					t.Fatalf("expected error %v, got: %v", testCase.expectedError, err)
+				}
+
+				if diff := cmp.Diff(got, testCase.expected); diff != "" {
+					t.Errorf("unexpected difference: %s", diff)
+				}
+			}
+		})
+	}
+}
+
+var benchValue tftypes.Value // Prevent compiler optimization
+
+func benchmarkPathLookupValue(b *testing.B, elementCount int) {
+	setType := tftypes.Set{ElementType: tftypes.String}
+	elements := make([]tftypes.Value, elementCount)
+
+	for idx := range elements {
+		elements[idx] = tftypes.NewValue(tftypes.String, strconv.Itoa(idx))
+	}
+
+	set := tftypes.NewValue(setType, elements)
+	setPath := tftypes.NewAttributePath()
+
+	var value tftypes.Value // Prevent compiler optimization
+
+	for n := 0; n < b.N; n++ {
+		lookup := valuehash.NewPathLookup(set)
+
+		for _, element := range elements {
+			value, _ = lookup.Value(setPath.WithElementKeyValue(element))
+		}
+	}
+
+	benchValue = value
+}
+
+func BenchmarkPathLookupValue10(b *testing.B) {
+	benchmarkPathLookupValue(b, 10)
+}
+
+func BenchmarkPathLookupValue100(b *testing.B) {
+	benchmarkPathLookupValue(b, 100)
+}
+
+func BenchmarkPathLookupValue1000(b *testing.B) {
+	benchmarkPathLookupValue(b, 1000)
+}
+
+func BenchmarkPathLookupValue10000(b *testing.B) {
+	benchmarkPathLookupValue(b, 10000)
+}
@@ -0,0 +1,265 @@
+package modelgen
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"reflect"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	attrValueType      = reflect.TypeOf((*attr.Value)(nil)).Elem()
+	bigFloatType       = reflect.TypeOf(new(big.Float))
+	bigIntType         = reflect.TypeOf(new(big.Int))
+	valueConverterType = reflect.TypeOf((*tftypes.ValueConverter)(nil)).Elem()
+)
+
+// CheckModel returns error diagnostics if the model, a struct or pointer to a
+// struct, cannot be used with the Get and Set methods of tfsdk.Config,
+// tfsdk.Plan, and tfsdk.State for the schema. It is intended to be called from
+// provider unit testing, for example:
+//
+//	diags := modelgen.CheckModel(ctx, schema, exampleResourceModel{})
+//
+//	if diags.HasError() {
+//	    t.Fatalf("incompatible model: %v", diags)
+//	}
+//
+// Current checks which return errors:
+//
+//   - Exported struct fields without a "tfsdk" struct tag
+//   - Duplicate "tfsdk" struct tags
+//   - Schema attributes or blocks without a struct field
+//   - Struct fields without a schema attribute or block
+//   - Struct field types which cannot represent the schema type, such as an
+//     attr.Value implementation other than the one created by the schema
+//     type or a Go type of a different kind
+//
+// Null and unknown value handling is not checked, since support depends on
+// the data, such as whether a Go type without pointers is only used with
+// Required attributes.
+func CheckModel(ctx context.Context, schema tfsdk.Schema, model interface{}) diag.Diagnostics {
+	modelType := reflect.TypeOf(model)
+
+	if modelType != nil && modelType.Kind() == reflect.Ptr {
+		modelType = modelType.Elem()
+	}
+
+	if modelType == nil || modelType.Kind() != reflect.Struct {
+		var diags diag.Diagnostics
+
+		diags.AddError(
+			"Invalid Model",
+			fmt.Sprintf("The model must be a struct or pointer to a struct, got: %T", model),
+		)
+
+		return diags
+	}
+
+	return checkType(ctx, path.Empty(), schema.AttributeType(), modelType)
+}
+
+func checkType(ctx context.Context, p path.Path, typ attr.Type, goType reflect.Type) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if goType.Implements(attrValueType) {
+		value, err := typ.ValueFromTerraform(ctx, tftypes.NewValue(typ.TerraformType(ctx), nil))
+
+		if err != nil {
+			diags.AddAttributeError(
+				p,
+				"Model Check Error",
+				fmt.Sprintf("Unable to create %s value: %s", typ, err),
+			)
+
+			return diags
+		}
+
+		if reflect.TypeOf(value) != goType {
+			diags.AddAttributeError(
+				p,
+				"Incompatible Model Field",
+				fmt.Sprintf("The schema type %s requires the %s value type, got: %s", typ, qualifiedTypeName(reflect.TypeOf(value)), qualifiedTypeName(goType)),
+			)
+		}
+
+		return diags
+	}
+
+	if goType.Implements(valueConverterType) || reflect.PtrTo(goType).Implements(valueConverterType) {
+		return diags
+	}
+
+	if goType == bigFloatType || goType == bigIntType {
+		return checkKind(ctx, p, typ, goType, tftypes.Number)
+	}
+
+	if goType.Kind() == reflect.Ptr {
+		return checkType(ctx, p, typ, goType.Elem())
+	}
+
+	terraformType := typ.TerraformType(ctx)
+
+	switch goType.Kind() {
+	case reflect.Bool:
+		return checkKind(ctx, p, typ, goType, tftypes.Bool)
+	case reflect.String:
+		return checkKind(ctx, p, typ, goType, tftypes.String)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32,
+		reflect.Int64, reflect.Uint, reflect.Uint8, reflect.Uint16,
+		reflect.Uint32, reflect.Uint64, reflect.Float32, reflect.Float64:
+		return checkKind(ctx, p, typ, goType, tftypes.Number)
+	case reflect.Slice:
+		if !terraformType.Is(tftypes.List{}) && !terraformType.Is(tftypes.Set{}) && !terraformType.Is(tftypes.Tuple{}) {
+			return incompatibleKind(p, typ, goType)
+		}
+
+		typWithElementType, ok := typ.(attr.TypeWithElementType)
+
+		if !ok {
+			// Tuples and custom collection types are not introspected.
+			return diags
+		}
+
+		return checkType(ctx, p, typWithElementType.ElementType(), goType.Elem())
+	case reflect.Map:
+		if !terraformType.Is(tftypes.Map{}) || goType.Key().Kind() != reflect.String {
+			return incompatibleKind(p, typ, goType)
+		}
+
+		typWithElementType, ok := typ.(attr.TypeWithElementType)
+
+		if !ok {
+			return diags
+		}
+
+		return checkType(ctx, p, typWithElementType.ElementType(), goType.Elem())
+	case reflect.Struct:
+		if !terraformType.Is(tftypes.Object{}) {
+			return incompatibleKind(p, typ, goType)
+		}
+
+		typWithAttributeTypes, ok := typ.(attr.TypeWithAttributeTypes)
+
+		if !ok {
+			return diags
+		}
+
+		return checkStruct(ctx, p, typWithAttributeTypes.AttributeTypes(), goType)
+	default:
+		return incompatibleKind(p, typ, goType)
+	}
+}
+
+func checkStruct(ctx context.Context, p path.Path, attrTypes map[string]attr.Type, goType reflect.Type) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	fields := map[string]reflect.StructField{}
+
+	for i := 0; i < goType.NumField(); i++ {
+		field := goType.Field(i)
+
+		if field.PkgPath != "" {
+			continue
+		}
+
+		tag := field.Tag.Get("tfsdk")
+
+		if tag == "-" {
+			continue
+		}
+
+		if tag == "" {
+			diags.AddAttributeError(
+				p,
+				"Missing Model Struct Tag",
+				fmt.Sprintf(`The %s field %s requires a "tfsdk" struct tag with the attribute or block name.`, goType, field.Name),
+			)
+
+			continue
+		}
+
+		if other, ok := fields[tag]; ok {
+			diags.AddAttributeError(
+				p.AtName(tag),
+				"Duplicate Model Struct Tag",
+				fmt.Sprintf(`The %s fields %s and %s have the same "tfsdk" struct tag.`, goType, other.Name, field.Name),
+			)
+
+			continue
+		}
+
+		fields[tag] = field
+
+		if _, ok := attrTypes[tag]; !ok {
+			diags.AddAttributeError(
+				p.AtName(tag),
+				"Unexpected Model Field",
+				fmt.Sprintf("The %s field %s has no corresponding schema attribute or block.", goType, field.Name),
+			)
+		}
+	}
+
+	names := make([]string, 0, len(attrTypes))
+
+	for name := range attrTypes {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		field, ok := fields[name]
+
+		if !ok {
+			diags.AddAttributeError(
+				p.AtName(name),
+				"Missing Model Field",
+				fmt.Sprintf(`The %s struct has no field with a "tfsdk" struct tag of %q.`, goType, name),
+			)
+
+			continue
+		}
+
+		diags.Append(checkType(ctx, p.AtName(name), attrTypes[name], field.Type)...)
+	}
+
+	return diags
+}
+
+func checkKind(ctx context.Context, p path.Path, typ attr.Type, goType reflect.Type, terraformType tftypes.Type) diag.Diagnostics {
+	if typ.TerraformType(ctx).Is(terraformType) {
+		return nil
+	}
+
+	return incompatibleKind(p, typ, goType)
+}
+
+func incompatibleKind(p path.Path, typ attr.Type, goType reflect.Type) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.AddAttributeError(
+		p,
+		"Incompatible Model Field",
+		fmt.Sprintf("The schema type %s cannot be represented by the %s type.", typ, goType),
+	)
+
+	return diags
+}
+
+// qualifiedTypeName returns the Go type name including the full package
+// path, since value types from different packages often share names.
+func qualifiedTypeName(t reflect.Type) string {
+	if t.PkgPath() == "" {
+		return t.String()
+	}
+
+	return t.PkgPath() + "." + t.Name()
+}
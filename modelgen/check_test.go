@@ -0,0 +1,172 @@
+package modelgen_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	testtypes "github.com/hashicorp/terraform-plugin-framework/internal/testing/types"
+	"github.com/hashicorp/terraform-plugin-framework/modelgen"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestCheckModel(t *testing.T) {
+	t.Parallel()
+
+	testSchema := tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"name": {
+				Type:     types.StringType,
+				Required: true,
+			},
+			"tags": {
+				Type:     types.MapType{ElemType: types.StringType},
+				Optional: true,
+			},
+			"network": {
+				Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+					"ip_address": {
+						Type:     types.StringType,
+						Required: true,
+					},
+				}),
+				Optional: true,
+			},
+		},
+		Blocks: map[string]tfsdk.Block{
+			"disk": {
+				Attributes: map[string]tfsdk.Attribute{
+					"ratio": {
+						Type:     types.NumberType,
+						Optional: true,
+					},
+				},
+				NestingMode: tfsdk.BlockNestingModeList,
+			},
+		},
+	}
+
+	type testNetworkModel struct {
+		IPAddress string `tfsdk:"ip_address"`
+	}
+
+	type testDiskModel struct {
+		Ratio *big.Float `tfsdk:"ratio"`
+	}
+
+	testCases := map[string]struct {
+		schema   tfsdk.Schema
+		model    interface{}
+		expected diag.Diagnostics
+	}{
+		"invalid-model": {
+			schema: testSchema,
+			model:  "test",
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Model",
+					"The model must be a struct or pointer to a struct, got: string",
+				),
+			},
+		},
+		"value-types-framework": {
+			schema: testSchema,
+			model: &struct {
+				Disk    types.List   `tfsdk:"disk"`
+				Name    types.String `tfsdk:"name"`
+				Network types.Object `tfsdk:"network"`
+				Tags    types.Map    `tfsdk:"tags"`
+			}{},
+			expected: nil,
+		},
+		"value-types-go": {
+			schema: testSchema,
+			model: struct {
+				Disk    []testDiskModel   `tfsdk:"disk"`
+				Name    string            `tfsdk:"name"`
+				Network *testNetworkModel `tfsdk:"network"`
+				Tags    map[string]string `tfsdk:"tags"`
+
+				unexported bool //nolint:structcheck,unused
+				Ignored    bool `tfsdk:"-"`
+			}{},
+			expected: nil,
+		},
+		"missing-and-unexpected-fields": {
+			schema: testSchema,
+			model: struct {
+				Disk    types.List   `tfsdk:"disk"`
+				Name    types.String `tfsdk:"name"`
+				Network types.Object `tfsdk:"network"`
+				Tag     types.Map    `tfsdk:"tag"`
+				NoTag   types.String
+			}{},
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("tag"),
+					"Unexpected Model Field",
+					`The struct { Disk types.List "tfsdk:\"disk\""; Name types.String "tfsdk:\"name\""; Network types.Object "tfsdk:\"network\""; Tag types.Map "tfsdk:\"tag\""; NoTag types.String } field Tag has no corresponding schema attribute or block.`,
+				),
+				diag.NewAttributeErrorDiagnostic(
+					path.Empty(),
+					"Missing Model Struct Tag",
+					`The struct { Disk types.List "tfsdk:\"disk\""; Name types.String "tfsdk:\"name\""; Network types.Object "tfsdk:\"network\""; Tag types.Map "tfsdk:\"tag\""; NoTag types.String } field NoTag requires a "tfsdk" struct tag with the attribute or block name.`,
+				),
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("tags"),
+					"Missing Model Field",
+					`The struct { Disk types.List "tfsdk:\"disk\""; Name types.String "tfsdk:\"name\""; Network types.Object "tfsdk:\"network\""; Tag types.Map "tfsdk:\"tag\""; NoTag types.String } struct has no field with a "tfsdk" struct tag of "tags".`,
+				),
+			},
+		},
+		"incompatible-types": {
+			schema: testSchema,
+			model: struct {
+				Disk    []testNetworkModel `tfsdk:"disk"`
+				Name    testtypes.String   `tfsdk:"name"`
+				Network types.Object       `tfsdk:"network"`
+				Tags    map[string]int64   `tfsdk:"tags"`
+			}{},
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("disk").AtName("ip_address"),
+					"Unexpected Model Field",
+					`The modelgen_test.testNetworkModel field IPAddress has no corresponding schema attribute or block.`,
+				),
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("disk").AtName("ratio"),
+					"Missing Model Field",
+					`The modelgen_test.testNetworkModel struct has no field with a "tfsdk" struct tag of "ratio".`,
+				),
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("name"),
+					"Incompatible Model Field",
+					"The schema type types.StringType requires the github.com/hashicorp/terraform-plugin-framework/types.String value type, got: github.com/hashicorp/terraform-plugin-framework/internal/testing/types.String",
+				),
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("tags"),
+					"Incompatible Model Field",
+					"The schema type types.StringType cannot be represented by the int64 type.",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := modelgen.CheckModel(context.Background(), testCase.schema, testCase.model)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
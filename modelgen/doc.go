@@ -0,0 +1,10 @@
+// Package modelgen generates Go model structs, with "tfsdk" struct tags, from
+// a tfsdk.Schema and checks existing model structs for compatibility with a
+// tfsdk.Schema.
+//
+// Generated structs can be used with the Get methods of tfsdk.Config,
+// tfsdk.Plan, and tfsdk.State and the Set methods of tfsdk.Plan and
+// tfsdk.State. The tfmodelgen command, in the cmd/tfmodelgen directory, is a
+// go generate friendly wrapper for this package which reads schemas in the
+// JSON format produced by tfsdk.Schema.MarshalJSON.
+package modelgen
@@ -0,0 +1,362 @@
+package modelgen
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"go/format"
+	"math/big"
+	"path"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Generate returns formatted Go source containing model structs for the
+// schema. The root struct is named Options.StructName and each nested
+// attribute, block, and object type (for ValueTypesGo) receives its own
+// struct, named by appending the Go name of the attribute or block to the
+// parent struct name.
+func Generate(ctx context.Context, schema tfsdk.Schema, opts Options) ([]byte, error) {
+	if opts.PackageName == "" {
+		return nil, errors.New("missing PackageName option")
+	}
+
+	if opts.StructName == "" {
+		return nil, errors.New("missing StructName option")
+	}
+
+	g := &generator{
+		imports: map[string]string{},
+		opts:    opts,
+	}
+
+	if err := g.addStruct(ctx, opts.StructName, "the schema", schema.Attributes, schema.Blocks); err != nil {
+		return nil, err
+	}
+
+	return g.source()
+}
+
+// generator holds the state of a single Generate call.
+type generator struct {
+	// imports maps import paths to the package names used in the source.
+	imports map[string]string
+
+	opts    Options
+	structs []structDefinition
+}
+
+type structDefinition struct {
+	name        string
+	description string
+	fields      []fieldDefinition
+}
+
+type fieldDefinition struct {
+	name   string
+	goType string
+	tag    string
+}
+
+func (g *generator) addStruct(ctx context.Context, structName string, description string, attributes map[string]tfsdk.Attribute, blocks map[string]tfsdk.Block) error {
+	definition := structDefinition{
+		name:        structName,
+		description: description,
+	}
+
+	// Reserve the position of this struct before nested structs, so the
+	// output reads from the root downwards.
+	index := len(g.structs)
+	g.structs = append(g.structs, definition)
+
+	names := make([]string, 0, len(attributes)+len(blocks))
+
+	for name := range attributes {
+		names = append(names, name)
+	}
+
+	for name := range blocks {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		var goType string
+		var err error
+
+		if attribute, ok := attributes[name]; ok {
+			goType, err = g.attributeGoType(ctx, structName+goName(name), name, attribute)
+		} else {
+			goType, err = g.blockGoType(ctx, structName+goName(name), name, blocks[name])
+		}
+
+		if err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+
+		definition.fields = append(definition.fields, fieldDefinition{
+			name:   goName(name),
+			goType: goType,
+			tag:    name,
+		})
+	}
+
+	g.structs[index] = definition
+
+	return nil
+}
+
+func (g *generator) attributeGoType(ctx context.Context, structName string, name string, attribute tfsdk.Attribute) (string, error) {
+	if attribute.Attributes == nil && attribute.Type == nil {
+		return "", errors.New("attribute must have Type or Attributes set")
+	}
+
+	if attribute.Attributes != nil {
+		err := g.addStruct(ctx, structName, fmt.Sprintf("the %s nested attribute", name), attribute.Attributes.GetAttributes(), nil)
+
+		if err != nil {
+			return "", err
+		}
+
+		if g.opts.ValueTypes == ValueTypesFramework {
+			return g.valueGoType(ctx, attribute.Attributes.AttributeType())
+		}
+
+		switch attribute.Attributes.GetNestingMode() {
+		case tfsdk.NestingModeSingle:
+			return pointerGoType(structName, attribute.Required), nil
+		case tfsdk.NestingModeList, tfsdk.NestingModeSet:
+			return "[]" + structName, nil
+		case tfsdk.NestingModeMap:
+			return "map[string]" + structName, nil
+		default:
+			return "", fmt.Errorf("unsupported nested attributes nesting mode %d", attribute.Attributes.GetNestingMode())
+		}
+	}
+
+	if g.opts.ValueTypes == ValueTypesFramework {
+		return g.valueGoType(ctx, attribute.Type)
+	}
+
+	goType, err := g.builtinGoType(ctx, structName, name, attribute.Type)
+
+	if err != nil {
+		return "", err
+	}
+
+	return pointerGoType(goType, attribute.Required), nil
+}
+
+func (g *generator) blockGoType(ctx context.Context, structName string, name string, block tfsdk.Block) (string, error) {
+	err := g.addStruct(ctx, structName, fmt.Sprintf("the %s block", name), block.Attributes, block.Blocks)
+
+	if err != nil {
+		return "", err
+	}
+
+	if g.opts.ValueTypes == ValueTypesGo {
+		return "[]" + structName, nil
+	}
+
+	switch block.NestingMode {
+	case tfsdk.BlockNestingModeList:
+		return g.qualifiedGoType(reflect.TypeOf(types.List{})), nil
+	case tfsdk.BlockNestingModeSet:
+		return g.qualifiedGoType(reflect.TypeOf(types.Set{})), nil
+	default:
+		return "", fmt.Errorf("unsupported block nesting mode %d", block.NestingMode)
+	}
+}
+
+// valueGoType returns the Go type of the attr.Value implementation created
+// by the attr.Type, which supports custom types.
+func (g *generator) valueGoType(ctx context.Context, typ attr.Type) (string, error) {
+	value, err := typ.ValueFromTerraform(ctx, tftypes.NewValue(typ.TerraformType(ctx), nil))
+
+	if err != nil {
+		return "", fmt.Errorf("unable to create %s value: %w", typ, err)
+	}
+
+	return g.qualifiedGoType(reflect.TypeOf(value)), nil
+}
+
+// builtinGoType returns the Go built-in type for the attr.Type. Custom types
+// are handled via their Terraform type, except for objects, which require
+// attr.TypeWithAttributeTypes, and collections, which require
+// attr.TypeWithElementType.
+func (g *generator) builtinGoType(ctx context.Context, structName string, name string, typ attr.Type) (string, error) {
+	switch {
+	case typ.Equal(types.Int32Type):
+		return "int32", nil
+	case typ.Equal(types.Int64Type):
+		return "int64", nil
+	case typ.Equal(types.Uint64Type):
+		return "uint64", nil
+	case typ.Equal(types.Float32Type):
+		return "float32", nil
+	case typ.Equal(types.Float64Type):
+		return "float64", nil
+	}
+
+	terraformType := typ.TerraformType(ctx)
+
+	switch {
+	case terraformType.Is(tftypes.String):
+		return "string", nil
+	case terraformType.Is(tftypes.Bool):
+		return "bool", nil
+	case terraformType.Is(tftypes.Number):
+		return g.qualifiedGoType(reflect.TypeOf(new(big.Float))), nil
+	case terraformType.Is(tftypes.List{}), terraformType.Is(tftypes.Set{}), terraformType.Is(tftypes.Map{}):
+		typWithElementType, ok := typ.(attr.TypeWithElementType)
+
+		if !ok {
+			return "", fmt.Errorf("type %s must implement attr.TypeWithElementType", typ)
+		}
+
+		elemGoType, err := g.builtinGoType(ctx, structName, name, typWithElementType.ElementType())
+
+		if err != nil {
+			return "", err
+		}
+
+		if terraformType.Is(tftypes.Map{}) {
+			return "map[string]" + elemGoType, nil
+		}
+
+		return "[]" + elemGoType, nil
+	case terraformType.Is(tftypes.Object{}):
+		typWithAttributeTypes, ok := typ.(attr.TypeWithAttributeTypes)
+
+		if !ok {
+			return "", fmt.Errorf("type %s must implement attr.TypeWithAttributeTypes", typ)
+		}
+
+		attributes := make(map[string]tfsdk.Attribute, len(typWithAttributeTypes.AttributeTypes()))
+
+		// Object attribute values may be null, so they are treated as
+		// Optional to generate pointers.
+		for attrName, attrType := range typWithAttributeTypes.AttributeTypes() {
+			attributes[attrName] = tfsdk.Attribute{
+				Type:     attrType,
+				Optional: true,
+			}
+		}
+
+		err := g.addStruct(ctx, structName, fmt.Sprintf("the %s object", name), attributes, nil)
+
+		if err != nil {
+			return "", err
+		}
+
+		return structName, nil
+	default:
+		return "", fmt.Errorf("unsupported Terraform type %s", terraformType)
+	}
+}
+
+// qualifiedGoType returns the Go source representation of a named type,
+// adding its package to the imports.
+func (g *generator) qualifiedGoType(t reflect.Type) string {
+	if t.Kind() == reflect.Ptr {
+		return "*" + g.qualifiedGoType(t.Elem())
+	}
+
+	if t.PkgPath() == "" {
+		return t.String()
+	}
+
+	packageName, ok := g.imports[t.PkgPath()]
+
+	if !ok {
+		packageName = strings.SplitN(t.String(), ".", 2)[0]
+		candidate := packageName
+
+		for i := 2; g.packageNameUsed(candidate); i++ {
+			candidate = packageName + strconv.Itoa(i)
+		}
+
+		packageName = candidate
+		g.imports[t.PkgPath()] = packageName
+	}
+
+	return packageName + "." + t.Name()
+}
+
+func (g *generator) packageNameUsed(name string) bool {
+	for _, packageName := range g.imports {
+		if packageName == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (g *generator) source() ([]byte, error) {
+	var b bytes.Buffer
+
+	b.WriteString("// Code generated from a terraform-plugin-framework schema. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&b, "package %s\n\n", g.opts.PackageName)
+
+	if len(g.imports) > 0 {
+		importPaths := make([]string, 0, len(g.imports))
+
+		for importPath := range g.imports {
+			importPaths = append(importPaths, importPath)
+		}
+
+		sort.Strings(importPaths)
+
+		b.WriteString("import (\n")
+
+		for _, importPath := range importPaths {
+			if g.imports[importPath] == path.Base(importPath) {
+				fmt.Fprintf(&b, "\t%q\n", importPath)
+				continue
+			}
+
+			fmt.Fprintf(&b, "\t%s %q\n", g.imports[importPath], importPath)
+		}
+
+		b.WriteString(")\n\n")
+	}
+
+	for _, s := range g.structs {
+		fmt.Fprintf(&b, "// %s describes the data model of %s.\n", s.name, s.description)
+		fmt.Fprintf(&b, "type %s struct {\n", s.name)
+
+		for _, field := range s.fields {
+			fmt.Fprintf(&b, "\t%s %s `tfsdk:%q`\n", field.name, field.goType, field.tag)
+		}
+
+		b.WriteString("}\n\n")
+	}
+
+	return format.Source(b.Bytes())
+}
+
+// pointerGoType returns a pointer to the Go type, if the attribute is not
+// Required and the Go type cannot already be nil.
+func pointerGoType(goType string, required bool) string {
+	if required {
+		return goType
+	}
+
+	for _, prefix := range []string{"*", "[]", "map["} {
+		if strings.HasPrefix(goType, prefix) {
+			return goType
+		}
+	}
+
+	return "*" + goType
+}
@@ -0,0 +1,252 @@
+package modelgen_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	testtypes "github.com/hashicorp/terraform-plugin-framework/internal/testing/types"
+	"github.com/hashicorp/terraform-plugin-framework/modelgen"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestGenerate(t *testing.T) {
+	t.Parallel()
+
+	testSchema := tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"instance_id": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"name": {
+				Type:     types.StringType,
+				Required: true,
+			},
+			"size": {
+				Type:     types.Int64Type,
+				Optional: true,
+			},
+			"tags": {
+				Type:     types.MapType{ElemType: types.StringType},
+				Optional: true,
+			},
+			"network": {
+				Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+					"ip_address": {
+						Type:     types.StringType,
+						Required: true,
+					},
+				}),
+				Optional: true,
+			},
+		},
+		Blocks: map[string]tfsdk.Block{
+			"disk": {
+				Attributes: map[string]tfsdk.Attribute{
+					"ratio": {
+						Type:     types.NumberType,
+						Optional: true,
+					},
+				},
+				NestingMode: tfsdk.BlockNestingModeList,
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		schema        tfsdk.Schema
+		opts          modelgen.Options
+		expected      string
+		expectedError string
+	}{
+		"missing-package-name": {
+			schema: testSchema,
+			opts: modelgen.Options{
+				StructName: "exampleModel",
+			},
+			expectedError: "missing PackageName option",
+		},
+		"missing-struct-name": {
+			schema: testSchema,
+			opts: modelgen.Options{
+				PackageName: "example",
+			},
+			expectedError: "missing StructName option",
+		},
+		"value-types-framework": {
+			schema: testSchema,
+			opts: modelgen.Options{
+				PackageName: "example",
+				StructName:  "exampleModel",
+			},
+			expected: `// Code generated from a terraform-plugin-framework schema. DO NOT EDIT.
+
+package example
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// exampleModel describes the data model of the schema.
+type exampleModel struct {
+	Disk       types.List   ` + "`" + `tfsdk:"disk"` + "`" + `
+	InstanceID types.String ` + "`" + `tfsdk:"instance_id"` + "`" + `
+	Name       types.String ` + "`" + `tfsdk:"name"` + "`" + `
+	Network    types.Object ` + "`" + `tfsdk:"network"` + "`" + `
+	Size       types.Int64  ` + "`" + `tfsdk:"size"` + "`" + `
+	Tags       types.Map    ` + "`" + `tfsdk:"tags"` + "`" + `
+}
+
+// exampleModelDisk describes the data model of the disk block.
+type exampleModelDisk struct {
+	Ratio types.Number ` + "`" + `tfsdk:"ratio"` + "`" + `
+}
+
+// exampleModelNetwork describes the data model of the network nested attribute.
+type exampleModelNetwork struct {
+	IPAddress types.String ` + "`" + `tfsdk:"ip_address"` + "`" + `
+}
+`,
+		},
+		"value-types-go": {
+			schema: testSchema,
+			opts: modelgen.Options{
+				PackageName: "example",
+				StructName:  "ExampleModel",
+				ValueTypes:  modelgen.ValueTypesGo,
+			},
+			expected: `// Code generated from a terraform-plugin-framework schema. DO NOT EDIT.
+
+package example
+
+import (
+	"math/big"
+)
+
+// ExampleModel describes the data model of the schema.
+type ExampleModel struct {
+	Disk       []ExampleModelDisk   ` + "`" + `tfsdk:"disk"` + "`" + `
+	InstanceID *string              ` + "`" + `tfsdk:"instance_id"` + "`" + `
+	Name       string               ` + "`" + `tfsdk:"name"` + "`" + `
+	Network    *ExampleModelNetwork ` + "`" + `tfsdk:"network"` + "`" + `
+	Size       *int64               ` + "`" + `tfsdk:"size"` + "`" + `
+	Tags       map[string]string    ` + "`" + `tfsdk:"tags"` + "`" + `
+}
+
+// ExampleModelDisk describes the data model of the disk block.
+type ExampleModelDisk struct {
+	Ratio *big.Float ` + "`" + `tfsdk:"ratio"` + "`" + `
+}
+
+// ExampleModelNetwork describes the data model of the network nested attribute.
+type ExampleModelNetwork struct {
+	IPAddress string ` + "`" + `tfsdk:"ip_address"` + "`" + `
+}
+`,
+		},
+		"value-types-go-sized-numbers": {
+			schema: tfsdk.Schema{
+				Attributes: map[string]tfsdk.Attribute{
+					"count": {
+						Type:     types.Int32Type,
+						Required: true,
+					},
+					"ratio": {
+						Type:     types.Float32Type,
+						Required: true,
+					},
+					"size": {
+						Type:     types.Uint64Type,
+						Required: true,
+					},
+				},
+			},
+			opts: modelgen.Options{
+				PackageName: "example",
+				StructName:  "ExampleModel",
+				ValueTypes:  modelgen.ValueTypesGo,
+			},
+			expected: `// Code generated from a terraform-plugin-framework schema. DO NOT EDIT.
+
+package example
+
+// ExampleModel describes the data model of the schema.
+type ExampleModel struct {
+	Count int32   ` + "`" + `tfsdk:"count"` + "`" + `
+	Ratio float32 ` + "`" + `tfsdk:"ratio"` + "`" + `
+	Size  uint64  ` + "`" + `tfsdk:"size"` + "`" + `
+}
+`,
+		},
+		"custom-types": {
+			schema: tfsdk.Schema{
+				Attributes: map[string]tfsdk.Attribute{
+					"custom": {
+						Type:     testtypes.StringType{},
+						Optional: true,
+					},
+					"object": {
+						Type: types.ObjectType{
+							AttrTypes: map[string]attr.Type{
+								"nested": types.BoolType,
+							},
+						},
+						Optional: true,
+					},
+				},
+			},
+			opts: modelgen.Options{
+				PackageName: "example",
+				StructName:  "exampleModel",
+			},
+			expected: `// Code generated from a terraform-plugin-framework schema. DO NOT EDIT.
+
+package example
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/types"
+	types2 "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// exampleModel describes the data model of the schema.
+type exampleModel struct {
+	Custom types.String  ` + "`" + `tfsdk:"custom"` + "`" + `
+	Object types2.Object ` + "`" + `tfsdk:"object"` + "`" + `
+}
+`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := modelgen.Generate(context.Background(), testCase.schema, testCase.opts)
+
+			if err != nil {
+				if testCase.expectedError == "" {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if diff := cmp.Diff(err.Error(), testCase.expectedError); diff != "" {
+					t.Fatalf("unexpected error difference: %s", diff)
+				}
+
+				return
+			}
+
+			if testCase.expectedError != "" {
+				t.Fatalf("expected error: %s", testCase.expectedError)
+			}
+
+			if diff := cmp.Diff(string(got), testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package modelgen
+
+import (
+	"strings"
+)
+
+// initialisms are attribute name parts which are fully uppercased in Go
+// identifiers, following Go naming conventions.
+var initialisms = map[string]struct{}{
+	"acl":   {},
+	"api":   {},
+	"arn":   {},
+	"cidr":  {},
+	"cpu":   {},
+	"dns":   {},
+	"http":  {},
+	"https": {},
+	"id":    {},
+	"ip":    {},
+	"json":  {},
+	"ssh":   {},
+	"tls":   {},
+	"ttl":   {},
+	"uri":   {},
+	"url":   {},
+	"uuid":  {},
+}
+
+// goName returns the exported Go identifier for a snake case schema name,
+// such as InstanceID for instance_id.
+func goName(name string) string {
+	var result strings.Builder
+
+	for _, part := range strings.Split(name, "_") {
+		if part == "" {
+			continue
+		}
+
+		if _, ok := initialisms[part]; ok {
+			result.WriteString(strings.ToUpper(part))
+			continue
+		}
+
+		result.WriteString(strings.ToUpper(part[:1]))
+		result.WriteString(part[1:])
+	}
+
+	// Schema names must start with a letter, but be defensive against
+	// leading numbers, which are not valid Go identifiers.
+	if result.Len() == 0 || (result.String()[0] >= '0' && result.String()[0] <= '9') {
+		return "Field" + result.String()
+	}
+
+	return result.String()
+}
@@ -0,0 +1,39 @@
+package modelgen
+
+// ValueTypes is an enum type of the Go types used for generated struct
+// fields.
+type ValueTypes uint8
+
+const (
+	// ValueTypesFramework generates struct fields using attr.Value
+	// implementations, such as types.String and types.List. Nested
+	// attributes and blocks are represented by their collection or object
+	// type, with a separate struct generated for usage with methods such as
+	// ElementsAs and As.
+	//
+	// This is the default, as it supports null and unknown values.
+	ValueTypesFramework ValueTypes = 0
+
+	// ValueTypesGo generates struct fields using Go built-in types, such as
+	// string and []string. Attributes which are not Required use pointer
+	// types so null values can be represented. Unknown values are not
+	// supported, so these structs should typically only be used where
+	// values are known, such as in the provider configuration.
+	ValueTypesGo ValueTypes = 1
+)
+
+// Options are options for customizing the behavior of Generate.
+type Options struct {
+	// PackageName is the Go package name of the generated source. This
+	// field is required.
+	PackageName string
+
+	// StructName is the Go type name of the generated root struct, which
+	// is also used as the prefix for generated nested structs. This field
+	// is required.
+	StructName string
+
+	// ValueTypes determines the Go types used for struct fields. Defaults
+	// to ValueTypesFramework.
+	ValueTypes ValueTypes
+}
@@ -38,7 +38,7 @@ func TestPathStepAttributeNameEqual(t *testing.T) {
 		},
 		"PathStepElementKeyValue": {
 			step:     path.PathStepAttributeName("test"),
-			other:    path.PathStepElementKeyValue{Value: types.String{Value: "test"}},
+			other:    path.PathStepElementKeyValue{Value: types.StringValue("test")},
 			expected: false,
 		},
 	}
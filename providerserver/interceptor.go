@@ -0,0 +1,92 @@
+package providerserver
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// Interceptor wraps each provider server RPC, such as for request timing,
+// audit logging, or enriching diagnostics. It is called identically for
+// protocol version 5 and 6.
+//
+// Calling next continues to the next Interceptor or the RPC itself, after
+// which the InterceptorResponse contains the RPC response data. Not calling
+// next skips the RPC, in which case the InterceptorResponse Diagnostics
+// should explain why.
+type Interceptor func(ctx context.Context, req InterceptorRequest, resp *InterceptorResponse, next func(context.Context))
+
+// InterceptorRequest is the RPC request data available to an Interceptor.
+// It should be treated as read-only.
+type InterceptorRequest struct {
+	// RPC is the RPC name, such as "ReadResource". Refer to the RPC
+	// constants for all names.
+	RPC string
+
+	// TypeName is the resource or data source type name, such as
+	// "examplecloud_thing". It is empty for provider RPCs.
+	TypeName string
+
+	// Config is the configuration, if the RPC has one.
+	Config *tfsdk.Config
+
+	// Plan is the proposed new state during PlanResourceChange or the
+	// planned state during ApplyResourceChange.
+	Plan *tfsdk.Plan
+
+	// State is the prior state during PlanResourceChange and
+	// ApplyResourceChange or the current state during ReadResource.
+	State *tfsdk.State
+}
+
+// InterceptorResponse is the RPC response data available to an Interceptor
+// after calling next.
+type InterceptorResponse struct {
+	// Diagnostics is the RPC response diagnostics. Interceptors may modify
+	// these, which are returned to Terraform in place of the RPC response
+	// diagnostics.
+	Diagnostics diag.Diagnostics
+
+	// State is the planned state of PlanResourceChange, the new state of
+	// ApplyResourceChange and ReadResource, the state of ReadDataSource,
+	// the upgraded state of UpgradeResourceState, or the imported state of
+	// ImportResourceState when a single resource was imported. It should be
+	// treated as read-only.
+	State *tfsdk.State
+}
+
+// RPC names for the InterceptorRequest RPC field.
+const (
+	RPCApplyResourceChange      = fwserver.RPCApplyResourceChange
+	RPCConfigureProvider        = fwserver.RPCConfigureProvider
+	RPCImportResourceState      = fwserver.RPCImportResourceState
+	RPCPlanResourceChange       = fwserver.RPCPlanResourceChange
+	RPCReadDataSource           = fwserver.RPCReadDataSource
+	RPCReadResource             = fwserver.RPCReadResource
+	RPCUpgradeResourceState     = fwserver.RPCUpgradeResourceState
+	RPCValidateDataSourceConfig = fwserver.RPCValidateDataSourceConfig
+	RPCValidateProviderConfig   = fwserver.RPCValidateProviderConfig
+	RPCValidateResourceConfig   = fwserver.RPCValidateResourceConfig
+)
+
+// fwserverInterceptors returns the fwserver.Interceptor equivalents of the
+// given Interceptors.
+func fwserverInterceptors(interceptors []Interceptor) []fwserver.Interceptor {
+	if len(interceptors) == 0 {
+		return nil
+	}
+
+	result := make([]fwserver.Interceptor, 0, len(interceptors))
+
+	for _, interceptor := range interceptors {
+		interceptor := interceptor
+
+		result = append(result, func(ctx context.Context, req fwserver.InterceptorRequest, resp *fwserver.InterceptorResponse, next func(context.Context)) {
+			interceptor(ctx, InterceptorRequest(req), (*InterceptorResponse)(resp), next)
+		})
+	}
+
+	return result
+}
@@ -3,6 +3,8 @@ package providerserver
 import (
 	"context"
 	"fmt"
+	"strings"
+	"sync"
 
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
 	"github.com/hashicorp/terraform-plugin-framework/internal/proto5server"
@@ -18,11 +20,15 @@ import (
 // based on the given Provider and suitable for usage with the
 // github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5server.Serve()
 // function and various terraform-plugin-mux functions.
-func NewProtocol5(p tfsdk.Provider) func() tfprotov5.ProviderServer {
+func NewProtocol5(p tfsdk.Provider, opts ...ServerOpt) func() tfprotov5.ProviderServer {
+	serverOpts := newServerOpts(opts)
+
 	return func() tfprotov5.ProviderServer {
 		return &proto5server.Server{
 			FrameworkServer: fwserver.Server{
-				Provider: p,
+				Interceptors:    fwserverInterceptors(serverOpts.interceptors),
+				Provider:        p,
+				ShutdownTimeout: serverOpts.shutdownTimeout,
 			},
 		}
 	}
@@ -33,11 +39,15 @@ func NewProtocol5(p tfsdk.Provider) func() tfprotov5.ProviderServer {
 // github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource.TestCase.ProtoV5ProviderFactories.
 //
 // The error return is not currently used, but it may be in the future.
-func NewProtocol5WithError(p tfsdk.Provider) func() (tfprotov5.ProviderServer, error) {
+func NewProtocol5WithError(p tfsdk.Provider, opts ...ServerOpt) func() (tfprotov5.ProviderServer, error) {
+	serverOpts := newServerOpts(opts)
+
 	return func() (tfprotov5.ProviderServer, error) {
 		return &proto5server.Server{
 			FrameworkServer: fwserver.Server{
-				Provider: p,
+				Interceptors:    fwserverInterceptors(serverOpts.interceptors),
+				Provider:        p,
+				ShutdownTimeout: serverOpts.shutdownTimeout,
 			},
 		}, nil
 	}
@@ -47,11 +57,15 @@ func NewProtocol5WithError(p tfsdk.Provider) func() (tfprotov5.ProviderServer, e
 // based on the given Provider and suitable for usage with the
 // github.com/hashicorp/terraform-plugin-go/tfprotov6/tf6server.Serve()
 // function and various terraform-plugin-mux functions.
-func NewProtocol6(p tfsdk.Provider) func() tfprotov6.ProviderServer {
+func NewProtocol6(p tfsdk.Provider, opts ...ServerOpt) func() tfprotov6.ProviderServer {
+	serverOpts := newServerOpts(opts)
+
 	return func() tfprotov6.ProviderServer {
 		return &proto6server.Server{
 			FrameworkServer: fwserver.Server{
-				Provider: p,
+				Interceptors:    fwserverInterceptors(serverOpts.interceptors),
+				Provider:        p,
+				ShutdownTimeout: serverOpts.shutdownTimeout,
 			},
 		}
 	}
@@ -62,17 +76,23 @@ func NewProtocol6(p tfsdk.Provider) func() tfprotov6.ProviderServer {
 // github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource.TestCase.ProtoV6ProviderFactories.
 //
 // The error return is not currently used, but it may be in the future.
-func NewProtocol6WithError(p tfsdk.Provider) func() (tfprotov6.ProviderServer, error) {
+func NewProtocol6WithError(p tfsdk.Provider, opts ...ServerOpt) func() (tfprotov6.ProviderServer, error) {
+	serverOpts := newServerOpts(opts)
+
 	return func() (tfprotov6.ProviderServer, error) {
 		return &proto6server.Server{
 			FrameworkServer: fwserver.Server{
-				Provider: p,
+				Interceptors:    fwserverInterceptors(serverOpts.interceptors),
+				Provider:        p,
+				ShutdownTimeout: serverOpts.shutdownTimeout,
 			},
 		}, nil
 	}
 }
 
-// Serve serves a provider, blocking until the context is canceled.
+// Serve serves a provider, blocking until the context is canceled. Before
+// returning, the Close method of providers implementing
+// tfsdk.ProviderWithClose is called.
 func Serve(ctx context.Context, providerFunc func() tfsdk.Provider, opts ServeOpts) error {
 	err := opts.validate(ctx)
 
@@ -80,6 +100,8 @@ func Serve(ctx context.Context, providerFunc func() tfsdk.Provider, opts ServeOp
 		return fmt.Errorf("unable to validate ServeOpts: %w", err)
 	}
 
+	servers := &frameworkServers{}
+
 	switch opts.ProtocolVersion {
 	case 5:
 		var tf5serverOpts []tf5server.ServeOpt
@@ -88,19 +110,27 @@ func Serve(ctx context.Context, providerFunc func() tfsdk.Provider, opts ServeOp
 			tf5serverOpts = append(tf5serverOpts, tf5server.WithManagedDebug())
 		}
 
-		return tf5server.Serve(
+		err := tf5server.Serve(
 			opts.Address,
 			func() tfprotov5.ProviderServer {
 				provider := providerFunc()
 
-				return &proto5server.Server{
+				server := &proto5server.Server{
 					FrameworkServer: fwserver.Server{
-						Provider: provider,
+						Interceptors:    fwserverInterceptors(opts.Interceptors),
+						Provider:        provider,
+						ShutdownTimeout: opts.ShutdownTimeout,
 					},
 				}
+
+				servers.add(&server.FrameworkServer)
+
+				return server
 			},
 			tf5serverOpts...,
 		)
+
+		return servers.close(ctx, err)
 	default:
 		var tf6serverOpts []tf6server.ServeOpt
 
@@ -108,18 +138,70 @@ func Serve(ctx context.Context, providerFunc func() tfsdk.Provider, opts ServeOp
 			tf6serverOpts = append(tf6serverOpts, tf6server.WithManagedDebug())
 		}
 
-		return tf6server.Serve(
+		err := tf6server.Serve(
 			opts.Address,
 			func() tfprotov6.ProviderServer {
 				provider := providerFunc()
 
-				return &proto6server.Server{
+				server := &proto6server.Server{
 					FrameworkServer: fwserver.Server{
-						Provider: provider,
+						Interceptors:    fwserverInterceptors(opts.Interceptors),
+						Provider:        provider,
+						ShutdownTimeout: opts.ShutdownTimeout,
 					},
 				}
+
+				servers.add(&server.FrameworkServer)
+
+				return server
 			},
 			tf6serverOpts...,
 		)
+
+		return servers.close(ctx, err)
 	}
 }
+
+// frameworkServers tracks the framework servers created by Serve, so
+// providers can be closed after serving.
+type frameworkServers struct {
+	servers []*fwserver.Server
+	mutex   sync.Mutex
+}
+
+// add tracks the given framework server.
+func (s *frameworkServers) add(server *fwserver.Server) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.servers = append(s.servers, server)
+}
+
+// close calls CloseProvider on all tracked framework servers. The given serve
+// error is returned unchanged, unless closing returned error diagnostics.
+func (s *frameworkServers) close(ctx context.Context, serveErr error) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	var errs []string
+
+	for _, server := range s.servers {
+		resp := &tfsdk.CloseProviderResponse{}
+
+		server.CloseProvider(ctx, &tfsdk.CloseProviderRequest{}, resp)
+
+		for _, diagnostic := range resp.Diagnostics.Errors() {
+			errs = append(errs, diagnostic.Summary()+": "+diagnostic.Detail())
+		}
+	}
+
+	if len(errs) == 0 {
+		return serveErr
+	}
+
+	if serveErr != nil {
+		return fmt.Errorf("%w; unable to close provider: %s", serveErr, strings.Join(errs, "; "))
+	}
+
+	return fmt.Errorf("unable to close provider: %s", strings.Join(errs, "; "))
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
@@ -70,3 +71,75 @@ func TestNewProtocol6WithError(t *testing.T) {
 		t.Fatalf("unexpected error calling ProviderServer: %s", err)
 	}
 }
+
+func TestNewProtocol5WithInterceptors(t *testing.T) {
+	provider := &testprovider.Provider{}
+
+	var rpcs []string
+
+	interceptor := func(ctx context.Context, req InterceptorRequest, resp *InterceptorResponse, next func(context.Context)) {
+		rpcs = append(rpcs, req.RPC)
+		next(ctx)
+		resp.Diagnostics.AddWarning("interceptor summary", "interceptor detail")
+	}
+
+	providerServer := NewProtocol5(provider, WithInterceptors(interceptor))()
+
+	resp, err := providerServer.ConfigureProvider(context.Background(), &tfprotov5.ConfigureProviderRequest{})
+
+	if err != nil {
+		t.Fatalf("unexpected error calling ProviderServer: %s", err)
+	}
+
+	expectedDiagnostics := []*tfprotov5.Diagnostic{
+		{
+			Severity: tfprotov5.DiagnosticSeverityWarning,
+			Summary:  "interceptor summary",
+			Detail:   "interceptor detail",
+		},
+	}
+
+	if diff := cmp.Diff(resp.Diagnostics, expectedDiagnostics); diff != "" {
+		t.Errorf("unexpected diagnostics difference: %s", diff)
+	}
+
+	if diff := cmp.Diff(rpcs, []string{RPCConfigureProvider}); diff != "" {
+		t.Errorf("unexpected RPCs difference: %s", diff)
+	}
+}
+
+func TestNewProtocol6WithInterceptors(t *testing.T) {
+	provider := &testprovider.Provider{}
+
+	var rpcs []string
+
+	interceptor := func(ctx context.Context, req InterceptorRequest, resp *InterceptorResponse, next func(context.Context)) {
+		rpcs = append(rpcs, req.RPC)
+		next(ctx)
+		resp.Diagnostics.AddWarning("interceptor summary", "interceptor detail")
+	}
+
+	providerServer := NewProtocol6(provider, WithInterceptors(interceptor))()
+
+	resp, err := providerServer.ConfigureProvider(context.Background(), &tfprotov6.ConfigureProviderRequest{})
+
+	if err != nil {
+		t.Fatalf("unexpected error calling ProviderServer: %s", err)
+	}
+
+	expectedDiagnostics := []*tfprotov6.Diagnostic{
+		{
+			Severity: tfprotov6.DiagnosticSeverityWarning,
+			Summary:  "interceptor summary",
+			Detail:   "interceptor detail",
+		},
+	}
+
+	if diff := cmp.Diff(resp.Diagnostics, expectedDiagnostics); diff != "" {
+		t.Errorf("unexpected diagnostics difference: %s", diff)
+	}
+
+	if diff := cmp.Diff(rpcs, []string{RPCConfigureProvider}); diff != "" {
+		t.Errorf("unexpected RPCs difference: %s", diff)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 )
 
 // ServeOpts are options for serving the provider.
@@ -21,6 +22,11 @@ type ServeOpts struct {
 	// os.Interrupt (Ctrl-c) can be used to stop the provider.
 	Debug bool
 
+	// Interceptors wrap each RPC, such as for request timing or audit
+	// logging. Interceptors are called in order, so the first Interceptor is
+	// the outermost.
+	Interceptors []Interceptor
+
 	// ProtocolVersion is the protocol version that should be used when serving
 	// the provider. Either protocol version 5 or protocol version 6 can be
 	// used. Defaults to protocol version 6.
@@ -31,6 +37,11 @@ type ServeOpts struct {
 	//     - tfsdk.Attribute cannot use Attributes field (nested attributes).
 	//
 	ProtocolVersion int
+
+	// ShutdownTimeout is the maximum duration to wait for the provider
+	// defined Stop method of tfsdk.ProviderWithStop and Close method of
+	// tfsdk.ProviderWithClose. Defaults to 30 seconds.
+	ShutdownTimeout time.Duration
 }
 
 // Validate a given provider address. This is only used for the Address field
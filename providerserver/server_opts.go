@@ -0,0 +1,45 @@
+package providerserver
+
+import (
+	"time"
+)
+
+// ServerOpt is an option for the NewProtocol5, NewProtocol5WithError,
+// NewProtocol6, and NewProtocol6WithError functions.
+type ServerOpt func(*serverOpts)
+
+// serverOpts contains the options applied by ServerOpt.
+type serverOpts struct {
+	interceptors    []Interceptor
+	shutdownTimeout time.Duration
+}
+
+// WithInterceptors returns a ServerOpt which wraps each RPC with the given
+// Interceptors. Interceptors are called in order, so the first Interceptor
+// is the outermost.
+func WithInterceptors(interceptors ...Interceptor) ServerOpt {
+	return func(opts *serverOpts) {
+		opts.interceptors = append(opts.interceptors, interceptors...)
+	}
+}
+
+// WithShutdownTimeout returns a ServerOpt which sets the maximum duration to
+// wait for the provider defined Stop method of tfsdk.ProviderWithStop and
+// Close method of tfsdk.ProviderWithClose. Each method is given its own
+// timeout. Defaults to 30 seconds.
+func WithShutdownTimeout(timeout time.Duration) ServerOpt {
+	return func(opts *serverOpts) {
+		opts.shutdownTimeout = timeout
+	}
+}
+
+// newServerOpts applies the given ServerOpt.
+func newServerOpts(opts []ServerOpt) serverOpts {
+	result := serverOpts{}
+
+	for _, opt := range opts {
+		opt(&result)
+	}
+
+	return result
+}
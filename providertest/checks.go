@@ -0,0 +1,890 @@
+package providertest
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// bugInProviderDetail is appended to consistency check diagnostics, matching
+// the Terraform core messaging.
+const bugInProviderDetail = "This is a bug in the provider, which should be reported in the provider's own issue tracker."
+
+// proposedNewElementFunc returns the proposed new value of a collection
+// element from its prior state and configuration values.
+type proposedNewElementFunc func(prior tftypes.Value, config tftypes.Value) (tftypes.Value, error)
+
+// proposedNewState returns the proposed new state sent to the
+// PlanResourceChange RPC, which is the configuration with any null Computed
+// attribute values replaced by the prior state value, including Computed
+// attributes within nested attributes and blocks.
+func proposedNewState(ctx context.Context, schema tfsdk.Schema, prior tftypes.Value, config tftypes.Value) (tftypes.Value, error) {
+	return proposedNewObject(schema.Attributes, schema.Blocks, prior, config)
+}
+
+// proposedNewObject returns the proposed new value of an object containing
+// the attributes and blocks.
+func proposedNewObject(attributes map[string]tfsdk.Attribute, blocks map[string]tfsdk.Block, prior tftypes.Value, config tftypes.Value) (tftypes.Value, error) {
+	// Without a prior value, Computed attributes have no value to keep.
+	if config.IsNull() || !config.IsKnown() || prior.IsNull() || !prior.IsKnown() {
+		return config, nil
+	}
+
+	var priorValues, configValues map[string]tftypes.Value
+
+	if err := prior.As(&priorValues); err != nil {
+		return tftypes.Value{}, fmt.Errorf("unable to read prior state: %w", err)
+	}
+
+	if err := config.As(&configValues); err != nil {
+		return tftypes.Value{}, fmt.Errorf("unable to read configuration: %w", err)
+	}
+
+	values := make(map[string]tftypes.Value, len(configValues))
+
+	for name, configValue := range configValues {
+		priorValue, ok := priorValues[name]
+
+		if !ok {
+			values[name] = configValue
+
+			continue
+		}
+
+		var value tftypes.Value
+		var err error
+
+		if attribute, ok := attributes[name]; ok {
+			value, err = proposedNewAttribute(attribute, priorValue, configValue)
+		} else if block, ok := blocks[name]; ok {
+			value, err = proposedNewBlock(block, priorValue, configValue)
+		} else {
+			err = fmt.Errorf("%q is not defined in the schema", name)
+		}
+
+		if err != nil {
+			return tftypes.Value{}, fmt.Errorf("%s: %w", name, err)
+		}
+
+		values[name] = value
+	}
+
+	return tftypes.NewValue(config.Type(), values), nil
+}
+
+// proposedNewAttribute returns the proposed new value of an attribute.
+func proposedNewAttribute(attribute tfsdk.Attribute, prior tftypes.Value, config tftypes.Value) (tftypes.Value, error) {
+	if attribute.Computed && config.IsNull() {
+		return prior, nil
+	}
+
+	if attribute.Attributes == nil || config.IsNull() || !config.IsKnown() || prior.IsNull() || !prior.IsKnown() {
+		return config, nil
+	}
+
+	nestedAttributes := attribute.Attributes.GetAttributes()
+	proposeElement := func(prior tftypes.Value, config tftypes.Value) (tftypes.Value, error) {
+		return proposedNewObject(nestedAttributes, nil, prior, config)
+	}
+
+	switch attribute.Attributes.GetNestingMode() {
+	case tfsdk.NestingModeSingle:
+		return proposeElement(prior, config)
+	case tfsdk.NestingModeList:
+		return proposedNewList(prior, config, proposeElement)
+	case tfsdk.NestingModeSet:
+		return proposedNewSet(nestedAttributes, nil, prior, config, proposeElement)
+	case tfsdk.NestingModeMap:
+		return proposedNewMap(prior, config, proposeElement)
+	default:
+		return tftypes.Value{}, fmt.Errorf("unsupported nested attributes nesting mode %d", attribute.Attributes.GetNestingMode())
+	}
+}
+
+// proposedNewBlock returns the proposed new value of a block.
+func proposedNewBlock(block tfsdk.Block, prior tftypes.Value, config tftypes.Value) (tftypes.Value, error) {
+	if config.IsNull() || !config.IsKnown() || prior.IsNull() || !prior.IsKnown() {
+		return config, nil
+	}
+
+	proposeElement := func(prior tftypes.Value, config tftypes.Value) (tftypes.Value, error) {
+		return proposedNewObject(block.Attributes, block.Blocks, prior, config)
+	}
+
+	switch block.NestingMode {
+	case tfsdk.BlockNestingModeList:
+		return proposedNewList(prior, config, proposeElement)
+	case tfsdk.BlockNestingModeSet:
+		return proposedNewSet(block.Attributes, block.Blocks, prior, config, proposeElement)
+	default:
+		return tftypes.Value{}, fmt.Errorf("unsupported block nesting mode %d", block.NestingMode)
+	}
+}
+
+// proposedNewList returns the proposed new value of a list, where elements
+// are correlated with prior elements by index.
+func proposedNewList(prior tftypes.Value, config tftypes.Value, proposeElement proposedNewElementFunc) (tftypes.Value, error) {
+	var priorElems, configElems []tftypes.Value
+
+	if err := prior.As(&priorElems); err != nil {
+		return tftypes.Value{}, fmt.Errorf("unable to read prior state: %w", err)
+	}
+
+	if err := config.As(&configElems); err != nil {
+		return tftypes.Value{}, fmt.Errorf("unable to read configuration: %w", err)
+	}
+
+	elems := make([]tftypes.Value, 0, len(configElems))
+
+	for idx, configElem := range configElems {
+		if idx >= len(priorElems) {
+			elems = append(elems, configElem)
+
+			continue
+		}
+
+		elem, err := proposeElement(priorElems[idx], configElem)
+
+		if err != nil {
+			return tftypes.Value{}, fmt.Errorf("[%d]: %w", idx, err)
+		}
+
+		elems = append(elems, elem)
+	}
+
+	return tftypes.NewValue(config.Type(), elems), nil
+}
+
+// proposedNewMap returns the proposed new value of a map, where elements are
+// correlated with prior elements by key.
+func proposedNewMap(prior tftypes.Value, config tftypes.Value, proposeElement proposedNewElementFunc) (tftypes.Value, error) {
+	var priorElems, configElems map[string]tftypes.Value
+
+	if err := prior.As(&priorElems); err != nil {
+		return tftypes.Value{}, fmt.Errorf("unable to read prior state: %w", err)
+	}
+
+	if err := config.As(&configElems); err != nil {
+		return tftypes.Value{}, fmt.Errorf("unable to read configuration: %w", err)
+	}
+
+	elems := make(map[string]tftypes.Value, len(configElems))
+
+	for key, configElem := range configElems {
+		priorElem, ok := priorElems[key]
+
+		if !ok {
+			elems[key] = configElem
+
+			continue
+		}
+
+		elem, err := proposeElement(priorElem, configElem)
+
+		if err != nil {
+			return tftypes.Value{}, fmt.Errorf("[%q]: %w", key, err)
+		}
+
+		elems[key] = elem
+	}
+
+	return tftypes.NewValue(config.Type(), elems), nil
+}
+
+// proposedNewSet returns the proposed new value of a set of objects
+// containing the attributes and blocks. As in Terraform core, elements are
+// correlated with a prior element if the configuration element equals the
+// prior element with all Computed attributes set to null, so any
+// configuration change results in a new element.
+func proposedNewSet(attributes map[string]tfsdk.Attribute, blocks map[string]tfsdk.Block, prior tftypes.Value, config tftypes.Value, proposeElement proposedNewElementFunc) (tftypes.Value, error) {
+	var priorElems, configElems []tftypes.Value
+
+	if err := prior.As(&priorElems); err != nil {
+		return tftypes.Value{}, fmt.Errorf("unable to read prior state: %w", err)
+	}
+
+	if err := config.As(&configElems); err != nil {
+		return tftypes.Value{}, fmt.Errorf("unable to read configuration: %w", err)
+	}
+
+	compareElems := make([]tftypes.Value, 0, len(priorElems))
+
+	for _, priorElem := range priorElems {
+		compareElem, err := setElementCompareValue(attributes, blocks, priorElem)
+
+		if err != nil {
+			return tftypes.Value{}, err
+		}
+
+		compareElems = append(compareElems, compareElem)
+	}
+
+	used := make([]bool, len(priorElems))
+	elems := make([]tftypes.Value, 0, len(configElems))
+
+	for _, configElem := range configElems {
+		elem := configElem
+
+		for idx, compareElem := range compareElems {
+			if used[idx] || !compareElem.Equal(configElem) {
+				continue
+			}
+
+			used[idx] = true
+
+			var err error
+
+			elem, err = proposeElement(priorElems[idx], configElem)
+
+			if err != nil {
+				return tftypes.Value{}, err
+			}
+
+			break
+		}
+
+		elems = append(elems, elem)
+	}
+
+	return tftypes.NewValue(config.Type(), elems), nil
+}
+
+// setElementCompareValue returns the object value with all Computed
+// attributes, including those within nested attributes and blocks, set to
+// null.
+func setElementCompareValue(attributes map[string]tfsdk.Attribute, blocks map[string]tfsdk.Block, value tftypes.Value) (tftypes.Value, error) {
+	if value.IsNull() || !value.IsKnown() {
+		return value, nil
+	}
+
+	var values map[string]tftypes.Value
+
+	if err := value.As(&values); err != nil {
+		return tftypes.Value{}, fmt.Errorf("unable to read set element: %w", err)
+	}
+
+	result := make(map[string]tftypes.Value, len(values))
+
+	for name, v := range values {
+		result[name] = v
+
+		if attribute, ok := attributes[name]; ok {
+			if attribute.Computed {
+				result[name] = tftypes.NewValue(v.Type(), nil)
+
+				continue
+			}
+
+			if attribute.Attributes == nil {
+				continue
+			}
+
+			nestedAttributes := attribute.Attributes.GetAttributes()
+			compareValue, err := transformElements(v, func(elem tftypes.Value) (tftypes.Value, error) {
+				return setElementCompareValue(nestedAttributes, nil, elem)
+			})
+
+			if err != nil {
+				return tftypes.Value{}, err
+			}
+
+			result[name] = compareValue
+
+			continue
+		}
+
+		if block, ok := blocks[name]; ok {
+			compareValue, err := transformElements(v, func(elem tftypes.Value) (tftypes.Value, error) {
+				return setElementCompareValue(block.Attributes, block.Blocks, elem)
+			})
+
+			if err != nil {
+				return tftypes.Value{}, err
+			}
+
+			result[name] = compareValue
+		}
+	}
+
+	return tftypes.NewValue(value.Type(), result), nil
+}
+
+// transformElements returns the value with transform applied to each list,
+// set, or map element, or to the value itself if it is an object.
+func transformElements(value tftypes.Value, transform func(tftypes.Value) (tftypes.Value, error)) (tftypes.Value, error) {
+	if value.IsNull() || !value.IsKnown() {
+		return value, nil
+	}
+
+	switch {
+	case value.Type().Is(tftypes.List{}), value.Type().Is(tftypes.Set{}):
+		var elems []tftypes.Value
+
+		if err := value.As(&elems); err != nil {
+			return tftypes.Value{}, err
+		}
+
+		for idx, elem := range elems {
+			transformed, err := transform(elem)
+
+			if err != nil {
+				return tftypes.Value{}, err
+			}
+
+			elems[idx] = transformed
+		}
+
+		return tftypes.NewValue(value.Type(), elems), nil
+	case value.Type().Is(tftypes.Map{}):
+		var elems map[string]tftypes.Value
+
+		if err := value.As(&elems); err != nil {
+			return tftypes.Value{}, err
+		}
+
+		for key, elem := range elems {
+			transformed, err := transform(elem)
+
+			if err != nil {
+				return tftypes.Value{}, err
+			}
+
+			elems[key] = transformed
+		}
+
+		return tftypes.NewValue(value.Type(), elems), nil
+	default:
+		return transform(value)
+	}
+}
+
+// planValidDiags returns error diagnostics if the planned state is not valid
+// for the configuration and prior state. As in Terraform core, the planned
+// value of each attribute, including attributes within nested attributes and
+// blocks, must match the configuration value unless the attribute is
+// Computed and not configured, or the planned value is the prior value.
+func planValidDiags(typeName string, schema tfsdk.Schema, prior tftypes.Value, config tftypes.Value, planned tftypes.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if config.IsNull() {
+		if !planned.IsNull() {
+			diags.AddError(
+				"Provider produced invalid plan",
+				fmt.Sprintf("Provider %q planned a non-null destroy-time value.\n\n%s", typeName, bugInProviderDetail),
+			)
+		}
+
+		return diags
+	}
+
+	if planned.IsNull() {
+		diags.AddError(
+			"Provider produced invalid plan",
+			fmt.Sprintf("Provider %q planned a null value for a configured resource.\n\n%s", typeName, bugInProviderDetail),
+		)
+
+		return diags
+	}
+
+	return planValidObjectDiags(typeName, schema.Attributes, schema.Blocks, prior, config, planned, tftypes.NewAttributePath())
+}
+
+// planValidObjectDiags returns error diagnostics if the planned value of any
+// attribute or block of an object is not valid.
+func planValidObjectDiags(typeName string, attributes map[string]tfsdk.Attribute, blocks map[string]tfsdk.Block, prior tftypes.Value, config tftypes.Value, planned tftypes.Value, tfPath *tftypes.AttributePath) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	priorValues, err := objectValues(prior)
+
+	if err != nil {
+		return invalidPlanDiags(typeName, tfPath, "unable to read prior state: "+err.Error())
+	}
+
+	configValues, err := objectValues(config)
+
+	if err != nil {
+		return invalidPlanDiags(typeName, tfPath, "unable to read configuration: "+err.Error())
+	}
+
+	plannedValues, err := objectValues(planned)
+
+	if err != nil {
+		return invalidPlanDiags(typeName, tfPath, "unable to read planned state: "+err.Error())
+	}
+
+	for _, name := range sortedNames(attributes) {
+		configValue, plannedValue, ok := configAndPlannedValues(configValues, plannedValues, name)
+
+		if !ok {
+			continue
+		}
+
+		priorValue := valueOrNull(priorValues, name, configValue.Type())
+
+		diags.Append(planValidAttributeDiags(typeName, attributes[name], priorValue, configValue, plannedValue, tfPath.WithAttributeName(name))...)
+	}
+
+	for _, name := range sortedNames(blocks) {
+		configValue, plannedValue, ok := configAndPlannedValues(configValues, plannedValues, name)
+
+		if !ok {
+			continue
+		}
+
+		priorValue := valueOrNull(priorValues, name, configValue.Type())
+
+		diags.Append(planValidBlockDiags(typeName, blocks[name], priorValue, configValue, plannedValue, tfPath.WithAttributeName(name))...)
+	}
+
+	return diags
+}
+
+// planValidAttributeDiags returns error diagnostics if the planned value of
+// an attribute is not valid.
+func planValidAttributeDiags(typeName string, attribute tfsdk.Attribute, prior tftypes.Value, config tftypes.Value, planned tftypes.Value, tfPath *tftypes.AttributePath) diag.Diagnostics {
+	if planned.Equal(config) {
+		return nil
+	}
+
+	// The provider kept the prior value, which it considers equivalent to
+	// the configuration value.
+	if !prior.IsNull() && !config.IsNull() && planned.Equal(prior) {
+		return nil
+	}
+
+	switch {
+	case attribute.Computed && !attribute.Optional:
+		return nil
+	case attribute.Computed && config.IsNull():
+		return nil
+	case config.IsNull() && !planned.IsNull():
+		return invalidPlanDiags(typeName, tfPath, fmt.Sprintf("planned value %s for a non-computed attribute", planned))
+	}
+
+	if attribute.Attributes != nil {
+		return planValidNestedAttributesDiags(typeName, attribute.Attributes, prior, config, planned, tfPath)
+	}
+
+	if prior.IsNull() {
+		return invalidPlanDiags(typeName, tfPath, fmt.Sprintf("planned value %s does not match config value %s", planned, config))
+	}
+
+	return invalidPlanDiags(typeName, tfPath, fmt.Sprintf("planned value %s does not match config value %s nor prior value %s", planned, config, prior))
+}
+
+// planValidNestedAttributesDiags returns error diagnostics if the planned
+// value of a nested attributes attribute is not valid.
+func planValidNestedAttributesDiags(typeName string, nestedAttributes tfsdk.NestedAttributes, prior tftypes.Value, config tftypes.Value, planned tftypes.Value, tfPath *tftypes.AttributePath) diag.Diagnostics {
+	if planned.IsNull() {
+		if !config.IsNull() {
+			return invalidPlanDiags(typeName, tfPath, "planned for absence but config wants existence")
+		}
+
+		return nil
+	}
+
+	if config.IsNull() {
+		return invalidPlanDiags(typeName, tfPath, "planned for existence but config wants absence")
+	}
+
+	if !planned.IsKnown() || !config.IsKnown() {
+		return nil
+	}
+
+	attributes := nestedAttributes.GetAttributes()
+	validateElement := func(prior tftypes.Value, config tftypes.Value, planned tftypes.Value, tfPath *tftypes.AttributePath) diag.Diagnostics {
+		return planValidObjectDiags(typeName, attributes, nil, prior, config, planned, tfPath)
+	}
+
+	switch nestedAttributes.GetNestingMode() {
+	case tfsdk.NestingModeSingle:
+		return validateElement(prior, config, planned, tfPath)
+	case tfsdk.NestingModeList:
+		return planValidListDiags(typeName, "element", prior, config, planned, tfPath, validateElement)
+	case tfsdk.NestingModeSet:
+		return planValidSetDiags(typeName, "element", config, planned, tfPath)
+	case tfsdk.NestingModeMap:
+		return planValidMapDiags(typeName, prior, config, planned, tfPath, validateElement)
+	default:
+		return invalidPlanDiags(typeName, tfPath, fmt.Sprintf("unsupported nested attributes nesting mode %d", nestedAttributes.GetNestingMode()))
+	}
+}
+
+// planValidBlockDiags returns error diagnostics if the planned value of a
+// block is not valid.
+func planValidBlockDiags(typeName string, block tfsdk.Block, prior tftypes.Value, config tftypes.Value, planned tftypes.Value, tfPath *tftypes.AttributePath) diag.Diagnostics {
+	if !planned.IsKnown() {
+		return invalidPlanDiags(typeName, tfPath, "attribute representing nested block must not be unknown itself; set nested attribute values to unknown instead")
+	}
+
+	if planned.IsNull() {
+		return invalidPlanDiags(typeName, tfPath, "attribute representing nested blocks must be empty to indicate no blocks, not null")
+	}
+
+	if config.IsNull() || !config.IsKnown() {
+		return nil
+	}
+
+	validateElement := func(prior tftypes.Value, config tftypes.Value, planned tftypes.Value, tfPath *tftypes.AttributePath) diag.Diagnostics {
+		return planValidObjectDiags(typeName, block.Attributes, block.Blocks, prior, config, planned, tfPath)
+	}
+
+	switch block.NestingMode {
+	case tfsdk.BlockNestingModeList:
+		return planValidListDiags(typeName, "block", prior, config, planned, tfPath, validateElement)
+	case tfsdk.BlockNestingModeSet:
+		return planValidSetDiags(typeName, "block", config, planned, tfPath)
+	default:
+		return invalidPlanDiags(typeName, tfPath, fmt.Sprintf("unsupported block nesting mode %d", block.NestingMode))
+	}
+}
+
+// planValidListDiags returns error diagnostics if the planned list does not
+// have the same number of elements as the configuration or any element is
+// not valid. Elements are correlated by index.
+func planValidListDiags(typeName string, kind string, prior tftypes.Value, config tftypes.Value, planned tftypes.Value, tfPath *tftypes.AttributePath, validateElement func(tftypes.Value, tftypes.Value, tftypes.Value, *tftypes.AttributePath) diag.Diagnostics) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var priorElems, configElems, plannedElems []tftypes.Value
+
+	if !prior.IsNull() && prior.IsKnown() {
+		if err := prior.As(&priorElems); err != nil {
+			return invalidPlanDiags(typeName, tfPath, "unable to read prior state: "+err.Error())
+		}
+	}
+
+	if err := config.As(&configElems); err != nil {
+		return invalidPlanDiags(typeName, tfPath, "unable to read configuration: "+err.Error())
+	}
+
+	if err := planned.As(&plannedElems); err != nil {
+		return invalidPlanDiags(typeName, tfPath, "unable to read planned state: "+err.Error())
+	}
+
+	if len(plannedElems) != len(configElems) {
+		return invalidPlanDiags(typeName, tfPath, fmt.Sprintf("%s count in plan (%d) disagrees with count in config (%d)", kind, len(plannedElems), len(configElems)))
+	}
+
+	for idx, plannedElem := range plannedElems {
+		elemPath := tfPath.WithElementKeyInt(idx)
+
+		if !plannedElem.IsKnown() {
+			diags.Append(invalidPlanDiags(typeName, elemPath, "element must not be unknown itself; set nested attribute values to unknown instead")...)
+
+			continue
+		}
+
+		priorElem := tftypes.NewValue(configElems[idx].Type(), nil)
+
+		if idx < len(priorElems) {
+			priorElem = priorElems[idx]
+		}
+
+		diags.Append(validateElement(priorElem, configElems[idx], plannedElem, elemPath)...)
+	}
+
+	return diags
+}
+
+// planValidMapDiags returns error diagnostics if the planned map does not
+// have the same keys as the configuration or any element is not valid.
+func planValidMapDiags(typeName string, prior tftypes.Value, config tftypes.Value, planned tftypes.Value, tfPath *tftypes.AttributePath, validateElement func(tftypes.Value, tftypes.Value, tftypes.Value, *tftypes.AttributePath) diag.Diagnostics) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var priorElems, configElems, plannedElems map[string]tftypes.Value
+
+	if !prior.IsNull() && prior.IsKnown() {
+		if err := prior.As(&priorElems); err != nil {
+			return invalidPlanDiags(typeName, tfPath, "unable to read prior state: "+err.Error())
+		}
+	}
+
+	if err := config.As(&configElems); err != nil {
+		return invalidPlanDiags(typeName, tfPath, "unable to read configuration: "+err.Error())
+	}
+
+	if err := planned.As(&plannedElems); err != nil {
+		return invalidPlanDiags(typeName, tfPath, "unable to read planned state: "+err.Error())
+	}
+
+	for _, key := range sortedKeys(plannedElems) {
+		if _, ok := configElems[key]; !ok {
+			diags.Append(invalidPlanDiags(typeName, tfPath, fmt.Sprintf("planned key %q does not exist in config", key))...)
+		}
+	}
+
+	for _, key := range sortedKeys(configElems) {
+		configElem := configElems[key]
+		plannedElem, ok := plannedElems[key]
+
+		if !ok {
+			diags.Append(invalidPlanDiags(typeName, tfPath, fmt.Sprintf("missing planned key %q", key))...)
+
+			continue
+		}
+
+		diags.Append(validateElement(valueOrNull(priorElems, key, configElem.Type()), configElem, plannedElem, tfPath.WithElementKeyString(key))...)
+	}
+
+	return diags
+}
+
+// planValidSetDiags returns error diagnostics if the planned set does not
+// have the same number of elements as the configuration or contains unknown
+// elements. As in Terraform core, set elements cannot be correlated with the
+// configuration, so their values are not validated.
+func planValidSetDiags(typeName string, kind string, config tftypes.Value, planned tftypes.Value, tfPath *tftypes.AttributePath) diag.Diagnostics {
+	var configElems, plannedElems []tftypes.Value
+
+	if err := config.As(&configElems); err != nil {
+		return invalidPlanDiags(typeName, tfPath, "unable to read configuration: "+err.Error())
+	}
+
+	if err := planned.As(&plannedElems); err != nil {
+		return invalidPlanDiags(typeName, tfPath, "unable to read planned state: "+err.Error())
+	}
+
+	for _, plannedElem := range plannedElems {
+		if !plannedElem.IsKnown() {
+			return invalidPlanDiags(typeName, tfPath, "element must not be unknown itself; set nested attribute values to unknown instead")
+		}
+	}
+
+	// Elements with unknown values may be combined once known, so the
+	// counts can only be compared if the planned set is fully known.
+	if planned.IsFullyKnown() && config.IsFullyKnown() && len(plannedElems) != len(configElems) {
+		return invalidPlanDiags(typeName, tfPath, fmt.Sprintf("%s count in plan (%d) disagrees with count in config (%d)", kind, len(plannedElems), len(configElems)))
+	}
+
+	return nil
+}
+
+// invalidPlanDiags returns the error diagnostics for an invalid planned value.
+func invalidPlanDiags(typeName string, tfPath *tftypes.AttributePath, detail string) diag.Diagnostics {
+	return diag.Diagnostics{
+		diag.NewErrorDiagnostic(
+			"Provider produced invalid plan",
+			fmt.Sprintf("Provider %q planned an invalid value for %s: %s.\n\n%s", typeName, terraformPathString(typeName, tfPath), detail, bugInProviderDetail),
+		),
+	}
+}
+
+// objectValues returns the attribute values of an object value, which are
+// empty if the value is null or unknown.
+func objectValues(value tftypes.Value) (map[string]tftypes.Value, error) {
+	var values map[string]tftypes.Value
+
+	if value.IsNull() || !value.IsKnown() {
+		return values, nil
+	}
+
+	err := value.As(&values)
+
+	return values, err
+}
+
+// configAndPlannedValues returns the configuration and planned values of an
+// attribute or block. The boolean is false if either value is missing.
+func configAndPlannedValues(configValues map[string]tftypes.Value, plannedValues map[string]tftypes.Value, name string) (tftypes.Value, tftypes.Value, bool) {
+	configValue, configOk := configValues[name]
+	plannedValue, plannedOk := plannedValues[name]
+
+	return configValue, plannedValue, configOk && plannedOk
+}
+
+// valueOrNull returns the value of the key, or a null value of the type if
+// the key is missing.
+func valueOrNull(values map[string]tftypes.Value, key string, typ tftypes.Type) tftypes.Value {
+	if value, ok := values[key]; ok {
+		return value
+	}
+
+	return tftypes.NewValue(typ, nil)
+}
+
+// terraformPathString returns a representation of the path matching
+// Terraform core consistency check diagnostics, such as
+// test_resource.block[0].name.
+func terraformPathString(typeName string, tfPath *tftypes.AttributePath) string {
+	var b strings.Builder
+
+	b.WriteString(typeName)
+
+	for _, step := range tfPath.Steps() {
+		switch step := step.(type) {
+		case tftypes.AttributeName:
+			fmt.Fprintf(&b, ".%s", string(step))
+		case tftypes.ElementKeyInt:
+			fmt.Fprintf(&b, "[%d]", int64(step))
+		case tftypes.ElementKeyString:
+			fmt.Fprintf(&b, "[%q]", string(step))
+		default:
+			fmt.Fprintf(&b, "[%v]", step)
+		}
+	}
+
+	return b.String()
+}
+
+// applyConsistentDiags returns error diagnostics if any known value in the
+// planned state was changed in the applied state or if the applied state
+// contains unknown values.
+func applyConsistentDiags(typeName string, planned tftypes.Value, applied tftypes.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !applied.IsFullyKnown() {
+		diags.AddError(
+			"Provider returned invalid result object after apply",
+			fmt.Sprintf("After the apply operation, the provider still indicated an unknown value for %s. All values must be known after apply, so this is always a bug in the provider and should be reported in the provider's own repository.", typeName),
+		)
+
+		return diags
+	}
+
+	err := tftypes.Walk(planned, func(attributePath *tftypes.AttributePath, plannedValue tftypes.Value) (bool, error) {
+		if !plannedValue.IsKnown() {
+			return false, nil
+		}
+
+		rawAppliedValue, _, err := tftypes.WalkAttributePath(applied, attributePath)
+
+		if err != nil {
+			diags.AddError(
+				"Provider produced inconsistent result after apply",
+				fmt.Sprintf("When applying changes to %s, provider produced an unexpected new value: %s was present, but now absent.\n\n%s", typeName, attributePathString(attributePath), bugInProviderDetail),
+			)
+
+			return false, nil
+		}
+
+		appliedValue, ok := rawAppliedValue.(tftypes.Value)
+
+		if !ok {
+			return false, fmt.Errorf("unexpected %T at %s", rawAppliedValue, attributePathString(attributePath))
+		}
+
+		if plannedValue.IsFullyKnown() || plannedValue.IsNull() != appliedValue.IsNull() {
+			if !plannedValue.Equal(appliedValue) {
+				diags.AddError(
+					"Provider produced inconsistent result after apply",
+					fmt.Sprintf("When applying changes to %s, provider produced an unexpected new value: %s: was %s, but now %s.\n\n%s", typeName, attributePathString(attributePath), plannedValue, appliedValue, bugInProviderDetail),
+				)
+			}
+
+			return false, nil
+		}
+
+		return true, nil
+	})
+
+	if err != nil {
+		diags.AddError(
+			"Provider produced inconsistent result after apply",
+			"Unable to compare the planned and applied values: "+err.Error(),
+		)
+	}
+
+	return diags
+}
+
+// emptyPlanDiags returns error diagnostics if the plan after apply and
+// refresh proposes any changes.
+func emptyPlanDiags(typeName string, plan *PlanResult, state tftypes.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !plan.HasChanges && len(plan.RequiresReplace) == 0 {
+		return diags
+	}
+
+	detail := fmt.Sprintf("After applying this test step and refreshing, the plan for %s was not empty.", typeName)
+
+	valueDiffs, err := state.Diff(plan.PlannedState.Raw)
+
+	if err == nil {
+		sort.Slice(valueDiffs, func(i, j int) bool {
+			return attributePathString(valueDiffs[i].Path) < attributePathString(valueDiffs[j].Path)
+		})
+
+		for _, valueDiff := range valueDiffs {
+			// Only report leaf differences, since parent values are
+			// also reported as different.
+			if !valueDiffIsLeaf(valueDiff) {
+				continue
+			}
+
+			detail += fmt.Sprintf("\n\n%s: %s => %s", attributePathString(valueDiff.Path), valueDiff.Value1, valueDiff.Value2)
+		}
+	}
+
+	if len(plan.RequiresReplace) > 0 {
+		detail += fmt.Sprintf("\n\nThe plan requires replacement due to: %s", plan.RequiresReplace)
+	}
+
+	diags.AddError("Plan not empty after apply", detail)
+
+	return diags
+}
+
+// valueDiffIsLeaf returns true if either value in the difference is missing,
+// null, unknown, or a primitive value.
+func valueDiffIsLeaf(valueDiff tftypes.ValueDiff) bool {
+	for _, value := range []*tftypes.Value{valueDiff.Value1, valueDiff.Value2} {
+		if value == nil || value.IsNull() || !value.IsKnown() {
+			return true
+		}
+
+		if value.Type().Is(tftypes.Bool) || value.Type().Is(tftypes.Number) || value.Type().Is(tftypes.String) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// attributePathString returns a human-friendly representation of a
+// tftypes.AttributePath.
+func attributePathString(attributePath *tftypes.AttributePath) string {
+	if len(attributePath.Steps()) == 0 {
+		return "(root)"
+	}
+
+	return attributePath.String()
+}
+
+// sortedNames returns the attribute or block names in sorted order, for
+// consistent diagnostics ordering.
+func sortedNames(schemaMap interface{}) []string {
+	var names []string
+
+	switch schemaMap := schemaMap.(type) {
+	case map[string]tfsdk.Attribute:
+		for name := range schemaMap {
+			names = append(names, name)
+		}
+	case map[string]tfsdk.Block:
+		for name := range schemaMap {
+			names = append(names, name)
+		}
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// sortedKeys returns the map keys in sorted order, for consistent
+// diagnostics ordering.
+func sortedKeys(values map[string]tftypes.Value) []string {
+	keys := make([]string, 0, len(values))
+
+	for key := range values {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
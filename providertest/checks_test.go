@@ -0,0 +1,231 @@
+package providertest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var testNestedElementAttributes = map[string]tfsdk.Attribute{
+	"computed": {
+		Computed: true,
+		Type:     types.StringType,
+	},
+	"value": {
+		Optional: true,
+		Type:     types.StringType,
+	},
+}
+
+var testNestedSchema = tfsdk.Schema{
+	Attributes: map[string]tfsdk.Attribute{
+		"id": {
+			Computed: true,
+			Type:     types.StringType,
+		},
+		"nested_list": {
+			Attributes: tfsdk.ListNestedAttributes(testNestedElementAttributes),
+			Optional:   true,
+		},
+		"nested_single": {
+			Attributes: tfsdk.SingleNestedAttributes(testNestedElementAttributes),
+			Optional:   true,
+		},
+	},
+	Blocks: map[string]tfsdk.Block{
+		"list_block": {
+			Attributes:  testNestedElementAttributes,
+			NestingMode: tfsdk.BlockNestingModeList,
+		},
+		"set_block": {
+			Attributes:  testNestedElementAttributes,
+			NestingMode: tfsdk.BlockNestingModeSet,
+		},
+	},
+}
+
+var testNestedElementType = tftypes.Object{
+	AttributeTypes: map[string]tftypes.Type{
+		"computed": tftypes.String,
+		"value":    tftypes.String,
+	},
+}
+
+// testNestedElement returns a nested attributes or block element value,
+// where a nil computed or value argument is a null value.
+func testNestedElement(computed interface{}, value interface{}) tftypes.Value {
+	return tftypes.NewValue(testNestedElementType, map[string]tftypes.Value{
+		"computed": tftypes.NewValue(tftypes.String, computed),
+		"value":    tftypes.NewValue(tftypes.String, value),
+	})
+}
+
+// testNestedValue returns a testNestedSchema value with a single element for
+// each nested attribute and block, other than set_block which contains the
+// setBlock elements.
+func testNestedValue(id interface{}, elem tftypes.Value, setBlock ...tftypes.Value) tftypes.Value {
+	return tftypes.NewValue(testNestedSchema.TerraformType(context.Background()), map[string]tftypes.Value{
+		"id":            tftypes.NewValue(tftypes.String, id),
+		"nested_list":   tftypes.NewValue(tftypes.List{ElementType: testNestedElementType}, []tftypes.Value{elem}),
+		"nested_single": elem,
+		"list_block":    tftypes.NewValue(tftypes.List{ElementType: testNestedElementType}, []tftypes.Value{elem}),
+		"set_block":     tftypes.NewValue(tftypes.Set{ElementType: testNestedElementType}, setBlock),
+	})
+}
+
+func TestProposedNewState(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		prior         tftypes.Value
+		config        tftypes.Value
+		expected      tftypes.Value
+		expectedError string
+	}{
+		"null-prior": {
+			prior:    tftypes.NewValue(testNestedSchema.TerraformType(context.Background()), nil),
+			config:   testNestedValue(nil, testNestedElement(nil, "test-value")),
+			expected: testNestedValue(nil, testNestedElement(nil, "test-value")),
+		},
+		"computed": {
+			prior: testNestedValue(
+				"test-id",
+				testNestedElement("test-computed", "test-value"),
+				testNestedElement("test-computed-1", "test-value-1"),
+				testNestedElement("test-computed-2", "test-value-2"),
+			),
+			config: testNestedValue(
+				nil,
+				testNestedElement(nil, "test-value"),
+				testNestedElement(nil, "test-value-2"),
+				testNestedElement(nil, "test-value-3"),
+			),
+			expected: testNestedValue(
+				"test-id",
+				testNestedElement("test-computed", "test-value"),
+				testNestedElement("test-computed-2", "test-value-2"),
+				testNestedElement(nil, "test-value-3"),
+			),
+		},
+		"unsupported-nesting-mode": {
+			prior:         testNestedValue("test-id", testNestedElement("test-computed", "test-value")),
+			config:        testNestedValue(nil, testNestedElement(nil, "test-value")),
+			expectedError: "list_block: unsupported block nesting mode 0",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			schema := testNestedSchema
+
+			if testCase.expectedError != "" {
+				schema = tfsdk.Schema{
+					Attributes: testNestedSchema.Attributes,
+					Blocks: map[string]tfsdk.Block{
+						"list_block": {
+							Attributes: testNestedElementAttributes,
+						},
+						"set_block": testNestedSchema.Blocks["set_block"],
+					},
+				}
+			}
+
+			got, err := proposedNewState(context.Background(), schema, testCase.prior, testCase.config)
+
+			if err != nil {
+				if diff := cmp.Diff(err.Error(), testCase.expectedError); diff != "" {
+					t.Fatalf("unexpected error difference: %s", diff)
+				}
+
+				return
+			}
+
+			if testCase.expectedError != "" {
+				t.Fatalf("expected error: %s", testCase.expectedError)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestPlanValidDiags(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		prior         tftypes.Value
+		config        tftypes.Value
+		planned       tftypes.Value
+		expectedDiags diag.Diagnostics
+	}{
+		"valid": {
+			prior:   tftypes.NewValue(testNestedSchema.TerraformType(context.Background()), nil),
+			config:  testNestedValue(nil, testNestedElement(nil, "test-value"), testNestedElement(nil, "test-value")),
+			planned: testNestedValue(tftypes.UnknownValue, testNestedElement(tftypes.UnknownValue, "test-value"), testNestedElement(tftypes.UnknownValue, "test-value")),
+		},
+		"prior-value": {
+			prior:   testNestedValue("test-id", testNestedElement("test-computed", "TEST-VALUE"), testNestedElement("test-computed", "TEST-VALUE")),
+			config:  testNestedValue(nil, testNestedElement(nil, "test-value"), testNestedElement(nil, "test-value")),
+			planned: testNestedValue("test-id", testNestedElement("test-computed", "TEST-VALUE"), testNestedElement("test-computed", "TEST-VALUE")),
+		},
+		"nested-value": {
+			prior:   tftypes.NewValue(testNestedSchema.TerraformType(context.Background()), nil),
+			config:  testNestedValue(nil, testNestedElement(nil, "test-value"), testNestedElement(nil, "test-value")),
+			planned: testNestedValue(tftypes.UnknownValue, testNestedElement(tftypes.UnknownValue, "test-planned-value"), testNestedElement(tftypes.UnknownValue, "test-planned-value")),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Provider produced invalid plan",
+					"Provider \"test_resource\" planned an invalid value for test_resource.nested_list[0].value: planned value tftypes.String<\"test-planned-value\"> does not match config value tftypes.String<\"test-value\">.\n\n"+
+						"This is a bug in the provider, which should be reported in the provider's own issue tracker.",
+				),
+				diag.NewErrorDiagnostic(
+					"Provider produced invalid plan",
+					"Provider \"test_resource\" planned an invalid value for test_resource.nested_single.value: planned value tftypes.String<\"test-planned-value\"> does not match config value tftypes.String<\"test-value\">.\n\n"+
+						"This is a bug in the provider, which should be reported in the provider's own issue tracker.",
+				),
+				diag.NewErrorDiagnostic(
+					"Provider produced invalid plan",
+					"Provider \"test_resource\" planned an invalid value for test_resource.list_block[0].value: planned value tftypes.String<\"test-planned-value\"> does not match config value tftypes.String<\"test-value\">.\n\n"+
+						"This is a bug in the provider, which should be reported in the provider's own issue tracker.",
+				),
+			},
+		},
+		"set-block-count": {
+			prior:   tftypes.NewValue(testNestedSchema.TerraformType(context.Background()), nil),
+			config:  testNestedValue(nil, testNestedElement(nil, "test-value"), testNestedElement(nil, "test-value")),
+			planned: testNestedValue(tftypes.UnknownValue, testNestedElement(tftypes.UnknownValue, "test-value"), testNestedElement("test-computed-1", "test-value"), testNestedElement("test-computed-2", "test-value")),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Provider produced invalid plan",
+					"Provider \"test_resource\" planned an invalid value for test_resource.set_block: block count in plan (2) disagrees with count in config (1).\n\n"+
+						"This is a bug in the provider, which should be reported in the provider's own issue tracker.",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := planValidDiags("test_resource", testNestedSchema, testCase.prior, testCase.config, testCase.planned)
+
+			if diff := cmp.Diff(got, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
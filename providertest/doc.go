@@ -0,0 +1,24 @@
+// Package providertest contains an in-process test harness which drives a
+// tfsdk.Provider through the framework server, without the Terraform CLI or
+// a plugin process.
+//
+// The Harness validates, plans, applies, reads, imports, upgrades, and
+// destroys resources from Go-native configuration values, while reproducing
+// the consistency checks Terraform core performs against provider responses,
+// such as a planned value which does not match the applied value or a
+// non-empty plan after apply. Results are returned as tfsdk.State so they can
+// be read into the same Go types as the provider implementation.
+//
+// The proposed new state and planned value checks walk nested attributes and
+// blocks as Terraform core does. Like Terraform core, elements of sets within
+// nested attributes or blocks are correlated with prior state elements only
+// if their configured values are equal, and planned set elements are only
+// checked for their count, since they cannot be correlated with the
+// configuration. Schemas with nesting modes these checks do not support
+// return an error diagnostic.
+//
+// This functionality is intended for provider unit testing. It does not
+// replace acceptance testing, since the Terraform CLI behaviors around
+// configuration evaluation, dependencies, and state storage are not
+// reproduced.
+package providertest
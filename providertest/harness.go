@@ -0,0 +1,241 @@
+package providertest
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Harness drives a tfsdk.Provider through the framework server. Each Harness
+// represents a single provider instance, so the provider is configured at
+// most once. Create a new Harness for each test.
+type Harness struct {
+	server *fwserver.Server
+}
+
+// Option configures a Harness.
+type Option func(*Harness)
+
+// WithLookupEnv sets the function used to read the environment variables
+// defined in the provider schema tfsdk.Attribute EnvVars field, which allows
+// tests to inject a fake environment. By default, os.LookupEnv is used.
+func WithLookupEnv(lookupEnv func(string) (string, bool)) Option {
+	return func(h *Harness) {
+		h.server.LookupEnv = lookupEnv
+	}
+}
+
+// New returns a Harness for the given provider.
+func New(provider tfsdk.Provider, opts ...Option) *Harness {
+	h := &Harness{
+		server: &fwserver.Server{
+			Provider: provider,
+		},
+	}
+
+	for _, opt := range opts {
+		opt(h)
+	}
+
+	return h
+}
+
+// ConfigureProvider validates and configures the provider with the given
+// Go-native configuration values, keyed by attribute or block name. Resource
+// and data source operations automatically configure the provider with an
+// empty configuration if this method was not called first.
+func (h *Harness) ConfigureProvider(ctx context.Context, config map[string]interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if h.server.ProviderConfigured() {
+		diags.AddError(
+			"Provider Already Configured",
+			"The provider was already configured by this test harness. Create a new Harness to configure the provider again.",
+		)
+
+		return diags
+	}
+
+	schema, schemaDiags := h.server.ProviderSchema(ctx)
+
+	diags.Append(schemaDiags...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	tfConfig, configDiags := newConfig(ctx, *schema, config)
+
+	diags.Append(configDiags...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	return h.configureProvider(ctx, tfConfig)
+}
+
+// configureProvider calls the ValidateProviderConfig and ConfigureProvider
+// RPCs.
+func (h *Harness) configureProvider(ctx context.Context, config *tfsdk.Config) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	validateReq := &fwserver.ValidateProviderConfigRequest{
+		Config: config,
+	}
+	validateResp := &fwserver.ValidateProviderConfigResponse{}
+
+	h.server.ValidateProviderConfig(ctx, validateReq, validateResp)
+
+	diags.Append(validateResp.Diagnostics...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	configureReq := &tfsdk.ConfigureProviderRequest{
+		Config: *config,
+	}
+	configureResp := &tfsdk.ConfigureProviderResponse{}
+
+	h.server.ConfigureProvider(ctx, configureReq, configureResp)
+
+	diags.Append(configureResp.Diagnostics...)
+
+	return diags
+}
+
+// ensureProviderConfigured configures the provider with an empty configuration
+// if it was not already configured.
+func (h *Harness) ensureProviderConfigured(ctx context.Context) diag.Diagnostics {
+	if h.server.ProviderConfigured() {
+		return nil
+	}
+
+	return h.ConfigureProvider(ctx, map[string]interface{}{})
+}
+
+// ReadDataSource validates the given Go-native configuration values and
+// reads the data source, returning the data source state.
+func (h *Harness) ReadDataSource(ctx context.Context, typeName string, config map[string]interface{}) (*tfsdk.State, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	diags.Append(h.ensureProviderConfigured(ctx)...)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	dataSourceType, typeDiags := h.server.DataSourceType(ctx, typeName)
+
+	diags.Append(typeDiags...)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	schema, schemaDiags := h.server.DataSourceSchema(ctx, typeName)
+
+	diags.Append(schemaDiags...)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	tfConfig, configDiags := newConfig(ctx, *schema, config)
+
+	diags.Append(configDiags...)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	validateReq := &fwserver.ValidateDataSourceConfigRequest{
+		Config:         tfConfig,
+		DataSourceType: dataSourceType,
+		TypeName:       typeName,
+	}
+	validateResp := &fwserver.ValidateDataSourceConfigResponse{}
+
+	h.server.ValidateDataSourceConfig(ctx, validateReq, validateResp)
+
+	diags.Append(validateResp.Diagnostics...)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	readReq := &fwserver.ReadDataSourceRequest{
+		Config:           tfConfig,
+		DataSourceSchema: *schema,
+		DataSourceType:   dataSourceType,
+		TypeName:         typeName,
+	}
+	readResp := &fwserver.ReadDataSourceResponse{}
+
+	h.server.ReadDataSource(ctx, readReq, readResp)
+
+	diags.Append(readResp.Diagnostics...)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	if readResp.State == nil || !readResp.State.Raw.IsFullyKnown() {
+		diags.AddError(
+			"Provider produced invalid object",
+			fmt.Sprintf("Provider produced invalid object for data source %s. The data source state must not contain unknown values after it is read.", typeName),
+		)
+
+		return nil, diags
+	}
+
+	return readResp.State, diags
+}
+
+// newConfig returns a tfsdk.Config from Go-native configuration values. A nil
+// map is a null configuration.
+func newConfig(ctx context.Context, schema tfsdk.Schema, config map[string]interface{}) (*tfsdk.Config, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var value interface{}
+
+	if config != nil {
+		value = config
+	}
+
+	raw, err := terraformValue(ctx, schema.TerraformType(ctx), value)
+
+	if err != nil {
+		diags.AddError(
+			"Invalid Test Configuration",
+			"The test configuration could not be converted into the schema type: "+err.Error(),
+		)
+
+		return nil, diags
+	}
+
+	return &tfsdk.Config{
+		Raw:    raw,
+		Schema: schema,
+	}, diags
+}
+
+// newRawState returns the protocol raw state from JSON state data.
+func newRawState(stateJSON []byte) *tfprotov6.RawState {
+	return &tfprotov6.RawState{
+		JSON: stateJSON,
+	}
+}
+
+// nullState returns a tfsdk.State with a null value for the schema.
+func nullState(ctx context.Context, schema tfsdk.Schema) *tfsdk.State {
+	return &tfsdk.State{
+		Raw:    tftypes.NewValue(schema.TerraformType(ctx), nil),
+		Schema: schema,
+	}
+}
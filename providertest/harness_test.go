@@ -0,0 +1,194 @@
+package providertest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestHarnessConfigureProvider(t *testing.T) {
+	t.Parallel()
+
+	testSchema := tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"token": {
+				EnvVars:  []string{"TEST_TOKEN"},
+				Optional: true,
+				Type:     types.StringType,
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		config        map[string]interface{}
+		lookupEnv     func(string) (string, bool)
+		expectedToken types.String
+		expectedDiags diag.Diagnostics
+	}{
+		"config": {
+			config:        map[string]interface{}{"token": "test-config-token"},
+			expectedToken: types.StringValue("test-config-token"),
+		},
+		"config-unknown": {
+			config:        map[string]interface{}{"token": Unknown},
+			expectedToken: types.StringUnknown(),
+		},
+		"env": {
+			config: map[string]interface{}{},
+			lookupEnv: func(name string) (string, bool) {
+				if name == "TEST_TOKEN" {
+					return "test-env-token", true
+				}
+
+				return "", false
+			},
+			expectedToken: types.StringValue("test-env-token"),
+		},
+		"invalid-config": {
+			config: map[string]interface{}{"token": true},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Test Configuration",
+					"The test configuration could not be converted into the schema type: attribute \"token\": expected string value, got: bool",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got types.String
+
+			provider := &testprovider.Provider{
+				ConfigureMethod: func(ctx context.Context, req tfsdk.ConfigureProviderRequest, resp *tfsdk.ConfigureProviderResponse) {
+					var data struct {
+						Token types.String `tfsdk:"token"`
+					}
+
+					resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+					got = data.Token
+				},
+				GetSchemaMethod: func(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+					return testSchema, nil
+				},
+			}
+
+			h := New(provider, WithLookupEnv(testCase.lookupEnv))
+
+			diags := h.ConfigureProvider(context.Background(), testCase.config)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expectedToken); diff != "" {
+				t.Errorf("unexpected token difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestHarnessReadDataSource(t *testing.T) {
+	t.Parallel()
+
+	testSchema := tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"test_computed": {
+				Computed: true,
+				Type:     types.StringType,
+			},
+			"test_required": {
+				Required: true,
+				Type:     types.StringType,
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		dataSource    tfsdk.DataSource
+		config        map[string]interface{}
+		expected      *tfsdk.State
+		expectedDiags diag.Diagnostics
+	}{
+		"read": {
+			dataSource: &testprovider.DataSource{
+				ReadMethod: func(ctx context.Context, req tfsdk.ReadDataSourceRequest, resp *tfsdk.ReadDataSourceResponse) {
+					var data struct {
+						TestComputed types.String `tfsdk:"test_computed"`
+						TestRequired types.String `tfsdk:"test_required"`
+					}
+
+					resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+					data.TestComputed = types.StringValue("test-state-value")
+
+					resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+				},
+			},
+			config: map[string]interface{}{"test_required": "test-config-value"},
+			expected: &tfsdk.State{
+				Raw: tftypes.NewValue(testSchema.TerraformType(context.Background()), map[string]tftypes.Value{
+					"test_computed": tftypes.NewValue(tftypes.String, "test-state-value"),
+					"test_required": tftypes.NewValue(tftypes.String, "test-config-value"),
+				}),
+				Schema: testSchema,
+			},
+		},
+		"unknown-after-read": {
+			dataSource: &testprovider.DataSource{
+				ReadMethod: func(ctx context.Context, req tfsdk.ReadDataSourceRequest, resp *tfsdk.ReadDataSourceResponse) {},
+			},
+			config: map[string]interface{}{"test_required": Unknown},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Provider produced invalid object",
+					"Provider produced invalid object for data source test_data_source. The data source state must not contain unknown values after it is read.",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			provider := &testprovider.Provider{
+				GetDataSourcesMethod: func(_ context.Context) (map[string]tfsdk.DataSourceType, diag.Diagnostics) {
+					return map[string]tfsdk.DataSourceType{
+						"test_data_source": &testprovider.DataSourceType{
+							GetSchemaMethod: func(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+								return testSchema, nil
+							},
+							NewDataSourceMethod: func(_ context.Context, _ tfsdk.Provider) (tfsdk.DataSource, diag.Diagnostics) {
+								return testCase.dataSource, nil
+							},
+						},
+					}, nil
+				},
+			}
+
+			got, diags := New(provider).ReadDataSource(context.Background(), "test_data_source", testCase.config)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected state difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,639 @@
+package providertest
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// ResourceState is the state of a managed resource between harness
+// operations, which is the equivalent of a resource instance in the Terraform
+// state.
+type ResourceState struct {
+	// TypeName is the resource type name.
+	TypeName string
+
+	// State is the resource state data.
+	State tfsdk.State
+
+	// Private is the provider private state data.
+	Private []byte
+}
+
+// PlanResult is the result of planning a resource change.
+type PlanResult struct {
+	// PlannedState is the planned new state of the resource. The Raw value
+	// is null when the resource is planned for destruction.
+	PlannedState *tfsdk.State
+
+	// PlannedPrivate is the provider private state data, which is passed to
+	// the apply.
+	PlannedPrivate []byte
+
+	// RequiresReplace is the attribute paths which require the resource to
+	// be replaced.
+	RequiresReplace path.Paths
+
+	// HasChanges is true if the planned state differs from the prior state.
+	HasChanges bool
+}
+
+// ApplyResult is the result of applying a resource change.
+type ApplyResult struct {
+	// Plan is the plan which was applied. If the resource was replaced, this
+	// is the plan for creating the new resource.
+	Plan *PlanResult
+
+	// State is the resource state after apply. It is nil if the resource was
+	// destroyed.
+	State *ResourceState
+
+	// Replaced is true if the resource was destroyed and created again,
+	// since the plan required replacement.
+	Replaced bool
+}
+
+// resourceInfo contains the resource type and schema for a resource type
+// name.
+type resourceInfo struct {
+	schema       tfsdk.Schema
+	resourceType tfsdk.ResourceType
+	typeName     string
+}
+
+// resource returns the resource type and schema for a resource type name,
+// configuring the provider first if necessary.
+func (h *Harness) resource(ctx context.Context, typeName string) (*resourceInfo, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	diags.Append(h.ensureProviderConfigured(ctx)...)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	resourceType, typeDiags := h.server.ResourceType(ctx, typeName)
+
+	diags.Append(typeDiags...)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	schema, schemaDiags := h.server.ResourceSchema(ctx, typeName)
+
+	diags.Append(schemaDiags...)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return &resourceInfo{
+		schema:       *schema,
+		resourceType: resourceType,
+		typeName:     typeName,
+	}, diags
+}
+
+// Plan validates the given Go-native configuration values and plans the
+// resource change from the prior resource state. A nil prior state plans the
+// creation of the resource, while a nil configuration plans the destruction
+// of the resource.
+func (h *Harness) Plan(ctx context.Context, typeName string, config map[string]interface{}, prior *ResourceState) (*PlanResult, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	resource, resourceDiags := h.resource(ctx, typeName)
+
+	diags.Append(resourceDiags...)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	tfConfig, configDiags := h.resourceConfig(ctx, resource, config)
+
+	diags.Append(configDiags...)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	plan, planDiags := h.plan(ctx, resource, tfConfig, prior)
+
+	diags.Append(planDiags...)
+
+	return plan, diags
+}
+
+// Apply validates the given Go-native configuration values, then plans and
+// applies the resource change from the prior resource state. A nil prior
+// state creates the resource, while a nil configuration destroys the resource.
+// If the plan requires replacement, the resource is destroyed and created
+// again. The planned and applied values are checked for consistency.
+func (h *Harness) Apply(ctx context.Context, typeName string, config map[string]interface{}, prior *ResourceState) (*ApplyResult, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	resource, resourceDiags := h.resource(ctx, typeName)
+
+	diags.Append(resourceDiags...)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	tfConfig, configDiags := h.resourceConfig(ctx, resource, config)
+
+	diags.Append(configDiags...)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	plan, planDiags := h.plan(ctx, resource, tfConfig, prior)
+
+	diags.Append(planDiags...)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	result := &ApplyResult{
+		Plan: plan,
+	}
+
+	if len(plan.RequiresReplace) > 0 && prior != nil && !tfConfig.Raw.IsNull() {
+		destroyPlan, planDiags := h.plan(ctx, resource, nullConfig(ctx, resource), prior)
+
+		diags.Append(planDiags...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		_, applyDiags := h.apply(ctx, resource, nullConfig(ctx, resource), destroyPlan, prior)
+
+		diags.Append(applyDiags...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		prior = nil
+		result.Replaced = true
+
+		plan, planDiags = h.plan(ctx, resource, tfConfig, prior)
+
+		diags.Append(planDiags...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		result.Plan = plan
+	}
+
+	state, applyDiags := h.apply(ctx, resource, tfConfig, plan, prior)
+
+	diags.Append(applyDiags...)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	result.State = state
+
+	return result, diags
+}
+
+// Destroy plans and applies the destruction of the resource.
+func (h *Harness) Destroy(ctx context.Context, current *ResourceState) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if current == nil {
+		return diags
+	}
+
+	_, applyDiags := h.Apply(ctx, current.TypeName, nil, current)
+
+	diags.Append(applyDiags...)
+
+	return diags
+}
+
+// Read refreshes the resource state. The returned resource state is nil if
+// the resource was removed.
+func (h *Harness) Read(ctx context.Context, current *ResourceState) (*ResourceState, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if current == nil {
+		return nil, diags
+	}
+
+	resource, resourceDiags := h.resource(ctx, current.TypeName)
+
+	diags.Append(resourceDiags...)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return h.read(ctx, resource, current)
+}
+
+// Import imports the resource with the given import identifier, then reads
+// the imported resource state, similar to the terraform import command.
+func (h *Harness) Import(ctx context.Context, typeName string, id string) ([]*ResourceState, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	resource, resourceDiags := h.resource(ctx, typeName)
+
+	diags.Append(resourceDiags...)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	importReq := &fwserver.ImportResourceStateRequest{
+		EmptyState:   *nullState(ctx, resource.schema),
+		ID:           id,
+		ResourceType: resource.resourceType,
+		TypeName:     typeName,
+	}
+	importResp := &fwserver.ImportResourceStateResponse{}
+
+	h.server.ImportResourceState(ctx, importReq, importResp)
+
+	diags.Append(importResp.Diagnostics...)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	var states []*ResourceState
+
+	for _, importedResource := range importResp.ImportedResources {
+		importedResourceInfo := resource
+
+		if importedResource.TypeName != typeName {
+			importedResourceInfo, resourceDiags = h.resource(ctx, importedResource.TypeName)
+
+			diags.Append(resourceDiags...)
+
+			if diags.HasError() {
+				return nil, diags
+			}
+		}
+
+		state, readDiags := h.read(ctx, importedResourceInfo, &ResourceState{
+			Private:  importedResource.Private,
+			State:    importedResource.State,
+			TypeName: importedResource.TypeName,
+		})
+
+		diags.Append(readDiags...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		if state == nil {
+			diags.AddError(
+				"Cannot import non-existent remote object",
+				fmt.Sprintf("While attempting to import an existing object to %s, the provider detected that no object exists with the given id. Only pre-existing objects can be imported; check that the id is correct and that it is associated with the provider's configured region or endpoint, or use \"terraform apply\" to create a new remote object for this resource.", importedResource.TypeName),
+			)
+
+			return nil, diags
+		}
+
+		states = append(states, state)
+	}
+
+	return states, diags
+}
+
+// UpgradeState upgrades the JSON resource state data from the given schema
+// version to the current resource schema.
+func (h *Harness) UpgradeState(ctx context.Context, typeName string, version int64, stateJSON []byte) (*ResourceState, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	resource, resourceDiags := h.resource(ctx, typeName)
+
+	diags.Append(resourceDiags...)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	upgradeReq := &fwserver.UpgradeResourceStateRequest{
+		RawState:       newRawState(stateJSON),
+		ResourceSchema: resource.schema,
+		ResourceType:   resource.resourceType,
+		TypeName:       typeName,
+		Version:        version,
+	}
+	upgradeResp := &fwserver.UpgradeResourceStateResponse{}
+
+	h.server.UpgradeResourceState(ctx, upgradeReq, upgradeResp)
+
+	diags.Append(upgradeResp.Diagnostics...)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	if upgradeResp.UpgradedState == nil {
+		diags.AddError(
+			"Missing Upgraded Resource State",
+			fmt.Sprintf("The provider returned no upgraded state for %s.", typeName),
+		)
+
+		return nil, diags
+	}
+
+	return &ResourceState{
+		State:    *upgradeResp.UpgradedState,
+		TypeName: typeName,
+	}, diags
+}
+
+// Lifecycle runs each Go-native configuration as a test step, similar to an
+// acceptance test. Each step applies the configuration, refreshes the
+// resource state, and verifies a second plan is empty. The resource is
+// destroyed after the last step. The refreshed resource state of each step is
+// returned, even when error diagnostics are returned for a later step.
+func (h *Harness) Lifecycle(ctx context.Context, typeName string, configs ...map[string]interface{}) ([]*ResourceState, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var current *ResourceState
+	var states []*ResourceState
+
+	for _, config := range configs {
+		applyResult, applyDiags := h.Apply(ctx, typeName, config, current)
+
+		diags.Append(applyDiags...)
+
+		if diags.HasError() {
+			break
+		}
+
+		current = applyResult.State
+
+		state, readDiags := h.Read(ctx, current)
+
+		diags.Append(readDiags...)
+
+		if diags.HasError() {
+			break
+		}
+
+		if state == nil {
+			diags.AddError(
+				"Resource removed after apply",
+				fmt.Sprintf("After applying this test step, the %s resource was removed when refreshed.", typeName),
+			)
+
+			current = nil
+
+			break
+		}
+
+		current = state
+		states = append(states, state)
+
+		plan, planDiags := h.Plan(ctx, typeName, config, current)
+
+		diags.Append(planDiags...)
+
+		if diags.HasError() {
+			break
+		}
+
+		diags.Append(emptyPlanDiags(typeName, plan, current.State.Raw)...)
+
+		if diags.HasError() {
+			break
+		}
+	}
+
+	diags.Append(h.Destroy(ctx, current)...)
+
+	return states, diags
+}
+
+// resourceConfig validates and returns a tfsdk.Config from Go-native
+// configuration values. A nil map is a null configuration, which is not
+// validated.
+func (h *Harness) resourceConfig(ctx context.Context, resource *resourceInfo, config map[string]interface{}) (*tfsdk.Config, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if config == nil {
+		return nullConfig(ctx, resource), diags
+	}
+
+	tfConfig, configDiags := newConfig(ctx, resource.schema, config)
+
+	diags.Append(configDiags...)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	validateReq := &fwserver.ValidateResourceConfigRequest{
+		Config:       tfConfig,
+		ResourceType: resource.resourceType,
+		TypeName:     resource.typeName,
+	}
+	validateResp := &fwserver.ValidateResourceConfigResponse{}
+
+	h.server.ValidateResourceConfig(ctx, validateReq, validateResp)
+
+	diags.Append(validateResp.Diagnostics...)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return tfConfig, diags
+}
+
+// plan calls the PlanResourceChange RPC and verifies the planned state is
+// valid for the configuration.
+func (h *Harness) plan(ctx context.Context, resource *resourceInfo, config *tfsdk.Config, prior *ResourceState) (*PlanResult, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	priorState := nullState(ctx, resource.schema)
+	var priorPrivate []byte
+
+	if prior != nil {
+		if prior.TypeName != resource.typeName {
+			diags.AddError(
+				"Invalid Prior Resource State",
+				fmt.Sprintf("The prior resource state is for %s, but the plan is for %s.", prior.TypeName, resource.typeName),
+			)
+
+			return nil, diags
+		}
+
+		priorState = &prior.State
+		priorPrivate = prior.Private
+	}
+
+	proposedNewState, err := proposedNewState(ctx, resource.schema, priorState.Raw, config.Raw)
+
+	if err != nil {
+		diags.AddError(
+			"Proposed New State Error",
+			"An unexpected error was encountered creating the proposed new state: "+err.Error(),
+		)
+
+		return nil, diags
+	}
+
+	planReq := &fwserver.PlanResourceChangeRequest{
+		Config:       config,
+		PriorPrivate: priorPrivate,
+		PriorState:   priorState,
+		ProposedNewState: &tfsdk.Plan{
+			Raw:    proposedNewState,
+			Schema: resource.schema,
+		},
+		ResourceSchema: resource.schema,
+		ResourceType:   resource.resourceType,
+		TypeName:       resource.typeName,
+	}
+	planResp := &fwserver.PlanResourceChangeResponse{}
+
+	h.server.PlanResourceChange(ctx, planReq, planResp)
+
+	diags.Append(planResp.Diagnostics...)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	if planResp.PlannedState == nil {
+		planResp.PlannedState = nullState(ctx, resource.schema)
+	}
+
+	diags.Append(planValidDiags(resource.typeName, resource.schema, priorState.Raw, config.Raw, planResp.PlannedState.Raw)...)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return &PlanResult{
+		HasChanges:      !planResp.PlannedState.Raw.Equal(priorState.Raw),
+		PlannedPrivate:  planResp.PlannedPrivate,
+		PlannedState:    planResp.PlannedState,
+		RequiresReplace: planResp.RequiresReplace,
+	}, diags
+}
+
+// apply calls the ApplyResourceChange RPC and verifies the new state is
+// consistent with the planned state. The returned resource state is nil if
+// the resource was destroyed.
+func (h *Harness) apply(ctx context.Context, resource *resourceInfo, config *tfsdk.Config, plan *PlanResult, prior *ResourceState) (*ResourceState, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	priorState := nullState(ctx, resource.schema)
+
+	if prior != nil {
+		priorState = &prior.State
+	}
+
+	applyReq := &fwserver.ApplyResourceChangeRequest{
+		Config:         config,
+		PlannedPrivate: plan.PlannedPrivate,
+		PlannedState: &tfsdk.Plan{
+			Raw:    plan.PlannedState.Raw,
+			Schema: plan.PlannedState.Schema,
+		},
+		PriorState:     priorState,
+		ResourceSchema: resource.schema,
+		ResourceType:   resource.resourceType,
+		TypeName:       resource.typeName,
+	}
+	applyResp := &fwserver.ApplyResourceChangeResponse{}
+
+	h.server.ApplyResourceChange(ctx, applyReq, applyResp)
+
+	diags.Append(applyResp.Diagnostics...)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	if applyResp.NewState == nil {
+		applyResp.NewState = nullState(ctx, resource.schema)
+	}
+
+	diags.Append(applyConsistentDiags(resource.typeName, plan.PlannedState.Raw, applyResp.NewState.Raw)...)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	if applyResp.NewState.Raw.IsNull() {
+		return nil, diags
+	}
+
+	return &ResourceState{
+		Private:  applyResp.Private,
+		State:    *applyResp.NewState,
+		TypeName: resource.typeName,
+	}, diags
+}
+
+// read calls the ReadResource RPC. The returned resource state is nil if the
+// resource was removed.
+func (h *Harness) read(ctx context.Context, resource *resourceInfo, current *ResourceState) (*ResourceState, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	readReq := &fwserver.ReadResourceRequest{
+		CurrentState: &current.State,
+		Private:      current.Private,
+		ResourceType: resource.resourceType,
+		TypeName:     resource.typeName,
+	}
+	readResp := &fwserver.ReadResourceResponse{}
+
+	h.server.ReadResource(ctx, readReq, readResp)
+
+	diags.Append(readResp.Diagnostics...)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	if readResp.NewState == nil || readResp.NewState.Raw.IsNull() {
+		return nil, diags
+	}
+
+	if !readResp.NewState.Raw.IsFullyKnown() {
+		diags.AddError(
+			"Provider produced invalid object",
+			fmt.Sprintf("Provider produced invalid object for %s. The resource state must not contain unknown values after it is read.", resource.typeName),
+		)
+
+		return nil, diags
+	}
+
+	return &ResourceState{
+		Private:  readResp.Private,
+		State:    *readResp.NewState,
+		TypeName: resource.typeName,
+	}, diags
+}
+
+// nullConfig returns a null tfsdk.Config for the resource schema.
+func nullConfig(ctx context.Context, resource *resourceInfo) *tfsdk.Config {
+	state := nullState(ctx, resource.schema)
+
+	return &tfsdk.Config{
+		Raw:    state.Raw,
+		Schema: state.Schema,
+	}
+}
@@ -0,0 +1,295 @@
+package providertest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testprovider"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var testResourceSchema = tfsdk.Schema{
+	Attributes: map[string]tfsdk.Attribute{
+		"id": {
+			Computed: true,
+			Type:     types.StringType,
+		},
+		"name": {
+			Required: true,
+			Type:     types.StringType,
+		},
+	},
+}
+
+type testResourceModel struct {
+	ID   types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+}
+
+// testResourceProvider returns a provider with a single test_resource
+// resource type using testResourceSchema.
+func testResourceProvider(resource tfsdk.Resource) tfsdk.Provider {
+	return &testprovider.Provider{
+		GetResourcesMethod: func(_ context.Context) (map[string]tfsdk.ResourceType, diag.Diagnostics) {
+			return map[string]tfsdk.ResourceType{
+				"test_resource": &testprovider.ResourceType{
+					GetSchemaMethod: func(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+						return testResourceSchema, nil
+					},
+					NewResourceMethod: func(_ context.Context, _ tfsdk.Provider) (tfsdk.Resource, diag.Diagnostics) {
+						return resource, nil
+					},
+				},
+			}, nil
+		},
+	}
+}
+
+// testResource returns a resource which saves the plan into the state and
+// sets the id attribute on create, which is preserved on update.
+func testResource() *testprovider.Resource {
+	return &testprovider.Resource{
+		CreateMethod: func(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+			var data testResourceModel
+
+			resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+			data.ID = types.StringValue("test-id")
+
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		},
+		UpdateMethod: func(ctx context.Context, req tfsdk.UpdateResourceRequest, resp *tfsdk.UpdateResourceResponse) {
+			var data, state testResourceModel
+
+			resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+			resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+			data.ID = state.ID
+
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		},
+	}
+}
+
+func testResourceState(id string, name string) *ResourceState {
+	return &ResourceState{
+		State: tfsdk.State{
+			Raw: tftypes.NewValue(testResourceSchema.TerraformType(context.Background()), map[string]tftypes.Value{
+				"id":   tftypes.NewValue(tftypes.String, id),
+				"name": tftypes.NewValue(tftypes.String, name),
+			}),
+			Schema: testResourceSchema,
+		},
+		TypeName: "test_resource",
+	}
+}
+
+func TestHarnessLifecycle(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		resource       tfsdk.Resource
+		configs        []map[string]interface{}
+		expectedStates []*ResourceState
+		expectedDiags  diag.Diagnostics
+	}{
+		"create-update-destroy": {
+			resource: testResource(),
+			configs: []map[string]interface{}{
+				{"name": "test-name-1"},
+				{"name": "test-name-2"},
+			},
+			expectedStates: []*ResourceState{
+				testResourceState("test-id", "test-name-1"),
+				testResourceState("test-id", "test-name-2"),
+			},
+		},
+		"invalid-config": {
+			resource: testResource(),
+			configs: []map[string]interface{}{
+				{"name": 1},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Test Configuration",
+					"The test configuration could not be converted into the schema type: attribute \"name\": expected string value, got: int",
+				),
+			},
+		},
+		"invalid-plan": {
+			resource: &testprovider.ResourceWithModifyPlan{
+				Resource: testResource(),
+				ModifyPlanMethod: func(ctx context.Context, req tfsdk.ModifyResourcePlanRequest, resp *tfsdk.ModifyResourcePlanResponse) {
+					resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("name"), "test-planned-name")...)
+				},
+			},
+			configs: []map[string]interface{}{
+				{"name": "test-name"},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Provider produced invalid plan",
+					"Provider \"test_resource\" planned an invalid value for test_resource.name: planned value tftypes.String<\"test-planned-name\"> does not match config value tftypes.String<\"test-name\">.\n\n"+
+						"This is a bug in the provider, which should be reported in the provider's own issue tracker.",
+				),
+			},
+		},
+		"inconsistent-apply": {
+			resource: &testprovider.Resource{
+				CreateMethod: func(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+					data := testResourceModel{
+						ID:   types.StringValue("test-id"),
+						Name: types.StringValue("test-applied-name"),
+					}
+
+					resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+				},
+			},
+			configs: []map[string]interface{}{
+				{"name": "test-name"},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Provider produced inconsistent result after apply",
+					"When applying changes to test_resource, provider produced an unexpected new value: AttributeName(\"name\"): was tftypes.String<\"test-name\">, but now tftypes.String<\"test-applied-name\">.\n\n"+
+						"This is a bug in the provider, which should be reported in the provider's own issue tracker.",
+				),
+			},
+		},
+		"unknown-after-apply": {
+			resource: &testprovider.Resource{
+				CreateMethod: func(ctx context.Context, req tfsdk.CreateResourceRequest, resp *tfsdk.CreateResourceResponse) {
+					var data testResourceModel
+
+					resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+					resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+				},
+			},
+			configs: []map[string]interface{}{
+				{"name": "test-name"},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Provider returned invalid result object after apply",
+					"After the apply operation, the provider still indicated an unknown value for test_resource. All values must be known after apply, so this is always a bug in the provider and should be reported in the provider's own repository.",
+				),
+			},
+		},
+		"non-empty-plan": {
+			resource: &testprovider.Resource{
+				CreateMethod: testResource().CreateMethod,
+				ReadMethod: func(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+					resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), "test-remote-name")...)
+				},
+			},
+			configs: []map[string]interface{}{
+				{"name": "test-name"},
+			},
+			expectedStates: []*ResourceState{
+				testResourceState("test-id", "test-remote-name"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Plan not empty after apply",
+					"After applying this test step and refreshing, the plan for test_resource was not empty.\n\n"+
+						"AttributeName(\"id\"): tftypes.String<\"test-id\"> => tftypes.String<unknown>\n\n"+
+						"AttributeName(\"name\"): tftypes.String<\"test-remote-name\"> => tftypes.String<\"test-name\">",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			h := New(testResourceProvider(testCase.resource))
+
+			got, diags := h.Lifecycle(context.Background(), "test_resource", testCase.configs...)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expectedStates); diff != "" {
+				t.Errorf("unexpected states difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestHarnessImport(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		resource       tfsdk.Resource
+		expectedStates []*ResourceState
+		expectedDiags  diag.Diagnostics
+	}{
+		"import": {
+			resource: &testprovider.ResourceWithImportState{
+				Resource: testResource(),
+				ImportStateMethod: func(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+					resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+				},
+			},
+			expectedStates: []*ResourceState{
+				{
+					State: tfsdk.State{
+						Raw: tftypes.NewValue(testResourceSchema.TerraformType(context.Background()), map[string]tftypes.Value{
+							"id":   tftypes.NewValue(tftypes.String, "test-import-id"),
+							"name": tftypes.NewValue(tftypes.String, nil),
+						}),
+						Schema: testResourceSchema,
+					},
+					TypeName: "test_resource",
+				},
+			},
+		},
+		"non-existent": {
+			resource: &testprovider.ResourceWithImportState{
+				Resource: &testprovider.Resource{
+					ReadMethod: func(ctx context.Context, req tfsdk.ReadResourceRequest, resp *tfsdk.ReadResourceResponse) {
+						resp.State.RemoveResource(ctx)
+					},
+				},
+				ImportStateMethod: func(ctx context.Context, req tfsdk.ImportResourceStateRequest, resp *tfsdk.ImportResourceStateResponse) {
+					resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Cannot import non-existent remote object",
+					"While attempting to import an existing object to test_resource, the provider detected that no object exists with the given id. Only pre-existing objects can be imported; check that the id is correct and that it is associated with the provider's configured region or endpoint, or use \"terraform apply\" to create a new remote object for this resource.",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			h := New(testResourceProvider(testCase.resource))
+
+			got, diags := h.Import(context.Background(), "test_resource", "test-import-id")
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expectedStates); diff != "" {
+				t.Errorf("unexpected states difference: %s", diff)
+			}
+		})
+	}
+}
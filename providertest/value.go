@@ -0,0 +1,230 @@
+package providertest
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"reflect"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Unknown can be used in Go-native configuration values to represent a value
+// which is not known until apply, such as a reference to another resource
+// attribute which is computed.
+var Unknown = unknownValue{}
+
+// unknownValue is the type of the Unknown sentinel value.
+type unknownValue struct{}
+
+// terraformValue converts a Go-native value into a tftypes.Value of the given
+// type. The supported Go values are:
+//
+//   - nil, which is a null value of any type.
+//   - Unknown, which is an unknown value of any type.
+//   - tftypes.Value and attr.Value, which are used as-is.
+//   - bool, for bool types.
+//   - string, for string types.
+//   - Any integer or float type and *big.Float, for number types.
+//   - Slices, for list, set, and tuple types.
+//   - Maps with string keys, for map and object types. Object attributes
+//     which are missing from the map are null.
+func terraformValue(ctx context.Context, typ tftypes.Type, value interface{}) (tftypes.Value, error) {
+	switch value := value.(type) {
+	case nil:
+		return tftypes.NewValue(typ, nil), nil
+	case unknownValue:
+		return tftypes.NewValue(typ, tftypes.UnknownValue), nil
+	case tftypes.Value:
+		if !value.Type().Equal(typ) {
+			return tftypes.Value{}, fmt.Errorf("expected %s value, got: %s", typ, value.Type())
+		}
+
+		return value, nil
+	case attr.Value:
+		return value.ToTerraformValue(ctx)
+	}
+
+	switch {
+	case typ.Is(tftypes.Bool):
+		b, ok := value.(bool)
+
+		if !ok {
+			return tftypes.Value{}, fmt.Errorf("expected bool value, got: %T", value)
+		}
+
+		return tftypes.NewValue(typ, b), nil
+	case typ.Is(tftypes.Number):
+		n, err := numberValue(value)
+
+		if err != nil {
+			return tftypes.Value{}, err
+		}
+
+		return tftypes.NewValue(typ, n), nil
+	case typ.Is(tftypes.String):
+		s, ok := value.(string)
+
+		if !ok {
+			return tftypes.Value{}, fmt.Errorf("expected string value, got: %T", value)
+		}
+
+		return tftypes.NewValue(typ, s), nil
+	case typ.Is(tftypes.List{}):
+		return elementsValue(ctx, typ, typ.(tftypes.List).ElementType, value)
+	case typ.Is(tftypes.Set{}):
+		return elementsValue(ctx, typ, typ.(tftypes.Set).ElementType, value)
+	case typ.Is(tftypes.Tuple{}):
+		return tupleValue(ctx, typ.(tftypes.Tuple), value)
+	case typ.Is(tftypes.Map{}):
+		return mapValue(ctx, typ.(tftypes.Map), value)
+	case typ.Is(tftypes.Object{}):
+		return objectValue(ctx, typ.(tftypes.Object), value)
+	default:
+		return tftypes.Value{}, fmt.Errorf("unsupported type %s", typ)
+	}
+}
+
+// numberValue converts a Go-native integer or float into a *big.Float.
+func numberValue(value interface{}) (*big.Float, error) {
+	if n, ok := value.(*big.Float); ok {
+		return n, nil
+	}
+
+	v := reflect.ValueOf(value)
+
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return new(big.Float).SetInt64(v.Int()), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return new(big.Float).SetUint64(v.Uint()), nil
+	case reflect.Float32, reflect.Float64:
+		return big.NewFloat(v.Float()), nil
+	default:
+		return nil, fmt.Errorf("expected number value, got: %T", value)
+	}
+}
+
+// elementsValue converts a Go-native slice into a list or set tftypes.Value.
+func elementsValue(ctx context.Context, typ tftypes.Type, elementType tftypes.Type, value interface{}) (tftypes.Value, error) {
+	v := reflect.ValueOf(value)
+
+	if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+		return tftypes.Value{}, fmt.Errorf("expected slice value for %s, got: %T", typ, value)
+	}
+
+	elements := make([]tftypes.Value, 0, v.Len())
+
+	for i := 0; i < v.Len(); i++ {
+		element, err := terraformValue(ctx, elementType, v.Index(i).Interface())
+
+		if err != nil {
+			return tftypes.Value{}, fmt.Errorf("element %d: %w", i, err)
+		}
+
+		elements = append(elements, element)
+	}
+
+	return tftypes.NewValue(typ, elements), nil
+}
+
+// tupleValue converts a Go-native slice into a tuple tftypes.Value.
+func tupleValue(ctx context.Context, typ tftypes.Tuple, value interface{}) (tftypes.Value, error) {
+	v := reflect.ValueOf(value)
+
+	if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+		return tftypes.Value{}, fmt.Errorf("expected slice value for %s, got: %T", typ, value)
+	}
+
+	if v.Len() != len(typ.ElementTypes) {
+		return tftypes.Value{}, fmt.Errorf("expected %d elements for %s, got: %d", len(typ.ElementTypes), typ, v.Len())
+	}
+
+	elements := make([]tftypes.Value, 0, v.Len())
+
+	for i, elementType := range typ.ElementTypes {
+		element, err := terraformValue(ctx, elementType, v.Index(i).Interface())
+
+		if err != nil {
+			return tftypes.Value{}, fmt.Errorf("element %d: %w", i, err)
+		}
+
+		elements = append(elements, element)
+	}
+
+	return tftypes.NewValue(typ, elements), nil
+}
+
+// mapValue converts a Go-native map into a map tftypes.Value.
+func mapValue(ctx context.Context, typ tftypes.Map, value interface{}) (tftypes.Value, error) {
+	v := reflect.ValueOf(value)
+
+	if v.Kind() != reflect.Map || v.Type().Key().Kind() != reflect.String {
+		return tftypes.Value{}, fmt.Errorf("expected map with string keys for %s, got: %T", typ, value)
+	}
+
+	elements := make(map[string]tftypes.Value, v.Len())
+
+	iter := v.MapRange()
+
+	for iter.Next() {
+		key := iter.Key().String()
+		element, err := terraformValue(ctx, typ.ElementType, iter.Value().Interface())
+
+		if err != nil {
+			return tftypes.Value{}, fmt.Errorf("element %q: %w", key, err)
+		}
+
+		elements[key] = element
+	}
+
+	return tftypes.NewValue(typ, elements), nil
+}
+
+// objectValue converts a Go-native map into an object tftypes.Value. Missing
+// attributes are set to null.
+func objectValue(ctx context.Context, typ tftypes.Object, value interface{}) (tftypes.Value, error) {
+	v := reflect.ValueOf(value)
+
+	if v.Kind() != reflect.Map || v.Type().Key().Kind() != reflect.String {
+		return tftypes.Value{}, fmt.Errorf("expected map with string keys for %s, got: %T", typ, value)
+	}
+
+	var unexpected []string
+
+	iter := v.MapRange()
+
+	for iter.Next() {
+		if _, ok := typ.AttributeTypes[iter.Key().String()]; !ok {
+			unexpected = append(unexpected, iter.Key().String())
+		}
+	}
+
+	if len(unexpected) > 0 {
+		sort.Strings(unexpected)
+
+		return tftypes.Value{}, fmt.Errorf("unexpected attribute %q", unexpected[0])
+	}
+
+	attributes := make(map[string]tftypes.Value, len(typ.AttributeTypes))
+
+	for name, attributeType := range typ.AttributeTypes {
+		var attributeValue interface{}
+
+		if element := v.MapIndex(reflect.ValueOf(name).Convert(v.Type().Key())); element.IsValid() {
+			attributeValue = element.Interface()
+		}
+
+		attribute, err := terraformValue(ctx, attributeType, attributeValue)
+
+		if err != nil {
+			return tftypes.Value{}, fmt.Errorf("attribute %q: %w", name, err)
+		}
+
+		attributes[name] = attribute
+	}
+
+	return tftypes.NewValue(typ, attributes), nil
+}
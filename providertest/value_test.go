@@ -0,0 +1,173 @@
+package providertest
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestTerraformValue(t *testing.T) {
+	t.Parallel()
+
+	testObjectType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_bool":   tftypes.Bool,
+			"test_string": tftypes.String,
+		},
+	}
+
+	testCases := map[string]struct {
+		typ           tftypes.Type
+		value         interface{}
+		expected      tftypes.Value
+		expectedError string
+	}{
+		"nil": {
+			typ:      tftypes.String,
+			value:    nil,
+			expected: tftypes.NewValue(tftypes.String, nil),
+		},
+		"unknown": {
+			typ:      tftypes.String,
+			value:    Unknown,
+			expected: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		},
+		"attr-value": {
+			typ:      tftypes.String,
+			value:    types.StringValue("test"),
+			expected: tftypes.NewValue(tftypes.String, "test"),
+		},
+		"tftypes-value": {
+			typ:      tftypes.String,
+			value:    tftypes.NewValue(tftypes.String, "test"),
+			expected: tftypes.NewValue(tftypes.String, "test"),
+		},
+		"tftypes-value-type-mismatch": {
+			typ:           tftypes.String,
+			value:         tftypes.NewValue(tftypes.Bool, true),
+			expectedError: "expected tftypes.String value, got: tftypes.Bool",
+		},
+		"bool": {
+			typ:      tftypes.Bool,
+			value:    true,
+			expected: tftypes.NewValue(tftypes.Bool, true),
+		},
+		"number-int": {
+			typ:      tftypes.Number,
+			value:    123,
+			expected: tftypes.NewValue(tftypes.Number, big.NewFloat(123)),
+		},
+		"number-uint8": {
+			typ:      tftypes.Number,
+			value:    uint8(123),
+			expected: tftypes.NewValue(tftypes.Number, big.NewFloat(123)),
+		},
+		"number-float64": {
+			typ:      tftypes.Number,
+			value:    1.5,
+			expected: tftypes.NewValue(tftypes.Number, big.NewFloat(1.5)),
+		},
+		"number-invalid": {
+			typ:           tftypes.Number,
+			value:         "123",
+			expectedError: "expected number value, got: string",
+		},
+		"string": {
+			typ:      tftypes.String,
+			value:    "test",
+			expected: tftypes.NewValue(tftypes.String, "test"),
+		},
+		"list": {
+			typ:   tftypes.List{ElementType: tftypes.String},
+			value: []string{"test1", "test2"},
+			expected: tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "test1"),
+				tftypes.NewValue(tftypes.String, "test2"),
+			}),
+		},
+		"list-element-invalid": {
+			typ:           tftypes.List{ElementType: tftypes.String},
+			value:         []interface{}{"test1", 2},
+			expectedError: "element 1: expected string value, got: int",
+		},
+		"set": {
+			typ:   tftypes.Set{ElementType: tftypes.String},
+			value: []interface{}{"test1", Unknown},
+			expected: tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "test1"),
+				tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			}),
+		},
+		"map": {
+			typ:   tftypes.Map{ElementType: tftypes.Number},
+			value: map[string]int{"test": 1},
+			expected: tftypes.NewValue(tftypes.Map{ElementType: tftypes.Number}, map[string]tftypes.Value{
+				"test": tftypes.NewValue(tftypes.Number, big.NewFloat(1)),
+			}),
+		},
+		"object": {
+			typ: testObjectType,
+			value: map[string]interface{}{
+				"test_string": "test",
+			},
+			expected: tftypes.NewValue(testObjectType, map[string]tftypes.Value{
+				"test_bool":   tftypes.NewValue(tftypes.Bool, nil),
+				"test_string": tftypes.NewValue(tftypes.String, "test"),
+			}),
+		},
+		"object-unexpected-attribute": {
+			typ: testObjectType,
+			value: map[string]interface{}{
+				"test_other": "test",
+			},
+			expectedError: "unexpected attribute \"test_other\"",
+		},
+		"tuple": {
+			typ:   tftypes.Tuple{ElementTypes: []tftypes.Type{tftypes.String, tftypes.Bool}},
+			value: []interface{}{"test", false},
+			expected: tftypes.NewValue(tftypes.Tuple{ElementTypes: []tftypes.Type{tftypes.String, tftypes.Bool}}, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "test"),
+				tftypes.NewValue(tftypes.Bool, false),
+			}),
+		},
+		"tuple-length-mismatch": {
+			typ:           tftypes.Tuple{ElementTypes: []tftypes.Type{tftypes.String, tftypes.Bool}},
+			value:         []interface{}{"test"},
+			expectedError: "expected 2 elements for tftypes.Tuple[tftypes.String, tftypes.Bool], got: 1",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := terraformValue(context.Background(), testCase.typ, testCase.value)
+
+			if err != nil {
+				if testCase.expectedError == "" {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if diff := cmp.Diff(err.Error(), testCase.expectedError); diff != "" {
+					t.Fatalf("unexpected error difference: %s", diff)
+				}
+
+				return
+			}
+
+			if testCase.expectedError != "" {
+				t.Fatalf("expected error: %s", testCase.expectedError)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
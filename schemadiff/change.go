@@ -0,0 +1,72 @@
+package schemadiff
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// Change is a single difference between a prior and current schema.
+type Change struct {
+	// Path is the schema path of the attribute or block which changed. Paths
+	// only contain attribute name steps, since schemas do not have list,
+	// map, or set elements.
+	Path path.Path
+
+	// Kind is the type of difference that was detected.
+	Kind ChangeKind
+
+	// Breaking is true if the change can cause existing practitioner
+	// configurations to raise errors or unexpected plans.
+	Breaking bool
+
+	// RequiresStateUpgrade is true if existing resource state will no longer
+	// be readable with the current schema, meaning the Schema Version must be
+	// incremented and a ResourceStateUpgrader implemented.
+	RequiresStateUpgrade bool
+
+	// Detail is a human-friendly explanation of the change, suitable for
+	// test failure messages.
+	Detail string
+}
+
+// Classification returns the most severe Classification of the change.
+func (c Change) Classification() Classification {
+	if c.Breaking {
+		return ClassificationBreaking
+	}
+
+	if c.RequiresStateUpgrade {
+		return ClassificationRequiresStateUpgrade
+	}
+
+	return ClassificationCompatible
+}
+
+// Equal returns true if the given Change is exactly equivalent.
+func (c Change) Equal(o Change) bool {
+	if !c.Path.Equal(o.Path) {
+		return false
+	}
+
+	if c.Kind != o.Kind {
+		return false
+	}
+
+	if c.Breaking != o.Breaking {
+		return false
+	}
+
+	if c.RequiresStateUpgrade != o.RequiresStateUpgrade {
+		return false
+	}
+
+	return c.Detail == o.Detail
+}
+
+// String returns a human-friendly representation of the change. It is
+// intended for logging and error messages and is not protected by
+// compatibility guarantees.
+func (c Change) String() string {
+	return fmt.Sprintf("%s: %s (%s): %s", c.Path, c.Kind, c.Classification(), c.Detail)
+}
@@ -0,0 +1,126 @@
+package schemadiff
+
+import "fmt"
+
+// ChangeKind is an enum type of the schema differences which can be detected.
+type ChangeKind uint8
+
+const (
+	// ChangeKindUnknown is an invalid change kind, used to catch when a
+	// change kind is expected and not set.
+	ChangeKindUnknown ChangeKind = 0
+
+	// ChangeKindAttributeAdded is an attribute that was not present in the
+	// prior schema. Adding a Required attribute is breaking.
+	ChangeKindAttributeAdded ChangeKind = 1
+
+	// ChangeKindAttributeRemoved is an attribute that is no longer present
+	// in the current schema. This is breaking and requires a state upgrade.
+	ChangeKindAttributeRemoved ChangeKind = 2
+
+	// ChangeKindAttributeTypeChanged is an attribute with a different Type.
+	// If the underlying Terraform type is different, this is breaking and
+	// requires a state upgrade, otherwise it is compatible.
+	ChangeKindAttributeTypeChanged ChangeKind = 3
+
+	// ChangeKindAttributeNestingModeChanged is a nested attribute with a
+	// different NestingMode. This is breaking and requires a state upgrade.
+	ChangeKindAttributeNestingModeChanged ChangeKind = 4
+
+	// ChangeKindAttributeRequiredChanged is an attribute where Required was
+	// changed. Becoming required is breaking.
+	ChangeKindAttributeRequiredChanged ChangeKind = 5
+
+	// ChangeKindAttributeOptionalChanged is an attribute where Optional was
+	// changed. No longer being configurable is breaking.
+	ChangeKindAttributeOptionalChanged ChangeKind = 6
+
+	// ChangeKindAttributeComputedChanged is an attribute where Computed was
+	// changed. No longer being computed is breaking, since prior state values
+	// which were set by the provider will now cause differences.
+	ChangeKindAttributeComputedChanged ChangeKind = 7
+
+	// ChangeKindAttributeSensitiveChanged is an attribute where Sensitive
+	// was changed. This is compatible.
+	ChangeKindAttributeSensitiveChanged ChangeKind = 8
+
+	// ChangeKindAttributeDeprecationChanged is an attribute where the
+	// DeprecationMessage was added or removed. This is compatible.
+	ChangeKindAttributeDeprecationChanged ChangeKind = 9
+
+	// ChangeKindAttributeBecameBlock is an attribute which was converted to
+	// a block. This is breaking, since the configuration syntax differs, and
+	// requires a state upgrade if the underlying Terraform type differs.
+	ChangeKindAttributeBecameBlock ChangeKind = 10
+
+	// ChangeKindBlockAdded is a block that was not present in the prior
+	// schema. Adding a block with MinItems is breaking.
+	ChangeKindBlockAdded ChangeKind = 11
+
+	// ChangeKindBlockRemoved is a block that is no longer present in the
+	// current schema. This is breaking and requires a state upgrade.
+	ChangeKindBlockRemoved ChangeKind = 12
+
+	// ChangeKindBlockNestingModeChanged is a block with a different
+	// NestingMode. This is breaking and requires a state upgrade.
+	ChangeKindBlockNestingModeChanged ChangeKind = 13
+
+	// ChangeKindBlockMinItemsChanged is a block where MinItems was changed.
+	// Increasing MinItems is breaking.
+	ChangeKindBlockMinItemsChanged ChangeKind = 14
+
+	// ChangeKindBlockMaxItemsChanged is a block where MaxItems was changed.
+	// Decreasing MaxItems is breaking.
+	ChangeKindBlockMaxItemsChanged ChangeKind = 15
+
+	// ChangeKindBlockDeprecationChanged is a block where the
+	// DeprecationMessage was added or removed. This is compatible.
+	ChangeKindBlockDeprecationChanged ChangeKind = 16
+
+	// ChangeKindBlockBecameAttribute is a block which was converted to an
+	// attribute. This is breaking, since the configuration syntax differs,
+	// and requires a state upgrade if the underlying Terraform type differs.
+	ChangeKindBlockBecameAttribute ChangeKind = 17
+)
+
+// String returns a human-friendly representation of the ChangeKind.
+func (k ChangeKind) String() string {
+	switch k {
+	case ChangeKindAttributeAdded:
+		return "attribute added"
+	case ChangeKindAttributeRemoved:
+		return "attribute removed"
+	case ChangeKindAttributeTypeChanged:
+		return "attribute type changed"
+	case ChangeKindAttributeNestingModeChanged:
+		return "attribute nesting mode changed"
+	case ChangeKindAttributeRequiredChanged:
+		return "attribute required changed"
+	case ChangeKindAttributeOptionalChanged:
+		return "attribute optional changed"
+	case ChangeKindAttributeComputedChanged:
+		return "attribute computed changed"
+	case ChangeKindAttributeSensitiveChanged:
+		return "attribute sensitive changed"
+	case ChangeKindAttributeDeprecationChanged:
+		return "attribute deprecation changed"
+	case ChangeKindAttributeBecameBlock:
+		return "attribute became block"
+	case ChangeKindBlockAdded:
+		return "block added"
+	case ChangeKindBlockRemoved:
+		return "block removed"
+	case ChangeKindBlockNestingModeChanged:
+		return "block nesting mode changed"
+	case ChangeKindBlockMinItemsChanged:
+		return "block min items changed"
+	case ChangeKindBlockMaxItemsChanged:
+		return "block max items changed"
+	case ChangeKindBlockDeprecationChanged:
+		return "block deprecation changed"
+	case ChangeKindBlockBecameAttribute:
+		return "block became attribute"
+	default:
+		return fmt.Sprintf("unknown change kind %d", k)
+	}
+}
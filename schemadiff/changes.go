@@ -0,0 +1,59 @@
+package schemadiff
+
+import "strings"
+
+// Changes is a collection of schema differences.
+type Changes []Change
+
+// Breaking returns all changes which are breaking for practitioners.
+func (c Changes) Breaking() Changes {
+	var result Changes
+
+	for _, change := range c {
+		if change.Breaking {
+			result = append(result, change)
+		}
+	}
+
+	return result
+}
+
+// Classification returns the most severe Classification of all changes. An
+// empty collection is ClassificationCompatible.
+func (c Changes) Classification() Classification {
+	result := ClassificationCompatible
+
+	for _, change := range c {
+		if classification := change.Classification(); classification > result {
+			result = classification
+		}
+	}
+
+	return result
+}
+
+// RequiresStateUpgrade returns all changes which require a state upgrade.
+func (c Changes) RequiresStateUpgrade() Changes {
+	var result Changes
+
+	for _, change := range c {
+		if change.RequiresStateUpgrade {
+			result = append(result, change)
+		}
+	}
+
+	return result
+}
+
+// String returns a human-friendly representation of the changes, one per
+// line. It is intended for logging and error messages and is not protected by
+// compatibility guarantees.
+func (c Changes) String() string {
+	lines := make([]string, 0, len(c))
+
+	for _, change := range c {
+		lines = append(lines, change.String())
+	}
+
+	return strings.Join(lines, "\n")
+}
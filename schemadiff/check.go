@@ -0,0 +1,114 @@
+package schemadiff
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// CheckOptions are options for customizing the behavior of Check.
+type CheckOptions struct {
+	// AllowBreaking prevents error diagnostics for changes which are
+	// breaking for practitioners, such as when preparing a major release.
+	// Version and state upgrade checks are still performed.
+	AllowBreaking bool
+
+	// StateUpgraders, if set, should be the result of the resource
+	// UpgradeState method. When the current schema Version was incremented,
+	// an upgrader for the prior schema Version must be present and its
+	// PriorSchema, if set, must be compatible with the prior schema.
+	StateUpgraders map[int64]tfsdk.ResourceStateUpgrader
+}
+
+// Check compares the prior and current schema, returning error diagnostics
+// for changes which would corrupt or break existing resource state and
+// practitioner configurations. It is intended to be called from provider unit
+// testing, for example:
+//
+//	diags := schemadiff.Check(ctx, priorSchema, currentSchema, schemadiff.CheckOptions{
+//	    StateUpgraders: (&exampleResource{}).UpgradeState(ctx),
+//	})
+//
+//	if diags.HasError() {
+//	    t.Fatalf("unexpected schema changes: %v", diags)
+//	}
+//
+// Current checks which return errors:
+//
+//   - Version is lower than the prior Version
+//   - Breaking changes, unless CheckOptions.AllowBreaking is true
+//   - Changes requiring a state upgrade without a Version increment
+//   - Version increment without a StateUpgraders entry for the prior Version,
+//     if CheckOptions.StateUpgraders is set
+//   - StateUpgraders entry for the prior Version with a PriorSchema that
+//     cannot read the prior state, if CheckOptions.StateUpgraders is set
+func Check(ctx context.Context, prior tfsdk.Schema, current tfsdk.Schema, opts CheckOptions) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if current.Version < prior.Version {
+		diags.AddError(
+			"Schema Version Decreased",
+			fmt.Sprintf("The schema Version decreased from %d to %d. Versions must only be incremented.", prior.Version, current.Version),
+		)
+
+		return diags
+	}
+
+	changes := Compare(ctx, prior, current)
+
+	if !opts.AllowBreaking {
+		for _, change := range changes.Breaking() {
+			diags.AddAttributeError(
+				change.Path,
+				"Breaking Schema Change",
+				fmt.Sprintf("The schema change (%s) is breaking for practitioners. %s", change.Kind, change.Detail),
+			)
+		}
+	}
+
+	upgradeChanges := changes.RequiresStateUpgrade()
+
+	if len(upgradeChanges) > 0 && current.Version == prior.Version {
+		for _, change := range upgradeChanges {
+			diags.AddAttributeError(
+				change.Path,
+				"Schema Version Not Incremented",
+				fmt.Sprintf("The schema change (%s) requires a state upgrade, however the schema Version was not incremented from %d. ", change.Kind, prior.Version)+
+					"Increment the schema Version and implement a ResourceStateUpgrader for the prior version. "+change.Detail,
+			)
+		}
+	}
+
+	if opts.StateUpgraders == nil || current.Version == prior.Version {
+		return diags
+	}
+
+	stateUpgrader, ok := opts.StateUpgraders[prior.Version]
+
+	if !ok {
+		diags.AddError(
+			"Missing Resource State Upgrader",
+			fmt.Sprintf("The schema Version was incremented from %d to %d, however no ResourceStateUpgrader was implemented for version %d.", prior.Version, current.Version, prior.Version),
+		)
+
+		return diags
+	}
+
+	if stateUpgrader.PriorSchema == nil {
+		return diags
+	}
+
+	priorSchemaChanges := Compare(ctx, prior, *stateUpgrader.PriorSchema).RequiresStateUpgrade()
+
+	if len(priorSchemaChanges) > 0 {
+		diags.AddError(
+			"Invalid Resource State Upgrader Prior Schema",
+			fmt.Sprintf("The ResourceStateUpgrader for version %d has a PriorSchema which cannot read state written with the prior schema. ", prior.Version)+
+				"The following differences were found:\n\n"+priorSchemaChanges.String(),
+		)
+	}
+
+	return diags
+}
@@ -0,0 +1,175 @@
+package schemadiff_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schemadiff"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestCheck(t *testing.T) {
+	t.Parallel()
+
+	testSchemaV0 := tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"test": {
+				Type:     types.StringType,
+				Optional: true,
+			},
+		},
+	}
+
+	testSchemaV0Compatible := tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"test": {
+				Type:     types.StringType,
+				Optional: true,
+			},
+			"added": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+		},
+	}
+
+	testSchemaV1 := tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"test": {
+				Type:     types.Int64Type,
+				Optional: true,
+			},
+		},
+		Version: 1,
+	}
+
+	testCases := map[string]struct {
+		prior    tfsdk.Schema
+		current  tfsdk.Schema
+		opts     schemadiff.CheckOptions
+		expected diag.Diagnostics
+	}{
+		"no-changes": {
+			prior:    testSchemaV0,
+			current:  testSchemaV0,
+			expected: nil,
+		},
+		"compatible": {
+			prior:    testSchemaV0,
+			current:  testSchemaV0Compatible,
+			expected: nil,
+		},
+		"version-decreased": {
+			prior:   testSchemaV1,
+			current: testSchemaV0,
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Schema Version Decreased",
+					"The schema Version decreased from 1 to 0. Versions must only be incremented.",
+				),
+			},
+		},
+		"breaking": {
+			prior:   testSchemaV0,
+			current: testSchemaV1,
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Breaking Schema Change",
+					"The schema change (attribute type changed) is breaking for practitioners. The attribute type changed from types.StringType to types.Int64Type.",
+				),
+			},
+		},
+		"breaking-allowed": {
+			prior:   testSchemaV0,
+			current: testSchemaV1,
+			opts: schemadiff.CheckOptions{
+				AllowBreaking: true,
+			},
+			expected: nil,
+		},
+		"version-not-incremented": {
+			prior: testSchemaV0,
+			current: tfsdk.Schema{
+				Attributes: testSchemaV1.Attributes,
+			},
+			opts: schemadiff.CheckOptions{
+				AllowBreaking: true,
+			},
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Schema Version Not Incremented",
+					"The schema change (attribute type changed) requires a state upgrade, however the schema Version was not incremented from 0. "+
+						"Increment the schema Version and implement a ResourceStateUpgrader for the prior version. "+
+						"The attribute type changed from types.StringType to types.Int64Type.",
+				),
+			},
+		},
+		"state-upgrader-missing": {
+			prior:   testSchemaV0,
+			current: testSchemaV1,
+			opts: schemadiff.CheckOptions{
+				AllowBreaking:  true,
+				StateUpgraders: map[int64]tfsdk.ResourceStateUpgrader{},
+			},
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Missing Resource State Upgrader",
+					"The schema Version was incremented from 0 to 1, however no ResourceStateUpgrader was implemented for version 0.",
+				),
+			},
+		},
+		"state-upgrader-prior-schema-compatible": {
+			prior:   testSchemaV0,
+			current: testSchemaV1,
+			opts: schemadiff.CheckOptions{
+				AllowBreaking: true,
+				StateUpgraders: map[int64]tfsdk.ResourceStateUpgrader{
+					0: {
+						PriorSchema: &testSchemaV0Compatible,
+					},
+				},
+			},
+			expected: nil,
+		},
+		"state-upgrader-prior-schema-invalid": {
+			prior:   testSchemaV0,
+			current: testSchemaV1,
+			opts: schemadiff.CheckOptions{
+				AllowBreaking: true,
+				StateUpgraders: map[int64]tfsdk.ResourceStateUpgrader{
+					0: {
+						PriorSchema: &testSchemaV1,
+					},
+				},
+			},
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Resource State Upgrader Prior Schema",
+					"The ResourceStateUpgrader for version 0 has a PriorSchema which cannot read state written with the prior schema. "+
+						"The following differences were found:\n\n"+
+						"test: attribute type changed (breaking): The attribute type changed from types.StringType to types.Int64Type.",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := schemadiff.Check(context.Background(), testCase.prior, testCase.current, testCase.opts)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package schemadiff
+
+import "fmt"
+
+// Classification is an enum type of the impact of a schema change.
+// Classifications are ordered by severity, so they can be compared.
+type Classification uint8
+
+const (
+	// ClassificationCompatible is a change that requires no action from
+	// practitioners and no state upgrade.
+	ClassificationCompatible Classification = 0
+
+	// ClassificationRequiresStateUpgrade is a change where existing resource
+	// state can no longer be read with the current schema. The Schema
+	// Version must be incremented and a ResourceStateUpgrader implemented for
+	// the prior version.
+	ClassificationRequiresStateUpgrade Classification = 1
+
+	// ClassificationBreaking is a change which can cause existing
+	// practitioner configurations to raise errors or unexpected plans. These
+	// changes may also require a state upgrade, which is available via the
+	// Change type RequiresStateUpgrade field.
+	ClassificationBreaking Classification = 2
+)
+
+// String returns a human-friendly representation of the Classification.
+func (c Classification) String() string {
+	switch c {
+	case ClassificationCompatible:
+		return "compatible"
+	case ClassificationRequiresStateUpgrade:
+		return "requires state upgrade"
+	case ClassificationBreaking:
+		return "breaking"
+	default:
+		return fmt.Sprintf("unknown classification %d", c)
+	}
+}
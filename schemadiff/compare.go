@@ -0,0 +1,412 @@
+package schemadiff
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Compare returns all differences between the prior and current schema,
+// sorted by path. Only differences which affect resource state or
+// practitioner configurations are returned, so descriptions, validators, and
+// plan modifiers are not compared.
+//
+// The Version field of the schemas is not compared here, use Check to verify
+// that version increments accompany changes requiring a state upgrade.
+func Compare(ctx context.Context, prior tfsdk.Schema, current tfsdk.Schema) Changes {
+	changes := compareMembers(ctx, path.Empty(), prior.Attributes, prior.Blocks, current.Attributes, current.Blocks)
+
+	sort.SliceStable(changes, func(i, j int) bool {
+		if changes[i].Path.String() != changes[j].Path.String() {
+			return changes[i].Path.String() < changes[j].Path.String()
+		}
+
+		return changes[i].Kind < changes[j].Kind
+	})
+
+	return changes
+}
+
+// compareMembers compares attributes and blocks at the same level of a
+// schema, detecting additions, removals, and conversions between attributes
+// and blocks, then recursing into matching members.
+func compareMembers(ctx context.Context, p path.Path, priorAttributes map[string]tfsdk.Attribute, priorBlocks map[string]tfsdk.Block, currentAttributes map[string]tfsdk.Attribute, currentBlocks map[string]tfsdk.Block) Changes {
+	var changes Changes
+
+	for _, name := range memberNames(priorAttributes, priorBlocks, currentAttributes, currentBlocks) {
+		memberPath := p.AtName(name)
+		priorAttribute, priorIsAttribute := priorAttributes[name]
+		priorBlock, priorIsBlock := priorBlocks[name]
+		currentAttribute, currentIsAttribute := currentAttributes[name]
+		currentBlock, currentIsBlock := currentBlocks[name]
+
+		switch {
+		case priorIsAttribute && currentIsAttribute:
+			changes = append(changes, compareAttributes(ctx, memberPath, priorAttribute, currentAttribute)...)
+		case priorIsBlock && currentIsBlock:
+			changes = append(changes, compareBlocks(ctx, memberPath, priorBlock, currentBlock)...)
+		case priorIsAttribute && currentIsBlock:
+			changes = append(changes, Change{
+				Path:                 memberPath,
+				Kind:                 ChangeKindAttributeBecameBlock,
+				Breaking:             true,
+				RequiresStateUpgrade: !terraformTypesEqual(attributeTerraformType(ctx, priorAttribute), blockTerraformType(ctx, currentBlock)),
+				Detail:               "The attribute was converted to a block, which requires configurations to remove the equals sign (=).",
+			})
+		case priorIsBlock && currentIsAttribute:
+			changes = append(changes, Change{
+				Path:                 memberPath,
+				Kind:                 ChangeKindBlockBecameAttribute,
+				Breaking:             true,
+				RequiresStateUpgrade: !terraformTypesEqual(blockTerraformType(ctx, priorBlock), attributeTerraformType(ctx, currentAttribute)),
+				Detail:               "The block was converted to an attribute, which requires configurations to add an equals sign (=).",
+			})
+		case priorIsAttribute:
+			changes = append(changes, Change{
+				Path:                 memberPath,
+				Kind:                 ChangeKindAttributeRemoved,
+				Breaking:             true,
+				RequiresStateUpgrade: true,
+				Detail:               "The attribute was removed. Configurations referencing it will raise errors and prior state containing it cannot be read.",
+			})
+		case priorIsBlock:
+			changes = append(changes, Change{
+				Path:                 memberPath,
+				Kind:                 ChangeKindBlockRemoved,
+				Breaking:             true,
+				RequiresStateUpgrade: true,
+				Detail:               "The block was removed. Configurations referencing it will raise errors and prior state containing it cannot be read.",
+			})
+		case currentIsAttribute:
+			change := Change{
+				Path:   memberPath,
+				Kind:   ChangeKindAttributeAdded,
+				Detail: "The attribute was added.",
+			}
+
+			if currentAttribute.Required {
+				change.Breaking = true
+				change.Detail = "The attribute was added as Required. Existing configurations will not include it."
+			}
+
+			changes = append(changes, change)
+		case currentIsBlock:
+			change := Change{
+				Path:   memberPath,
+				Kind:   ChangeKindBlockAdded,
+				Detail: "The block was added.",
+			}
+
+			if currentBlock.MinItems > 0 {
+				change.Breaking = true
+				change.Detail = fmt.Sprintf("The block was added with MinItems %d. Existing configurations will not include it.", currentBlock.MinItems)
+			}
+
+			changes = append(changes, change)
+		}
+	}
+
+	return changes
+}
+
+// compareAttributes compares an attribute which exists in both schemas.
+func compareAttributes(ctx context.Context, p path.Path, prior tfsdk.Attribute, current tfsdk.Attribute) Changes {
+	var changes Changes
+
+	switch {
+	case prior.Attributes != nil && current.Attributes != nil:
+		if prior.Attributes.GetNestingMode() != current.Attributes.GetNestingMode() {
+			changes = append(changes, Change{
+				Path:                 p,
+				Kind:                 ChangeKindAttributeNestingModeChanged,
+				Breaking:             true,
+				RequiresStateUpgrade: true,
+				Detail:               fmt.Sprintf("The nested attributes nesting mode changed from %s to %s.", nestingModeString(prior.Attributes.GetNestingMode()), nestingModeString(current.Attributes.GetNestingMode())),
+			})
+			break
+		}
+
+		changes = append(changes, compareMembers(ctx, p, prior.Attributes.GetAttributes(), nil, current.Attributes.GetAttributes(), nil)...)
+	case prior.Type != nil && current.Type != nil && prior.Type.Equal(current.Type):
+		// No type changes.
+	default:
+		priorType := attributeTerraformType(ctx, prior)
+		currentType := attributeTerraformType(ctx, current)
+		stateChange := !terraformTypesEqual(priorType, currentType)
+
+		changes = append(changes, Change{
+			Path:                 p,
+			Kind:                 ChangeKindAttributeTypeChanged,
+			Breaking:             stateChange,
+			RequiresStateUpgrade: stateChange,
+			Detail:               fmt.Sprintf("The attribute type changed from %s to %s.", attributeTypeString(prior), attributeTypeString(current)),
+		})
+	}
+
+	if prior.Required != current.Required {
+		change := Change{
+			Path:   p,
+			Kind:   ChangeKindAttributeRequiredChanged,
+			Detail: "The attribute is no longer Required.",
+		}
+
+		if current.Required {
+			change.Breaking = true
+			change.Detail = "The attribute is now Required. Existing configurations may not include it."
+		}
+
+		changes = append(changes, change)
+	}
+
+	if prior.Optional != current.Optional {
+		change := Change{
+			Path:   p,
+			Kind:   ChangeKindAttributeOptionalChanged,
+			Detail: "The attribute is now Optional.",
+		}
+
+		if prior.Optional {
+			change.Detail = "The attribute is no longer Optional."
+
+			// Becoming Required is already reported as breaking.
+			if !current.Required {
+				change.Breaking = true
+				change.Detail = "The attribute is no longer Optional. Existing configurations which set it will raise errors."
+			}
+		}
+
+		changes = append(changes, change)
+	}
+
+	if prior.Computed != current.Computed {
+		change := Change{
+			Path:   p,
+			Kind:   ChangeKindAttributeComputedChanged,
+			Detail: "The attribute is now Computed.",
+		}
+
+		if prior.Computed {
+			change.Breaking = true
+			change.Detail = "The attribute is no longer Computed. Values previously set by the provider will cause plan differences."
+		}
+
+		changes = append(changes, change)
+	}
+
+	if prior.Sensitive != current.Sensitive {
+		change := Change{
+			Path:   p,
+			Kind:   ChangeKindAttributeSensitiveChanged,
+			Detail: "The attribute is now Sensitive.",
+		}
+
+		if prior.Sensitive {
+			change.Detail = "The attribute is no longer Sensitive."
+		}
+
+		changes = append(changes, change)
+	}
+
+	if (prior.DeprecationMessage == "") != (current.DeprecationMessage == "") {
+		change := Change{
+			Path:   p,
+			Kind:   ChangeKindAttributeDeprecationChanged,
+			Detail: "The attribute is now deprecated.",
+		}
+
+		if prior.DeprecationMessage != "" {
+			change.Detail = "The attribute is no longer deprecated."
+		}
+
+		changes = append(changes, change)
+	}
+
+	return changes
+}
+
+// compareBlocks compares a block which exists in both schemas.
+func compareBlocks(ctx context.Context, p path.Path, prior tfsdk.Block, current tfsdk.Block) Changes {
+	var changes Changes
+
+	if prior.NestingMode != current.NestingMode {
+		return append(changes, Change{
+			Path:                 p,
+			Kind:                 ChangeKindBlockNestingModeChanged,
+			Breaking:             true,
+			RequiresStateUpgrade: true,
+			Detail:               fmt.Sprintf("The block nesting mode changed from %s to %s.", blockNestingModeString(prior.NestingMode), blockNestingModeString(current.NestingMode)),
+		})
+	}
+
+	if prior.MinItems != current.MinItems {
+		changes = append(changes, Change{
+			Path:     p,
+			Kind:     ChangeKindBlockMinItemsChanged,
+			Breaking: current.MinItems > prior.MinItems,
+			Detail:   fmt.Sprintf("The block MinItems changed from %d to %d.", prior.MinItems, current.MinItems),
+		})
+	}
+
+	if prior.MaxItems != current.MaxItems {
+		changes = append(changes, Change{
+			Path: p,
+			Kind: ChangeKindBlockMaxItemsChanged,
+			// Zero represents no maximum.
+			Breaking: current.MaxItems != 0 && (prior.MaxItems == 0 || current.MaxItems < prior.MaxItems),
+			Detail:   fmt.Sprintf("The block MaxItems changed from %d to %d.", prior.MaxItems, current.MaxItems),
+		})
+	}
+
+	if (prior.DeprecationMessage == "") != (current.DeprecationMessage == "") {
+		change := Change{
+			Path:   p,
+			Kind:   ChangeKindBlockDeprecationChanged,
+			Detail: "The block is now deprecated.",
+		}
+
+		if prior.DeprecationMessage != "" {
+			change.Detail = "The block is no longer deprecated."
+		}
+
+		changes = append(changes, change)
+	}
+
+	return append(changes, compareMembers(ctx, p, prior.Attributes, prior.Blocks, current.Attributes, current.Blocks)...)
+}
+
+// memberNames returns the sorted, unique names of all attributes and blocks.
+func memberNames(priorAttributes map[string]tfsdk.Attribute, priorBlocks map[string]tfsdk.Block, currentAttributes map[string]tfsdk.Attribute, currentBlocks map[string]tfsdk.Block) []string {
+	unique := map[string]struct{}{}
+
+	for name := range priorAttributes {
+		unique[name] = struct{}{}
+	}
+
+	for name := range priorBlocks {
+		unique[name] = struct{}{}
+	}
+
+	for name := range currentAttributes {
+		unique[name] = struct{}{}
+	}
+
+	for name := range currentBlocks {
+		unique[name] = struct{}{}
+	}
+
+	names := make([]string, 0, len(unique))
+
+	for name := range unique {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// attributeTerraformType returns the tftypes.Type of an attribute or nil if
+// the attribute has neither Type nor Attributes.
+func attributeTerraformType(ctx context.Context, a tfsdk.Attribute) tftypes.Type {
+	if a.Attributes != nil {
+		return a.Attributes.AttributeType().TerraformType(ctx)
+	}
+
+	if a.Type != nil {
+		return a.Type.TerraformType(ctx)
+	}
+
+	return nil
+}
+
+// blockTerraformType returns the tftypes.Type of a block or nil if the block
+// has an invalid nesting mode.
+func blockTerraformType(ctx context.Context, b tfsdk.Block) tftypes.Type {
+	if !blockNestingModesValid(b) {
+		return nil
+	}
+
+	schema := tfsdk.Schema{
+		Blocks: map[string]tfsdk.Block{
+			"block": b,
+		},
+	}
+
+	schemaType, ok := schema.TerraformType(ctx).(tftypes.Object)
+
+	if !ok {
+		return nil
+	}
+
+	return schemaType.AttributeTypes["block"]
+}
+
+// blockNestingModesValid returns false if the block or any underlying block
+// has an unsupported nesting mode, which would otherwise panic when
+// generating the block type.
+func blockNestingModesValid(b tfsdk.Block) bool {
+	switch b.NestingMode {
+	case tfsdk.BlockNestingModeList, tfsdk.BlockNestingModeSet:
+	default:
+		return false
+	}
+
+	for _, nestedBlock := range b.Blocks {
+		if !blockNestingModesValid(nestedBlock) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// terraformTypesEqual returns true if both types are non-nil and equal.
+func terraformTypesEqual(a tftypes.Type, b tftypes.Type) bool {
+	if a == nil || b == nil {
+		return false
+	}
+
+	return a.Equal(b)
+}
+
+func attributeTypeString(a tfsdk.Attribute) string {
+	if a.Attributes != nil {
+		return nestingModeString(a.Attributes.GetNestingMode()) + " nested attributes"
+	}
+
+	if a.Type != nil {
+		return a.Type.String()
+	}
+
+	return "no type"
+}
+
+func nestingModeString(m tfsdk.NestingMode) string {
+	switch m {
+	case tfsdk.NestingModeSingle:
+		return "single"
+	case tfsdk.NestingModeList:
+		return "list"
+	case tfsdk.NestingModeSet:
+		return "set"
+	case tfsdk.NestingModeMap:
+		return "map"
+	default:
+		return fmt.Sprintf("unknown nesting mode %d", m)
+	}
+}
+
+func blockNestingModeString(m tfsdk.BlockNestingMode) string {
+	switch m {
+	case tfsdk.BlockNestingModeList:
+		return "list"
+	case tfsdk.BlockNestingModeSet:
+		return "set"
+	default:
+		return fmt.Sprintf("unknown nesting mode %d", m)
+	}
+}
@@ -0,0 +1,682 @@
+package schemadiff_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schemadiff"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestCompare(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		prior    tfsdk.Schema
+		current  tfsdk.Schema
+		expected schemadiff.Changes
+	}{
+		"no-changes": {
+			prior: tfsdk.Schema{
+				Attributes: map[string]tfsdk.Attribute{
+					"test": {
+						Type:     types.StringType,
+						Required: true,
+					},
+				},
+			},
+			current: tfsdk.Schema{
+				Attributes: map[string]tfsdk.Attribute{
+					"test": {
+						Type:        types.StringType,
+						Required:    true,
+						Description: "descriptions are not compared",
+					},
+				},
+			},
+			expected: nil,
+		},
+		"attribute-added-optional": {
+			prior: tfsdk.Schema{},
+			current: tfsdk.Schema{
+				Attributes: map[string]tfsdk.Attribute{
+					"test": {
+						Type:     types.StringType,
+						Optional: true,
+					},
+				},
+			},
+			expected: schemadiff.Changes{
+				{
+					Path:   path.Root("test"),
+					Kind:   schemadiff.ChangeKindAttributeAdded,
+					Detail: "The attribute was added.",
+				},
+			},
+		},
+		"attribute-added-required": {
+			prior: tfsdk.Schema{},
+			current: tfsdk.Schema{
+				Attributes: map[string]tfsdk.Attribute{
+					"test": {
+						Type:     types.StringType,
+						Required: true,
+					},
+				},
+			},
+			expected: schemadiff.Changes{
+				{
+					Path:     path.Root("test"),
+					Kind:     schemadiff.ChangeKindAttributeAdded,
+					Breaking: true,
+					Detail:   "The attribute was added as Required. Existing configurations will not include it.",
+				},
+			},
+		},
+		"attribute-removed": {
+			prior: tfsdk.Schema{
+				Attributes: map[string]tfsdk.Attribute{
+					"test": {
+						Type:     types.StringType,
+						Optional: true,
+					},
+				},
+			},
+			current: tfsdk.Schema{},
+			expected: schemadiff.Changes{
+				{
+					Path:                 path.Root("test"),
+					Kind:                 schemadiff.ChangeKindAttributeRemoved,
+					Breaking:             true,
+					RequiresStateUpgrade: true,
+					Detail:               "The attribute was removed. Configurations referencing it will raise errors and prior state containing it cannot be read.",
+				},
+			},
+		},
+		"attribute-optional-to-required": {
+			prior: tfsdk.Schema{
+				Attributes: map[string]tfsdk.Attribute{
+					"test": {
+						Type:     types.StringType,
+						Optional: true,
+					},
+				},
+			},
+			current: tfsdk.Schema{
+				Attributes: map[string]tfsdk.Attribute{
+					"test": {
+						Type:     types.StringType,
+						Required: true,
+					},
+				},
+			},
+			expected: schemadiff.Changes{
+				{
+					Path:     path.Root("test"),
+					Kind:     schemadiff.ChangeKindAttributeRequiredChanged,
+					Breaking: true,
+					Detail:   "The attribute is now Required. Existing configurations may not include it.",
+				},
+				{
+					Path:   path.Root("test"),
+					Kind:   schemadiff.ChangeKindAttributeOptionalChanged,
+					Detail: "The attribute is no longer Optional.",
+				},
+			},
+		},
+		"attribute-required-to-optional": {
+			prior: tfsdk.Schema{
+				Attributes: map[string]tfsdk.Attribute{
+					"test": {
+						Type:     types.StringType,
+						Required: true,
+					},
+				},
+			},
+			current: tfsdk.Schema{
+				Attributes: map[string]tfsdk.Attribute{
+					"test": {
+						Type:     types.StringType,
+						Optional: true,
+					},
+				},
+			},
+			expected: schemadiff.Changes{
+				{
+					Path:   path.Root("test"),
+					Kind:   schemadiff.ChangeKindAttributeRequiredChanged,
+					Detail: "The attribute is no longer Required.",
+				},
+				{
+					Path:   path.Root("test"),
+					Kind:   schemadiff.ChangeKindAttributeOptionalChanged,
+					Detail: "The attribute is now Optional.",
+				},
+			},
+		},
+		"attribute-optional-to-computed": {
+			prior: tfsdk.Schema{
+				Attributes: map[string]tfsdk.Attribute{
+					"test": {
+						Type:     types.StringType,
+						Optional: true,
+					},
+				},
+			},
+			current: tfsdk.Schema{
+				Attributes: map[string]tfsdk.Attribute{
+					"test": {
+						Type:     types.StringType,
+						Computed: true,
+					},
+				},
+			},
+			expected: schemadiff.Changes{
+				{
+					Path:     path.Root("test"),
+					Kind:     schemadiff.ChangeKindAttributeOptionalChanged,
+					Breaking: true,
+					Detail:   "The attribute is no longer Optional. Existing configurations which set it will raise errors.",
+				},
+				{
+					Path:   path.Root("test"),
+					Kind:   schemadiff.ChangeKindAttributeComputedChanged,
+					Detail: "The attribute is now Computed.",
+				},
+			},
+		},
+		"attribute-computed-removed": {
+			prior: tfsdk.Schema{
+				Attributes: map[string]tfsdk.Attribute{
+					"test": {
+						Type:     types.StringType,
+						Optional: true,
+						Computed: true,
+					},
+				},
+			},
+			current: tfsdk.Schema{
+				Attributes: map[string]tfsdk.Attribute{
+					"test": {
+						Type:     types.StringType,
+						Optional: true,
+					},
+				},
+			},
+			expected: schemadiff.Changes{
+				{
+					Path:     path.Root("test"),
+					Kind:     schemadiff.ChangeKindAttributeComputedChanged,
+					Breaking: true,
+					Detail:   "The attribute is no longer Computed. Values previously set by the provider will cause plan differences.",
+				},
+			},
+		},
+		"attribute-sensitive-and-deprecated": {
+			prior: tfsdk.Schema{
+				Attributes: map[string]tfsdk.Attribute{
+					"test": {
+						Type:     types.StringType,
+						Optional: true,
+					},
+				},
+			},
+			current: tfsdk.Schema{
+				Attributes: map[string]tfsdk.Attribute{
+					"test": {
+						Type:               types.StringType,
+						Optional:           true,
+						Sensitive:          true,
+						DeprecationMessage: "Use other_test instead.",
+					},
+				},
+			},
+			expected: schemadiff.Changes{
+				{
+					Path:   path.Root("test"),
+					Kind:   schemadiff.ChangeKindAttributeSensitiveChanged,
+					Detail: "The attribute is now Sensitive.",
+				},
+				{
+					Path:   path.Root("test"),
+					Kind:   schemadiff.ChangeKindAttributeDeprecationChanged,
+					Detail: "The attribute is now deprecated.",
+				},
+			},
+		},
+		"attribute-type-changed": {
+			prior: tfsdk.Schema{
+				Attributes: map[string]tfsdk.Attribute{
+					"test": {
+						Type:     types.StringType,
+						Optional: true,
+					},
+				},
+			},
+			current: tfsdk.Schema{
+				Attributes: map[string]tfsdk.Attribute{
+					"test": {
+						Type:     types.Int64Type,
+						Optional: true,
+					},
+				},
+			},
+			expected: schemadiff.Changes{
+				{
+					Path:                 path.Root("test"),
+					Kind:                 schemadiff.ChangeKindAttributeTypeChanged,
+					Breaking:             true,
+					RequiresStateUpgrade: true,
+					Detail:               "The attribute type changed from types.StringType to types.Int64Type.",
+				},
+			},
+		},
+		"attribute-type-changed-same-terraform-type": {
+			prior: tfsdk.Schema{
+				Attributes: map[string]tfsdk.Attribute{
+					"test": {
+						Type:     types.NumberType,
+						Optional: true,
+					},
+				},
+			},
+			current: tfsdk.Schema{
+				Attributes: map[string]tfsdk.Attribute{
+					"test": {
+						Type:     types.Int64Type,
+						Optional: true,
+					},
+				},
+			},
+			expected: schemadiff.Changes{
+				{
+					Path:   path.Root("test"),
+					Kind:   schemadiff.ChangeKindAttributeTypeChanged,
+					Detail: "The attribute type changed from types.NumberType to types.Int64Type.",
+				},
+			},
+		},
+		"attribute-type-to-nested-attributes-same-terraform-type": {
+			prior: tfsdk.Schema{
+				Attributes: map[string]tfsdk.Attribute{
+					"test": {
+						Type: types.ListType{
+							ElemType: types.ObjectType{
+								AttrTypes: map[string]attr.Type{
+									"nested": types.StringType,
+								},
+							},
+						},
+						Optional: true,
+					},
+				},
+			},
+			current: tfsdk.Schema{
+				Attributes: map[string]tfsdk.Attribute{
+					"test": {
+						Attributes: tfsdk.ListNestedAttributes(map[string]tfsdk.Attribute{
+							"nested": {
+								Type:     types.StringType,
+								Optional: true,
+							},
+						}),
+						Optional: true,
+					},
+				},
+			},
+			expected: schemadiff.Changes{
+				{
+					Path:   path.Root("test"),
+					Kind:   schemadiff.ChangeKindAttributeTypeChanged,
+					Detail: `The attribute type changed from types.ListType[types.ObjectType["nested":types.StringType]] to list nested attributes.`,
+				},
+			},
+		},
+		"nested-attributes-nesting-mode-changed": {
+			prior: tfsdk.Schema{
+				Attributes: map[string]tfsdk.Attribute{
+					"test": {
+						Attributes: tfsdk.ListNestedAttributes(map[string]tfsdk.Attribute{
+							"nested": {
+								Type:     types.StringType,
+								Optional: true,
+							},
+						}),
+						Optional: true,
+					},
+				},
+			},
+			current: tfsdk.Schema{
+				Attributes: map[string]tfsdk.Attribute{
+					"test": {
+						Attributes: tfsdk.SetNestedAttributes(map[string]tfsdk.Attribute{
+							"nested": {
+								Type:     types.StringType,
+								Optional: true,
+							},
+						}),
+						Optional: true,
+					},
+				},
+			},
+			expected: schemadiff.Changes{
+				{
+					Path:                 path.Root("test"),
+					Kind:                 schemadiff.ChangeKindAttributeNestingModeChanged,
+					Breaking:             true,
+					RequiresStateUpgrade: true,
+					Detail:               "The nested attributes nesting mode changed from list to set.",
+				},
+			},
+		},
+		"nested-attributes-attribute-removed": {
+			prior: tfsdk.Schema{
+				Attributes: map[string]tfsdk.Attribute{
+					"test": {
+						Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+							"nested": {
+								Type:     types.StringType,
+								Optional: true,
+							},
+							"other": {
+								Type:     types.StringType,
+								Optional: true,
+							},
+						}),
+						Optional: true,
+					},
+				},
+			},
+			current: tfsdk.Schema{
+				Attributes: map[string]tfsdk.Attribute{
+					"test": {
+						Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+							"other": {
+								Type:     types.StringType,
+								Optional: true,
+							},
+						}),
+						Optional: true,
+					},
+				},
+			},
+			expected: schemadiff.Changes{
+				{
+					Path:                 path.Root("test").AtName("nested"),
+					Kind:                 schemadiff.ChangeKindAttributeRemoved,
+					Breaking:             true,
+					RequiresStateUpgrade: true,
+					Detail:               "The attribute was removed. Configurations referencing it will raise errors and prior state containing it cannot be read.",
+				},
+			},
+		},
+		"block-added-min-items": {
+			prior: tfsdk.Schema{},
+			current: tfsdk.Schema{
+				Blocks: map[string]tfsdk.Block{
+					"test": {
+						Attributes: map[string]tfsdk.Attribute{
+							"nested": {
+								Type:     types.StringType,
+								Optional: true,
+							},
+						},
+						MinItems:    1,
+						NestingMode: tfsdk.BlockNestingModeList,
+					},
+				},
+			},
+			expected: schemadiff.Changes{
+				{
+					Path:     path.Root("test"),
+					Kind:     schemadiff.ChangeKindBlockAdded,
+					Breaking: true,
+					Detail:   "The block was added with MinItems 1. Existing configurations will not include it.",
+				},
+			},
+		},
+		"block-removed": {
+			prior: tfsdk.Schema{
+				Blocks: map[string]tfsdk.Block{
+					"test": {
+						Attributes: map[string]tfsdk.Attribute{
+							"nested": {
+								Type:     types.StringType,
+								Optional: true,
+							},
+						},
+						NestingMode: tfsdk.BlockNestingModeList,
+					},
+				},
+			},
+			current: tfsdk.Schema{},
+			expected: schemadiff.Changes{
+				{
+					Path:                 path.Root("test"),
+					Kind:                 schemadiff.ChangeKindBlockRemoved,
+					Breaking:             true,
+					RequiresStateUpgrade: true,
+					Detail:               "The block was removed. Configurations referencing it will raise errors and prior state containing it cannot be read.",
+				},
+			},
+		},
+		"block-items-and-nested-attribute": {
+			prior: tfsdk.Schema{
+				Blocks: map[string]tfsdk.Block{
+					"test": {
+						Attributes: map[string]tfsdk.Attribute{
+							"nested": {
+								Type:     types.StringType,
+								Optional: true,
+							},
+						},
+						MaxItems:    2,
+						MinItems:    1,
+						NestingMode: tfsdk.BlockNestingModeList,
+					},
+				},
+			},
+			current: tfsdk.Schema{
+				Blocks: map[string]tfsdk.Block{
+					"test": {
+						Attributes: map[string]tfsdk.Attribute{
+							"nested": {
+								Type:     types.StringType,
+								Optional: true,
+							},
+							"new": {
+								Type:     types.StringType,
+								Required: true,
+							},
+						},
+						MaxItems:    1,
+						NestingMode: tfsdk.BlockNestingModeList,
+					},
+				},
+			},
+			expected: schemadiff.Changes{
+				{
+					Path:   path.Root("test"),
+					Kind:   schemadiff.ChangeKindBlockMinItemsChanged,
+					Detail: "The block MinItems changed from 1 to 0.",
+				},
+				{
+					Path:     path.Root("test"),
+					Kind:     schemadiff.ChangeKindBlockMaxItemsChanged,
+					Breaking: true,
+					Detail:   "The block MaxItems changed from 2 to 1.",
+				},
+				{
+					Path:     path.Root("test").AtName("new"),
+					Kind:     schemadiff.ChangeKindAttributeAdded,
+					Breaking: true,
+					Detail:   "The attribute was added as Required. Existing configurations will not include it.",
+				},
+			},
+		},
+		"block-nesting-mode-changed": {
+			prior: tfsdk.Schema{
+				Blocks: map[string]tfsdk.Block{
+					"test": {
+						NestingMode: tfsdk.BlockNestingModeList,
+					},
+				},
+			},
+			current: tfsdk.Schema{
+				Blocks: map[string]tfsdk.Block{
+					"test": {
+						NestingMode: tfsdk.BlockNestingModeSet,
+					},
+				},
+			},
+			expected: schemadiff.Changes{
+				{
+					Path:                 path.Root("test"),
+					Kind:                 schemadiff.ChangeKindBlockNestingModeChanged,
+					Breaking:             true,
+					RequiresStateUpgrade: true,
+					Detail:               "The block nesting mode changed from list to set.",
+				},
+			},
+		},
+		"block-became-attribute-same-terraform-type": {
+			prior: tfsdk.Schema{
+				Blocks: map[string]tfsdk.Block{
+					"test": {
+						Attributes: map[string]tfsdk.Attribute{
+							"nested": {
+								Type:     types.StringType,
+								Optional: true,
+							},
+						},
+						NestingMode: tfsdk.BlockNestingModeList,
+					},
+				},
+			},
+			current: tfsdk.Schema{
+				Attributes: map[string]tfsdk.Attribute{
+					"test": {
+						Attributes: tfsdk.ListNestedAttributes(map[string]tfsdk.Attribute{
+							"nested": {
+								Type:     types.StringType,
+								Optional: true,
+							},
+						}),
+						Optional: true,
+					},
+				},
+			},
+			expected: schemadiff.Changes{
+				{
+					Path:     path.Root("test"),
+					Kind:     schemadiff.ChangeKindBlockBecameAttribute,
+					Breaking: true,
+					Detail:   "The block was converted to an attribute, which requires configurations to add an equals sign (=).",
+				},
+			},
+		},
+		"attribute-became-block-different-terraform-type": {
+			prior: tfsdk.Schema{
+				Attributes: map[string]tfsdk.Attribute{
+					"test": {
+						Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+							"nested": {
+								Type:     types.StringType,
+								Optional: true,
+							},
+						}),
+						Optional: true,
+					},
+				},
+			},
+			current: tfsdk.Schema{
+				Blocks: map[string]tfsdk.Block{
+					"test": {
+						Attributes: map[string]tfsdk.Attribute{
+							"nested": {
+								Type:     types.StringType,
+								Optional: true,
+							},
+						},
+						NestingMode: tfsdk.BlockNestingModeList,
+					},
+				},
+			},
+			expected: schemadiff.Changes{
+				{
+					Path:                 path.Root("test"),
+					Kind:                 schemadiff.ChangeKindAttributeBecameBlock,
+					Breaking:             true,
+					RequiresStateUpgrade: true,
+					Detail:               "The attribute was converted to a block, which requires configurations to remove the equals sign (=).",
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := schemadiff.Compare(context.Background(), testCase.prior, testCase.current)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestChangesClassification(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		changes  schemadiff.Changes
+		expected schemadiff.Classification
+	}{
+		"empty": {
+			changes:  nil,
+			expected: schemadiff.ClassificationCompatible,
+		},
+		"compatible": {
+			changes: schemadiff.Changes{
+				{Kind: schemadiff.ChangeKindAttributeAdded},
+			},
+			expected: schemadiff.ClassificationCompatible,
+		},
+		"requires-state-upgrade": {
+			changes: schemadiff.Changes{
+				{Kind: schemadiff.ChangeKindAttributeAdded},
+				{Kind: schemadiff.ChangeKindAttributeTypeChanged, RequiresStateUpgrade: true},
+			},
+			expected: schemadiff.ClassificationRequiresStateUpgrade,
+		},
+		"breaking": {
+			changes: schemadiff.Changes{
+				{Kind: schemadiff.ChangeKindAttributeRemoved, Breaking: true, RequiresStateUpgrade: true},
+				{Kind: schemadiff.ChangeKindAttributeTypeChanged, RequiresStateUpgrade: true},
+			},
+			expected: schemadiff.ClassificationBreaking,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.changes.Classification()
+
+			if got != testCase.expected {
+				t.Errorf("expected %s, got %s", testCase.expected, got)
+			}
+		})
+	}
+}
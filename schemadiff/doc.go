@@ -0,0 +1,10 @@
+// Package schemadiff contains functionality for comparing two versions of a
+// tfsdk.Schema, such as a snapshot of a previously released schema and the
+// schema returned by the current ResourceType GetSchema method, and
+// classifying the differences by their impact on existing resource state and
+// practitioner configurations.
+//
+// This functionality is intended for provider unit testing, so schema changes
+// that require a Schema Version increment and a ResourceStateUpgrader, or
+// that will break practitioner configurations, can be caught before release.
+package schemadiff
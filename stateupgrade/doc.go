@@ -0,0 +1,12 @@
+// Package stateupgrade contains declarative transforms for resource state
+// upgrades, such as renaming or removing an attribute, which are composed
+// into a tfsdk.ResourceStateUpgrader without a custom StateUpgrader
+// implementation.
+//
+// Transforms operate on the JSON resource state data of the
+// UpgradeResourceState RPC in order, then the result is converted into the
+// state data for the upgraded schema. Any attribute in the prior state data
+// which does not exist in the upgraded schema must be explicitly removed or
+// moved, while any attribute missing from the transformed state data is set
+// to null.
+package stateupgrade
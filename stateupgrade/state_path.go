@@ -0,0 +1,205 @@
+package stateupgrade
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// The functions in this file navigate decoded JSON resource state data,
+// where objects and maps are map[string]interface{} and lists, sets, and
+// tuples are []interface{}. Set values are not addressable, since the JSON
+// representation has no stable ordering.
+
+// getValue returns the value at the path steps. The boolean is false if the
+// value, or any of its parents, is missing or null.
+func getValue(value interface{}, steps path.PathSteps) (interface{}, bool, error) {
+	if len(steps) == 0 {
+		return value, value != nil, nil
+	}
+
+	if value == nil {
+		return nil, false, nil
+	}
+
+	step, remainingSteps := steps.NextStep()
+
+	switch step := step.(type) {
+	case path.PathStepAttributeName, path.PathStepElementKeyString:
+		object, ok := value.(map[string]interface{})
+
+		if !ok {
+			return nil, false, fmt.Errorf("expected object or map at %s, got: %T", step, value)
+		}
+
+		child, ok := object[stepKey(step)]
+
+		if !ok {
+			return nil, false, nil
+		}
+
+		return getValue(child, remainingSteps)
+	case path.PathStepElementKeyInt:
+		list, ok := value.([]interface{})
+
+		if !ok {
+			return nil, false, fmt.Errorf("expected list at %s, got: %T", step, value)
+		}
+
+		if step < 0 || int(step) >= len(list) {
+			return nil, false, nil
+		}
+
+		return getValue(list[step], remainingSteps)
+	default:
+		return nil, false, fmt.Errorf("unsupported path step %T", step)
+	}
+}
+
+// setValue returns the value with the new value set at the path steps.
+// Missing or null parents are created as objects, for attribute name and map
+// key steps, or as lists, for list index steps. A list index step may refer
+// to an existing element or append a new element.
+func setValue(value interface{}, steps path.PathSteps, newValue interface{}) (interface{}, error) {
+	if len(steps) == 0 {
+		return newValue, nil
+	}
+
+	step, remainingSteps := steps.NextStep()
+
+	switch step := step.(type) {
+	case path.PathStepAttributeName, path.PathStepElementKeyString:
+		if value == nil {
+			value = map[string]interface{}{}
+		}
+
+		object, ok := value.(map[string]interface{})
+
+		if !ok {
+			return nil, fmt.Errorf("expected object or map at %s, got: %T", step, value)
+		}
+
+		child, err := setValue(object[stepKey(step)], remainingSteps, newValue)
+
+		if err != nil {
+			return nil, err
+		}
+
+		object[stepKey(step)] = child
+
+		return object, nil
+	case path.PathStepElementKeyInt:
+		if value == nil {
+			value = []interface{}{}
+		}
+
+		list, ok := value.([]interface{})
+
+		if !ok {
+			return nil, fmt.Errorf("expected list at %s, got: %T", step, value)
+		}
+
+		if step < 0 || int(step) > len(list) {
+			return nil, fmt.Errorf("list index %s is out of range for list length %d", step, len(list))
+		}
+
+		if int(step) == len(list) {
+			list = append(list, nil)
+		}
+
+		child, err := setValue(list[step], remainingSteps, newValue)
+
+		if err != nil {
+			return nil, err
+		}
+
+		list[step] = child
+
+		return list, nil
+	default:
+		return nil, fmt.Errorf("unsupported path step %T", step)
+	}
+}
+
+// removeValue returns the value with the value at the path steps removed,
+// along with the removed value. Removing a list element shifts subsequent
+// elements. Missing values are not an error.
+func removeValue(value interface{}, steps path.PathSteps) (interface{}, interface{}, error) {
+	if len(steps) == 0 || value == nil {
+		return value, nil, nil
+	}
+
+	step, remainingSteps := steps.NextStep()
+
+	switch step := step.(type) {
+	case path.PathStepAttributeName, path.PathStepElementKeyString:
+		object, ok := value.(map[string]interface{})
+
+		if !ok {
+			return nil, nil, fmt.Errorf("expected object or map at %s, got: %T", step, value)
+		}
+
+		child, ok := object[stepKey(step)]
+
+		if !ok {
+			return object, nil, nil
+		}
+
+		if len(remainingSteps) == 0 {
+			delete(object, stepKey(step))
+
+			return object, child, nil
+		}
+
+		child, removed, err := removeValue(child, remainingSteps)
+
+		if err != nil {
+			return nil, nil, err
+		}
+
+		object[stepKey(step)] = child
+
+		return object, removed, nil
+	case path.PathStepElementKeyInt:
+		list, ok := value.([]interface{})
+
+		if !ok {
+			return nil, nil, fmt.Errorf("expected list at %s, got: %T", step, value)
+		}
+
+		if step < 0 || int(step) >= len(list) {
+			return list, nil, nil
+		}
+
+		if len(remainingSteps) == 0 {
+			removed := list[step]
+
+			return append(list[:step], list[step+1:]...), removed, nil
+		}
+
+		child, removed, err := removeValue(list[step], remainingSteps)
+
+		if err != nil {
+			return nil, nil, err
+		}
+
+		list[step] = child
+
+		return list, removed, nil
+	default:
+		return nil, nil, fmt.Errorf("unsupported path step %T", step)
+	}
+}
+
+// stepKey returns the object or map key of an attribute name or map key
+// path step.
+func stepKey(step path.PathStep) string {
+	switch step := step.(type) {
+	case path.PathStepAttributeName:
+		return string(step)
+	case path.PathStepElementKeyString:
+		return string(step)
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,277 @@
+package stateupgrade
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/rawstate"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Transform modifies decoded JSON resource state data in place. Objects and
+// maps are represented as map[string]interface{}, lists, sets, and tuples as
+// []interface{}, numbers as json.Number, and null as nil.
+//
+// The transforms in this package cover common state upgrades, however
+// providers can also implement their own. The state data is discarded after
+// any error diagnostic, so transforms are not required to leave it unchanged
+// on error.
+type Transform func(ctx context.Context, state map[string]interface{}) diag.Diagnostics
+
+// Remove returns a Transform which removes the value at the path. A missing
+// value is not an error.
+func Remove(p path.Path) Transform {
+	return func(ctx context.Context, state map[string]interface{}) diag.Diagnostics {
+		var diags diag.Diagnostics
+
+		if len(p.Steps()) == 0 {
+			diags.Append(transformError(p, "Remove", "the path must not be empty"))
+			return diags
+		}
+
+		if _, _, err := removeValue(state, p.Steps()); err != nil {
+			diags.Append(transformError(p, "Remove", err.Error()))
+		}
+
+		return diags
+	}
+}
+
+// Rename returns a Transform which renames the attribute or map key at the
+// path to the new name, keeping its value. A missing value is not an error.
+func Rename(p path.Path, name string) Transform {
+	to := p.ParentPath().AtName(name)
+
+	if _, ok := lastStep(p).(path.PathStepElementKeyString); ok {
+		to = p.ParentPath().AtMapKey(name)
+	}
+
+	return Move(p, to)
+}
+
+// Move returns a Transform which moves the value at the from path to the to
+// path, such as moving an attribute into a nested attribute or block. Any
+// missing parents of the to path are created as objects, for attribute name
+// and map key steps, or as lists, for list index steps. For example, this
+// wraps a value into a single element list under the same attribute name:
+//
+//	stateupgrade.Move(path.Root("example"), path.Root("example").AtListIndex(0))
+//
+// A missing value is not an error, however an existing value at the to path
+// is an error.
+func Move(from path.Path, to path.Path) Transform {
+	return func(ctx context.Context, state map[string]interface{}) diag.Diagnostics {
+		var diags diag.Diagnostics
+
+		if len(from.Steps()) == 0 || len(to.Steps()) == 0 {
+			diags.Append(transformError(from, "Move", "the from and to paths must not be empty"))
+			return diags
+		}
+
+		if _, ok, err := getValue(state, from.Steps()); err != nil || !ok {
+			if err != nil {
+				diags.Append(transformError(from, "Move", err.Error()))
+			}
+
+			return diags
+		}
+
+		_, value, err := removeValue(state, from.Steps())
+
+		if err != nil {
+			diags.Append(transformError(from, "Move", err.Error()))
+			return diags
+		}
+
+		_, exists, err := getValue(state, to.Steps())
+
+		if err != nil {
+			diags.Append(transformError(to, "Move", err.Error()))
+			return diags
+		}
+
+		if exists {
+			diags.Append(transformError(to, "Move", fmt.Sprintf("the value from %s cannot replace an existing value", from)))
+			return diags
+		}
+
+		if _, err := setValue(state, to.Steps(), value); err != nil {
+			diags.Append(transformError(to, "Move", err.Error()))
+		}
+
+		return diags
+	}
+}
+
+// ConvertType returns a Transform which converts the primitive value at the
+// path to the tftypes.Bool, tftypes.Number, or tftypes.String type. Strings
+// are converted to booleans and numbers using the strconv package
+// representations. A missing or null value is not an error.
+func ConvertType(p path.Path, typ tftypes.Type) Transform {
+	return func(ctx context.Context, state map[string]interface{}) diag.Diagnostics {
+		var diags diag.Diagnostics
+
+		if len(p.Steps()) == 0 {
+			diags.Append(transformError(p, "ConvertType", "the path must not be empty"))
+			return diags
+		}
+
+		value, ok, err := getValue(state, p.Steps())
+
+		if err != nil {
+			diags.Append(transformError(p, "ConvertType", err.Error()))
+			return diags
+		}
+
+		if !ok {
+			return diags
+		}
+
+		converted, err := convertPrimitive(value, typ)
+
+		if err != nil {
+			diags.Append(transformError(p, "ConvertType", err.Error()))
+			return diags
+		}
+
+		if _, err := setValue(state, p.Steps(), converted); err != nil {
+			diags.Append(transformError(p, "ConvertType", err.Error()))
+		}
+
+		return diags
+	}
+}
+
+// SetDefault returns a Transform which sets the value at the path, if it is
+// missing or null. Any missing parents are created in the same manner as
+// Move. The value must not be unknown.
+func SetDefault(p path.Path, value attr.Value) Transform {
+	return func(ctx context.Context, state map[string]interface{}) diag.Diagnostics {
+		var diags diag.Diagnostics
+
+		if len(p.Steps()) == 0 {
+			diags.Append(transformError(p, "SetDefault", "the path must not be empty"))
+			return diags
+		}
+
+		_, ok, err := getValue(state, p.Steps())
+
+		if err != nil {
+			diags.Append(transformError(p, "SetDefault", err.Error()))
+			return diags
+		}
+
+		if ok {
+			return diags
+		}
+
+		defaultValue, err := jsonValue(ctx, value)
+
+		if err != nil {
+			diags.Append(transformError(p, "SetDefault", err.Error()))
+			return diags
+		}
+
+		if _, err := setValue(state, p.Steps(), defaultValue); err != nil {
+			diags.Append(transformError(p, "SetDefault", err.Error()))
+		}
+
+		return diags
+	}
+}
+
+// convertPrimitive converts a decoded JSON primitive value to the Terraform
+// type.
+func convertPrimitive(value interface{}, typ tftypes.Type) (interface{}, error) {
+	switch {
+	case typ.Is(tftypes.Bool):
+		switch value := value.(type) {
+		case bool:
+			return value, nil
+		case string:
+			result, err := strconv.ParseBool(value)
+
+			if err != nil {
+				return nil, fmt.Errorf("unable to convert %q to bool", value)
+			}
+
+			return result, nil
+		}
+	case typ.Is(tftypes.Number):
+		switch value := value.(type) {
+		case json.Number:
+			return value, nil
+		case string:
+			if _, err := strconv.ParseFloat(value, 64); err != nil {
+				return nil, fmt.Errorf("unable to convert %q to number", value)
+			}
+
+			return json.Number(value), nil
+		}
+	case typ.Is(tftypes.String):
+		switch value := value.(type) {
+		case bool:
+			return strconv.FormatBool(value), nil
+		case json.Number:
+			return value.String(), nil
+		case string:
+			return value, nil
+		}
+	default:
+		return nil, fmt.Errorf("unsupported conversion type %s, must be bool, number, or string", typ)
+	}
+
+	return nil, fmt.Errorf("unable to convert %T value to %s", value, typ)
+}
+
+// jsonValue returns the decoded JSON representation of an attr.Value.
+func jsonValue(ctx context.Context, value attr.Value) (interface{}, error) {
+	if value == nil {
+		return nil, fmt.Errorf("missing value")
+	}
+
+	terraformValue, err := value.ToTerraformValue(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert value to Terraform value: %w", err)
+	}
+
+	valueJSON, err := rawstate.ValueToJSON(terraformValue)
+
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert value to JSON: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(valueJSON))
+	decoder.UseNumber()
+
+	var result interface{}
+
+	if err := decoder.Decode(&result); err != nil {
+		return nil, fmt.Errorf("unable to decode value JSON: %w", err)
+	}
+
+	return result, nil
+}
+
+// lastStep returns the last step of the path or nil if the path is empty.
+func lastStep(p path.Path) path.PathStep {
+	step, _ := p.Steps().LastStep()
+
+	return step
+}
+
+func transformError(p path.Path, transform string, detail string) diag.Diagnostic {
+	return diag.NewAttributeErrorDiagnostic(
+		p,
+		"Resource State Upgrade Transform Error",
+		fmt.Sprintf("The %s transform was unable to modify the prior resource state data: %s. ", transform, detail)+
+			"This is always an issue with the Terraform Provider and should be reported to the provider developer.",
+	)
+}
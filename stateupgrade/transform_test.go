@@ -0,0 +1,304 @@
+package stateupgrade_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/stateupgrade"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestTransforms(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		state         map[string]interface{}
+		transform     stateupgrade.Transform
+		expectedState map[string]interface{}
+		expectedDiags diag.Diagnostics
+	}{
+		"Remove": {
+			state: map[string]interface{}{
+				"id":      "test",
+				"removed": "test",
+			},
+			transform: stateupgrade.Remove(path.Root("removed")),
+			expectedState: map[string]interface{}{
+				"id": "test",
+			},
+		},
+		"Remove-missing": {
+			state: map[string]interface{}{
+				"id": "test",
+			},
+			transform: stateupgrade.Remove(path.Root("removed")),
+			expectedState: map[string]interface{}{
+				"id": "test",
+			},
+		},
+		"Remove-list-element": {
+			state: map[string]interface{}{
+				"list": []interface{}{"one", "two", "three"},
+			},
+			transform: stateupgrade.Remove(path.Root("list").AtListIndex(1)),
+			expectedState: map[string]interface{}{
+				"list": []interface{}{"one", "three"},
+			},
+		},
+		"Remove-nested": {
+			state: map[string]interface{}{
+				"block": []interface{}{
+					map[string]interface{}{
+						"kept":    "test",
+						"removed": "test",
+					},
+				},
+			},
+			transform: stateupgrade.Remove(path.Root("block").AtListIndex(0).AtName("removed")),
+			expectedState: map[string]interface{}{
+				"block": []interface{}{
+					map[string]interface{}{
+						"kept": "test",
+					},
+				},
+			},
+		},
+		"Remove-set-value": {
+			state: map[string]interface{}{
+				"set": []interface{}{"test"},
+			},
+			transform: stateupgrade.Remove(path.Root("set").AtSetValue(types.StringValue("test"))),
+			expectedState: map[string]interface{}{
+				"set": []interface{}{"test"},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("set").AtSetValue(types.StringValue("test")),
+					"Resource State Upgrade Transform Error",
+					"The Remove transform was unable to modify the prior resource state data: unsupported path step path.PathStepElementKeyValue. "+
+						"This is always an issue with the Terraform Provider and should be reported to the provider developer.",
+				),
+			},
+		},
+		"Rename": {
+			state: map[string]interface{}{
+				"old": "test",
+			},
+			transform: stateupgrade.Rename(path.Root("old"), "new"),
+			expectedState: map[string]interface{}{
+				"new": "test",
+			},
+		},
+		"Rename-map-key": {
+			state: map[string]interface{}{
+				"map": map[string]interface{}{
+					"old": "test",
+				},
+			},
+			transform: stateupgrade.Rename(path.Root("map").AtMapKey("old"), "new"),
+			expectedState: map[string]interface{}{
+				"map": map[string]interface{}{
+					"new": "test",
+				},
+			},
+		},
+		"Rename-missing": {
+			state:         map[string]interface{}{},
+			transform:     stateupgrade.Rename(path.Root("old"), "new"),
+			expectedState: map[string]interface{}{},
+		},
+		"Rename-existing": {
+			state: map[string]interface{}{
+				"new": "existing",
+				"old": "test",
+			},
+			transform: stateupgrade.Rename(path.Root("old"), "new"),
+			expectedState: map[string]interface{}{
+				"new": "existing",
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("new"),
+					"Resource State Upgrade Transform Error",
+					"The Move transform was unable to modify the prior resource state data: the value from old cannot replace an existing value. "+
+						"This is always an issue with the Terraform Provider and should be reported to the provider developer.",
+				),
+			},
+		},
+		"Move-nested": {
+			state: map[string]interface{}{
+				"id":   "test",
+				"port": json.Number("443"),
+			},
+			transform: stateupgrade.Move(path.Root("port"), path.Root("listener").AtListIndex(0).AtName("port")),
+			expectedState: map[string]interface{}{
+				"id": "test",
+				"listener": []interface{}{
+					map[string]interface{}{
+						"port": json.Number("443"),
+					},
+				},
+			},
+		},
+		"Move-wrap-list": {
+			state: map[string]interface{}{
+				"example": "test",
+			},
+			transform: stateupgrade.Move(path.Root("example"), path.Root("example").AtListIndex(0)),
+			expectedState: map[string]interface{}{
+				"example": []interface{}{"test"},
+			},
+		},
+		"Move-list-index-out-of-range": {
+			state: map[string]interface{}{
+				"example": "test",
+			},
+			transform:     stateupgrade.Move(path.Root("example"), path.Root("list").AtListIndex(1)),
+			expectedState: map[string]interface{}{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("list").AtListIndex(1),
+					"Resource State Upgrade Transform Error",
+					"The Move transform was unable to modify the prior resource state data: list index [1] is out of range for list length 0. "+
+						"This is always an issue with the Terraform Provider and should be reported to the provider developer.",
+				),
+			},
+		},
+		"ConvertType-string-to-number": {
+			state: map[string]interface{}{
+				"size": "10.5",
+			},
+			transform: stateupgrade.ConvertType(path.Root("size"), tftypes.Number),
+			expectedState: map[string]interface{}{
+				"size": json.Number("10.5"),
+			},
+		},
+		"ConvertType-string-to-bool": {
+			state: map[string]interface{}{
+				"enabled": "true",
+			},
+			transform: stateupgrade.ConvertType(path.Root("enabled"), tftypes.Bool),
+			expectedState: map[string]interface{}{
+				"enabled": true,
+			},
+		},
+		"ConvertType-number-to-string": {
+			state: map[string]interface{}{
+				"size": json.Number("10"),
+			},
+			transform: stateupgrade.ConvertType(path.Root("size"), tftypes.String),
+			expectedState: map[string]interface{}{
+				"size": "10",
+			},
+		},
+		"ConvertType-null": {
+			state: map[string]interface{}{
+				"size": nil,
+			},
+			transform: stateupgrade.ConvertType(path.Root("size"), tftypes.Number),
+			expectedState: map[string]interface{}{
+				"size": nil,
+			},
+		},
+		"ConvertType-invalid": {
+			state: map[string]interface{}{
+				"size": "large",
+			},
+			transform: stateupgrade.ConvertType(path.Root("size"), tftypes.Number),
+			expectedState: map[string]interface{}{
+				"size": "large",
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("size"),
+					"Resource State Upgrade Transform Error",
+					"The ConvertType transform was unable to modify the prior resource state data: unable to convert \"large\" to number. "+
+						"This is always an issue with the Terraform Provider and should be reported to the provider developer.",
+				),
+			},
+		},
+		"ConvertType-unsupported-type": {
+			state: map[string]interface{}{
+				"size": "10",
+			},
+			transform: stateupgrade.ConvertType(path.Root("size"), tftypes.List{ElementType: tftypes.String}),
+			expectedState: map[string]interface{}{
+				"size": "10",
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("size"),
+					"Resource State Upgrade Transform Error",
+					"The ConvertType transform was unable to modify the prior resource state data: unsupported conversion type tftypes.List[tftypes.String], must be bool, number, or string. "+
+						"This is always an issue with the Terraform Provider and should be reported to the provider developer.",
+				),
+			},
+		},
+		"SetDefault-missing": {
+			state:     map[string]interface{}{},
+			transform: stateupgrade.SetDefault(path.Root("tier"), types.StringValue("standard")),
+			expectedState: map[string]interface{}{
+				"tier": "standard",
+			},
+		},
+		"SetDefault-null": {
+			state: map[string]interface{}{
+				"tags": nil,
+			},
+			transform: stateupgrade.SetDefault(path.Root("tags"), types.MapValueMust(types.StringType, map[string]attr.Value{
+				"managed": types.StringValue("terraform"),
+			})),
+			expectedState: map[string]interface{}{
+				"tags": map[string]interface{}{
+					"managed": "terraform",
+				},
+			},
+		},
+		"SetDefault-existing": {
+			state: map[string]interface{}{
+				"tier": "premium",
+			},
+			transform: stateupgrade.SetDefault(path.Root("tier"), types.StringValue("standard")),
+			expectedState: map[string]interface{}{
+				"tier": "premium",
+			},
+		},
+		"SetDefault-unknown": {
+			state:         map[string]interface{}{},
+			transform:     stateupgrade.SetDefault(path.Root("tier"), types.StringUnknown()),
+			expectedState: map[string]interface{}{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("tier"),
+					"Resource State Upgrade Transform Error",
+					"The SetDefault transform was unable to modify the prior resource state data: unable to convert value to JSON: unknown values cannot be converted to JSON. "+
+						"This is always an issue with the Terraform Provider and should be reported to the provider developer.",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := testCase.transform(context.Background(), testCase.state)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(testCase.state, testCase.expectedState); diff != "" {
+				t.Errorf("unexpected state difference: %s", diff)
+			}
+		})
+	}
+}
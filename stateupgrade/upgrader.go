@@ -0,0 +1,121 @@
+package stateupgrade
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/rawstate"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// ResourceStateUpgrader returns a tfsdk.ResourceStateUpgrader which applies
+// the transforms, in order, to the prior resource state data.
+//
+// The priorSchema is optional, since transforms do not require it, except
+// when the upgrader is used with tfsdk.ResourceWithUpgradeStateChain or must
+// support flatmap state saved by Terraform CLI 0.11 and earlier.
+func ResourceStateUpgrader(priorSchema *tfsdk.Schema, transforms ...Transform) tfsdk.ResourceStateUpgrader {
+	return tfsdk.ResourceStateUpgrader{
+		PriorSchema:   priorSchema,
+		StateUpgrader: StateUpgrader(transforms...),
+	}
+}
+
+// StateUpgrader returns a function suitable for the tfsdk.ResourceStateUpgrader
+// type StateUpgrader field, which applies the transforms, in order, to the
+// prior resource state data.
+func StateUpgrader(transforms ...Transform) func(context.Context, tfsdk.UpgradeResourceStateRequest, *tfsdk.UpgradeResourceStateResponse) {
+	return func(ctx context.Context, req tfsdk.UpgradeResourceStateRequest, resp *tfsdk.UpgradeResourceStateResponse) {
+		var stateJSON []byte
+
+		switch {
+		case req.RawState != nil && req.RawState.JSON != nil:
+			stateJSON = req.RawState.JSON
+		case req.State != nil:
+			// Flatmap state, saved by Terraform CLI 0.11 and earlier, is
+			// only available as JSON after the framework has read it with
+			// the PriorSchema.
+			var err error
+
+			stateJSON, err = rawstate.ValueToJSON(req.State.Raw)
+
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Unable to Read Previously Saved State for UpgradeResourceState",
+					"There was an error converting the saved resource state to JSON. "+
+						"Please report this to the provider developer:\n\n"+err.Error(),
+				)
+				return
+			}
+		default:
+			resp.Diagnostics.AddError(
+				"Missing Resource State",
+				"The resource state upgrade transforms require prior resource state data in JSON format, which was not provided. "+
+					"Flatmap state, saved by Terraform CLI 0.11 and earlier, requires the ResourceStateUpgrader PriorSchema field. "+
+					"This is always an issue with the Terraform Provider and should be reported to the provider developer.",
+			)
+			return
+		}
+
+		decoder := json.NewDecoder(bytes.NewReader(stateJSON))
+
+		// Preserve number precision across the transforms.
+		decoder.UseNumber()
+
+		var state map[string]interface{}
+
+		if err := decoder.Decode(&state); err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read Previously Saved State for UpgradeResourceState",
+				"There was an error reading the saved resource state as a JSON object. "+
+					"Please report this to the provider developer:\n\n"+err.Error(),
+			)
+			return
+		}
+
+		// A null state object has nothing to transform, but transforms are
+		// still run to allow defaults to be set.
+		if state == nil {
+			state = map[string]interface{}{}
+		}
+
+		for _, transform := range transforms {
+			resp.Diagnostics.Append(transform(ctx, state)...)
+
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+
+		transformedJSON, err := json.Marshal(state)
+
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Upgrade Resource State",
+				"There was an error encoding the transformed resource state. "+
+					"This is always an issue with the Terraform Provider and should be reported to the provider developer:\n\n"+err.Error(),
+			)
+			return
+		}
+
+		rawState := tfprotov6.RawState{
+			JSON: transformedJSON,
+		}
+
+		stateValue, err := rawState.Unmarshal(resp.State.Schema.TerraformType(ctx))
+
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Upgrade Resource State",
+				"The transformed resource state is not compatible with the upgraded resource schema. "+
+					"Attributes which no longer exist must be removed or moved by a transform. "+
+					"This is always an issue with the Terraform Provider and should be reported to the provider developer:\n\n"+err.Error(),
+			)
+			return
+		}
+
+		resp.State.Raw = stateValue
+	}
+}
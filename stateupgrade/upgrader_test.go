@@ -0,0 +1,227 @@
+package stateupgrade_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/stateupgrade"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestStateUpgrader(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	schema := tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"listener": {
+				Attributes: tfsdk.ListNestedAttributes(map[string]tfsdk.Attribute{
+					"port": {
+						Type:     types.Int64Type,
+						Required: true,
+					},
+				}),
+				Required: true,
+			},
+			"name": {
+				Type:     types.StringType,
+				Required: true,
+			},
+			"optional": {
+				Type:     types.StringType,
+				Optional: true,
+			},
+			"tier": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+		},
+		Version: 1,
+	}
+	schemaType := schema.TerraformType(ctx)
+
+	transforms := []stateupgrade.Transform{
+		stateupgrade.Remove(path.Root("removed")),
+		stateupgrade.Rename(path.Root("old_name"), "name"),
+		stateupgrade.ConvertType(path.Root("port"), tftypes.Number),
+		stateupgrade.Move(path.Root("port"), path.Root("listener").AtListIndex(0).AtName("port")),
+		stateupgrade.SetDefault(path.Root("tier"), types.StringValue("standard")),
+	}
+
+	priorSchema := tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"old_name": {
+				Type:     types.StringType,
+				Required: true,
+			},
+			"port": {
+				Type:     types.StringType,
+				Required: true,
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		rawState      *tfprotov6.RawState
+		state         *tfsdk.State
+		expectedState tftypes.Value
+		expectedDiags diag.Diagnostics
+	}{
+		"transformed": {
+			rawState: &tfprotov6.RawState{
+				JSON: []byte(`{"id":"test-id","old_name":"test-name","port":"443","removed":true}`),
+			},
+			expectedState: tftypes.NewValue(schemaType, map[string]tftypes.Value{
+				"id": tftypes.NewValue(tftypes.String, "test-id"),
+				"listener": tftypes.NewValue(
+					tftypes.List{
+						ElementType: tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"port": tftypes.Number,
+							},
+						},
+					},
+					[]tftypes.Value{
+						tftypes.NewValue(
+							tftypes.Object{
+								AttributeTypes: map[string]tftypes.Type{
+									"port": tftypes.Number,
+								},
+							},
+							map[string]tftypes.Value{
+								"port": tftypes.NewValue(tftypes.Number, 443),
+							},
+						),
+					},
+				),
+				"name":     tftypes.NewValue(tftypes.String, "test-name"),
+				"optional": tftypes.NewValue(tftypes.String, nil),
+				"tier":     tftypes.NewValue(tftypes.String, "standard"),
+			}),
+		},
+		"State-flatmap": {
+			rawState: &tfprotov6.RawState{
+				Flatmap: map[string]string{
+					"id":       "test-id",
+					"old_name": "test-name",
+					"port":     "443",
+				},
+			},
+			state: &tfsdk.State{
+				Raw: tftypes.NewValue(priorSchema.TerraformType(ctx), map[string]tftypes.Value{
+					"id":       tftypes.NewValue(tftypes.String, "test-id"),
+					"old_name": tftypes.NewValue(tftypes.String, "test-name"),
+					"port":     tftypes.NewValue(tftypes.String, "443"),
+				}),
+				Schema: priorSchema,
+			},
+			expectedState: tftypes.NewValue(schemaType, map[string]tftypes.Value{
+				"id": tftypes.NewValue(tftypes.String, "test-id"),
+				"listener": tftypes.NewValue(
+					tftypes.List{
+						ElementType: tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"port": tftypes.Number,
+							},
+						},
+					},
+					[]tftypes.Value{
+						tftypes.NewValue(
+							tftypes.Object{
+								AttributeTypes: map[string]tftypes.Type{
+									"port": tftypes.Number,
+								},
+							},
+							map[string]tftypes.Value{
+								"port": tftypes.NewValue(tftypes.Number, 443),
+							},
+						),
+					},
+				),
+				"name":     tftypes.NewValue(tftypes.String, "test-name"),
+				"optional": tftypes.NewValue(tftypes.String, nil),
+				"tier":     tftypes.NewValue(tftypes.String, "standard"),
+			}),
+		},
+		"RawState-missing": {
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Missing Resource State",
+					"The resource state upgrade transforms require prior resource state data in JSON format, which was not provided. "+
+						"Flatmap state, saved by Terraform CLI 0.11 and earlier, requires the ResourceStateUpgrader PriorSchema field. "+
+						"This is always an issue with the Terraform Provider and should be reported to the provider developer.",
+				),
+			},
+		},
+		"transform-error": {
+			rawState: &tfprotov6.RawState{
+				JSON: []byte(`{"id":"test-id","old_name":"test-name","port":"https"}`),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("port"),
+					"Resource State Upgrade Transform Error",
+					"The ConvertType transform was unable to modify the prior resource state data: unable to convert \"https\" to number. "+
+						"This is always an issue with the Terraform Provider and should be reported to the provider developer.",
+				),
+			},
+		},
+		"untransformed-attribute": {
+			rawState: &tfprotov6.RawState{
+				JSON: []byte(`{"id":"test-id","name":"test-name","unexpected":true}`),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Unable to Upgrade Resource State",
+					"The transformed resource state is not compatible with the upgraded resource schema. "+
+						"Attributes which no longer exist must be removed or moved by a transform. "+
+						"This is always an issue with the Terraform Provider and should be reported to the provider developer:\n\n"+
+						"ElementKeyValue(tftypes.String<unknown>): unsupported attribute \"unexpected\"",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			upgrader := stateupgrade.ResourceStateUpgrader(nil, transforms...)
+			req := tfsdk.UpgradeResourceStateRequest{
+				RawState: testCase.rawState,
+				State:    testCase.state,
+			}
+			resp := &tfsdk.UpgradeResourceStateResponse{
+				State: tfsdk.State{
+					Schema: schema,
+				},
+			}
+
+			upgrader.StateUpgrader(context.Background(), req, resp)
+
+			if diff := cmp.Diff(resp.Diagnostics, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(resp.State.Raw, testCase.expectedState); diff != "" {
+				t.Errorf("unexpected state difference: %s", diff)
+			}
+		})
+	}
+}
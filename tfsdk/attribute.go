@@ -66,6 +66,38 @@ type Attribute struct {
 	//
 	Computed bool
 
+	// NullEqualsEmpty indicates whether a null value and an empty list, set,
+	// or map value should be treated as equivalent for this attribute. This
+	// is only supported for attributes with a list, set, or map based Type or
+	// nested attributes mode. Setting NullEqualsEmpty on any other attribute
+	// will have no effect.
+	//
+	// It is intended for Optional and Computed attributes where the remote
+	// system returns an empty collection when none is configured, or vice
+	// versa. When the resource state returned by Create or Update contains a
+	// null value where the planned value is empty, or the reverse, the
+	// planned value is kept. If the planned value is unknown, the configured
+	// value is used instead. When the resource state returned by Read
+	// differs in the same way from the prior state, the prior state value is
+	// kept. This prevents "inconsistent result after apply" errors and
+	// unexpected plan differences.
+	NullEqualsEmpty bool
+
+	// EnvVars defines environment variable names, in order of precedence,
+	// which are used for the attribute value when the practitioner has not
+	// configured one. Environment variables set to an empty string are
+	// treated as unset. This is only supported for top level attributes with
+	// a bool, number, or string based Type in the provider schema. Setting
+	// EnvVars on any other attribute will have no effect.
+	//
+	// The environment variable value is used everywhere the framework handles
+	// provider configuration, such as Config.Get in Provider.Configure. A
+	// Required attribute is only considered missing if none of the
+	// environment variables are set. Terraform is told the attribute is
+	// Optional and the environment variable names are added to the attribute
+	// description.
+	EnvVars []string
+
 	// Sensitive indicates whether the value of this attribute should be
 	// considered sensitive data. Setting it to true will obscure the value
 	// in CLI output. Sensitive does not impact how values are stored, and
@@ -143,6 +175,17 @@ func (a Attribute) Equal(o Attribute) bool {
 	if a.Computed != o.Computed {
 		return false
 	}
+	if a.NullEqualsEmpty != o.NullEqualsEmpty {
+		return false
+	}
+	if len(a.EnvVars) != len(o.EnvVars) {
+		return false
+	}
+	for i := range a.EnvVars {
+		if a.EnvVars[i] != o.EnvVars[i] {
+			return false
+		}
+	}
 	if a.Sensitive != o.Sensitive {
 		return false
 	}
@@ -27,25 +27,25 @@ func TestUseStateForUnknownModifier(t *testing.T) {
 			// this honestly just shouldn't happen, but let's be
 			// sure we're not going to panic if it does
 			state:    nil,
-			plan:     types.String{Unknown: true},
-			config:   types.String{Null: true},
-			expected: types.String{Unknown: true},
+			plan:     types.StringUnknown(),
+			config:   types.StringNull(),
+			expected: types.StringUnknown(),
 		},
 		"nil-plan": {
 			// this honestly just shouldn't happen, but let's be
 			// sure we're not going to panic if it does
-			state:    types.String{Null: true},
+			state:    types.StringNull(),
 			plan:     nil,
-			config:   types.String{Null: true},
+			config:   types.StringNull(),
 			expected: nil,
 		},
 		"null-state": {
 			// when we first create the resource, use the unknown
 			// value
-			state:    types.String{Null: true},
-			plan:     types.String{Unknown: true},
-			config:   types.String{Null: true},
-			expected: types.String{Unknown: true},
+			state:    types.StringNull(),
+			plan:     types.StringUnknown(),
+			config:   types.StringNull(),
+			expected: types.StringUnknown(),
 		},
 		"known-plan": {
 			// this would really only happen if we had a plan
@@ -54,18 +54,18 @@ func TestUseStateForUnknownModifier(t *testing.T) {
 			//
 			// but we still want to preserve that value, in this
 			// case
-			state:    types.String{Value: "foo"},
-			plan:     types.String{Value: "bar"},
-			config:   types.String{Null: true},
-			expected: types.String{Value: "bar"},
+			state:    types.StringValue("foo"),
+			plan:     types.StringValue("bar"),
+			config:   types.StringNull(),
+			expected: types.StringValue("bar"),
 		},
 		"non-null-state-unknown-plan": {
 			// this is the situation we want to preserve the state
 			// in
-			state:    types.String{Value: "foo"},
-			plan:     types.String{Unknown: true},
-			config:   types.String{Null: true},
-			expected: types.String{Value: "foo"},
+			state:    types.StringValue("foo"),
+			plan:     types.StringUnknown(),
+			config:   types.StringNull(),
+			expected: types.StringValue("foo"),
 		},
 		"unknown-config": {
 			// this is the situation in which a user is
@@ -74,10 +74,10 @@ func TestUseStateForUnknownModifier(t *testing.T) {
 			// errors for changing the value even though we knew it
 			// was legitimately possible for it to change and the
 			// provider can't prevent this from happening
-			state:    types.String{Value: "foo"},
-			plan:     types.String{Unknown: true},
-			config:   types.String{Unknown: true},
-			expected: types.String{Unknown: true},
+			state:    types.StringValue("foo"),
+			plan:     types.StringUnknown(),
+			config:   types.StringUnknown(),
+			expected: types.StringUnknown(),
 		},
 	}
 
@@ -230,7 +230,7 @@ func TestRequiresReplaceModifier(t *testing.T) {
 				}),
 			},
 			path:         path.Root("optional-computed"),
-			expectedPlan: types.String{Value: "foo"},
+			expectedPlan: types.StringValue("foo"),
 			expectedRR:   false,
 		},
 		"null-plan": {
@@ -285,7 +285,7 @@ func TestRequiresReplaceModifier(t *testing.T) {
 				}),
 			},
 			path:         path.Root("optional"),
-			expectedPlan: types.String{Value: "bar"},
+			expectedPlan: types.StringValue("bar"),
 			expectedRR:   true,
 		},
 		"null-attribute-plan": {
@@ -314,7 +314,7 @@ func TestRequiresReplaceModifier(t *testing.T) {
 				}),
 			},
 			path:         path.Root("optional"),
-			expectedPlan: types.String{Null: true},
+			expectedPlan: types.StringNull(),
 			expectedRR:   true,
 		},
 		"known-state-change": {
@@ -342,7 +342,7 @@ func TestRequiresReplaceModifier(t *testing.T) {
 				}),
 			},
 			path:         path.Root("optional"),
-			expectedPlan: types.String{Value: "quux"},
+			expectedPlan: types.StringValue("quux"),
 			expectedRR:   true,
 		},
 		"known-state-no-change": {
@@ -370,7 +370,7 @@ func TestRequiresReplaceModifier(t *testing.T) {
 				}),
 			},
 			path:         path.Root("optional-computed"),
-			expectedPlan: types.String{Value: "foo"},
+			expectedPlan: types.StringValue("foo"),
 			expectedRR:   false,
 		},
 		"null-config-computed": {
@@ -405,7 +405,7 @@ func TestRequiresReplaceModifier(t *testing.T) {
 				}),
 			},
 			path:         path.Root("optional-computed"),
-			expectedPlan: types.String{Unknown: true},
+			expectedPlan: types.StringUnknown(),
 			expectedRR:   false,
 		},
 		"null-config-not-computed": {
@@ -441,7 +441,7 @@ func TestRequiresReplaceModifier(t *testing.T) {
 				}),
 			},
 			path:         path.Root("optional"),
-			expectedPlan: types.String{Null: true},
+			expectedPlan: types.StringNull(),
 			expectedRR:   true,
 		},
 		"block-no-change": {
@@ -518,26 +518,20 @@ func TestRequiresReplaceModifier(t *testing.T) {
 				}),
 			},
 			path: path.Root("block"),
-			expectedPlan: types.List{
-				ElemType: types.ObjectType{
-					AttrTypes: map[string]attr.Type{
-						"optional-computed": types.StringType,
-						"optional":          types.StringType,
-					},
-				},
-				Elems: []attr.Value{
-					types.Object{
-						AttrTypes: map[string]attr.Type{
-							"optional-computed": types.StringType,
-							"optional":          types.StringType,
-						},
-						Attrs: map[string]attr.Value{
-							"optional-computed": types.String{Value: "samevalue"},
-							"optional":          types.String{Value: "samevalue"},
-						},
-					},
+			expectedPlan: types.ListValueMust(types.ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"optional-computed": types.StringType,
+					"optional":          types.StringType,
 				},
-			},
+			}, []attr.Value{
+				types.ObjectValueMust(map[string]attr.Type{
+					"optional-computed": types.StringType,
+					"optional":          types.StringType,
+				}, map[string]attr.Value{
+					"optional-computed": types.StringValue("samevalue"),
+					"optional":          types.StringValue("samevalue"),
+				}),
+			}),
 			expectedRR: false,
 		},
 		"block-element-count-change": {
@@ -632,36 +626,27 @@ func TestRequiresReplaceModifier(t *testing.T) {
 				}),
 			},
 			path: path.Root("block"),
-			expectedPlan: types.List{
-				ElemType: types.ObjectType{
-					AttrTypes: map[string]attr.Type{
-						"optional-computed": types.StringType,
-						"optional":          types.StringType,
-					},
+			expectedPlan: types.ListValueMust(types.ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"optional-computed": types.StringType,
+					"optional":          types.StringType,
 				},
-				Elems: []attr.Value{
-					types.Object{
-						AttrTypes: map[string]attr.Type{
-							"optional-computed": types.StringType,
-							"optional":          types.StringType,
-						},
-						Attrs: map[string]attr.Value{
-							"optional-computed": types.String{Value: "samevalue"},
-							"optional":          types.String{Value: "samevalue"},
-						},
-					},
-					types.Object{
-						AttrTypes: map[string]attr.Type{
-							"optional-computed": types.StringType,
-							"optional":          types.StringType,
-						},
-						Attrs: map[string]attr.Value{
-							"optional-computed": types.String{Value: "newvalue"},
-							"optional":          types.String{Value: "newvalue"},
-						},
-					},
-				},
-			},
+			}, []attr.Value{
+				types.ObjectValueMust(map[string]attr.Type{
+					"optional-computed": types.StringType,
+					"optional":          types.StringType,
+				}, map[string]attr.Value{
+					"optional-computed": types.StringValue("samevalue"),
+					"optional":          types.StringValue("samevalue"),
+				}),
+				types.ObjectValueMust(map[string]attr.Type{
+					"optional-computed": types.StringType,
+					"optional":          types.StringType,
+				}, map[string]attr.Value{
+					"optional-computed": types.StringValue("newvalue"),
+					"optional":          types.StringValue("newvalue"),
+				}),
+			}),
 			expectedRR: true,
 		},
 		"block-nested-attribute-change": {
@@ -738,26 +723,20 @@ func TestRequiresReplaceModifier(t *testing.T) {
 				}),
 			},
 			path: path.Root("block"),
-			expectedPlan: types.List{
-				ElemType: types.ObjectType{
-					AttrTypes: map[string]attr.Type{
-						"optional-computed": types.StringType,
-						"optional":          types.StringType,
-					},
+			expectedPlan: types.ListValueMust(types.ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"optional-computed": types.StringType,
+					"optional":          types.StringType,
 				},
-				Elems: []attr.Value{
-					types.Object{
-						AttrTypes: map[string]attr.Type{
-							"optional-computed": types.StringType,
-							"optional":          types.StringType,
-						},
-						Attrs: map[string]attr.Value{
-							"optional-computed": types.String{Value: "samevalue"},
-							"optional":          types.String{Value: "newvalue"},
-						},
-					},
-				},
-			},
+			}, []attr.Value{
+				types.ObjectValueMust(map[string]attr.Type{
+					"optional-computed": types.StringType,
+					"optional":          types.StringType,
+				}, map[string]attr.Value{
+					"optional-computed": types.StringValue("samevalue"),
+					"optional":          types.StringValue("newvalue"),
+				}),
+			}),
 			expectedRR: true,
 		},
 	}
@@ -883,7 +862,7 @@ func TestRequiresReplaceIfModifier(t *testing.T) {
 			priorRR:      false,
 			path:         path.Root("optional-computed"),
 			ifReturn:     true,
-			expectedPlan: types.String{Value: "foo"},
+			expectedPlan: types.StringValue("foo"),
 			expectedRR:   false,
 		},
 		"null-plan": {
@@ -942,7 +921,7 @@ func TestRequiresReplaceIfModifier(t *testing.T) {
 			priorRR:      false,
 			path:         path.Root("optional"),
 			ifReturn:     true,
-			expectedPlan: types.String{Value: "bar"},
+			expectedPlan: types.StringValue("bar"),
 			expectedRR:   true,
 		},
 		"null-attribute-plan": {
@@ -973,7 +952,7 @@ func TestRequiresReplaceIfModifier(t *testing.T) {
 			priorRR:      false,
 			ifReturn:     true,
 			path:         path.Root("optional"),
-			expectedPlan: types.String{Null: true},
+			expectedPlan: types.StringNull(),
 			expectedRR:   true,
 		},
 		"known-state-change-true": {
@@ -1004,7 +983,7 @@ func TestRequiresReplaceIfModifier(t *testing.T) {
 			priorRR:      false,
 			path:         path.Root("optional"),
 			ifReturn:     true,
-			expectedPlan: types.String{Value: "quux"},
+			expectedPlan: types.StringValue("quux"),
 			expectedRR:   true,
 		},
 		"known-state-change-false": {
@@ -1035,7 +1014,7 @@ func TestRequiresReplaceIfModifier(t *testing.T) {
 			priorRR:      false,
 			path:         path.Root("optional"),
 			ifReturn:     false,
-			expectedPlan: types.String{Value: "quux"},
+			expectedPlan: types.StringValue("quux"),
 			expectedRR:   false,
 		},
 		"known-state-change-false-dont-override": {
@@ -1067,7 +1046,7 @@ func TestRequiresReplaceIfModifier(t *testing.T) {
 			priorRR:      true,
 			path:         path.Root("optional"),
 			ifReturn:     false,
-			expectedPlan: types.String{Value: "quux"},
+			expectedPlan: types.StringValue("quux"),
 			expectedRR:   true,
 		},
 		"known-state-no-change": {
@@ -1097,7 +1076,7 @@ func TestRequiresReplaceIfModifier(t *testing.T) {
 			priorRR:      false,
 			path:         path.Root("optional-computed"),
 			ifReturn:     true,
-			expectedPlan: types.String{Value: "foo"},
+			expectedPlan: types.StringValue("foo"),
 			expectedRR:   false,
 		},
 		"null-config-computed": {
@@ -1134,7 +1113,7 @@ func TestRequiresReplaceIfModifier(t *testing.T) {
 			priorRR:      false,
 			path:         path.Root("optional-computed"),
 			ifReturn:     true,
-			expectedPlan: types.String{Unknown: true},
+			expectedPlan: types.StringUnknown(),
 			expectedRR:   false,
 		},
 		"null-config-not-computed": {
@@ -1172,7 +1151,7 @@ func TestRequiresReplaceIfModifier(t *testing.T) {
 			priorRR:      false,
 			path:         path.Root("optional"),
 			ifReturn:     true,
-			expectedPlan: types.String{Null: true},
+			expectedPlan: types.StringNull(),
 			expectedRR:   true,
 		},
 		"block-no-change": {
@@ -1249,26 +1228,20 @@ func TestRequiresReplaceIfModifier(t *testing.T) {
 				}),
 			},
 			path: path.Root("block"),
-			expectedPlan: types.List{
-				ElemType: types.ObjectType{
-					AttrTypes: map[string]attr.Type{
-						"optional-computed": types.StringType,
-						"optional":          types.StringType,
-					},
-				},
-				Elems: []attr.Value{
-					types.Object{
-						AttrTypes: map[string]attr.Type{
-							"optional-computed": types.StringType,
-							"optional":          types.StringType,
-						},
-						Attrs: map[string]attr.Value{
-							"optional-computed": types.String{Value: "samevalue"},
-							"optional":          types.String{Value: "samevalue"},
-						},
-					},
+			expectedPlan: types.ListValueMust(types.ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"optional-computed": types.StringType,
+					"optional":          types.StringType,
 				},
-			},
+			}, []attr.Value{
+				types.ObjectValueMust(map[string]attr.Type{
+					"optional-computed": types.StringType,
+					"optional":          types.StringType,
+				}, map[string]attr.Value{
+					"optional-computed": types.StringValue("samevalue"),
+					"optional":          types.StringValue("samevalue"),
+				}),
+			}),
 			ifReturn:   false,
 			expectedRR: false,
 		},
@@ -1364,36 +1337,27 @@ func TestRequiresReplaceIfModifier(t *testing.T) {
 				}),
 			},
 			path: path.Root("block"),
-			expectedPlan: types.List{
-				ElemType: types.ObjectType{
-					AttrTypes: map[string]attr.Type{
-						"optional-computed": types.StringType,
-						"optional":          types.StringType,
-					},
+			expectedPlan: types.ListValueMust(types.ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"optional-computed": types.StringType,
+					"optional":          types.StringType,
 				},
-				Elems: []attr.Value{
-					types.Object{
-						AttrTypes: map[string]attr.Type{
-							"optional-computed": types.StringType,
-							"optional":          types.StringType,
-						},
-						Attrs: map[string]attr.Value{
-							"optional-computed": types.String{Value: "samevalue"},
-							"optional":          types.String{Value: "samevalue"},
-						},
-					},
-					types.Object{
-						AttrTypes: map[string]attr.Type{
-							"optional-computed": types.StringType,
-							"optional":          types.StringType,
-						},
-						Attrs: map[string]attr.Value{
-							"optional-computed": types.String{Value: "newvalue"},
-							"optional":          types.String{Value: "newvalue"},
-						},
-					},
-				},
-			},
+			}, []attr.Value{
+				types.ObjectValueMust(map[string]attr.Type{
+					"optional-computed": types.StringType,
+					"optional":          types.StringType,
+				}, map[string]attr.Value{
+					"optional-computed": types.StringValue("samevalue"),
+					"optional":          types.StringValue("samevalue"),
+				}),
+				types.ObjectValueMust(map[string]attr.Type{
+					"optional-computed": types.StringType,
+					"optional":          types.StringType,
+				}, map[string]attr.Value{
+					"optional-computed": types.StringValue("newvalue"),
+					"optional":          types.StringValue("newvalue"),
+				}),
+			}),
 			ifReturn:   true,
 			expectedRR: true,
 		},
@@ -1471,26 +1435,20 @@ func TestRequiresReplaceIfModifier(t *testing.T) {
 				}),
 			},
 			path: path.Root("block"),
-			expectedPlan: types.List{
-				ElemType: types.ObjectType{
-					AttrTypes: map[string]attr.Type{
-						"optional-computed": types.StringType,
-						"optional":          types.StringType,
-					},
+			expectedPlan: types.ListValueMust(types.ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"optional-computed": types.StringType,
+					"optional":          types.StringType,
 				},
-				Elems: []attr.Value{
-					types.Object{
-						AttrTypes: map[string]attr.Type{
-							"optional-computed": types.StringType,
-							"optional":          types.StringType,
-						},
-						Attrs: map[string]attr.Value{
-							"optional-computed": types.String{Value: "samevalue"},
-							"optional":          types.String{Value: "newvalue"},
-						},
-					},
-				},
-			},
+			}, []attr.Value{
+				types.ObjectValueMust(map[string]attr.Type{
+					"optional-computed": types.StringType,
+					"optional":          types.StringType,
+				}, map[string]attr.Value{
+					"optional-computed": types.StringValue("samevalue"),
+					"optional":          types.StringValue("newvalue"),
+				}),
+			}),
 			ifReturn:   true,
 			expectedRR: true,
 		},
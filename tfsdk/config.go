@@ -21,14 +21,44 @@ type Config struct {
 	Schema Schema
 }
 
+// NewConfig returns a Config for the schema populated with the Go value
+// `val`, which is intended for unit testing provider logic such as validators
+// and plan modifiers. The value can be a struct, using the same reflection
+// rules as State.Set, or a map[string]attr.Value keyed by attribute name,
+// where missing attributes are null. Null and unknown values are set with
+// the attr.Value types, such as types.StringUnknown(). A nil `val`
+// returns a null config.
+func NewConfig(ctx context.Context, schema Schema, val interface{}) (Config, diag.Diagnostics) {
+	raw, diags := schemaValue(ctx, schema, val, "Config Write Error", "config")
+
+	return Config{
+		Raw:    raw,
+		Schema: schema,
+	}, diags
+}
+
 // Get populates the struct passed as `target` with the entire config.
 func (c Config) Get(ctx context.Context, target interface{}) diag.Diagnostics {
-	return reflect.Into(ctx, c.Schema.AttributeType(), c.Raw, target, reflect.Options{})
+	return c.GetWithOptions(ctx, target, ValueAsOptions{})
+}
+
+// GetWithOptions is the same as Get, except `opts` controls how null,
+// unknown, and inexact number values are handled when `target` cannot
+// represent them.
+func (c Config) GetWithOptions(ctx context.Context, target interface{}, opts ValueAsOptions) diag.Diagnostics {
+	return reflect.Into(ctx, c.Schema.AttributeType(), c.Raw, target, opts.reflectOptions())
 }
 
 // GetAttribute retrieves the attribute found at `path` and populates the
 // `target` with the value.
 func (c Config) GetAttribute(ctx context.Context, path path.Path, target interface{}) diag.Diagnostics {
+	return c.GetAttributeWithOptions(ctx, path, target, ValueAsOptions{})
+}
+
+// GetAttributeWithOptions is the same as GetAttribute, except `opts` controls
+// how null, unknown, and inexact number values are handled when `target`
+// cannot represent them.
+func (c Config) GetAttributeWithOptions(ctx context.Context, path path.Path, target interface{}, opts ValueAsOptions) diag.Diagnostics {
 	ctx = logging.FrameworkWithAttributePath(ctx, path.String())
 
 	attrValue, diags := c.getAttributeValue(ctx, path)
@@ -47,11 +77,13 @@ func (c Config) GetAttribute(ctx context.Context, path path.Path, target interfa
 		return diags
 	}
 
-	valueAsDiags := ValueAs(ctx, attrValue, target)
+	valueAsDiags := valueAs(ctx, attrValue, target, opts, path)
 
-	// ValueAs does not have path information for its Diagnostics.
+	// Conversion errors do not have path information.
 	for idx, valueAsDiag := range valueAsDiags {
-		valueAsDiags[idx] = diag.WithPath(path, valueAsDiag)
+		if _, ok := valueAsDiag.(diag.DiagnosticWithPath); !ok {
+			valueAsDiags[idx] = diag.WithPath(path, valueAsDiag)
+		}
 	}
 
 	diags.Append(valueAsDiags...)
@@ -16,6 +16,98 @@ import (
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
+func TestNewConfig(t *testing.T) {
+	t.Parallel()
+
+	testSchema := Schema{
+		Attributes: map[string]Attribute{
+			"name": {
+				Optional: true,
+				Type:     types.StringType,
+			},
+		},
+	}
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"name": tftypes.String,
+		},
+	}
+
+	testCases := map[string]struct {
+		val           interface{}
+		expected      Config
+		expectedDiags diag.Diagnostics
+	}{
+		"nil": {
+			val: nil,
+			expected: Config{
+				Raw:    tftypes.NewValue(testType, nil),
+				Schema: testSchema,
+			},
+		},
+		"struct": {
+			val: struct {
+				Name types.String `tfsdk:"name"`
+			}{
+				Name: types.StringUnknown(),
+			},
+			expected: Config{
+				Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+					"name": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				}),
+				Schema: testSchema,
+			},
+		},
+		"map": {
+			val: map[string]attr.Value{
+				"name": types.StringValue("test"),
+			},
+			expected: Config{
+				Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+					"name": tftypes.NewValue(tftypes.String, "test"),
+				}),
+				Schema: testSchema,
+			},
+		},
+		"map-undefined-attribute": {
+			val: map[string]attr.Value{
+				"other": types.StringValue("test"),
+			},
+			expected: Config{
+				Raw:    tftypes.NewValue(testType, nil),
+				Schema: testSchema,
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("other"),
+					"Config Write Error",
+					"An unexpected error was encountered trying to write the config. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"attribute \"other\" is not defined in the schema",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := NewConfig(context.Background(), testSchema, testCase.val)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics (+wanted, -got): %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected result (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
 func TestConfigGet(t *testing.T) {
 	t.Parallel()
 
@@ -49,7 +141,7 @@ func TestConfigGet(t *testing.T) {
 				},
 			},
 			expected: testConfigGetData{
-				Name: types.String{Value: "namevalue"},
+				Name: types.StringValue("namevalue"),
 			},
 		},
 	}
@@ -107,7 +199,7 @@ func TestConfigGet_testTypes(t *testing.T) {
 				},
 			},
 			expected: testConfigGetData{
-				Name: testtypes.String{InternalString: types.String{Value: ""}, CreatedBy: testtypes.StringTypeWithValidateError{}},
+				Name: testtypes.String{InternalString: types.StringNull(), CreatedBy: testtypes.StringTypeWithValidateError{}},
 			},
 			expectedDiags: diag.Diagnostics{testtypes.TestErrorDiagnostic(path.Root("name"))},
 		},
@@ -130,7 +222,7 @@ func TestConfigGet_testTypes(t *testing.T) {
 				},
 			},
 			expected: testConfigGetData{
-				Name: testtypes.String{InternalString: types.String{Value: "namevalue"}, CreatedBy: testtypes.StringTypeWithValidateWarning{}},
+				Name: testtypes.String{InternalString: types.StringValue("namevalue"), CreatedBy: testtypes.StringTypeWithValidateWarning{}},
 			},
 			expectedDiags: diag.Diagnostics{testtypes.TestWarningDiagnostic(path.Root("name"))},
 		},
@@ -227,8 +319,11 @@ func TestConfigGetAttribute(t *testing.T) {
 					},
 				},
 			},
-			target:   new(types.String),
-			expected: &types.String{Value: "namevalue"},
+			target: new(types.String),
+			expected: func() *types.String {
+				v := types.StringValue("namevalue")
+				return &v
+			}(),
 		},
 		"incompatible-target": {
 			config: Config{
@@ -254,7 +349,7 @@ func TestConfigGetAttribute(t *testing.T) {
 				diag.WithPath(
 					path.Root("name"),
 					intreflect.DiagNewAttributeValueIntoWrongType{
-						ValType:    reflect.TypeOf(types.String{Value: "namevalue"}),
+						ValType:    reflect.TypeOf(types.StringValue("namevalue")),
 						TargetType: reflect.TypeOf(testtypes.String{}),
 						SchemaType: types.StringType,
 					},
@@ -333,7 +428,7 @@ func TestConfigGetAttribute(t *testing.T) {
 				},
 			},
 			target:        new(testtypes.String),
-			expected:      &testtypes.String{InternalString: types.String{Value: "namevalue"}, CreatedBy: testtypes.StringTypeWithValidateWarning{}},
+			expected:      &testtypes.String{InternalString: types.StringValue("namevalue"), CreatedBy: testtypes.StringTypeWithValidateWarning{}},
 			expectedDiags: diag.Diagnostics{testtypes.TestWarningDiagnostic(path.Root("name"))},
 		},
 	}
@@ -356,6 +451,247 @@ func TestConfigGetAttribute(t *testing.T) {
 	}
 }
 
+func TestConfigGetWithOptions(t *testing.T) {
+	t.Parallel()
+
+	type testConfigGetWithOptionsData struct {
+		Count int64   `tfsdk:"count"`
+		ID    *string `tfsdk:"id"`
+		Name  string  `tfsdk:"name"`
+	}
+
+	testSchema := Schema{
+		Attributes: map[string]Attribute{
+			"count": {
+				Type:     types.NumberType,
+				Optional: true,
+			},
+			"id": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"name": {
+				Type:     types.StringType,
+				Optional: true,
+			},
+		},
+	}
+
+	testValue := func(count interface{}, id interface{}, name interface{}) Config {
+		return Config{
+			Raw: tftypes.NewValue(testSchema.TerraformType(context.Background()), map[string]tftypes.Value{
+				"count": tftypes.NewValue(tftypes.Number, count),
+				"id":    tftypes.NewValue(tftypes.String, id),
+				"name":  tftypes.NewValue(tftypes.String, name),
+			}),
+			Schema: testSchema,
+		}
+	}
+
+	testCases := map[string]struct {
+		config        Config
+		opts          ValueAsOptions
+		expected      testConfigGetWithOptionsData
+		expectedDiags diag.Diagnostics
+	}{
+		"known": {
+			config: testValue(2, "test-id", "test-name"),
+			expected: testConfigGetWithOptionsData{
+				Count: 2,
+				ID:    newStringPointer("test-id"),
+				Name:  "test-name",
+			},
+		},
+		"null": {
+			config:   testValue(2, nil, nil),
+			expected: testConfigGetWithOptionsData{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("name"),
+					"Value Conversion Error",
+					"An unexpected error was encountered trying to build a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"unhandled null value",
+				),
+			},
+		},
+		"null-as-empty": {
+			config: testValue(2, nil, nil),
+			opts: ValueAsOptions{
+				UnhandledNullAsEmpty: true,
+			},
+			expected: testConfigGetWithOptionsData{
+				Count: 2,
+			},
+		},
+		"unknown": {
+			config:   testValue(2, tftypes.UnknownValue, "test-name"),
+			expected: testConfigGetWithOptionsData{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("id"),
+					"Value Conversion Error",
+					"An unexpected error was encountered trying to build a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"unhandled unknown value",
+				),
+			},
+		},
+		"unknown-as-empty": {
+			config: testValue(2, tftypes.UnknownValue, "test-name"),
+			opts: ValueAsOptions{
+				UnhandledUnknownAsEmpty: true,
+			},
+			expected: testConfigGetWithOptionsData{
+				Count: 2,
+				Name:  "test-name",
+			},
+		},
+		"rounding": {
+			config:   testValue(1.5, "test-id", "test-name"),
+			expected: testConfigGetWithOptionsData{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("count"),
+					"Value Conversion Error",
+					"An unexpected error was encountered trying to convert to number. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"cannot store 1.5 in int64",
+				),
+			},
+		},
+		"rounding-allowed": {
+			config: testValue(1.5, "test-id", "test-name"),
+			opts: ValueAsOptions{
+				AllowRoundingNumbers: true,
+			},
+			expected: testConfigGetWithOptionsData{
+				Count: 1,
+				ID:    newStringPointer("test-id"),
+				Name:  "test-name",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got testConfigGetWithOptionsData
+
+			diags := tc.config.GetWithOptions(context.Background(), &got, tc.opts)
+
+			if diff := cmp.Diff(diags, tc.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics (+wanted, -got): %s", diff)
+			}
+
+			if diff := cmp.Diff(got, tc.expected); diff != "" {
+				t.Errorf("unexpected value (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestConfigGetAttributeWithOptions(t *testing.T) {
+	t.Parallel()
+
+	testSchema := Schema{
+		Attributes: map[string]Attribute{
+			"tags": {
+				Type:     types.ListType{ElemType: types.StringType},
+				Optional: true,
+			},
+		},
+	}
+
+	testValue := func(tags ...tftypes.Value) Config {
+		return Config{
+			Raw: tftypes.NewValue(testSchema.TerraformType(context.Background()), map[string]tftypes.Value{
+				"tags": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, tags),
+			}),
+			Schema: testSchema,
+		}
+	}
+
+	testCases := map[string]struct {
+		config        Config
+		opts          ValueAsOptions
+		expected      []string
+		expectedDiags diag.Diagnostics
+	}{
+		"known": {
+			config: testValue(
+				tftypes.NewValue(tftypes.String, "first"),
+				tftypes.NewValue(tftypes.String, "second"),
+			),
+			expected: []string{"first", "second"},
+		},
+		"null-element": {
+			config: testValue(
+				tftypes.NewValue(tftypes.String, "first"),
+				tftypes.NewValue(tftypes.String, nil),
+			),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("tags").AtListIndex(1),
+					"Value Conversion Error",
+					"An unexpected error was encountered trying to build a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"unhandled null value",
+				),
+			},
+		},
+		"null-element-as-empty": {
+			config: testValue(
+				tftypes.NewValue(tftypes.String, "first"),
+				tftypes.NewValue(tftypes.String, nil),
+			),
+			opts: ValueAsOptions{
+				UnhandledNullAsEmpty: true,
+			},
+			expected: []string{"first", ""},
+		},
+		"unknown-element": {
+			config: testValue(
+				tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("tags").AtListIndex(0),
+					"Value Conversion Error",
+					"An unexpected error was encountered trying to build a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"unhandled unknown value",
+				),
+			},
+		},
+		"unknown-element-as-empty": {
+			config: testValue(
+				tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			),
+			opts: ValueAsOptions{
+				UnhandledUnknownAsEmpty: true,
+			},
+			expected: []string{""},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got []string
+
+			diags := tc.config.GetAttributeWithOptions(context.Background(), path.Root("tags"), &got, tc.opts)
+
+			if diff := cmp.Diff(diags, tc.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics (+wanted, -got): %s", diff)
+			}
+
+			if diff := cmp.Diff(got, tc.expected); diff != "" {
+				t.Errorf("unexpected value (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
 func TestConfigGetAttributeValue(t *testing.T) {
 	t.Parallel()
 
@@ -451,7 +787,7 @@ func TestConfigGetAttributeValue(t *testing.T) {
 				},
 			},
 			path:     path.Root("test").AtListIndex(0),
-			expected: types.String{Null: true},
+			expected: types.StringNull(),
 		},
 		"WithAttributeName-List-WithElementKeyInt": {
 			config: Config{
@@ -487,7 +823,7 @@ func TestConfigGetAttributeValue(t *testing.T) {
 				},
 			},
 			path:     path.Root("test").AtListIndex(0),
-			expected: types.String{Value: "value"},
+			expected: types.StringValue("value"),
 		},
 		"WithAttributeName-ListNestedAttributes-null-WithElementKeyInt-WithAttributeName": {
 			config: Config{
@@ -531,7 +867,7 @@ func TestConfigGetAttributeValue(t *testing.T) {
 				},
 			},
 			path:     path.Root("test").AtListIndex(0).AtName("sub_test"),
-			expected: types.String{Null: true},
+			expected: types.StringNull(),
 		},
 		"WithAttributeName-ListNestedAttributes-null-WithElementKeyInt-WithAttributeName-Object": {
 			config: Config{
@@ -587,11 +923,8 @@ func TestConfigGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path: path.Root("test").AtListIndex(0).AtName("sub_test"),
-			expected: types.Object{
-				Null:      true,
-				AttrTypes: map[string]attr.Type{"value": types.StringType},
-			},
+			path:     path.Root("test").AtListIndex(0).AtName("sub_test"),
+			expected: types.ObjectNull(map[string]attr.Type{"value": types.StringType}),
 		},
 		"WithAttributeName-ListNestedAttributes-WithElementKeyInt-WithAttributeName": {
 			config: Config{
@@ -643,7 +976,7 @@ func TestConfigGetAttributeValue(t *testing.T) {
 				},
 			},
 			path:     path.Root("test").AtListIndex(0).AtName("sub_test"),
-			expected: types.String{Value: "value"},
+			expected: types.StringValue("value"),
 		},
 		"WithAttributeName-ListNestedBlocks-null-WithElementKeyInt-WithAttributeName": {
 			config: Config{
@@ -712,7 +1045,7 @@ func TestConfigGetAttributeValue(t *testing.T) {
 				},
 			},
 			path:     path.Root("test").AtListIndex(0).AtName("sub_test"),
-			expected: types.String{Null: true},
+			expected: types.StringNull(),
 		},
 		"WithAttributeName-ListNestedBlocks-WithElementKeyInt-WithAttributeName": {
 			config: Config{
@@ -789,7 +1122,7 @@ func TestConfigGetAttributeValue(t *testing.T) {
 				},
 			},
 			path:     path.Root("test").AtListIndex(0).AtName("sub_test"),
-			expected: types.String{Value: "value"},
+			expected: types.StringValue("value"),
 		},
 		"WithAttributeName-Map-null-WithElementKeyString": {
 			config: Config{
@@ -822,7 +1155,7 @@ func TestConfigGetAttributeValue(t *testing.T) {
 				},
 			},
 			path:     path.Root("test").AtMapKey("sub_test"),
-			expected: types.String{Null: true},
+			expected: types.StringNull(),
 		},
 		"WithAttributeName-Map-WithElementKeyString": {
 			config: Config{
@@ -858,7 +1191,7 @@ func TestConfigGetAttributeValue(t *testing.T) {
 				},
 			},
 			path:     path.Root("test").AtMapKey("sub_test"),
-			expected: types.String{Value: "value"},
+			expected: types.StringValue("value"),
 		},
 		"WithAttributeName-Map-WithElementKeyString-nonexistent": {
 			config: Config{
@@ -893,7 +1226,7 @@ func TestConfigGetAttributeValue(t *testing.T) {
 				},
 			},
 			path:     path.Root("test").AtMapKey("other"),
-			expected: types.String{Null: true},
+			expected: types.StringNull(),
 		},
 		"WithAttributeName-MapNestedAttributes-null-WithElementKeyInt-WithAttributeName": {
 			config: Config{
@@ -937,7 +1270,7 @@ func TestConfigGetAttributeValue(t *testing.T) {
 				},
 			},
 			path:     path.Root("test").AtMapKey("element").AtName("sub_test"),
-			expected: types.String{Null: true},
+			expected: types.StringNull(),
 		},
 		"WithAttributeName-MapNestedAttributes-WithElementKeyString-WithAttributeName": {
 			config: Config{
@@ -989,7 +1322,7 @@ func TestConfigGetAttributeValue(t *testing.T) {
 				},
 			},
 			path:     path.Root("test").AtMapKey("element").AtName("sub_test"),
-			expected: types.String{Value: "value"},
+			expected: types.StringValue("value"),
 		},
 		"WithAttributeName-Object-WithAttributeName": {
 			config: Config{
@@ -1030,7 +1363,7 @@ func TestConfigGetAttributeValue(t *testing.T) {
 				},
 			},
 			path:     path.Root("test").AtName("sub_test"),
-			expected: types.String{Value: "value"},
+			expected: types.StringValue("value"),
 		},
 		"WithAttributeName-Set-null-WithElementKeyValue": {
 			config: Config{
@@ -1062,8 +1395,8 @@ func TestConfigGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     path.Root("test").AtSetValue(types.String{Value: "value"}),
-			expected: types.String{Null: true},
+			path:     path.Root("test").AtSetValue(types.StringValue("value")),
+			expected: types.StringNull(),
 		},
 		"WithAttributeName-Set-WithElementKeyValue": {
 			config: Config{
@@ -1098,8 +1431,8 @@ func TestConfigGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     path.Root("test").AtSetValue(types.String{Value: "value"}),
-			expected: types.String{Value: "value"},
+			path:     path.Root("test").AtSetValue(types.StringValue("value")),
+			expected: types.StringValue("value"),
 		},
 		"WithAttributeName-SetNestedAttributes-null-WithElementKeyValue-WithAttributeName": {
 			config: Config{
@@ -1142,15 +1475,12 @@ func TestConfigGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path: path.Root("test").AtSetValue(types.Object{
-				AttrTypes: map[string]attr.Type{
-					"sub_test": types.StringType,
-				},
-				Attrs: map[string]attr.Value{
-					"sub_test": types.String{Value: "value"},
-				},
-			}).AtName("sub_test"),
-			expected: types.String{Null: true},
+			path: path.Root("test").AtSetValue(types.ObjectValueMust(map[string]attr.Type{
+				"sub_test": types.StringType,
+			}, map[string]attr.Value{
+				"sub_test": types.StringValue("value"),
+			})).AtName("sub_test"),
+			expected: types.StringNull(),
 		},
 		"WithAttributeName-SetNestedAttributes-WithElementKeyValue-WithAttributeName": {
 			config: Config{
@@ -1201,15 +1531,12 @@ func TestConfigGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path: path.Root("test").AtSetValue(types.Object{
-				AttrTypes: map[string]attr.Type{
-					"sub_test": types.StringType,
-				},
-				Attrs: map[string]attr.Value{
-					"sub_test": types.String{Value: "value"},
-				},
-			}).AtName("sub_test"),
-			expected: types.String{Value: "value"},
+			path: path.Root("test").AtSetValue(types.ObjectValueMust(map[string]attr.Type{
+				"sub_test": types.StringType,
+			}, map[string]attr.Value{
+				"sub_test": types.StringValue("value"),
+			})).AtName("sub_test"),
+			expected: types.StringValue("value"),
 		},
 		"WithAttributeName-SetNestedBlocks-null-WithElementKeyValue-WithAttributeName": {
 			config: Config{
@@ -1277,15 +1604,12 @@ func TestConfigGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path: path.Root("test").AtSetValue(types.Object{
-				AttrTypes: map[string]attr.Type{
-					"sub_test": types.StringType,
-				},
-				Attrs: map[string]attr.Value{
-					"sub_test": types.String{Value: "value"},
-				},
-			}).AtName("sub_test"),
-			expected: types.String{Null: true},
+			path: path.Root("test").AtSetValue(types.ObjectValueMust(map[string]attr.Type{
+				"sub_test": types.StringType,
+			}, map[string]attr.Value{
+				"sub_test": types.StringValue("value"),
+			})).AtName("sub_test"),
+			expected: types.StringNull(),
 		},
 		"WithAttributeName-SetNestedBlocks-WithElementKeyValue-WithAttributeName": {
 			config: Config{
@@ -1361,15 +1685,12 @@ func TestConfigGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path: path.Root("test").AtSetValue(types.Object{
-				AttrTypes: map[string]attr.Type{
-					"sub_test": types.StringType,
-				},
-				Attrs: map[string]attr.Value{
-					"sub_test": types.String{Value: "value"},
-				},
-			}).AtName("sub_test"),
-			expected: types.String{Value: "value"},
+			path: path.Root("test").AtSetValue(types.ObjectValueMust(map[string]attr.Type{
+				"sub_test": types.StringType,
+			}, map[string]attr.Value{
+				"sub_test": types.StringValue("value"),
+			})).AtName("sub_test"),
+			expected: types.StringValue("value"),
 		},
 		"WithAttributeName-SingleNestedAttributes-null-WithAttributeName-Float64": {
 			config: Config{
@@ -1409,7 +1730,7 @@ func TestConfigGetAttributeValue(t *testing.T) {
 				},
 			},
 			path:     path.Root("test").AtName("sub_test"),
-			expected: types.Float64{Null: true},
+			expected: types.Float64Null(),
 		},
 		"WithAttributeName-SingleNestedAttributes-null-WithAttributeName-Int64": {
 			config: Config{
@@ -1449,7 +1770,7 @@ func TestConfigGetAttributeValue(t *testing.T) {
 				},
 			},
 			path:     path.Root("test").AtName("sub_test"),
-			expected: types.Int64{Null: true},
+			expected: types.Int64Null(),
 		},
 		"WithAttributeName-SingleNestedAttributes-null-WithAttributeName-Set": {
 			config: Config{
@@ -1495,7 +1816,7 @@ func TestConfigGetAttributeValue(t *testing.T) {
 				},
 			},
 			path:     path.Root("test").AtName("sub_test"),
-			expected: types.Set{ElemType: types.StringType, Null: true},
+			expected: types.SetNull(types.StringType),
 		},
 		"WithAttributeName-SingleNestedAttributes-null-WithAttributeName-String": {
 			config: Config{
@@ -1535,7 +1856,7 @@ func TestConfigGetAttributeValue(t *testing.T) {
 				},
 			},
 			path:     path.Root("test").AtName("sub_test"),
-			expected: types.String{Null: true},
+			expected: types.StringNull(),
 		},
 		"WithAttributeName-SingleNestedAttributes-WithAttributeName": {
 			config: Config{
@@ -1577,7 +1898,7 @@ func TestConfigGetAttributeValue(t *testing.T) {
 				},
 			},
 			path:     path.Root("test").AtName("sub_test"),
-			expected: types.String{Value: "value"},
+			expected: types.StringValue("value"),
 		},
 		"WithAttributeName-String-null": {
 			config: Config{
@@ -1604,7 +1925,7 @@ func TestConfigGetAttributeValue(t *testing.T) {
 				},
 			},
 			path:     path.Root("test"),
-			expected: types.String{Null: true},
+			expected: types.StringNull(),
 		},
 		"WithAttributeName-String-unknown": {
 			config: Config{
@@ -1631,7 +1952,7 @@ func TestConfigGetAttributeValue(t *testing.T) {
 				},
 			},
 			path:     path.Root("test"),
-			expected: types.String{Unknown: true},
+			expected: types.StringUnknown(),
 		},
 		"WithAttributeName-String-value": {
 			config: Config{
@@ -1658,7 +1979,7 @@ func TestConfigGetAttributeValue(t *testing.T) {
 				},
 			},
 			path:     path.Root("test"),
-			expected: types.String{Value: "value"},
+			expected: types.StringValue("value"),
 		},
 		"AttrTypeWithValidateError": {
 			config: Config{
@@ -1713,7 +2034,7 @@ func TestConfigGetAttributeValue(t *testing.T) {
 				},
 			},
 			path:          path.Root("test"),
-			expected:      testtypes.String{InternalString: types.String{Value: "value"}, CreatedBy: testtypes.StringTypeWithValidateWarning{}},
+			expected:      testtypes.String{InternalString: types.StringValue("value"), CreatedBy: testtypes.StringTypeWithValidateWarning{}},
 			expectedDiags: diag.Diagnostics{testtypes.TestWarningDiagnostic(path.Root("test"))},
 		},
 	}
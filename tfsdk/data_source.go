@@ -14,6 +14,10 @@ type DataSourceType interface {
 	GetSchema(context.Context) (Schema, diag.Diagnostics)
 
 	// NewDataSource instantiates a new DataSource of this DataSourceType.
+	//
+	// Rather than type asserting the Provider to access provider data, such
+	// as an API client, the DataSource can implement the DataSourceWithConfigure
+	// interface.
 	NewDataSource(context.Context, Provider) (DataSource, diag.Diagnostics)
 }
 
@@ -0,0 +1,23 @@
+package tfsdk
+
+import (
+	"context"
+)
+
+// Optional interface on top of DataSource that enables the provider to pass
+// data, such as a configured API client, from the Provider Configure method
+// to the DataSource, without type asserting the Provider given to the
+// DataSourceType NewDataSource method.
+type DataSourceWithConfigure interface {
+	DataSource
+
+	// Configure is called after NewDataSource and before any other
+	// DataSource method, except during the ValidateDataSourceConfig RPC,
+	// since Terraform validates configurations before the provider is
+	// configured. The ConfigureDataSourceRequest ProviderData field contains
+	// the ConfigureProviderResponse DataSourceData field value.
+	//
+	// If the provider has not been configured, an error diagnostic is
+	// returned to Terraform and Configure is not called.
+	Configure(context.Context, ConfigureDataSourceRequest, *ConfigureDataSourceResponse)
+}
@@ -0,0 +1,23 @@
+package tfsdk
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// Optional interface on top of DataSource that enables the DataSource to
+// report its own type name and schema. This is required for data sources
+// returned by the ProviderWithDataSources DataSources method, which have no
+// DataSourceType.
+type DataSourceWithMetadata interface {
+	DataSource
+
+	// GetSchema returns the schema for this data source.
+	GetSchema(context.Context) (Schema, diag.Diagnostics)
+
+	// Metadata should return the full type name of the data source, such as
+	// "examplecloud_thing". The DataSourceMetadataRequest ProviderTypeName
+	// field can be used as the prefix.
+	Metadata(context.Context, DataSourceMetadataRequest, *DataSourceMetadataResponse)
+}
@@ -0,0 +1,53 @@
+package tfsdk
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Diff returns the differences between two values of the same schema, such
+// as the Raw values of a State and a Plan. Paths in the result are relative to
+// the schema root. Refer to xattr.Diff for details on how values are compared.
+func Diff(ctx context.Context, schema Schema, oldRaw tftypes.Value, newRaw tftypes.Value) (xattr.ValueDiff, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	schemaType := schema.AttributeType()
+
+	oldValue, err := schemaType.ValueFromTerraform(ctx, oldRaw)
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Empty(),
+			"Value Diff Error",
+			"An unexpected error was encountered trying to convert the prior value for comparison. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+		)
+
+		return xattr.ValueDiff{}, diags
+	}
+
+	newValue, err := schemaType.ValueFromTerraform(ctx, newRaw)
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Empty(),
+			"Value Diff Error",
+			"An unexpected error was encountered trying to convert the new value for comparison. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+		)
+
+		return xattr.ValueDiff{}, diags
+	}
+
+	return xattr.Diff(ctx, oldValue, newValue)
+}
+
+// Diff returns the differences between the prior State and the Plan, such as
+// the attributes to include in a partial update request to an API. Values
+// which will only be known after the update, such as computed attributes, are
+// reported in the Unknowns of the result.
+func (r UpdateResourceRequest) Diff(ctx context.Context) (xattr.ValueDiff, diag.Diagnostics) {
+	return Diff(ctx, r.Plan.Schema, r.State.Raw, r.Plan.Raw)
+}
@@ -0,0 +1,126 @@
+package tfsdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestUpdateResourceRequestDiff(t *testing.T) {
+	t.Parallel()
+
+	schema := Schema{
+		Attributes: map[string]Attribute{
+			"id": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"name": {
+				Type:     types.StringType,
+				Required: true,
+			},
+			"tags": {
+				Type:     types.SetType{ElemType: types.StringType},
+				Optional: true,
+			},
+		},
+	}
+	schemaType := schema.TerraformType(context.Background())
+
+	testCases := map[string]struct {
+		state    tftypes.Value
+		plan     tftypes.Value
+		expected xattr.ValueDiff
+	}{
+		"no-changes": {
+			state: tftypes.NewValue(schemaType, map[string]tftypes.Value{
+				"id":   tftypes.NewValue(tftypes.String, "123"),
+				"name": tftypes.NewValue(tftypes.String, "test"),
+				"tags": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "a"),
+					tftypes.NewValue(tftypes.String, "b"),
+				}),
+			}),
+			plan: tftypes.NewValue(schemaType, map[string]tftypes.Value{
+				"id":   tftypes.NewValue(tftypes.String, "123"),
+				"name": tftypes.NewValue(tftypes.String, "test"),
+				"tags": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "b"),
+					tftypes.NewValue(tftypes.String, "a"),
+				}),
+			}),
+			expected: xattr.ValueDiff{},
+		},
+		"changes": {
+			state: tftypes.NewValue(schemaType, map[string]tftypes.Value{
+				"id":   tftypes.NewValue(tftypes.String, "123"),
+				"name": tftypes.NewValue(tftypes.String, "old"),
+				"tags": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "a"),
+				}),
+			}),
+			plan: tftypes.NewValue(schemaType, map[string]tftypes.Value{
+				"id":   tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				"name": tftypes.NewValue(tftypes.String, "new"),
+				"tags": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "a"),
+					tftypes.NewValue(tftypes.String, "b"),
+				}),
+			}),
+			expected: xattr.ValueDiff{
+				Changes: []xattr.ValueChange{
+					{
+						Path: path.Root("name"),
+						Old:  types.StringValue("old"),
+						New:  types.StringValue("new"),
+					},
+					{
+						Path: path.Root("tags").AtSetValue(types.StringValue("b")),
+						New:  types.StringValue("b"),
+					},
+				},
+				Unknowns: []xattr.ValueChange{
+					{
+						Path: path.Root("id"),
+						Old:  types.StringValue("123"),
+						New:  types.StringUnknown(),
+					},
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := UpdateResourceRequest{
+				Plan: Plan{
+					Schema: schema,
+					Raw:    testCase.plan,
+				},
+				State: State{
+					Schema: schema,
+					Raw:    testCase.state,
+				},
+			}
+
+			got, diags := req.Diff(context.Background())
+
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
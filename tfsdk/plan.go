@@ -21,14 +21,44 @@ type Plan struct {
 	Schema Schema
 }
 
+// NewPlan returns a Plan for the schema populated with the Go value
+// `val`, which is intended for unit testing provider logic such as validators
+// and plan modifiers. The value can be a struct, using the same reflection
+// rules as State.Set, or a map[string]attr.Value keyed by attribute name,
+// where missing attributes are null. Null and unknown values are set with
+// the attr.Value types, such as types.StringUnknown(). A nil `val`
+// returns a null plan.
+func NewPlan(ctx context.Context, schema Schema, val interface{}) (Plan, diag.Diagnostics) {
+	raw, diags := schemaValue(ctx, schema, val, "Plan Write Error", "plan")
+
+	return Plan{
+		Raw:    raw,
+		Schema: schema,
+	}, diags
+}
+
 // Get populates the struct passed as `target` with the entire plan.
 func (p Plan) Get(ctx context.Context, target interface{}) diag.Diagnostics {
-	return reflect.Into(ctx, p.Schema.AttributeType(), p.Raw, target, reflect.Options{})
+	return p.GetWithOptions(ctx, target, ValueAsOptions{})
+}
+
+// GetWithOptions is the same as Get, except `opts` controls how null,
+// unknown, and inexact number values are handled when `target` cannot
+// represent them.
+func (p Plan) GetWithOptions(ctx context.Context, target interface{}, opts ValueAsOptions) diag.Diagnostics {
+	return reflect.Into(ctx, p.Schema.AttributeType(), p.Raw, target, opts.reflectOptions())
 }
 
 // GetAttribute retrieves the attribute found at `path` and populates the
 // `target` with the value.
 func (p Plan) GetAttribute(ctx context.Context, path path.Path, target interface{}) diag.Diagnostics {
+	return p.GetAttributeWithOptions(ctx, path, target, ValueAsOptions{})
+}
+
+// GetAttributeWithOptions is the same as GetAttribute, except `opts` controls
+// how null, unknown, and inexact number values are handled when `target`
+// cannot represent them.
+func (p Plan) GetAttributeWithOptions(ctx context.Context, path path.Path, target interface{}, opts ValueAsOptions) diag.Diagnostics {
 	ctx = logging.FrameworkWithAttributePath(ctx, path.String())
 
 	attrValue, diags := p.getAttributeValue(ctx, path)
@@ -47,11 +77,13 @@ func (p Plan) GetAttribute(ctx context.Context, path path.Path, target interface
 		return diags
 	}
 
-	valueAsDiags := ValueAs(ctx, attrValue, target)
+	valueAsDiags := valueAs(ctx, attrValue, target, opts, path)
 
-	// ValueAs does not have path information for its Diagnostics.
+	// Conversion errors do not have path information.
 	for idx, valueAsDiag := range valueAsDiags {
-		valueAsDiags[idx] = diag.WithPath(path, valueAsDiag)
+		if _, ok := valueAsDiag.(diag.DiagnosticWithPath); !ok {
+			valueAsDiags[idx] = diag.WithPath(path, valueAsDiag)
+		}
 	}
 
 	diags.Append(valueAsDiags...)
@@ -17,12 +17,15 @@ type Provider interface {
 	// provider configuration block. These are supplied in the
 	// ConfigureProviderRequest argument.
 	// Values from provider configuration are often used to initialise an
-	// API client, which should be stored on the struct implementing the
-	// Provider interface.
+	// API client, which should be set in the ConfigureProviderResponse
+	// ResourceData and DataSourceData fields for resources and data sources
+	// implementing the ResourceWithConfigure and DataSourceWithConfigure
+	// interfaces.
 	Configure(context.Context, ConfigureProviderRequest, *ConfigureProviderResponse)
 
 	// GetResources returns a mapping of resource names to type
-	// implementations.
+	// implementations. Providers implementing ProviderWithResources may
+	// return an empty mapping.
 	//
 	// Conventionally, resource names should each include a prefix of the
 	// provider name and an underscore. For example, a provider named
@@ -31,7 +34,8 @@ type Provider interface {
 	GetResources(context.Context) (map[string]ResourceType, diag.Diagnostics)
 
 	// GetDataSources returns a mapping of data source name to types
-	// implementations.
+	// implementations. Providers implementing ProviderWithDataSources may
+	// return an empty mapping.
 	//
 	// Conventionally, data source names should each include a prefix of the
 	// provider name and an underscore. For example, a provider named
@@ -49,3 +53,54 @@ type ProviderWithProviderMeta interface {
 	// GetMetaSchema returns the provider meta schema.
 	GetMetaSchema(context.Context) (Schema, diag.Diagnostics)
 }
+
+// ProviderWithMetadata is a provider with a type name. The type name is
+// given to the Metadata method of resources and data sources returned by
+// ProviderWithResources and ProviderWithDataSources, so they can compose
+// their type names from it.
+type ProviderWithMetadata interface {
+	Provider
+
+	// Metadata should return the type name of the provider, such as
+	// "examplecloud".
+	Metadata(context.Context, ProviderMetadataRequest, *ProviderMetadataResponse)
+}
+
+// ProviderWithResources is a provider which registers resources through
+// factory functions, rather than a mapping of resource names to
+// ResourceType in GetResources. Each Resource reports its own type name and
+// schema through the ResourceWithMetadata interface.
+//
+// Resources from both GetResources and Resources are available to
+// Terraform. The framework returns an error diagnostic if any resource type
+// name is defined more than once.
+type ProviderWithResources interface {
+	Provider
+
+	// Resources returns a slice of functions to instantiate each Resource
+	// implementation. Each Resource must implement ResourceWithMetadata.
+	//
+	// The function is called each time a new Resource instance is needed,
+	// so it should return a new instance rather than a shared one.
+	Resources(context.Context) []func() Resource
+}
+
+// ProviderWithDataSources is a provider which registers data sources through
+// factory functions, rather than a mapping of data source names to
+// DataSourceType in GetDataSources. Each DataSource reports its own type
+// name and schema through the DataSourceWithMetadata interface.
+//
+// Data sources from both GetDataSources and DataSources are available to
+// Terraform. The framework returns an error diagnostic if any data source
+// type name is defined more than once.
+type ProviderWithDataSources interface {
+	Provider
+
+	// DataSources returns a slice of functions to instantiate each
+	// DataSource implementation. Each DataSource must implement
+	// DataSourceWithMetadata.
+	//
+	// The function is called each time a new DataSource instance is needed,
+	// so it should return a new instance rather than a shared one.
+	DataSources(context.Context) []func() DataSource
+}
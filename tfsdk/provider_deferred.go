@@ -0,0 +1,37 @@
+package tfsdk
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// UnknownProviderConfigValueDiagnostic returns a warning diagnostic for a
+// provider configuration attribute with an unknown value, which explains to
+// practitioners that the provider cannot be fully configured until apply.
+// It is intended to be added to the ConfigureProviderResponse Diagnostics
+// when setting the ConfigureProviderResponse Deferred field.
+func UnknownProviderConfigValueDiagnostic(attributePath path.Path) diag.Diagnostic {
+	return diag.NewAttributeWarningDiagnostic(
+		attributePath,
+		"Unknown Provider Configuration Value",
+		"The provider configuration value is not known until apply, because it depends on values from other resources. "+
+			"Resources and data sources which require this provider to communicate with a remote system may not plan accurately "+
+			"or may fail until the value is known.\n\n"+
+			"To prevent this, apply the resources the provider configuration depends on first, such as with the -target option, "+
+			"or set the value to a known value, such as with a variable.",
+	)
+}
+
+// ProviderDeferredDiagnostic returns an error diagnostic for resources or
+// data sources which cannot continue because the ProviderDeferred request
+// field is true. It explains to practitioners that the provider
+// configuration must be known before the operation can succeed.
+func ProviderDeferredDiagnostic() diag.Diagnostic {
+	return diag.NewErrorDiagnostic(
+		"Provider Configuration Not Known",
+		"The provider configuration contains values which are not known until apply, because they depend on values from other resources. "+
+			"The provider cannot complete this operation until the configuration is known.\n\n"+
+			"To prevent this, apply the resources the provider configuration depends on first, such as with the -target option, "+
+			"or set the provider configuration to known values, such as with variables.",
+	)
+}
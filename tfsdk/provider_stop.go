@@ -0,0 +1,32 @@
+package tfsdk
+
+import (
+	"context"
+)
+
+// Optional interface on top of Provider that enables the provider to react
+// to Terraform stopping in-flight operations, such as when a practitioner
+// interrupts Terraform CLI. The framework cancels the context of in-flight
+// operations before calling Stop.
+type ProviderWithStop interface {
+	Provider
+
+	// Stop is called when Terraform sends the StopProvider RPC. The
+	// context.Context parameter is canceled after the provider server
+	// shutdown timeout, after which the framework returns an error to
+	// Terraform without waiting for Stop to complete.
+	Stop(context.Context, StopProviderRequest, *StopProviderResponse)
+}
+
+// Optional interface on top of Provider that enables the provider to clean
+// up resources, such as connection pools, background goroutines, or
+// temporary files, before the provider process exits.
+type ProviderWithClose interface {
+	Provider
+
+	// Close is called once when providerserver.Serve returns. The
+	// context.Context parameter is canceled after the provider server
+	// shutdown timeout, after which the framework stops waiting for Close to
+	// complete.
+	Close(context.Context, CloseProviderRequest, *CloseProviderResponse)
+}
@@ -16,6 +16,16 @@ type ConfigureProviderRequest struct {
 	// that's implementing the Provider interface, for use in later
 	// resource CRUD operations.
 	Config Config
+
+	// ConfigHasUnknownValues is true if any part of Config is unknown, such
+	// as when the provider configuration references an attribute of a
+	// resource that has not been created yet. Terraform CLI will configure
+	// the provider again with known values during apply.
+	//
+	// Providers which cannot create a fully functional client with unknown
+	// values can set the ConfigureProviderResponse Deferred field, rather
+	// than returning an error, to plan resources without the client.
+	ConfigHasUnknownValues bool
 }
 
 // CreateResourceRequest represents a request for the provider to create a
@@ -47,6 +57,14 @@ type ReadResourceRequest struct {
 
 	// ProviderMeta is metadata from the provider_meta block of the module.
 	ProviderMeta Config
+
+	// ProviderDeferred is true if the provider set the
+	// ConfigureProviderResponse Deferred field, such as when the provider
+	// configuration contains unknown values during plan. Any provider data
+	// or client may not be available, so logic should avoid remote calls.
+	// The ProviderDeferredDiagnostic function returns an error diagnostic
+	// which explains the situation to practitioners.
+	ProviderDeferred bool
 }
 
 // UpdateResourceRequest represents a request for the provider to update a
@@ -101,6 +119,14 @@ type ModifyResourcePlanRequest struct {
 
 	// ProviderMeta is metadata from the provider_meta block of the module.
 	ProviderMeta Config
+
+	// ProviderDeferred is true if the provider set the
+	// ConfigureProviderResponse Deferred field, such as when the provider
+	// configuration contains unknown values during plan. Any provider data
+	// or client may not be available, so logic should avoid remote calls.
+	// The ProviderDeferredDiagnostic function returns an error diagnostic
+	// which explains the situation to practitioners.
+	ProviderDeferred bool
 }
 
 // ReadDataSourceRequest represents a request for the provider to read a data
@@ -117,4 +143,12 @@ type ReadDataSourceRequest struct {
 
 	// ProviderMeta is metadata from the provider_meta block of the module.
 	ProviderMeta Config
+
+	// ProviderDeferred is true if the provider set the
+	// ConfigureProviderResponse Deferred field, such as when the provider
+	// configuration contains unknown values during plan. Any provider data
+	// or client may not be available, so logic should avoid remote calls.
+	// The ProviderDeferredDiagnostic function returns an error diagnostic
+	// which explains the situation to practitioners.
+	ProviderDeferred bool
 }
@@ -0,0 +1,32 @@
+package tfsdk
+
+// ConfigureResourceRequest represents a request for the provider to configure
+// a resource, after the provider has been configured. An instance of this
+// request struct is supplied as an argument to the Resource's Configure
+// method.
+type ConfigureResourceRequest struct {
+	// ProviderData is the data set in the ConfigureProviderResponse
+	// ResourceData field. This data is provider-specific and therefore can
+	// contain any necessary remote system clients, custom provider data, or
+	// anything else pertinent to the functionality of the Resource.
+	//
+	// This data is nil if the ConfigureProviderResponse ResourceData field
+	// was not set, or if the provider has not been configured yet, which
+	// can happen when Terraform upgrades the resource state.
+	ProviderData interface{}
+}
+
+// ConfigureDataSourceRequest represents a request for the provider to
+// configure a data source, after the provider has been configured. An
+// instance of this request struct is supplied as an argument to the
+// DataSource's Configure method.
+type ConfigureDataSourceRequest struct {
+	// ProviderData is the data set in the ConfigureProviderResponse
+	// DataSourceData field. This data is provider-specific and therefore can
+	// contain any necessary remote system clients, custom provider data, or
+	// anything else pertinent to the functionality of the DataSource.
+	//
+	// This data is nil if the ConfigureProviderResponse DataSourceData field
+	// was not set.
+	ProviderData interface{}
+}
@@ -0,0 +1,26 @@
+package tfsdk
+
+// ProviderMetadataRequest represents a request for the Provider to return its
+// type name. An instance of this request struct is supplied as an argument to
+// the Provider's Metadata method.
+type ProviderMetadataRequest struct{}
+
+// ResourceMetadataRequest represents a request for the Resource to return
+// its type name. An instance of this request struct is supplied as an
+// argument to the Resource's Metadata method.
+type ResourceMetadataRequest struct {
+	// ProviderTypeName is the type name of the provider, if the Provider
+	// implements ProviderWithMetadata. It can be used as the prefix of the
+	// resource type name, such as ProviderTypeName + "_thing".
+	ProviderTypeName string
+}
+
+// DataSourceMetadataRequest represents a request for the DataSource to return
+// its type name. An instance of this request struct is supplied as an
+// argument to the DataSource's Metadata method.
+type DataSourceMetadataRequest struct {
+	// ProviderTypeName is the type name of the provider, if the Provider
+	// implements ProviderWithMetadata. It can be used as the prefix of the
+	// data source type name, such as ProviderTypeName + "_thing".
+	ProviderTypeName string
+}
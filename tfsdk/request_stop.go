@@ -0,0 +1,11 @@
+package tfsdk
+
+// StopProviderRequest represents a request for the provider to stop any
+// in-flight operations. An instance of this request struct is supplied as an
+// argument to the Provider's Stop method.
+type StopProviderRequest struct{}
+
+// CloseProviderRequest represents a request for the provider to clean up
+// before the provider process exits. An instance of this request struct is
+// supplied as an argument to the Provider's Close method.
+type CloseProviderRequest struct{}
@@ -14,6 +14,10 @@ type ResourceType interface {
 	GetSchema(context.Context) (Schema, diag.Diagnostics)
 
 	// NewResource instantiates a new Resource of this ResourceType.
+	//
+	// Rather than type asserting the Provider to access provider data, such
+	// as an API client, the Resource can implement the ResourceWithConfigure
+	// interface.
 	NewResource(context.Context, Provider) (Resource, diag.Diagnostics)
 }
 
@@ -0,0 +1,26 @@
+package tfsdk
+
+import (
+	"context"
+)
+
+// Optional interface on top of Resource that enables the provider to pass
+// data, such as a configured API client, from the Provider Configure method
+// to the Resource, without type asserting the Provider given to the
+// ResourceType NewResource method.
+type ResourceWithConfigure interface {
+	Resource
+
+	// Configure is called after NewResource and before any other Resource
+	// method, except during the ValidateResourceConfig RPC, since Terraform
+	// validates configurations before the provider is configured. The
+	// ConfigureResourceRequest ProviderData field contains the
+	// ConfigureProviderResponse ResourceData field value.
+	//
+	// Configure may be called before the provider has been configured,
+	// such as when Terraform upgrades the resource state, in which case the
+	// ConfigureResourceRequest ProviderData field is nil. Implementations
+	// must handle a nil ProviderData, such as by returning early without
+	// diagnostics.
+	Configure(context.Context, ConfigureResourceRequest, *ConfigureResourceResponse)
+}
@@ -0,0 +1,22 @@
+package tfsdk
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// Optional interface on top of Resource that enables the Resource to report
+// its own type name and schema. This is required for resources returned by
+// the ProviderWithResources Resources method, which have no ResourceType.
+type ResourceWithMetadata interface {
+	Resource
+
+	// GetSchema returns the schema for this resource.
+	GetSchema(context.Context) (Schema, diag.Diagnostics)
+
+	// Metadata should return the full type name of the resource, such as
+	// "examplecloud_thing". The ResourceMetadataRequest ProviderTypeName
+	// field can be used as the prefix.
+	Metadata(context.Context, ResourceMetadataRequest, *ResourceMetadataResponse)
+}
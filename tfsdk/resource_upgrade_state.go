@@ -33,6 +33,34 @@ type ResourceWithUpgradeState interface {
 	UpgradeState(context.Context) map[int64]ResourceStateUpgrader
 }
 
+// Optional interface on top of Resource that enables chained state upgrades
+// for the UpgradeResourceState RPC. Unlike ResourceWithUpgradeState, where
+// each state upgrader must convert prior state directly to the current schema
+// version, each state upgrader in the chain only converts prior state to the
+// next version. The framework runs the state upgraders sequentially, such as
+// version 0 to 1, 1 to 2, and so on, until the current schema version.
+//
+// If the Resource also implements ResourceWithUpgradeState and has a state
+// upgrader for the prior state version, it takes precedence over the chain.
+// This allows shortcuts for common prior state versions.
+type ResourceWithUpgradeStateChain interface {
+	Resource
+
+	// A mapping of prior state version to the state upgrade implementation
+	// for the next version.
+	//
+	// Every ResourceStateUpgrader in the chain must set PriorSchema, which
+	// is also used as the upgraded state schema of the previous state
+	// upgrader in the chain. The state upgrader for the version before the
+	// current schema version upgrades to the current schema.
+	//
+	// Version keys begin at 0, which is the default schema version when
+	// undefined. The framework will return an error diagnostic should any
+	// version between the requested state version and current schema
+	// version not be implemented.
+	UpgradeStateChain(context.Context) map[int64]ResourceStateUpgrader
+}
+
 // Implementation handler for a UpgradeResourceState operation.
 //
 // This is used to encapsulate all upgrade logic from a prior state to the
@@ -49,7 +77,8 @@ type ResourceStateUpgrader struct {
 	PriorSchema *Schema
 
 	// Provider defined logic for upgrading a resource state from the prior
-	// state version to the current schema version.
+	// state version to the current schema version, or the next version when
+	// used with ResourceWithUpgradeStateChain.
 	//
 	// The context.Context parameter contains framework-defined loggers and
 	// supports request cancellation.
@@ -87,6 +116,10 @@ type UpgradeResourceStateRequest struct {
 	// Previous state of the resource if the wrapping ResourceStateUpgrader
 	// type PriorSchema field was present. When available, this allows for
 	// easier data handling such as calling Get() or GetAttribute().
+	//
+	// The framework populates this from either the JSON or flatmap format
+	// RawState, so providers do not need to handle flatmap state saved by
+	// Terraform CLI 0.11 and earlier themselves.
 	State *State
 }
 
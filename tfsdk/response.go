@@ -14,6 +14,25 @@ type ConfigureProviderResponse struct {
 	// provider. An empty slice indicates success, with no warnings or
 	// errors generated.
 	Diagnostics diag.Diagnostics
+
+	// DataSourceData is provider-defined data, clients, etc. that is passed
+	// to the ConfigureDataSourceRequest ProviderData field of data sources
+	// which implement the DataSourceWithConfigure interface.
+	DataSourceData interface{}
+
+	// ResourceData is provider-defined data, clients, etc. that is passed
+	// to the ConfigureResourceRequest ProviderData field of resources which
+	// implement the ResourceWithConfigure interface.
+	ResourceData interface{}
+
+	// Deferred signals that the provider could not be fully configured,
+	// typically because the ConfigureProviderRequest ConfigHasUnknownValues
+	// field is true. When set, the framework sets the ProviderDeferred field
+	// of the ModifyResourcePlanRequest, ReadResourceRequest, and
+	// ReadDataSourceRequest, so resources and data sources can avoid using a
+	// partially configured client until Terraform CLI configures the
+	// provider again during apply.
+	Deferred bool
 }
 
 // CreateResourceResponse represents a response to a CreateResourceRequest. An
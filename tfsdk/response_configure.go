@@ -0,0 +1,28 @@
+package tfsdk
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// ConfigureResourceResponse represents a response to a
+// ConfigureResourceRequest. An instance of this response struct is supplied
+// as an argument to the Resource's Configure method, in which the provider
+// should set values on the ConfigureResourceResponse as appropriate.
+type ConfigureResourceResponse struct {
+	// Diagnostics report errors or warnings related to configuring the
+	// resource. An empty slice indicates success, with no warnings or
+	// errors generated.
+	Diagnostics diag.Diagnostics
+}
+
+// ConfigureDataSourceResponse represents a response to a
+// ConfigureDataSourceRequest. An instance of this response struct is
+// supplied as an argument to the DataSource's Configure method, in which the
+// provider should set values on the ConfigureDataSourceResponse as
+// appropriate.
+type ConfigureDataSourceResponse struct {
+	// Diagnostics report errors or warnings related to configuring the data
+	// source. An empty slice indicates success, with no warnings or errors
+	// generated.
+	Diagnostics diag.Diagnostics
+}
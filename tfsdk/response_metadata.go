@@ -0,0 +1,30 @@
+package tfsdk
+
+// ProviderMetadataResponse represents a response to a
+// ProviderMetadataRequest. An instance of this response struct is supplied as
+// an argument to the Provider's Metadata method, in which the provider should
+// set values on the ProviderMetadataResponse as appropriate.
+type ProviderMetadataResponse struct {
+	// TypeName should be the provider type name, such as "examplecloud".
+	TypeName string
+}
+
+// ResourceMetadataResponse represents a response to a
+// ResourceMetadataRequest. An instance of this response struct is supplied as
+// an argument to the Resource's Metadata method, in which the resource should
+// set values on the ResourceMetadataResponse as appropriate.
+type ResourceMetadataResponse struct {
+	// TypeName should be the full resource type name, such as
+	// "examplecloud_thing".
+	TypeName string
+}
+
+// DataSourceMetadataResponse represents a response to a
+// DataSourceMetadataRequest. An instance of this response struct is supplied
+// as an argument to the DataSource's Metadata method, in which the data
+// source should set values on the DataSourceMetadataResponse as appropriate.
+type DataSourceMetadataResponse struct {
+	// TypeName should be the full data source type name, such as
+	// "examplecloud_thing".
+	TypeName string
+}
@@ -0,0 +1,28 @@
+package tfsdk
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// StopProviderResponse represents a response to a StopProviderRequest. An
+// instance of this response struct is supplied as an argument to the
+// Provider's Stop method, in which the provider should set values on the
+// StopProviderResponse as appropriate.
+type StopProviderResponse struct {
+	// Diagnostics report errors or warnings related to stopping the
+	// provider. Terraform only supports a single error message for this
+	// operation, so error diagnostics are combined and warning diagnostics
+	// are only logged.
+	Diagnostics diag.Diagnostics
+}
+
+// CloseProviderResponse represents a response to a CloseProviderRequest. An
+// instance of this response struct is supplied as an argument to the
+// Provider's Close method, in which the provider should set values on the
+// CloseProviderResponse as appropriate.
+type CloseProviderResponse struct {
+	// Diagnostics report errors or warnings related to closing the provider.
+	// Error diagnostics are returned as an error from providerserver.Serve.
+	// Warning diagnostics are only logged.
+	Diagnostics diag.Diagnostics
+}
@@ -0,0 +1,590 @@
+package tfsdk
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+const (
+	// SchemaStructTag is the struct tag key, used by SchemaFromStruct, for
+	// comma-separated attribute and block flags. Valid flags are:
+	//
+	//   - required, optional, computed: set the Attribute field of the same
+	//     name. At least one must be set for each attribute.
+	//   - sensitive: sets the Attribute Sensitive field.
+	//   - set: uses a set instead of a list for slices and arrays.
+	//   - block: uses a Block instead of nested attributes for slices and
+	//     arrays of structs.
+	//
+	// For example:
+	//
+	//	Name types.String `tfsdk:"name" tfsdkschema:"required"`
+	SchemaStructTag = "tfsdkschema"
+
+	// SchemaDescriptionStructTag is the struct tag key, used by
+	// SchemaFromStruct, for the attribute or block Description.
+	SchemaDescriptionStructTag = "description"
+
+	// SchemaDeprecationMessageStructTag is the struct tag key, used by
+	// SchemaFromStruct, for the attribute or block DeprecationMessage.
+	SchemaDeprecationMessageStructTag = "deprecation_message"
+)
+
+// SchemaFromStructOptions are options for customizing the behavior of
+// SchemaFromStruct.
+type SchemaFromStructOptions struct {
+	// AttributeTypes are the attr.Type for struct fields whose type cannot
+	// be determined from the Go type alone, such as types.List, types.Set,
+	// types.Map, and types.Object, which require an element or attribute
+	// types. The map key is the attribute names from the schema root, joined
+	// with periods, such as "tags" or "network.ip_addresses".
+	//
+	// Entries are also used, instead of the Go type, for any other field.
+	AttributeTypes map[string]attr.Type
+}
+
+// SchemaFromStruct returns a Schema derived from the model, a struct or
+// pointer to a struct, so models and schemas are defined in one place. Each
+// exported struct field must have a "tfsdk" struct tag with the attribute or
+// block name, or "-" to be skipped. Additional struct tags describe the
+// attribute or block, see SchemaStructTag, SchemaDescriptionStructTag, and
+// SchemaDeprecationMessageStructTag.
+//
+// Go types are mapped as follows:
+//
+//   - string, bool, *big.Float, and *big.Int to the types package type
+//   - int32 to types.Int32Type, uint and uint64 to types.Uint64Type, other
+//     integers to types.Int64Type, float32 to types.Float32Type, and float64
+//     to types.Float64Type
+//   - attr.Value implementations to the attr.Type returned by their Type
+//     method, or SchemaFromStructOptions.AttributeTypes if present
+//   - structs to single nested attributes
+//   - slices and arrays to lists, or sets with the set flag, and slices and
+//     arrays of structs to list or set nested attributes, or blocks with the
+//     block flag
+//   - maps with string keys to maps, and maps of structs to map nested
+//     attributes
+//
+// Pointers are mapped as the type they point to. Validators, PlanModifiers,
+// and schema level fields, such as Version, can be added to the returned
+// Schema.
+func SchemaFromStruct(ctx context.Context, model interface{}, opts SchemaFromStructOptions) (Schema, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	modelType := reflect.TypeOf(model)
+
+	if modelType != nil && modelType.Kind() == reflect.Ptr {
+		modelType = modelType.Elem()
+	}
+
+	if modelType == nil || modelType.Kind() != reflect.Struct {
+		diags.AddError(
+			"Schema From Struct Error",
+			fmt.Sprintf("An unexpected error was encountered deriving a schema from a struct. The model must be a struct or pointer to a struct, got: %T", model),
+		)
+
+		return Schema{}, diags
+	}
+
+	attributes, blocks, diags := schemaMembersFromStruct(ctx, path.Empty(), modelType, true, opts)
+
+	return Schema{
+		Attributes: attributes,
+		Blocks:     blocks,
+	}, diags
+}
+
+// schemaStructFlags are the parsed SchemaStructTag flags of a struct field.
+type schemaStructFlags struct {
+	block     bool
+	computed  bool
+	optional  bool
+	required  bool
+	sensitive bool
+	set       bool
+}
+
+func schemaMembersFromStruct(ctx context.Context, parentPath path.Path, structType reflect.Type, allowBlocks bool, opts SchemaFromStructOptions) (map[string]Attribute, map[string]Block, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attributes := map[string]Attribute{}
+	blocks := map[string]Block{}
+
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+
+		if field.PkgPath != "" {
+			// skip unexported fields
+			continue
+		}
+
+		name := field.Tag.Get("tfsdk")
+
+		if name == "-" {
+			continue
+		}
+
+		if name == "" {
+			diags.AddAttributeError(
+				parentPath,
+				"Schema From Struct Error",
+				fmt.Sprintf(`An unexpected error was encountered deriving a schema from a struct. The field %s requires a "tfsdk" struct tag.`, field.Name),
+			)
+
+			continue
+		}
+
+		fieldPath := parentPath.AtName(name)
+
+		_, isAttribute := attributes[name]
+		_, isBlock := blocks[name]
+
+		if isAttribute || isBlock {
+			diags.AddAttributeError(
+				fieldPath,
+				"Schema From Struct Error",
+				fmt.Sprintf(`An unexpected error was encountered deriving a schema from a struct. The field %s has a duplicate "tfsdk" struct tag.`, field.Name),
+			)
+
+			continue
+		}
+
+		flags, err := parseSchemaStructFlags(field.Tag.Get(SchemaStructTag))
+
+		if err != nil {
+			diags.AddAttributeError(
+				fieldPath,
+				"Schema From Struct Error",
+				fmt.Sprintf("An unexpected error was encountered deriving a schema from a struct. The field %s has an invalid %q struct tag: %s", field.Name, SchemaStructTag, err),
+			)
+
+			continue
+		}
+
+		if flags.block {
+			if !allowBlocks {
+				diags.AddAttributeError(
+					fieldPath,
+					"Schema From Struct Error",
+					fmt.Sprintf("An unexpected error was encountered deriving a schema from a struct. The field %s cannot be a block within nested attributes.", field.Name),
+				)
+
+				continue
+			}
+
+			block, blockDiags := schemaBlockFromStructField(ctx, fieldPath, field, flags, opts)
+
+			diags.Append(blockDiags...)
+
+			if blockDiags.HasError() {
+				continue
+			}
+
+			blocks[name] = block
+
+			continue
+		}
+
+		attribute, attributeDiags := schemaAttributeFromStructField(ctx, fieldPath, field, flags, opts)
+
+		diags.Append(attributeDiags...)
+
+		if attributeDiags.HasError() {
+			continue
+		}
+
+		attributes[name] = attribute
+	}
+
+	if len(attributes) == 0 {
+		attributes = nil
+	}
+
+	if len(blocks) == 0 {
+		blocks = nil
+	}
+
+	return attributes, blocks, diags
+}
+
+func schemaAttributeFromStructField(ctx context.Context, fieldPath path.Path, field reflect.StructField, flags schemaStructFlags, opts SchemaFromStructOptions) (Attribute, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if !flags.required && !flags.optional && !flags.computed {
+		diags.AddAttributeError(
+			fieldPath,
+			"Schema From Struct Error",
+			fmt.Sprintf("An unexpected error was encountered deriving a schema from a struct. The field %s requires at least one of the required, optional, or computed flags in the %q struct tag.", field.Name, SchemaStructTag),
+		)
+
+		return Attribute{}, diags
+	}
+
+	attribute := Attribute{
+		Computed:           flags.computed,
+		DeprecationMessage: field.Tag.Get(SchemaDeprecationMessageStructTag),
+		Description:        field.Tag.Get(SchemaDescriptionStructTag),
+		Optional:           flags.optional,
+		Required:           flags.required,
+		Sensitive:          flags.sensitive,
+	}
+
+	if attrType, ok := opts.AttributeTypes[schemaStructPathKey(fieldPath)]; ok {
+		attribute.Type = attrType
+
+		return attribute, diags
+	}
+
+	goType := field.Type
+
+	if goType.Kind() == reflect.Ptr && !goType.Implements(attrValueReflectType) && goType != bigFloatReflectType && goType != bigIntReflectType {
+		goType = goType.Elem()
+	}
+
+	// Nested attributes are only created for structs, or collections of
+	// structs, directly on the field. Deeper structs become object types.
+	var nestedType reflect.Type
+	var nestingMode NestingMode
+
+	switch goType.Kind() {
+	case reflect.Struct:
+		if !goType.Implements(attrValueReflectType) {
+			nestedType = goType
+			nestingMode = NestingModeSingle
+		}
+	case reflect.Slice, reflect.Array:
+		if isSchemaStruct(goType.Elem()) {
+			nestedType = indirectReflectType(goType.Elem())
+			nestingMode = NestingModeList
+
+			if flags.set {
+				nestingMode = NestingModeSet
+			}
+		}
+	case reflect.Map:
+		if isSchemaStruct(goType.Elem()) && goType.Key().Kind() == reflect.String {
+			nestedType = indirectReflectType(goType.Elem())
+			nestingMode = NestingModeMap
+		}
+	}
+
+	if nestedType == nil {
+		attrType, typeDiags := attrTypeFromReflectType(ctx, fieldPath, goType, flags.set, opts)
+
+		diags.Append(typeDiags...)
+
+		attribute.Type = attrType
+
+		return attribute, diags
+	}
+
+	nestedAttributes, _, nestedDiags := schemaMembersFromStruct(ctx, fieldPath, nestedType, false, opts)
+
+	diags.Append(nestedDiags...)
+
+	switch nestingMode {
+	case NestingModeSingle:
+		attribute.Attributes = SingleNestedAttributes(nestedAttributes)
+	case NestingModeList:
+		attribute.Attributes = ListNestedAttributes(nestedAttributes)
+	case NestingModeSet:
+		attribute.Attributes = SetNestedAttributes(nestedAttributes)
+	case NestingModeMap:
+		attribute.Attributes = MapNestedAttributes(nestedAttributes)
+	}
+
+	return attribute, diags
+}
+
+func schemaBlockFromStructField(ctx context.Context, fieldPath path.Path, field reflect.StructField, flags schemaStructFlags, opts SchemaFromStructOptions) (Block, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	goType := field.Type
+
+	if (goType.Kind() != reflect.Slice && goType.Kind() != reflect.Array) || !isSchemaStruct(goType.Elem()) {
+		diags.AddAttributeError(
+			fieldPath,
+			"Schema From Struct Error",
+			fmt.Sprintf("An unexpected error was encountered deriving a schema from a struct. The field %s has the block flag, which requires a slice or array of structs, got: %s", field.Name, goType),
+		)
+
+		return Block{}, diags
+	}
+
+	if flags.required || flags.optional || flags.computed || flags.sensitive {
+		diags.AddAttributeError(
+			fieldPath,
+			"Schema From Struct Error",
+			fmt.Sprintf("An unexpected error was encountered deriving a schema from a struct. The field %s has the block flag, which cannot be combined with the required, optional, computed, or sensitive flags.", field.Name),
+		)
+
+		return Block{}, diags
+	}
+
+	attributes, blocks, nestedDiags := schemaMembersFromStruct(ctx, fieldPath, indirectReflectType(goType.Elem()), true, opts)
+
+	diags.Append(nestedDiags...)
+
+	block := Block{
+		Attributes:         attributes,
+		Blocks:             blocks,
+		DeprecationMessage: field.Tag.Get(SchemaDeprecationMessageStructTag),
+		Description:        field.Tag.Get(SchemaDescriptionStructTag),
+		NestingMode:        BlockNestingModeList,
+	}
+
+	if flags.set {
+		block.NestingMode = BlockNestingModeSet
+	}
+
+	return block, diags
+}
+
+var (
+	attrValueReflectType      = reflect.TypeOf((*attr.Value)(nil)).Elem()
+	bigFloatReflectType       = reflect.TypeOf(new(big.Float))
+	bigIntReflectType         = reflect.TypeOf(new(big.Int))
+	valueConverterReflectType = reflect.TypeOf((*tftypes.ValueConverter)(nil)).Elem()
+)
+
+// attrTypeFromReflectType returns the attr.Type for a Go type. The set flag
+// only applies to the outermost slice or array.
+func attrTypeFromReflectType(ctx context.Context, fieldPath path.Path, goType reflect.Type, set bool, opts SchemaFromStructOptions) (attr.Type, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if goType.Implements(attrValueReflectType) {
+		zero := reflect.Zero(goType)
+
+		// Calling methods on a nil pointer may panic, so use a pointer to
+		// the zero value instead.
+		if goType.Kind() == reflect.Ptr {
+			zero = reflect.New(goType.Elem())
+		}
+
+		attrType := zero.Interface().(attr.Value).Type(ctx)
+
+		if !schemaAttrTypeComplete(attrType) {
+			diags.AddAttributeError(
+				fieldPath,
+				"Schema From Struct Error",
+				fmt.Sprintf("An unexpected error was encountered deriving a schema from a struct. The %s type cannot be determined from the Go type alone. Add an entry for %q to SchemaFromStructOptions.AttributeTypes.", goType, schemaStructPathKey(fieldPath)),
+			)
+
+			return nil, diags
+		}
+
+		return attrType, diags
+	}
+
+	if goType.Implements(valueConverterReflectType) || reflect.PtrTo(goType).Implements(valueConverterReflectType) {
+		diags.AddAttributeError(
+			fieldPath,
+			"Schema From Struct Error",
+			fmt.Sprintf("An unexpected error was encountered deriving a schema from a struct. The %s type cannot be determined from the Go type alone. Add an entry for %q to SchemaFromStructOptions.AttributeTypes.", goType, schemaStructPathKey(fieldPath)),
+		)
+
+		return nil, diags
+	}
+
+	if goType == bigFloatReflectType || goType == bigIntReflectType {
+		return types.NumberType, diags
+	}
+
+	switch goType.Kind() {
+	case reflect.Ptr:
+		return attrTypeFromReflectType(ctx, fieldPath, goType.Elem(), set, opts)
+	case reflect.Bool:
+		return types.BoolType, diags
+	case reflect.String:
+		return types.StringType, diags
+	case reflect.Int32:
+		return types.Int32Type, diags
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int64,
+		reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return types.Int64Type, diags
+	case reflect.Uint, reflect.Uint64:
+		return types.Uint64Type, diags
+	case reflect.Float32:
+		return types.Float32Type, diags
+	case reflect.Float64:
+		return types.Float64Type, diags
+	case reflect.Slice, reflect.Array:
+		elemType, elemDiags := attrTypeFromReflectType(ctx, fieldPath, goType.Elem(), false, opts)
+
+		diags.Append(elemDiags...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		if set {
+			return types.SetType{ElemType: elemType}, diags
+		}
+
+		return types.ListType{ElemType: elemType}, diags
+	case reflect.Map:
+		if goType.Key().Kind() != reflect.String {
+			diags.AddAttributeError(
+				fieldPath,
+				"Schema From Struct Error",
+				fmt.Sprintf("An unexpected error was encountered deriving a schema from a struct. Maps must have string keys, got: %s", goType),
+			)
+
+			return nil, diags
+		}
+
+		elemType, elemDiags := attrTypeFromReflectType(ctx, fieldPath, goType.Elem(), false, opts)
+
+		diags.Append(elemDiags...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return types.MapType{ElemType: elemType}, diags
+	case reflect.Struct:
+		attrTypes := map[string]attr.Type{}
+
+		for i := 0; i < goType.NumField(); i++ {
+			field := goType.Field(i)
+
+			if field.PkgPath != "" {
+				continue
+			}
+
+			name := field.Tag.Get("tfsdk")
+
+			if name == "-" {
+				continue
+			}
+
+			if name == "" {
+				diags.AddAttributeError(
+					fieldPath,
+					"Schema From Struct Error",
+					fmt.Sprintf(`An unexpected error was encountered deriving a schema from a struct. The field %s requires a "tfsdk" struct tag.`, field.Name),
+				)
+
+				continue
+			}
+
+			attrType, attrTypeDiags := attrTypeFromReflectType(ctx, fieldPath.AtName(name), field.Type, false, opts)
+
+			diags.Append(attrTypeDiags...)
+
+			attrTypes[name] = attrType
+		}
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return types.ObjectType{AttrTypes: attrTypes}, diags
+	default:
+		diags.AddAttributeError(
+			fieldPath,
+			"Schema From Struct Error",
+			fmt.Sprintf("An unexpected error was encountered deriving a schema from a struct. The %s type is not supported.", goType),
+		)
+
+		return nil, diags
+	}
+}
+
+// schemaAttrTypeComplete returns false if the attr.Type is missing element
+// or attribute types, such as the type of a zero value types.List.
+func schemaAttrTypeComplete(attrType attr.Type) bool {
+	if attrType == nil {
+		return false
+	}
+
+	if t, ok := attrType.(attr.TypeWithElementType); ok {
+		return schemaAttrTypeComplete(t.ElementType())
+	}
+
+	if t, ok := attrType.(attr.TypeWithAttributeTypes); ok {
+		if t.AttributeTypes() == nil {
+			return false
+		}
+
+		for _, attrType := range t.AttributeTypes() {
+			if !schemaAttrTypeComplete(attrType) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+func parseSchemaStructFlags(tag string) (schemaStructFlags, error) {
+	var flags schemaStructFlags
+
+	if tag == "" {
+		return flags, nil
+	}
+
+	for _, flag := range strings.Split(tag, ",") {
+		switch strings.TrimSpace(flag) {
+		case "block":
+			flags.block = true
+		case "computed":
+			flags.computed = true
+		case "optional":
+			flags.optional = true
+		case "required":
+			flags.required = true
+		case "sensitive":
+			flags.sensitive = true
+		case "set":
+			flags.set = true
+		default:
+			return flags, fmt.Errorf("unknown flag %q", flag)
+		}
+	}
+
+	return flags, nil
+}
+
+// schemaStructPathKey returns the SchemaFromStructOptions.AttributeTypes key
+// for a path, which only contains attribute name steps.
+func schemaStructPathKey(p path.Path) string {
+	names := make([]string, 0, len(p.Steps()))
+
+	for _, step := range p.Steps() {
+		if name, ok := step.(path.PathStepAttributeName); ok {
+			names = append(names, string(name))
+		}
+	}
+
+	return strings.Join(names, ".")
+}
+
+// isSchemaStruct returns true if the Go type is a struct, or pointer to a
+// struct, which is not an attr.Value or big number.
+func isSchemaStruct(goType reflect.Type) bool {
+	if goType.Implements(attrValueReflectType) {
+		return false
+	}
+
+	goType = indirectReflectType(goType)
+
+	return goType.Kind() == reflect.Struct && !goType.Implements(attrValueReflectType) && goType != bigFloatReflectType.Elem() && goType != bigIntReflectType.Elem()
+}
+
+func indirectReflectType(goType reflect.Type) reflect.Type {
+	if goType.Kind() == reflect.Ptr {
+		return goType.Elem()
+	}
+
+	return goType
+}
@@ -0,0 +1,328 @@
+package tfsdk
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	testtypes "github.com/hashicorp/terraform-plugin-framework/internal/testing/types"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSchemaFromStruct(t *testing.T) {
+	t.Parallel()
+
+	type testNestedModel struct {
+		Address string `tfsdk:"address" tfsdkschema:"required"`
+	}
+
+	type testObjectModel struct {
+		Enabled bool `tfsdk:"enabled"`
+	}
+
+	type testBlockModel struct {
+		Size   int64             `tfsdk:"size" tfsdkschema:"optional"`
+		Nested []testNestedModel `tfsdk:"nested" tfsdkschema:"block"`
+	}
+
+	testCases := map[string]struct {
+		model         interface{}
+		opts          SchemaFromStructOptions
+		expected      Schema
+		expectedDiags diag.Diagnostics
+	}{
+		"invalid-model": {
+			model: "test",
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Schema From Struct Error",
+					"An unexpected error was encountered deriving a schema from a struct. The model must be a struct or pointer to a struct, got: string",
+				),
+			},
+		},
+		"go-types": {
+			model: &struct {
+				String  string              `tfsdk:"string" tfsdkschema:"required,sensitive" description:"test description"`
+				Bool    *bool               `tfsdk:"bool" tfsdkschema:"optional" deprecation_message:"test deprecation"`
+				Int     int32               `tfsdk:"int" tfsdkschema:"computed"`
+				Float   float64             `tfsdk:"float" tfsdkschema:"optional,computed"`
+				Number  *big.Float          `tfsdk:"number" tfsdkschema:"optional"`
+				List    []string            `tfsdk:"list" tfsdkschema:"optional"`
+				Set     []int64             `tfsdk:"set" tfsdkschema:"optional,set"`
+				Map     map[string]float32  `tfsdk:"map" tfsdkschema:"optional"`
+				Uint    uint64              `tfsdk:"uint" tfsdkschema:"optional"`
+				Objects [][]testObjectModel `tfsdk:"objects" tfsdkschema:"optional"`
+
+				Ignored    string `tfsdk:"-"`
+				unexported string //nolint:structcheck,unused
+			}{},
+			expected: Schema{
+				Attributes: map[string]Attribute{
+					"string": {
+						Type:        types.StringType,
+						Required:    true,
+						Sensitive:   true,
+						Description: "test description",
+					},
+					"bool": {
+						Type:               types.BoolType,
+						Optional:           true,
+						DeprecationMessage: "test deprecation",
+					},
+					"int": {
+						Type:     types.Int32Type,
+						Computed: true,
+					},
+					"float": {
+						Type:     types.Float64Type,
+						Optional: true,
+						Computed: true,
+					},
+					"number": {
+						Type:     types.NumberType,
+						Optional: true,
+					},
+					"list": {
+						Type:     types.ListType{ElemType: types.StringType},
+						Optional: true,
+					},
+					"set": {
+						Type:     types.SetType{ElemType: types.Int64Type},
+						Optional: true,
+					},
+					"map": {
+						Type:     types.MapType{ElemType: types.Float32Type},
+						Optional: true,
+					},
+					"uint": {
+						Type:     types.Uint64Type,
+						Optional: true,
+					},
+					"objects": {
+						Type: types.ListType{
+							ElemType: types.ListType{
+								ElemType: types.ObjectType{
+									AttrTypes: map[string]attr.Type{
+										"enabled": types.BoolType,
+									},
+								},
+							},
+						},
+						Optional: true,
+					},
+				},
+			},
+		},
+		"value-types": {
+			model: struct {
+				String testtypes.String `tfsdk:"string" tfsdkschema:"required"`
+				Int64  types.Int64      `tfsdk:"int64" tfsdkschema:"optional"`
+				Bool   *types.Bool      `tfsdk:"bool" tfsdkschema:"optional"`
+				List   types.List       `tfsdk:"list" tfsdkschema:"optional"`
+			}{},
+			opts: SchemaFromStructOptions{
+				AttributeTypes: map[string]attr.Type{
+					"list":   types.ListType{ElemType: types.StringType},
+					"string": testtypes.StringType{},
+				},
+			},
+			expected: Schema{
+				Attributes: map[string]Attribute{
+					"string": {
+						Type:     testtypes.StringType{},
+						Required: true,
+					},
+					"int64": {
+						Type:     types.Int64Type,
+						Optional: true,
+					},
+					"bool": {
+						Type:     types.BoolType,
+						Optional: true,
+					},
+					"list": {
+						Type:     types.ListType{ElemType: types.StringType},
+						Optional: true,
+					},
+				},
+			},
+		},
+		"nested-attributes-and-blocks": {
+			model: struct {
+				Single *testNestedModel           `tfsdk:"single" tfsdkschema:"optional"`
+				List   []testNestedModel          `tfsdk:"list" tfsdkschema:"optional"`
+				Set    []*testNestedModel         `tfsdk:"set" tfsdkschema:"computed,set"`
+				Map    map[string]testNestedModel `tfsdk:"map" tfsdkschema:"required"`
+				Block  []testBlockModel           `tfsdk:"block" tfsdkschema:"block,set" description:"test block"`
+			}{},
+			expected: Schema{
+				Attributes: map[string]Attribute{
+					"single": {
+						Attributes: SingleNestedAttributes(map[string]Attribute{
+							"address": {
+								Type:     types.StringType,
+								Required: true,
+							},
+						}),
+						Optional: true,
+					},
+					"list": {
+						Attributes: ListNestedAttributes(map[string]Attribute{
+							"address": {
+								Type:     types.StringType,
+								Required: true,
+							},
+						}),
+						Optional: true,
+					},
+					"set": {
+						Attributes: SetNestedAttributes(map[string]Attribute{
+							"address": {
+								Type:     types.StringType,
+								Required: true,
+							},
+						}),
+						Computed: true,
+					},
+					"map": {
+						Attributes: MapNestedAttributes(map[string]Attribute{
+							"address": {
+								Type:     types.StringType,
+								Required: true,
+							},
+						}),
+						Required: true,
+					},
+				},
+				Blocks: map[string]Block{
+					"block": {
+						Attributes: map[string]Attribute{
+							"size": {
+								Type:     types.Int64Type,
+								Optional: true,
+							},
+						},
+						Blocks: map[string]Block{
+							"nested": {
+								Attributes: map[string]Attribute{
+									"address": {
+										Type:     types.StringType,
+										Required: true,
+									},
+								},
+								NestingMode: BlockNestingModeList,
+							},
+						},
+						Description: "test block",
+						NestingMode: BlockNestingModeSet,
+					},
+				},
+			},
+		},
+		"errors": {
+			model: struct {
+				NoTag    string                  `tfsdkschema:"required"`
+				NoFlags  string                  `tfsdk:"no_flags"`
+				BadFlag  string                  `tfsdk:"bad_flag" tfsdkschema:"requird"`
+				Dup1     string                  `tfsdk:"dup" tfsdkschema:"required"`
+				Dup2     string                  `tfsdk:"dup" tfsdkschema:"required"`
+				List     types.List              `tfsdk:"list" tfsdkschema:"optional"`
+				Block    testNestedModel         `tfsdk:"block" tfsdkschema:"block"`
+				IntMap   map[int]string          `tfsdk:"int_map" tfsdkschema:"optional"`
+				Channel  chan string             `tfsdk:"channel" tfsdkschema:"optional"`
+				Nested   *struct{ B []struct{} } `tfsdk:"nested" tfsdkschema:"optional"`
+				Required []testBlockModel        `tfsdk:"required" tfsdkschema:"block,required"`
+				Inner    []struct {
+					Block []testNestedModel `tfsdk:"block" tfsdkschema:"block"`
+				} `tfsdk:"inner" tfsdkschema:"optional"`
+			}{},
+			expected: Schema{
+				Attributes: map[string]Attribute{
+					"dup": {
+						Type:     types.StringType,
+						Required: true,
+					},
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Empty(),
+					"Schema From Struct Error",
+					`An unexpected error was encountered deriving a schema from a struct. The field NoTag requires a "tfsdk" struct tag.`,
+				),
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("no_flags"),
+					"Schema From Struct Error",
+					`An unexpected error was encountered deriving a schema from a struct. The field NoFlags requires at least one of the required, optional, or computed flags in the "tfsdkschema" struct tag.`,
+				),
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("bad_flag"),
+					"Schema From Struct Error",
+					`An unexpected error was encountered deriving a schema from a struct. The field BadFlag has an invalid "tfsdkschema" struct tag: unknown flag "requird"`,
+				),
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("dup"),
+					"Schema From Struct Error",
+					`An unexpected error was encountered deriving a schema from a struct. The field Dup2 has a duplicate "tfsdk" struct tag.`,
+				),
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("list"),
+					"Schema From Struct Error",
+					`An unexpected error was encountered deriving a schema from a struct. The types.List type cannot be determined from the Go type alone. Add an entry for "list" to SchemaFromStructOptions.AttributeTypes.`,
+				),
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("block"),
+					"Schema From Struct Error",
+					`An unexpected error was encountered deriving a schema from a struct. The field Block has the block flag, which requires a slice or array of structs, got: tfsdk.testNestedModel`,
+				),
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("int_map"),
+					"Schema From Struct Error",
+					`An unexpected error was encountered deriving a schema from a struct. Maps must have string keys, got: map[int]string`,
+				),
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("channel"),
+					"Schema From Struct Error",
+					`An unexpected error was encountered deriving a schema from a struct. The chan string type is not supported.`,
+				),
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("nested"),
+					"Schema From Struct Error",
+					`An unexpected error was encountered deriving a schema from a struct. The field B requires a "tfsdk" struct tag.`,
+				),
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("required"),
+					"Schema From Struct Error",
+					`An unexpected error was encountered deriving a schema from a struct. The field Required has the block flag, which cannot be combined with the required, optional, computed, or sensitive flags.`,
+				),
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("inner").AtName("block"),
+					"Schema From Struct Error",
+					`An unexpected error was encountered deriving a schema from a struct. The field Block cannot be a block within nested attributes.`,
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := SchemaFromStruct(context.Background(), testCase.model, testCase.opts)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
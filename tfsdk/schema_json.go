@@ -0,0 +1,705 @@
+package tfsdk
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// SchemaJSONOptions are options for customizing the behavior of
+// SchemaFromJSON.
+type SchemaJSONOptions struct {
+	// CustomTypes are the attr.Type implementations, outside the types
+	// package, which may be referenced in the JSON. Custom types are
+	// referenced by their Go type name, such as "mytypes.TimestampType", or
+	// by their String method if the Go type is unexported.
+	//
+	// Custom types implementing attr.TypeWithElementType or
+	// attr.TypeWithAttributeTypes will have WithElementType or
+	// WithAttributeTypes called with the element or attribute types from
+	// the JSON.
+	CustomTypes []attr.Type
+}
+
+// MarshalJSON returns a stable JSON representation of the Schema, including
+// all attributes, nested attributes, blocks, types, flags, descriptions, and
+// the version. Object keys are sorted, so the output is suitable for golden
+// files and comparison across releases. Use SchemaFromJSON to convert the
+// JSON back into a Schema.
+//
+// Types from the types package are represented by name, such as "string" or
+// {"list":"string"}. Custom types are represented by their Go type name along
+// with their Terraform type, such as
+// {"custom":"mytypes.TimestampType","terraform_type":"string"}.
+//
+// Validators and PlanModifiers are Go implementations and are not included.
+func (s Schema) MarshalJSON() ([]byte, error) {
+	ctx := context.Background()
+
+	attributes, err := attributesToJSON(ctx, path.Empty(), s.Attributes)
+
+	if err != nil {
+		return nil, err
+	}
+
+	blocks, err := blocksToJSON(ctx, path.Empty(), s.Blocks)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(schemaJSON{
+		Attributes:          attributes,
+		Blocks:              blocks,
+		DeprecationMessage:  s.DeprecationMessage,
+		Description:         s.Description,
+		MarkdownDescription: s.MarkdownDescription,
+		Version:             s.Version,
+	})
+}
+
+// SchemaFromJSON returns the Schema represented by JSON previously created by
+// Schema.MarshalJSON. Unknown fields, unknown types, and custom types not
+// present in SchemaJSONOptions.CustomTypes return an error.
+func SchemaFromJSON(ctx context.Context, data []byte, opts SchemaJSONOptions) (Schema, error) {
+	var s schemaJSON
+
+	if err := unmarshalJSONStrict(data, &s); err != nil {
+		return Schema{}, fmt.Errorf("error decoding schema: %w", err)
+	}
+
+	customTypes := make(map[string]attr.Type, len(opts.CustomTypes))
+
+	for _, customType := range opts.CustomTypes {
+		customTypes[customTypeName(customType)] = customType
+	}
+
+	attributes, err := attributesFromJSON(ctx, path.Empty(), s.Attributes, customTypes)
+
+	if err != nil {
+		return Schema{}, err
+	}
+
+	blocks, err := blocksFromJSON(ctx, path.Empty(), s.Blocks, customTypes)
+
+	if err != nil {
+		return Schema{}, err
+	}
+
+	return Schema{
+		Attributes:          attributes,
+		Blocks:              blocks,
+		DeprecationMessage:  s.DeprecationMessage,
+		Description:         s.Description,
+		MarkdownDescription: s.MarkdownDescription,
+		Version:             s.Version,
+	}, nil
+}
+
+type schemaJSON struct {
+	Attributes          map[string]attributeJSON `json:"attributes,omitempty"`
+	Blocks              map[string]blockJSON     `json:"blocks,omitempty"`
+	DeprecationMessage  string                   `json:"deprecation_message,omitempty"`
+	Description         string                   `json:"description,omitempty"`
+	MarkdownDescription string                   `json:"markdown_description,omitempty"`
+	Version             int64                    `json:"version"`
+}
+
+type attributeJSON struct {
+	Type                json.RawMessage       `json:"type,omitempty"`
+	NestedAttributes    *nestedAttributesJSON `json:"nested_attributes,omitempty"`
+	Required            bool                  `json:"required,omitempty"`
+	Optional            bool                  `json:"optional,omitempty"`
+	Computed            bool                  `json:"computed,omitempty"`
+	EnvVars             []string              `json:"env_vars,omitempty"`
+	NullEqualsEmpty     bool                  `json:"null_equals_empty,omitempty"`
+	Sensitive           bool                  `json:"sensitive,omitempty"`
+	DeprecationMessage  string                `json:"deprecation_message,omitempty"`
+	Description         string                `json:"description,omitempty"`
+	MarkdownDescription string                `json:"markdown_description,omitempty"`
+}
+
+type nestedAttributesJSON struct {
+	NestingMode string                   `json:"nesting_mode"`
+	Attributes  map[string]attributeJSON `json:"attributes"`
+}
+
+type blockJSON struct {
+	NestingMode         string                   `json:"nesting_mode"`
+	Attributes          map[string]attributeJSON `json:"attributes,omitempty"`
+	Blocks              map[string]blockJSON     `json:"blocks,omitempty"`
+	MinItems            int64                    `json:"min_items,omitempty"`
+	MaxItems            int64                    `json:"max_items,omitempty"`
+	DeprecationMessage  string                   `json:"deprecation_message,omitempty"`
+	Description         string                   `json:"description,omitempty"`
+	MarkdownDescription string                   `json:"markdown_description,omitempty"`
+}
+
+// typeJSON is the object representation of collection, object, and custom
+// types. Exactly one of List, Set, Map, Object, or Custom must be set.
+type typeJSON struct {
+	List   json.RawMessage             `json:"list,omitempty"`
+	Set    json.RawMessage             `json:"set,omitempty"`
+	Map    json.RawMessage             `json:"map,omitempty"`
+	Object *map[string]json.RawMessage `json:"object,omitempty"`
+
+	Custom         string                      `json:"custom,omitempty"`
+	TerraformType  json.RawMessage             `json:"terraform_type,omitempty"`
+	ElementType    json.RawMessage             `json:"element_type,omitempty"`
+	AttributeTypes *map[string]json.RawMessage `json:"attribute_types,omitempty"`
+}
+
+var (
+	nestingModeJSONNames = map[NestingMode]string{
+		NestingModeSingle: "single",
+		NestingModeList:   "list",
+		NestingModeSet:    "set",
+		NestingModeMap:    "map",
+	}
+
+	blockNestingModeJSONNames = map[BlockNestingMode]string{
+		BlockNestingModeList: "list",
+		BlockNestingModeSet:  "set",
+	}
+
+	// primitiveTypesJSON are keyed by name rather than type, since map
+	// lookups of custom types which are not comparable would panic.
+	primitiveTypesJSON = map[string]attr.Type{
+		"bool":    types.BoolType,
+		"float32": types.Float32Type,
+		"float64": types.Float64Type,
+		"int32":   types.Int32Type,
+		"int64":   types.Int64Type,
+		"number":  types.NumberType,
+		"string":  types.StringType,
+		"uint64":  types.Uint64Type,
+	}
+)
+
+func attributesToJSON(ctx context.Context, parentPath path.Path, attributes map[string]Attribute) (map[string]attributeJSON, error) {
+	if len(attributes) == 0 {
+		return nil, nil
+	}
+
+	result := make(map[string]attributeJSON, len(attributes))
+
+	for name, attribute := range attributes {
+		attributePath := parentPath.AtName(name)
+
+		a := attributeJSON{
+			Required:            attribute.Required,
+			Optional:            attribute.Optional,
+			Computed:            attribute.Computed,
+			EnvVars:             attribute.EnvVars,
+			NullEqualsEmpty:     attribute.NullEqualsEmpty,
+			Sensitive:           attribute.Sensitive,
+			DeprecationMessage:  attribute.DeprecationMessage,
+			Description:         attribute.Description,
+			MarkdownDescription: attribute.MarkdownDescription,
+		}
+
+		switch {
+		case attribute.Attributes != nil:
+			nestingMode, ok := nestingModeJSONNames[attribute.Attributes.GetNestingMode()]
+
+			if !ok {
+				return nil, fmt.Errorf("error encoding %s: unsupported nested attributes nesting mode %d", attributePath, attribute.Attributes.GetNestingMode())
+			}
+
+			nestedAttributes, err := attributesToJSON(ctx, attributePath, attribute.Attributes.GetAttributes())
+
+			if err != nil {
+				return nil, err
+			}
+
+			if nestedAttributes == nil {
+				nestedAttributes = map[string]attributeJSON{}
+			}
+
+			a.NestedAttributes = &nestedAttributesJSON{
+				NestingMode: nestingMode,
+				Attributes:  nestedAttributes,
+			}
+		case attribute.Type != nil:
+			typ, err := typeToJSON(ctx, attribute.Type)
+
+			if err != nil {
+				return nil, fmt.Errorf("error encoding %s type: %w", attributePath, err)
+			}
+
+			a.Type = typ
+		default:
+			return nil, fmt.Errorf("error encoding %s: attribute must have Type or Attributes set", attributePath)
+		}
+
+		result[name] = a
+	}
+
+	return result, nil
+}
+
+func blocksToJSON(ctx context.Context, parentPath path.Path, blocks map[string]Block) (map[string]blockJSON, error) {
+	if len(blocks) == 0 {
+		return nil, nil
+	}
+
+	result := make(map[string]blockJSON, len(blocks))
+
+	for name, block := range blocks {
+		blockPath := parentPath.AtName(name)
+
+		nestingMode, ok := blockNestingModeJSONNames[block.NestingMode]
+
+		if !ok {
+			return nil, fmt.Errorf("error encoding %s: unsupported block nesting mode %d", blockPath, block.NestingMode)
+		}
+
+		attributes, err := attributesToJSON(ctx, blockPath, block.Attributes)
+
+		if err != nil {
+			return nil, err
+		}
+
+		nestedBlocks, err := blocksToJSON(ctx, blockPath, block.Blocks)
+
+		if err != nil {
+			return nil, err
+		}
+
+		result[name] = blockJSON{
+			NestingMode:         nestingMode,
+			Attributes:          attributes,
+			Blocks:              nestedBlocks,
+			MinItems:            block.MinItems,
+			MaxItems:            block.MaxItems,
+			DeprecationMessage:  block.DeprecationMessage,
+			Description:         block.Description,
+			MarkdownDescription: block.MarkdownDescription,
+		}
+	}
+
+	return result, nil
+}
+
+func typeToJSON(ctx context.Context, typ attr.Type) (json.RawMessage, error) {
+	switch t := typ.(type) {
+	case types.ListType:
+		elemType, err := typeToJSON(ctx, t.ElemType)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return json.Marshal(typeJSON{List: elemType})
+	case types.SetType:
+		elemType, err := typeToJSON(ctx, t.ElemType)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return json.Marshal(typeJSON{Set: elemType})
+	case types.MapType:
+		elemType, err := typeToJSON(ctx, t.ElemType)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return json.Marshal(typeJSON{Map: elemType})
+	case types.ObjectType:
+		attrTypes, err := attributeTypesToJSON(ctx, t.AttrTypes)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return json.Marshal(typeJSON{Object: &attrTypes})
+	case nil:
+		return nil, errors.New("missing type")
+	}
+
+	for name, primitiveType := range primitiveTypesJSON {
+		if primitiveType.Equal(typ) {
+			return json.Marshal(name)
+		}
+	}
+
+	terraformType, err := json.Marshal(typ.TerraformType(ctx))
+
+	if err != nil {
+		return nil, err
+	}
+
+	result := typeJSON{
+		Custom:        customTypeName(typ),
+		TerraformType: terraformType,
+	}
+
+	if t, ok := typ.(attr.TypeWithElementType); ok && t.ElementType() != nil {
+		elemType, err := typeToJSON(ctx, t.ElementType())
+
+		if err != nil {
+			return nil, err
+		}
+
+		result.ElementType = elemType
+	}
+
+	if t, ok := typ.(attr.TypeWithAttributeTypes); ok && t.AttributeTypes() != nil {
+		attrTypes, err := attributeTypesToJSON(ctx, t.AttributeTypes())
+
+		if err != nil {
+			return nil, err
+		}
+
+		result.AttributeTypes = &attrTypes
+	}
+
+	return json.Marshal(result)
+}
+
+func attributeTypesToJSON(ctx context.Context, attrTypes map[string]attr.Type) (map[string]json.RawMessage, error) {
+	result := make(map[string]json.RawMessage, len(attrTypes))
+
+	for name, attrType := range attrTypes {
+		typ, err := typeToJSON(ctx, attrType)
+
+		if err != nil {
+			return nil, fmt.Errorf("object attribute %q: %w", name, err)
+		}
+
+		result[name] = typ
+	}
+
+	return result, nil
+}
+
+func attributesFromJSON(ctx context.Context, parentPath path.Path, attributes map[string]attributeJSON, customTypes map[string]attr.Type) (map[string]Attribute, error) {
+	if attributes == nil {
+		return nil, nil
+	}
+
+	result := make(map[string]Attribute, len(attributes))
+
+	var names []string
+
+	for name := range attributes {
+		names = append(names, name)
+	}
+
+	// Sort for consistent errors.
+	sort.Strings(names)
+
+	for _, name := range names {
+		a := attributes[name]
+		attributePath := parentPath.AtName(name)
+
+		attribute := Attribute{
+			Required:            a.Required,
+			Optional:            a.Optional,
+			Computed:            a.Computed,
+			EnvVars:             a.EnvVars,
+			NullEqualsEmpty:     a.NullEqualsEmpty,
+			Sensitive:           a.Sensitive,
+			DeprecationMessage:  a.DeprecationMessage,
+			Description:         a.Description,
+			MarkdownDescription: a.MarkdownDescription,
+		}
+
+		switch {
+		case a.Type != nil && a.NestedAttributes != nil:
+			return nil, fmt.Errorf("error decoding %s: only one of type or nested_attributes can be set", attributePath)
+		case a.NestedAttributes != nil:
+			nestedAttributes, err := attributesFromJSON(ctx, attributePath, a.NestedAttributes.Attributes, customTypes)
+
+			if err != nil {
+				return nil, err
+			}
+
+			switch a.NestedAttributes.NestingMode {
+			case nestingModeJSONNames[NestingModeSingle]:
+				attribute.Attributes = SingleNestedAttributes(nestedAttributes)
+			case nestingModeJSONNames[NestingModeList]:
+				attribute.Attributes = ListNestedAttributes(nestedAttributes)
+			case nestingModeJSONNames[NestingModeSet]:
+				attribute.Attributes = SetNestedAttributes(nestedAttributes)
+			case nestingModeJSONNames[NestingModeMap]:
+				attribute.Attributes = MapNestedAttributes(nestedAttributes)
+			default:
+				return nil, fmt.Errorf("error decoding %s: unknown nested attributes nesting mode %q", attributePath, a.NestedAttributes.NestingMode)
+			}
+		case a.Type != nil:
+			typ, err := typeFromJSON(ctx, a.Type, customTypes)
+
+			if err != nil {
+				return nil, fmt.Errorf("error decoding %s type: %w", attributePath, err)
+			}
+
+			attribute.Type = typ
+		default:
+			return nil, fmt.Errorf("error decoding %s: one of type or nested_attributes must be set", attributePath)
+		}
+
+		result[name] = attribute
+	}
+
+	return result, nil
+}
+
+func blocksFromJSON(ctx context.Context, parentPath path.Path, blocks map[string]blockJSON, customTypes map[string]attr.Type) (map[string]Block, error) {
+	if blocks == nil {
+		return nil, nil
+	}
+
+	result := make(map[string]Block, len(blocks))
+
+	var names []string
+
+	for name := range blocks {
+		names = append(names, name)
+	}
+
+	// Sort for consistent errors.
+	sort.Strings(names)
+
+	for _, name := range names {
+		b := blocks[name]
+		blockPath := parentPath.AtName(name)
+
+		block := Block{
+			MinItems:            b.MinItems,
+			MaxItems:            b.MaxItems,
+			DeprecationMessage:  b.DeprecationMessage,
+			Description:         b.Description,
+			MarkdownDescription: b.MarkdownDescription,
+		}
+
+		switch b.NestingMode {
+		case blockNestingModeJSONNames[BlockNestingModeList]:
+			block.NestingMode = BlockNestingModeList
+		case blockNestingModeJSONNames[BlockNestingModeSet]:
+			block.NestingMode = BlockNestingModeSet
+		default:
+			return nil, fmt.Errorf("error decoding %s: unknown block nesting mode %q", blockPath, b.NestingMode)
+		}
+
+		attributes, err := attributesFromJSON(ctx, blockPath, b.Attributes, customTypes)
+
+		if err != nil {
+			return nil, err
+		}
+
+		nestedBlocks, err := blocksFromJSON(ctx, blockPath, b.Blocks, customTypes)
+
+		if err != nil {
+			return nil, err
+		}
+
+		block.Attributes = attributes
+		block.Blocks = nestedBlocks
+
+		result[name] = block
+	}
+
+	return result, nil
+}
+
+func typeFromJSON(ctx context.Context, data json.RawMessage, customTypes map[string]attr.Type) (attr.Type, error) {
+	data = bytes.TrimSpace(data)
+
+	if len(data) > 0 && data[0] == '"' {
+		var name string
+
+		if err := json.Unmarshal(data, &name); err != nil {
+			return nil, err
+		}
+
+		if typ, ok := primitiveTypesJSON[name]; ok {
+			return typ, nil
+		}
+
+		return nil, fmt.Errorf("unknown type %q", name)
+	}
+
+	var t typeJSON
+
+	if err := unmarshalJSONStrict(data, &t); err != nil {
+		return nil, err
+	}
+
+	var set []string
+
+	if t.List != nil {
+		set = append(set, "list")
+	}
+
+	if t.Set != nil {
+		set = append(set, "set")
+	}
+
+	if t.Map != nil {
+		set = append(set, "map")
+	}
+
+	if t.Object != nil {
+		set = append(set, "object")
+	}
+
+	if t.Custom != "" {
+		set = append(set, "custom")
+	}
+
+	if len(set) != 1 {
+		return nil, fmt.Errorf("exactly one of list, set, map, object, or custom must be set, got: %s", strings.Join(set, ", "))
+	}
+
+	if t.Custom == "" && (t.TerraformType != nil || t.ElementType != nil || t.AttributeTypes != nil) {
+		return nil, errors.New("terraform_type, element_type, and attribute_types can only be set with custom")
+	}
+
+	switch {
+	case t.List != nil:
+		elemType, err := typeFromJSON(ctx, t.List, customTypes)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return types.ListType{ElemType: elemType}, nil
+	case t.Set != nil:
+		elemType, err := typeFromJSON(ctx, t.Set, customTypes)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return types.SetType{ElemType: elemType}, nil
+	case t.Map != nil:
+		elemType, err := typeFromJSON(ctx, t.Map, customTypes)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return types.MapType{ElemType: elemType}, nil
+	case t.Object != nil:
+		attrTypes, err := attributeTypesFromJSON(ctx, *t.Object, customTypes)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return types.ObjectType{AttrTypes: attrTypes}, nil
+	}
+
+	typ, ok := customTypes[t.Custom]
+
+	if !ok {
+		return nil, fmt.Errorf("unknown custom type %q, which must be added to SchemaJSONOptions.CustomTypes", t.Custom)
+	}
+
+	if t.ElementType != nil {
+		typWithElementType, ok := typ.(attr.TypeWithElementType)
+
+		if !ok {
+			return nil, fmt.Errorf("custom type %q does not implement attr.TypeWithElementType", t.Custom)
+		}
+
+		elemType, err := typeFromJSON(ctx, t.ElementType, customTypes)
+
+		if err != nil {
+			return nil, err
+		}
+
+		typ = typWithElementType.WithElementType(elemType)
+	}
+
+	if t.AttributeTypes != nil {
+		typWithAttributeTypes, ok := typ.(attr.TypeWithAttributeTypes)
+
+		if !ok {
+			return nil, fmt.Errorf("custom type %q does not implement attr.TypeWithAttributeTypes", t.Custom)
+		}
+
+		attrTypes, err := attributeTypesFromJSON(ctx, *t.AttributeTypes, customTypes)
+
+		if err != nil {
+			return nil, err
+		}
+
+		typ = typWithAttributeTypes.WithAttributeTypes(attrTypes)
+	}
+
+	if t.TerraformType != nil {
+		terraformType, err := tftypes.ParseJSONType(t.TerraformType)
+
+		if err != nil {
+			return nil, fmt.Errorf("custom type %q terraform_type: %w", t.Custom, err)
+		}
+
+		if !typ.TerraformType(ctx).Equal(terraformType) {
+			return nil, fmt.Errorf("custom type %q has Terraform type %s, expected %s", t.Custom, typ.TerraformType(ctx), terraformType)
+		}
+	}
+
+	return typ, nil
+}
+
+func attributeTypesFromJSON(ctx context.Context, data map[string]json.RawMessage, customTypes map[string]attr.Type) (map[string]attr.Type, error) {
+	result := make(map[string]attr.Type, len(data))
+
+	var names []string
+
+	for name := range data {
+		names = append(names, name)
+	}
+
+	// Sort for consistent errors.
+	sort.Strings(names)
+
+	for _, name := range names {
+		attrTypeData := data[name]
+		attrType, err := typeFromJSON(ctx, attrTypeData, customTypes)
+
+		if err != nil {
+			return nil, fmt.Errorf("object attribute %q: %w", name, err)
+		}
+
+		result[name] = attrType
+	}
+
+	return result, nil
+}
+
+// customTypeName returns the name used to reference a custom type in JSON,
+// which is its Go type name without any pointer indicator. Unexported Go
+// types, which may be shared by several exported values such as
+// nettypes.IPAddressType and nettypes.CIDRType, use the String method of the
+// type instead.
+func customTypeName(typ attr.Type) string {
+	name := strings.TrimPrefix(fmt.Sprintf("%T", typ), "*")
+
+	if typeName := name[strings.LastIndex(name, ".")+1:]; typeName != "" && unicode.IsLower([]rune(typeName)[0]) {
+		return typ.String()
+	}
+
+	return name
+}
+
+func unmarshalJSONStrict(data []byte, v interface{}) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+
+	return decoder.Decode(v)
+}
@@ -0,0 +1,375 @@
+package tfsdk
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	testtypes "github.com/hashicorp/terraform-plugin-framework/internal/testing/types"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/nettypes"
+)
+
+func TestSchemaMarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		schema        Schema
+		expected      string
+		expectedError string
+	}{
+		"empty": {
+			schema:   Schema{},
+			expected: `{"version":0}`,
+		},
+		"attributes": {
+			schema: Schema{
+				Attributes: map[string]Attribute{
+					"test_string": {
+						Type:                types.StringType,
+						Required:            true,
+						Sensitive:           true,
+						Description:         "plain",
+						MarkdownDescription: "*markdown*",
+					},
+					"test_list": {
+						Type:               types.ListType{ElemType: types.Int64Type},
+						Optional:           true,
+						Computed:           true,
+						DeprecationMessage: "deprecated",
+					},
+					"test_object": {
+						Type: types.ObjectType{
+							AttrTypes: map[string]attr.Type{
+								"nested": types.MapType{ElemType: types.BoolType},
+							},
+						},
+						Computed: true,
+					},
+					"test_custom": {
+						Type:     testtypes.StringType{},
+						Optional: true,
+					},
+				},
+				Version: 2,
+			},
+			expected: `{"attributes":{` +
+				`"test_custom":{"type":{"custom":"types.StringType","terraform_type":"string"},"optional":true},` +
+				`"test_list":{"type":{"list":"int64"},"optional":true,"computed":true,"deprecation_message":"deprecated"},` +
+				`"test_object":{"type":{"object":{"nested":{"map":"bool"}}},"computed":true},` +
+				`"test_string":{"type":"string","required":true,"sensitive":true,"description":"plain","markdown_description":"*markdown*"}` +
+				`},"version":2}`,
+		},
+		"nested-attributes-and-blocks": {
+			schema: Schema{
+				Attributes: map[string]Attribute{
+					"test_nested": {
+						Attributes: SetNestedAttributes(map[string]Attribute{
+							"nested": {
+								Type:     types.Float64Type,
+								Required: true,
+							},
+						}),
+						Optional: true,
+					},
+				},
+				Blocks: map[string]Block{
+					"test_block": {
+						Attributes: map[string]Attribute{
+							"nested": {
+								Type:     types.NumberType,
+								Optional: true,
+							},
+						},
+						Blocks: map[string]Block{
+							"nested_block": {
+								NestingMode: BlockNestingModeSet,
+							},
+						},
+						MaxItems:    2,
+						MinItems:    1,
+						NestingMode: BlockNestingModeList,
+					},
+				},
+				Description: "test schema",
+			},
+			expected: `{"attributes":{` +
+				`"test_nested":{"nested_attributes":{"nesting_mode":"set","attributes":{"nested":{"type":"float64","required":true}}},"optional":true}` +
+				`},"blocks":{` +
+				`"test_block":{"nesting_mode":"list","attributes":{"nested":{"type":"number","optional":true}},"blocks":{"nested_block":{"nesting_mode":"set"}},"min_items":1,"max_items":2}` +
+				`},"description":"test schema","version":0}`,
+		},
+		"attribute-missing-type": {
+			schema: Schema{
+				Attributes: map[string]Attribute{
+					"test": {
+						Required: true,
+					},
+				},
+			},
+			expectedError: `error encoding test: attribute must have Type or Attributes set`,
+		},
+		"block-missing-nesting-mode": {
+			schema: Schema{
+				Blocks: map[string]Block{
+					"test": {},
+				},
+			},
+			expectedError: `error encoding test: unsupported block nesting mode 0`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.schema.MarshalJSON()
+
+			if err != nil {
+				if testCase.expectedError == "" {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if diff := cmp.Diff(err.Error(), testCase.expectedError); diff != "" {
+					t.Fatalf("unexpected error difference: %s", diff)
+				}
+
+				return
+			}
+
+			if testCase.expectedError != "" {
+				t.Fatalf("expected error: %s", testCase.expectedError)
+			}
+
+			if diff := cmp.Diff(string(got), testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestSchemaFromJSON(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		json          string
+		opts          SchemaJSONOptions
+		expected      Schema
+		expectedError string
+	}{
+		"empty": {
+			json:     `{"version":0}`,
+			expected: Schema{},
+		},
+		"attributes": {
+			json: `{"attributes":{` +
+				`"test_custom":{"type":{"custom":"types.StringType","terraform_type":"string"},"optional":true},` +
+				`"test_list":{"type":{"list":"int64"},"optional":true,"computed":true,"deprecation_message":"deprecated"},` +
+				`"test_nested":{"nested_attributes":{"nesting_mode":"map","attributes":{"nested":{"type":{"set":"string"},"required":true}}},"optional":true},` +
+				`"test_object":{"type":{"object":{}},"computed":true},` +
+				`"test_string":{"type":"string","required":true,"sensitive":true,"description":"plain","markdown_description":"*markdown*"}` +
+				`},"version":2}`,
+			opts: SchemaJSONOptions{
+				CustomTypes: []attr.Type{testtypes.StringType{}},
+			},
+			expected: Schema{
+				Attributes: map[string]Attribute{
+					"test_custom": {
+						Type:     testtypes.StringType{},
+						Optional: true,
+					},
+					"test_list": {
+						Type:               types.ListType{ElemType: types.Int64Type},
+						Optional:           true,
+						Computed:           true,
+						DeprecationMessage: "deprecated",
+					},
+					"test_nested": {
+						Attributes: MapNestedAttributes(map[string]Attribute{
+							"nested": {
+								Type:     types.SetType{ElemType: types.StringType},
+								Required: true,
+							},
+						}),
+						Optional: true,
+					},
+					"test_object": {
+						Type:     types.ObjectType{AttrTypes: map[string]attr.Type{}},
+						Computed: true,
+					},
+					"test_string": {
+						Type:                types.StringType,
+						Required:            true,
+						Sensitive:           true,
+						Description:         "plain",
+						MarkdownDescription: "*markdown*",
+					},
+				},
+				Version: 2,
+			},
+		},
+		"blocks": {
+			json: `{"blocks":{"test_block":{"nesting_mode":"set","attributes":{"nested":{"type":"bool","computed":true}},"max_items":1}},"version":1}`,
+			expected: Schema{
+				Blocks: map[string]Block{
+					"test_block": {
+						Attributes: map[string]Attribute{
+							"nested": {
+								Type:     types.BoolType,
+								Computed: true,
+							},
+						},
+						MaxItems:    1,
+						NestingMode: BlockNestingModeSet,
+					},
+				},
+				Version: 1,
+			},
+		},
+		"sized-numbers": {
+			json: `{"attributes":{` +
+				`"test_float32":{"type":"float32","optional":true},` +
+				`"test_int32":{"type":{"list":"int32"},"optional":true},` +
+				`"test_uint64":{"type":{"map":"uint64"},"optional":true}` +
+				`},"version":0}`,
+			expected: Schema{
+				Attributes: map[string]Attribute{
+					"test_float32": {
+						Type:     types.Float32Type,
+						Optional: true,
+					},
+					"test_int32": {
+						Type:     types.ListType{ElemType: types.Int32Type},
+						Optional: true,
+					},
+					"test_uint64": {
+						Type:     types.MapType{ElemType: types.Uint64Type},
+						Optional: true,
+					},
+				},
+			},
+		},
+		"custom-types-unexported-go-type": {
+			json: `{"attributes":{` +
+				`"test_cidr":{"type":{"custom":"nettypes.CIDRType","terraform_type":"string"},"optional":true},` +
+				`"test_ip_address":{"type":{"custom":"nettypes.IPAddressType","terraform_type":"string"},"optional":true}` +
+				`},"version":0}`,
+			opts: SchemaJSONOptions{
+				CustomTypes: []attr.Type{nettypes.CIDRType, nettypes.IPAddressType},
+			},
+			expected: Schema{
+				Attributes: map[string]Attribute{
+					"test_cidr": {
+						Type:     nettypes.CIDRType,
+						Optional: true,
+					},
+					"test_ip_address": {
+						Type:     nettypes.IPAddressType,
+						Optional: true,
+					},
+				},
+			},
+		},
+		"attribute-env-vars": {
+			json: `{"attributes":{"test":{"type":"string","required":true,"env_vars":["TEST_ENV_VAR","TEST_ENV_VAR_LEGACY"]}},"version":0}`,
+			expected: Schema{
+				Attributes: map[string]Attribute{
+					"test": {
+						Type:     types.StringType,
+						Required: true,
+						EnvVars:  []string{"TEST_ENV_VAR", "TEST_ENV_VAR_LEGACY"},
+					},
+				},
+			},
+		},
+		"attribute-null-equals-empty": {
+			json: `{"attributes":{"test":{"type":{"list":"string"},"optional":true,"computed":true,"null_equals_empty":true}},"version":0}`,
+			expected: Schema{
+				Attributes: map[string]Attribute{
+					"test": {
+						Type:            types.ListType{ElemType: types.StringType},
+						Optional:        true,
+						Computed:        true,
+						NullEqualsEmpty: true,
+					},
+				},
+			},
+		},
+		"unknown-field": {
+			json:          `{"version":0,"attribute":{}}`,
+			expectedError: `error decoding schema: json: unknown field "attribute"`,
+		},
+		"unknown-type": {
+			json:          `{"attributes":{"test":{"type":"strin","optional":true}},"version":0}`,
+			expectedError: `error decoding test type: unknown type "strin"`,
+		},
+		"unknown-custom-type": {
+			json:          `{"attributes":{"test":{"type":{"list":{"custom":"types.StringType"}},"optional":true}},"version":0}`,
+			expectedError: `error decoding test type: unknown custom type "types.StringType", which must be added to SchemaJSONOptions.CustomTypes`,
+		},
+		"custom-type-terraform-type-mismatch": {
+			json: `{"attributes":{"test":{"type":{"custom":"types.StringType","terraform_type":"number"},"optional":true}},"version":0}`,
+			opts: SchemaJSONOptions{
+				CustomTypes: []attr.Type{testtypes.StringType{}},
+			},
+			expectedError: `error decoding test type: custom type "types.StringType" has Terraform type tftypes.String, expected tftypes.Number`,
+		},
+		"multiple-type-kinds": {
+			json:          `{"attributes":{"test":{"type":{"list":"string","set":"string"},"optional":true}},"version":0}`,
+			expectedError: `error decoding test type: exactly one of list, set, map, object, or custom must be set, got: list, set`,
+		},
+		"type-and-nested-attributes": {
+			json:          `{"attributes":{"test":{"type":"string","nested_attributes":{"nesting_mode":"single","attributes":{}},"optional":true}},"version":0}`,
+			expectedError: `error decoding test: only one of type or nested_attributes can be set`,
+		},
+		"nested-block-unknown-nesting-mode": {
+			json:          `{"blocks":{"test":{"nesting_mode":"list","blocks":{"nested":{"nesting_mode":"single"}}}},"version":0}`,
+			expectedError: `error decoding test.nested: unknown block nesting mode "single"`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := SchemaFromJSON(context.Background(), []byte(testCase.json), testCase.opts)
+
+			if err != nil {
+				if testCase.expectedError == "" {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if diff := cmp.Diff(err.Error(), testCase.expectedError); diff != "" {
+					t.Fatalf("unexpected error difference: %s", diff)
+				}
+
+				return
+			}
+
+			if testCase.expectedError != "" {
+				t.Fatalf("expected error: %s", testCase.expectedError)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			// Verify the JSON round trips.
+			roundTrip, err := json.Marshal(got)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(string(roundTrip), testCase.json); diff != "" {
+				t.Errorf("unexpected round trip difference: %s", diff)
+			}
+		})
+	}
+}
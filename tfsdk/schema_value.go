@@ -0,0 +1,129 @@
+package tfsdk
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/reflect"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// schemaValue returns the tftypes.Value of the schema type for the Go value
+// `val`, which is used by NewConfig, NewPlan, and NewState. A nil `val` is a
+// null value. A map[string]attr.Value is converted using the attribute names
+// as keys, where missing attributes are null. Any other value is converted
+// using the same reflection rules as the Set methods.
+//
+// The `summary` and `data` are used to describe errors, such as "State Write
+// Error" and "state".
+func schemaValue(ctx context.Context, schema Schema, val interface{}, summary string, data string) (tftypes.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	schemaType := schema.TerraformType(ctx)
+
+	if val == nil {
+		return tftypes.NewValue(schemaType, nil), diags
+	}
+
+	if attrValues, ok := val.(map[string]attr.Value); ok {
+		return schemaValueFromAttrValues(ctx, schema, attrValues, summary, data)
+	}
+
+	attrValue, reflectDiags := reflect.FromValue(ctx, schema.AttributeType(), val, path.Empty())
+
+	diags.Append(reflectDiags...)
+
+	if diags.HasError() {
+		return tftypes.NewValue(schemaType, nil), diags
+	}
+
+	tfValue, err := attrValue.ToTerraformValue(ctx)
+
+	if err != nil {
+		diags.AddError(
+			summary,
+			fmt.Sprintf("An unexpected error was encountered trying to write the %s. This is always an error in the provider. Please report the following to the provider developer:\n\n", data)+
+				fmt.Sprintf("error running ToTerraformValue on %s: %s", data, err),
+		)
+
+		return tftypes.NewValue(schemaType, nil), diags
+	}
+
+	return tfValue, diags
+}
+
+// schemaValueFromAttrValues returns the tftypes.Value of the schema type for
+// a map of attribute names to attr.Value. Missing attributes are null.
+func schemaValueFromAttrValues(ctx context.Context, schema Schema, attrValues map[string]attr.Value, summary string, data string) (tftypes.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	schemaType := schema.TerraformType(ctx)
+	attrTypes := schema.AttributeType().(types.ObjectType).AttrTypes
+
+	var names []string
+
+	for name := range attrValues {
+		names = append(names, name)
+	}
+
+	// Sort for consistent diagnostics ordering.
+	sort.Strings(names)
+
+	for _, name := range names {
+		if _, ok := attrTypes[name]; !ok {
+			diags.AddAttributeError(
+				path.Root(name),
+				summary,
+				fmt.Sprintf("An unexpected error was encountered trying to write the %s. This is always an error in the provider. Please report the following to the provider developer:\n\n", data)+
+					fmt.Sprintf("attribute %q is not defined in the schema", name),
+			)
+		}
+	}
+
+	if diags.HasError() {
+		return tftypes.NewValue(schemaType, nil), diags
+	}
+
+	tfValues := make(map[string]tftypes.Value, len(attrTypes))
+
+	for name, attrType := range attrTypes {
+		tfType := attrType.TerraformType(ctx)
+		attrValue, ok := attrValues[name]
+
+		if !ok || attrValue == nil {
+			tfValues[name] = tftypes.NewValue(tfType, nil)
+
+			continue
+		}
+
+		tfValue, err := attrValue.ToTerraformValue(ctx)
+
+		if err == nil && !tfValue.Type().Equal(tfType) {
+			err = fmt.Errorf("expected value of type %s, got: %s", tfType, tfValue.Type())
+		}
+
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root(name),
+				summary,
+				fmt.Sprintf("An unexpected error was encountered trying to write the %s. This is always an error in the provider. Please report the following to the provider developer:\n\n", data)+
+					fmt.Sprintf("error running ToTerraformValue on attribute %q: %s", name, err),
+			)
+
+			continue
+		}
+
+		tfValues[name] = tfValue
+	}
+
+	if diags.HasError() {
+		return tftypes.NewValue(schemaType, nil), diags
+	}
+
+	return tftypes.NewValue(schemaType, tfValues), diags
+}
@@ -16,6 +16,158 @@ import (
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
+func TestNewState(t *testing.T) {
+	t.Parallel()
+
+	testSchema := Schema{
+		Attributes: map[string]Attribute{
+			"computed": {
+				Computed: true,
+				Type:     types.StringType,
+			},
+			"required": {
+				Required: true,
+				Type:     types.StringType,
+			},
+		},
+	}
+
+	testType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"computed": tftypes.String,
+			"required": tftypes.String,
+		},
+	}
+
+	type testModel struct {
+		Computed types.String `tfsdk:"computed"`
+		Required types.String `tfsdk:"required"`
+	}
+
+	testCases := map[string]struct {
+		val           interface{}
+		expected      State
+		expectedDiags diag.Diagnostics
+	}{
+		"nil": {
+			val: nil,
+			expected: State{
+				Raw:    tftypes.NewValue(testType, nil),
+				Schema: testSchema,
+			},
+		},
+		"struct": {
+			val: testModel{
+				Computed: types.StringUnknown(),
+				Required: types.StringValue("test"),
+			},
+			expected: State{
+				Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+					"computed": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+					"required": tftypes.NewValue(tftypes.String, "test"),
+				}),
+				Schema: testSchema,
+			},
+		},
+		"struct-mismatch": {
+			val: struct {
+				Required types.String `tfsdk:"required"`
+			}{},
+			expected: State{
+				Raw:    tftypes.NewValue(testType, nil),
+				Schema: testSchema,
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.WithPath(
+					path.Empty(),
+					diag.NewErrorDiagnostic(
+						"Value Conversion Error",
+						"An unexpected error was encountered trying to convert the Terraform value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+							"expected tftypes.Object[\"computed\":tftypes.String, \"required\":tftypes.String], got tftypes.Object[\"required\":tftypes.String]",
+					),
+				),
+			},
+		},
+		"map": {
+			val: map[string]attr.Value{
+				"required": types.StringValue("test"),
+			},
+			expected: State{
+				Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+					"computed": tftypes.NewValue(tftypes.String, nil),
+					"required": tftypes.NewValue(tftypes.String, "test"),
+				}),
+				Schema: testSchema,
+			},
+		},
+		"map-unknown": {
+			val: map[string]attr.Value{
+				"computed": types.StringUnknown(),
+				"required": types.StringNull(),
+			},
+			expected: State{
+				Raw: tftypes.NewValue(testType, map[string]tftypes.Value{
+					"computed": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+					"required": tftypes.NewValue(tftypes.String, nil),
+				}),
+				Schema: testSchema,
+			},
+		},
+		"map-undefined-attribute": {
+			val: map[string]attr.Value{
+				"other": types.StringValue("test"),
+			},
+			expected: State{
+				Raw:    tftypes.NewValue(testType, nil),
+				Schema: testSchema,
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("other"),
+					"State Write Error",
+					"An unexpected error was encountered trying to write the state. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"attribute \"other\" is not defined in the schema",
+				),
+			},
+		},
+		"map-type-mismatch": {
+			val: map[string]attr.Value{
+				"required": types.BoolValue(true),
+			},
+			expected: State{
+				Raw:    tftypes.NewValue(testType, nil),
+				Schema: testSchema,
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("required"),
+					"State Write Error",
+					"An unexpected error was encountered trying to write the state. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"error running ToTerraformValue on attribute \"required\": expected value of type tftypes.String, got: tftypes.Bool",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := NewState(context.Background(), testSchema, testCase.val)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics (+wanted, -got): %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected result (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
 func TestStateGet(t *testing.T) {
 	t.Parallel()
 
@@ -244,24 +396,18 @@ func TestStateGet(t *testing.T) {
 				},
 			},
 			expected: testStateGetData{
-				Name:        types.String{Value: "hello, world"},
+				Name:        types.StringValue("hello, world"),
 				MachineType: "e2-medium",
-				Tags: types.List{
-					ElemType: types.StringType,
-					Elems: []attr.Value{
-						types.String{Value: "red"},
-						types.String{Value: "blue"},
-						types.String{Value: "green"},
-					},
-				},
-				TagsSet: types.Set{
-					ElemType: types.StringType,
-					Elems: []attr.Value{
-						types.String{Value: "red"},
-						types.String{Value: "blue"},
-						types.String{Value: "green"},
-					},
-				},
+				Tags: types.ListValueMust(types.StringType, []attr.Value{
+					types.StringValue("red"),
+					types.StringValue("blue"),
+					types.StringValue("green"),
+				}),
+				TagsSet: types.SetValueMust(types.StringType, []attr.Value{
+					types.StringValue("red"),
+					types.StringValue("blue"),
+					types.StringValue("green"),
+				}),
 				Disks: []struct {
 					ID                 string `tfsdk:"id"`
 					DeleteWithInstance bool   `tfsdk:"delete_with_instance"`
@@ -552,7 +698,7 @@ func TestStateGet_testTypes(t *testing.T) {
 				},
 			},
 			expected: testStateGetDataTestTypes{
-				Name:        testtypes.String{InternalString: types.String{Value: ""}, CreatedBy: testtypes.StringTypeWithValidateError{}},
+				Name:        testtypes.String{InternalString: types.StringNull(), CreatedBy: testtypes.StringTypeWithValidateError{}},
 				MachineType: "",
 				Tags:        types.List{},
 				TagsSet:     types.Set{},
@@ -769,24 +915,18 @@ func TestStateGet_testTypes(t *testing.T) {
 				},
 			},
 			expected: testStateGetDataTestTypes{
-				Name:        testtypes.String{InternalString: types.String{Value: "namevalue"}, CreatedBy: testtypes.StringTypeWithValidateWarning{}},
+				Name:        testtypes.String{InternalString: types.StringValue("namevalue"), CreatedBy: testtypes.StringTypeWithValidateWarning{}},
 				MachineType: "e2-medium",
-				Tags: types.List{
-					ElemType: types.StringType,
-					Elems: []attr.Value{
-						types.String{Value: "red"},
-						types.String{Value: "blue"},
-						types.String{Value: "green"},
-					},
-				},
-				TagsSet: types.Set{
-					ElemType: types.StringType,
-					Elems: []attr.Value{
-						types.String{Value: "red"},
-						types.String{Value: "blue"},
-						types.String{Value: "green"},
-					},
-				},
+				Tags: types.ListValueMust(types.StringType, []attr.Value{
+					types.StringValue("red"),
+					types.StringValue("blue"),
+					types.StringValue("green"),
+				}),
+				TagsSet: types.SetValueMust(types.StringType, []attr.Value{
+					types.StringValue("red"),
+					types.StringValue("blue"),
+					types.StringValue("green"),
+				}),
 				Disks: []struct {
 					ID                 string `tfsdk:"id"`
 					DeleteWithInstance bool   `tfsdk:"delete_with_instance"`
@@ -924,8 +1064,11 @@ func TestStateGetAttribute(t *testing.T) {
 					},
 				},
 			},
-			target:   new(types.String),
-			expected: &types.String{Value: "namevalue"},
+			target: new(types.String),
+			expected: func() *types.String {
+				v := types.StringValue("namevalue")
+				return &v
+			}(),
 		},
 		"incompatible-target": {
 			state: State{
@@ -951,7 +1094,7 @@ func TestStateGetAttribute(t *testing.T) {
 				diag.WithPath(
 					path.Root("name"),
 					intreflect.DiagNewAttributeValueIntoWrongType{
-						ValType:    reflect.TypeOf(types.String{Value: "namevalue"}),
+						ValType:    reflect.TypeOf(types.StringValue("namevalue")),
 						TargetType: reflect.TypeOf(testtypes.String{}),
 						SchemaType: types.StringType,
 					},
@@ -1030,7 +1173,7 @@ func TestStateGetAttribute(t *testing.T) {
 				},
 			},
 			target:        new(testtypes.String),
-			expected:      &testtypes.String{InternalString: types.String{Value: "namevalue"}, CreatedBy: testtypes.StringTypeWithValidateWarning{}},
+			expected:      &testtypes.String{InternalString: types.StringValue("namevalue"), CreatedBy: testtypes.StringTypeWithValidateWarning{}},
 			expectedDiags: diag.Diagnostics{testtypes.TestWarningDiagnostic(path.Root("name"))},
 		},
 	}
@@ -1053,6 +1196,247 @@ func TestStateGetAttribute(t *testing.T) {
 	}
 }
 
+func TestStateGetWithOptions(t *testing.T) {
+	t.Parallel()
+
+	type testStateGetWithOptionsData struct {
+		Count int64   `tfsdk:"count"`
+		ID    *string `tfsdk:"id"`
+		Name  string  `tfsdk:"name"`
+	}
+
+	testSchema := Schema{
+		Attributes: map[string]Attribute{
+			"count": {
+				Type:     types.NumberType,
+				Optional: true,
+			},
+			"id": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"name": {
+				Type:     types.StringType,
+				Optional: true,
+			},
+		},
+	}
+
+	testValue := func(count interface{}, id interface{}, name interface{}) State {
+		return State{
+			Raw: tftypes.NewValue(testSchema.TerraformType(context.Background()), map[string]tftypes.Value{
+				"count": tftypes.NewValue(tftypes.Number, count),
+				"id":    tftypes.NewValue(tftypes.String, id),
+				"name":  tftypes.NewValue(tftypes.String, name),
+			}),
+			Schema: testSchema,
+		}
+	}
+
+	testCases := map[string]struct {
+		state         State
+		opts          ValueAsOptions
+		expected      testStateGetWithOptionsData
+		expectedDiags diag.Diagnostics
+	}{
+		"known": {
+			state: testValue(2, "test-id", "test-name"),
+			expected: testStateGetWithOptionsData{
+				Count: 2,
+				ID:    newStringPointer("test-id"),
+				Name:  "test-name",
+			},
+		},
+		"null": {
+			state:    testValue(2, nil, nil),
+			expected: testStateGetWithOptionsData{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("name"),
+					"Value Conversion Error",
+					"An unexpected error was encountered trying to build a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"unhandled null value",
+				),
+			},
+		},
+		"null-as-empty": {
+			state: testValue(2, nil, nil),
+			opts: ValueAsOptions{
+				UnhandledNullAsEmpty: true,
+			},
+			expected: testStateGetWithOptionsData{
+				Count: 2,
+			},
+		},
+		"unknown": {
+			state:    testValue(2, tftypes.UnknownValue, "test-name"),
+			expected: testStateGetWithOptionsData{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("id"),
+					"Value Conversion Error",
+					"An unexpected error was encountered trying to build a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"unhandled unknown value",
+				),
+			},
+		},
+		"unknown-as-empty": {
+			state: testValue(2, tftypes.UnknownValue, "test-name"),
+			opts: ValueAsOptions{
+				UnhandledUnknownAsEmpty: true,
+			},
+			expected: testStateGetWithOptionsData{
+				Count: 2,
+				Name:  "test-name",
+			},
+		},
+		"rounding": {
+			state:    testValue(1.5, "test-id", "test-name"),
+			expected: testStateGetWithOptionsData{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("count"),
+					"Value Conversion Error",
+					"An unexpected error was encountered trying to convert to number. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"cannot store 1.5 in int64",
+				),
+			},
+		},
+		"rounding-allowed": {
+			state: testValue(1.5, "test-id", "test-name"),
+			opts: ValueAsOptions{
+				AllowRoundingNumbers: true,
+			},
+			expected: testStateGetWithOptionsData{
+				Count: 1,
+				ID:    newStringPointer("test-id"),
+				Name:  "test-name",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got testStateGetWithOptionsData
+
+			diags := tc.state.GetWithOptions(context.Background(), &got, tc.opts)
+
+			if diff := cmp.Diff(diags, tc.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics (+wanted, -got): %s", diff)
+			}
+
+			if diff := cmp.Diff(got, tc.expected); diff != "" {
+				t.Errorf("unexpected value (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestStateGetAttributeWithOptions(t *testing.T) {
+	t.Parallel()
+
+	testSchema := Schema{
+		Attributes: map[string]Attribute{
+			"tags": {
+				Type:     types.ListType{ElemType: types.StringType},
+				Optional: true,
+			},
+		},
+	}
+
+	testValue := func(tags ...tftypes.Value) State {
+		return State{
+			Raw: tftypes.NewValue(testSchema.TerraformType(context.Background()), map[string]tftypes.Value{
+				"tags": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, tags),
+			}),
+			Schema: testSchema,
+		}
+	}
+
+	testCases := map[string]struct {
+		state         State
+		opts          ValueAsOptions
+		expected      []string
+		expectedDiags diag.Diagnostics
+	}{
+		"known": {
+			state: testValue(
+				tftypes.NewValue(tftypes.String, "first"),
+				tftypes.NewValue(tftypes.String, "second"),
+			),
+			expected: []string{"first", "second"},
+		},
+		"null-element": {
+			state: testValue(
+				tftypes.NewValue(tftypes.String, "first"),
+				tftypes.NewValue(tftypes.String, nil),
+			),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("tags").AtListIndex(1),
+					"Value Conversion Error",
+					"An unexpected error was encountered trying to build a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"unhandled null value",
+				),
+			},
+		},
+		"null-element-as-empty": {
+			state: testValue(
+				tftypes.NewValue(tftypes.String, "first"),
+				tftypes.NewValue(tftypes.String, nil),
+			),
+			opts: ValueAsOptions{
+				UnhandledNullAsEmpty: true,
+			},
+			expected: []string{"first", ""},
+		},
+		"unknown-element": {
+			state: testValue(
+				tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("tags").AtListIndex(0),
+					"Value Conversion Error",
+					"An unexpected error was encountered trying to build a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"unhandled unknown value",
+				),
+			},
+		},
+		"unknown-element-as-empty": {
+			state: testValue(
+				tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			),
+			opts: ValueAsOptions{
+				UnhandledUnknownAsEmpty: true,
+			},
+			expected: []string{""},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got []string
+
+			diags := tc.state.GetAttributeWithOptions(context.Background(), path.Root("tags"), &got, tc.opts)
+
+			if diff := cmp.Diff(diags, tc.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics (+wanted, -got): %s", diff)
+			}
+
+			if diff := cmp.Diff(got, tc.expected); diff != "" {
+				t.Errorf("unexpected value (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
 func TestStateGetAttributeValue(t *testing.T) {
 	t.Parallel()
 
@@ -1148,7 +1532,7 @@ func TestStateGetAttributeValue(t *testing.T) {
 				},
 			},
 			path:     path.Root("test").AtListIndex(0),
-			expected: types.String{Null: true},
+			expected: types.StringNull(),
 		},
 		"WithAttributeName-List-WithElementKeyInt": {
 			state: State{
@@ -1184,7 +1568,7 @@ func TestStateGetAttributeValue(t *testing.T) {
 				},
 			},
 			path:     path.Root("test").AtListIndex(0),
-			expected: types.String{Value: "value"},
+			expected: types.StringValue("value"),
 		},
 		"WithAttributeName-ListNestedAttributes-null-WithElementKeyInt-WithAttributeName": {
 			state: State{
@@ -1228,7 +1612,7 @@ func TestStateGetAttributeValue(t *testing.T) {
 				},
 			},
 			path:     path.Root("test").AtListIndex(0).AtName("sub_test"),
-			expected: types.String{Null: true},
+			expected: types.StringNull(),
 		},
 		"WithAttributeName-ListNestedAttributes-null-WithElementKeyInt-WithAttributeName-Object": {
 			state: State{
@@ -1284,11 +1668,8 @@ func TestStateGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path: path.Root("test").AtListIndex(0).AtName("sub_test"),
-			expected: types.Object{
-				Null:      true,
-				AttrTypes: map[string]attr.Type{"value": types.StringType},
-			},
+			path:     path.Root("test").AtListIndex(0).AtName("sub_test"),
+			expected: types.ObjectNull(map[string]attr.Type{"value": types.StringType}),
 		},
 		"WithAttributeName-ListNestedAttributes-WithElementKeyInt-WithAttributeName": {
 			state: State{
@@ -1340,7 +1721,7 @@ func TestStateGetAttributeValue(t *testing.T) {
 				},
 			},
 			path:     path.Root("test").AtListIndex(0).AtName("sub_test"),
-			expected: types.String{Value: "value"},
+			expected: types.StringValue("value"),
 		},
 		"WithAttributeName-ListNestedBlocks-null-WithElementKeyInt-WithAttributeName": {
 			state: State{
@@ -1409,7 +1790,7 @@ func TestStateGetAttributeValue(t *testing.T) {
 				},
 			},
 			path:     path.Root("test").AtListIndex(0).AtName("sub_test"),
-			expected: types.String{Null: true},
+			expected: types.StringNull(),
 		},
 		"WithAttributeName-ListNestedBlocks-WithElementKeyInt-WithAttributeName": {
 			state: State{
@@ -1486,7 +1867,7 @@ func TestStateGetAttributeValue(t *testing.T) {
 				},
 			},
 			path:     path.Root("test").AtListIndex(0).AtName("sub_test"),
-			expected: types.String{Value: "value"},
+			expected: types.StringValue("value"),
 		},
 		"WithAttributeName-Map-null-WithElementKeyString": {
 			state: State{
@@ -1519,7 +1900,7 @@ func TestStateGetAttributeValue(t *testing.T) {
 				},
 			},
 			path:     path.Root("test").AtMapKey("sub_test"),
-			expected: types.String{Null: true},
+			expected: types.StringNull(),
 		},
 		"WithAttributeName-Map-WithElementKeyString": {
 			state: State{
@@ -1555,7 +1936,7 @@ func TestStateGetAttributeValue(t *testing.T) {
 				},
 			},
 			path:     path.Root("test").AtMapKey("sub_test"),
-			expected: types.String{Value: "value"},
+			expected: types.StringValue("value"),
 		},
 		"WithAttributeName-Map-WithElementKeyString-nonexistent": {
 			state: State{
@@ -1590,7 +1971,7 @@ func TestStateGetAttributeValue(t *testing.T) {
 				},
 			},
 			path:     path.Root("test").AtMapKey("other"),
-			expected: types.String{Null: true},
+			expected: types.StringNull(),
 		},
 		"WithAttributeName-MapNestedAttributes-null-WithElementKeyInt-WithAttributeName": {
 			state: State{
@@ -1634,7 +2015,7 @@ func TestStateGetAttributeValue(t *testing.T) {
 				},
 			},
 			path:     path.Root("test").AtMapKey("element").AtName("sub_test"),
-			expected: types.String{Null: true},
+			expected: types.StringNull(),
 		},
 		"WithAttributeName-MapNestedAttributes-WithElementKeyString-WithAttributeName": {
 			state: State{
@@ -1686,7 +2067,7 @@ func TestStateGetAttributeValue(t *testing.T) {
 				},
 			},
 			path:     path.Root("test").AtMapKey("element").AtName("sub_test"),
-			expected: types.String{Value: "value"},
+			expected: types.StringValue("value"),
 		},
 		"WithAttributeName-Object-WithAttributeName": {
 			state: State{
@@ -1727,7 +2108,7 @@ func TestStateGetAttributeValue(t *testing.T) {
 				},
 			},
 			path:     path.Root("test").AtName("sub_test"),
-			expected: types.String{Value: "value"},
+			expected: types.StringValue("value"),
 		},
 		"WithAttributeName-Set-null-WithElementKeyValue": {
 			state: State{
@@ -1759,8 +2140,8 @@ func TestStateGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     path.Root("test").AtSetValue(types.String{Value: "value"}),
-			expected: types.String{Null: true},
+			path:     path.Root("test").AtSetValue(types.StringValue("value")),
+			expected: types.StringNull(),
 		},
 		"WithAttributeName-Set-WithElementKeyValue": {
 			state: State{
@@ -1795,8 +2176,8 @@ func TestStateGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     path.Root("test").AtSetValue(types.String{Value: "value"}),
-			expected: types.String{Value: "value"},
+			path:     path.Root("test").AtSetValue(types.StringValue("value")),
+			expected: types.StringValue("value"),
 		},
 		"WithAttributeName-SetNestedAttributes-null-WithElementKeyValue-WithAttributeName": {
 			state: State{
@@ -1839,15 +2220,12 @@ func TestStateGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path: path.Root("test").AtSetValue(types.Object{
-				AttrTypes: map[string]attr.Type{
-					"sub_test": types.StringType,
-				},
-				Attrs: map[string]attr.Value{
-					"sub_test": types.String{Value: "value"},
-				},
-			}).AtName("sub_test"),
-			expected: types.String{Null: true},
+			path: path.Root("test").AtSetValue(types.ObjectValueMust(map[string]attr.Type{
+				"sub_test": types.StringType,
+			}, map[string]attr.Value{
+				"sub_test": types.StringValue("value"),
+			})).AtName("sub_test"),
+			expected: types.StringNull(),
 		},
 		"WithAttributeName-SetNestedAttributes-WithElementKeyValue-WithAttributeName": {
 			state: State{
@@ -1898,15 +2276,12 @@ func TestStateGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path: path.Root("test").AtSetValue(types.Object{
-				AttrTypes: map[string]attr.Type{
-					"sub_test": types.StringType,
-				},
-				Attrs: map[string]attr.Value{
-					"sub_test": types.String{Value: "value"},
-				},
-			}).AtName("sub_test"),
-			expected: types.String{Value: "value"},
+			path: path.Root("test").AtSetValue(types.ObjectValueMust(map[string]attr.Type{
+				"sub_test": types.StringType,
+			}, map[string]attr.Value{
+				"sub_test": types.StringValue("value"),
+			})).AtName("sub_test"),
+			expected: types.StringValue("value"),
 		},
 		"WithAttributeName-SetNestedBlocks-null-WithElementKeyValue-WithAttributeName": {
 			state: State{
@@ -1974,15 +2349,12 @@ func TestStateGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path: path.Root("test").AtSetValue(types.Object{
-				AttrTypes: map[string]attr.Type{
-					"sub_test": types.StringType,
-				},
-				Attrs: map[string]attr.Value{
-					"sub_test": types.String{Value: "value"},
-				},
-			}).AtName("sub_test"),
-			expected: types.String{Null: true},
+			path: path.Root("test").AtSetValue(types.ObjectValueMust(map[string]attr.Type{
+				"sub_test": types.StringType,
+			}, map[string]attr.Value{
+				"sub_test": types.StringValue("value"),
+			})).AtName("sub_test"),
+			expected: types.StringNull(),
 		},
 		"WithAttributeName-SetNestedBlocks-WithElementKeyValue-WithAttributeName": {
 			state: State{
@@ -2058,15 +2430,12 @@ func TestStateGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path: path.Root("test").AtSetValue(types.Object{
-				AttrTypes: map[string]attr.Type{
-					"sub_test": types.StringType,
-				},
-				Attrs: map[string]attr.Value{
-					"sub_test": types.String{Value: "value"},
-				},
-			}).AtName("sub_test"),
-			expected: types.String{Value: "value"},
+			path: path.Root("test").AtSetValue(types.ObjectValueMust(map[string]attr.Type{
+				"sub_test": types.StringType,
+			}, map[string]attr.Value{
+				"sub_test": types.StringValue("value"),
+			})).AtName("sub_test"),
+			expected: types.StringValue("value"),
 		},
 		"WithAttributeName-SingleNestedAttributes-null-WithAttributeName-Float64": {
 			state: State{
@@ -2106,7 +2475,7 @@ func TestStateGetAttributeValue(t *testing.T) {
 				},
 			},
 			path:     path.Root("test").AtName("sub_test"),
-			expected: types.Float64{Null: true},
+			expected: types.Float64Null(),
 		},
 		"WithAttributeName-SingleNestedAttributes-null-WithAttributeName-Int64": {
 			state: State{
@@ -2146,7 +2515,7 @@ func TestStateGetAttributeValue(t *testing.T) {
 				},
 			},
 			path:     path.Root("test").AtName("sub_test"),
-			expected: types.Int64{Null: true},
+			expected: types.Int64Null(),
 		},
 		"WithAttributeName-SingleNestedAttributes-null-WithAttributeName-Set": {
 			state: State{
@@ -2192,7 +2561,7 @@ func TestStateGetAttributeValue(t *testing.T) {
 				},
 			},
 			path:     path.Root("test").AtName("sub_test"),
-			expected: types.Set{ElemType: types.StringType, Null: true},
+			expected: types.SetNull(types.StringType),
 		},
 		"WithAttributeName-SingleNestedAttributes-null-WithAttributeName-String": {
 			state: State{
@@ -2232,7 +2601,7 @@ func TestStateGetAttributeValue(t *testing.T) {
 				},
 			},
 			path:     path.Root("test").AtName("sub_test"),
-			expected: types.String{Null: true},
+			expected: types.StringNull(),
 		},
 		"WithAttributeName-SingleNestedAttributes-WithAttributeName": {
 			state: State{
@@ -2274,7 +2643,7 @@ func TestStateGetAttributeValue(t *testing.T) {
 				},
 			},
 			path:     path.Root("test").AtName("sub_test"),
-			expected: types.String{Value: "value"},
+			expected: types.StringValue("value"),
 		},
 		"WithAttributeName-String-null": {
 			state: State{
@@ -2301,7 +2670,7 @@ func TestStateGetAttributeValue(t *testing.T) {
 				},
 			},
 			path:     path.Root("test"),
-			expected: types.String{Null: true},
+			expected: types.StringNull(),
 		},
 		"WithAttributeName-String-unknown": {
 			state: State{
@@ -2328,7 +2697,7 @@ func TestStateGetAttributeValue(t *testing.T) {
 				},
 			},
 			path:     path.Root("test"),
-			expected: types.String{Unknown: true},
+			expected: types.StringUnknown(),
 		},
 		"WithAttributeName-String-value": {
 			state: State{
@@ -2355,7 +2724,7 @@ func TestStateGetAttributeValue(t *testing.T) {
 				},
 			},
 			path:     path.Root("test"),
-			expected: types.String{Value: "value"},
+			expected: types.StringValue("value"),
 		},
 		"AttrTypeWithValidateError": {
 			state: State{
@@ -2410,7 +2779,7 @@ func TestStateGetAttributeValue(t *testing.T) {
 				},
 			},
 			path:          path.Root("test"),
-			expected:      testtypes.String{InternalString: types.String{Value: "value"}, CreatedBy: testtypes.StringTypeWithValidateWarning{}},
+			expected:      testtypes.String{InternalString: types.StringValue("value"), CreatedBy: testtypes.StringTypeWithValidateWarning{}},
 			expectedDiags: diag.Diagnostics{testtypes.TestWarningDiagnostic(path.Root("test"))},
 		},
 	}
@@ -2791,7 +3160,7 @@ func TestStatePathExists(t *testing.T) {
 					},
 				},
 			},
-			path:     path.Root("test").AtSetValue(types.String{Value: "testvalue"}),
+			path:     path.Root("test").AtSetValue(types.StringValue("testvalue")),
 			expected: true,
 		},
 		"WithAttributeName.WithElementKeyValue-mismatch-child": {
@@ -2820,7 +3189,7 @@ func TestStatePathExists(t *testing.T) {
 					},
 				},
 			},
-			path:     path.Root("test").AtSetValue(types.String{Value: "othervalue"}),
+			path:     path.Root("test").AtSetValue(types.StringValue("othervalue")),
 			expected: false,
 		},
 		"WithAttributeName.WithElementKeyValue-mismatch-parent": {
@@ -2841,7 +3210,7 @@ func TestStatePathExists(t *testing.T) {
 					},
 				},
 			},
-			path:     path.Root("test").AtSetValue(types.String{Value: "othervalue"}),
+			path:     path.Root("test").AtSetValue(types.StringValue("othervalue")),
 			expected: false,
 		},
 	}
@@ -4074,16 +4443,13 @@ func TestStateSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: path.Root("disks").AtSetValue(types.Object{
-				AttrTypes: map[string]attr.Type{
-					"id":                   types.StringType,
-					"delete_with_instance": types.BoolType,
-				},
-				Attrs: map[string]attr.Value{
-					"id":                   types.String{Value: "mynewdisk"},
-					"delete_with_instance": types.Bool{Value: true},
-				},
-			}),
+			path: path.Root("disks").AtSetValue(types.ObjectValueMust(map[string]attr.Type{
+				"id":                   types.StringType,
+				"delete_with_instance": types.BoolType,
+			}, map[string]attr.Value{
+				"id":                   types.StringValue("mynewdisk"),
+				"delete_with_instance": types.BoolValue(true),
+			})),
 			val: struct {
 				ID                 string `tfsdk:"id"`
 				DeleteWithInstance bool   `tfsdk:"delete_with_instance"`
@@ -4182,16 +4548,13 @@ func TestStateSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: path.Root("disks").AtSetValue(types.Object{
-				AttrTypes: map[string]attr.Type{
-					"id":                   types.StringType,
-					"delete_with_instance": types.BoolType,
-				},
-				Attrs: map[string]attr.Value{
-					"id":                   types.String{Value: "mynewdisk"},
-					"delete_with_instance": types.Bool{Value: true},
-				},
-			}),
+			path: path.Root("disks").AtSetValue(types.ObjectValueMust(map[string]attr.Type{
+				"id":                   types.StringType,
+				"delete_with_instance": types.BoolType,
+			}, map[string]attr.Value{
+				"id":                   types.StringValue("mynewdisk"),
+				"delete_with_instance": types.BoolValue(true),
+			})),
 			val: struct {
 				ID                 string `tfsdk:"id"`
 				DeleteWithInstance bool   `tfsdk:"delete_with_instance"`
@@ -4826,16 +5189,13 @@ func TestStateSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: path.Root("disks").AtSetValue(types.Object{
-				AttrTypes: map[string]attr.Type{
-					"id":                   types.StringType,
-					"delete_with_instance": types.BoolType,
-				},
-				Attrs: map[string]attr.Value{
-					"id":                   types.String{Value: "disk1"},
-					"delete_with_instance": types.Bool{Value: false},
-				},
-			}),
+			path: path.Root("disks").AtSetValue(types.ObjectValueMust(map[string]attr.Type{
+				"id":                   types.StringType,
+				"delete_with_instance": types.BoolType,
+			}, map[string]attr.Value{
+				"id":                   types.StringValue("disk1"),
+				"delete_with_instance": types.BoolValue(false),
+			})),
 			val: struct {
 				ID                 string `tfsdk:"id"`
 				DeleteWithInstance bool   `tfsdk:"delete_with_instance"`
@@ -4920,7 +5280,7 @@ func TestStateSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: path.Root("tags").AtSetValue(types.String{Value: "three"}),
+			path: path.Root("tags").AtSetValue(types.StringValue("three")),
 			val:  "three",
 			expected: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
@@ -5700,16 +6060,13 @@ func TestStateSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: path.Root("disks").AtSetValue(types.Object{
-				AttrTypes: map[string]attr.Type{
-					"id":                   types.StringType,
-					"delete_with_instance": types.BoolType,
-				},
-				Attrs: map[string]attr.Value{
-					"id":                   types.String{Value: "mynewdisk"},
-					"delete_with_instance": types.Bool{Value: true},
-				},
-			}),
+			path: path.Root("disks").AtSetValue(types.ObjectValueMust(map[string]attr.Type{
+				"id":                   types.StringType,
+				"delete_with_instance": types.BoolType,
+			}, map[string]attr.Value{
+				"id":                   types.StringValue("mynewdisk"),
+				"delete_with_instance": types.BoolValue(true),
+			})),
 			val: struct {
 				ID                 string `tfsdk:"id"`
 				DeleteWithInstance bool   `tfsdk:"delete_with_instance"`
@@ -5779,7 +6136,7 @@ func TestStateSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: path.Root("test").AtSetValue(types.String{Value: "testvalue"}),
+			path: path.Root("test").AtSetValue(types.StringValue("testvalue")),
 			val:  "testvalue",
 			expected: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
@@ -5838,16 +6195,13 @@ func TestStateSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: path.Root("disks").AtSetValue(types.Object{
-				AttrTypes: map[string]attr.Type{
-					"id":                   types.StringType,
-					"delete_with_instance": types.BoolType,
-				},
-				Attrs: map[string]attr.Value{
-					"id":                   types.String{Value: "mynewdisk"},
-					"delete_with_instance": types.Bool{Value: true},
-				},
-			}),
+			path: path.Root("disks").AtSetValue(types.ObjectValueMust(map[string]attr.Type{
+				"id":                   types.StringType,
+				"delete_with_instance": types.BoolType,
+			}, map[string]attr.Value{
+				"id":                   types.StringValue("mynewdisk"),
+				"delete_with_instance": types.BoolValue(true),
+			})),
 			val: struct {
 				ID                 string `tfsdk:"id"`
 				DeleteWithInstance bool   `tfsdk:"delete_with_instance"`
@@ -5889,16 +6243,13 @@ func TestStateSetAttribute(t *testing.T) {
 				"other": tftypes.NewValue(tftypes.String, nil),
 			}),
 			expectedDiags: diag.Diagnostics{
-				testtypes.TestWarningDiagnostic(path.Root("disks").AtSetValue(types.Object{
-					AttrTypes: map[string]attr.Type{
-						"id":                   types.StringType,
-						"delete_with_instance": types.BoolType,
-					},
-					Attrs: map[string]attr.Value{
-						"id":                   types.String{Value: "mynewdisk"},
-						"delete_with_instance": types.Bool{Value: true},
-					},
-				}).AtName("id")),
+				testtypes.TestWarningDiagnostic(path.Root("disks").AtSetValue(types.ObjectValueMust(map[string]attr.Type{
+					"id":                   types.StringType,
+					"delete_with_instance": types.BoolType,
+				}, map[string]attr.Value{
+					"id":                   types.StringValue("mynewdisk"),
+					"delete_with_instance": types.BoolValue(true),
+				})).AtName("id")),
 			},
 		},
 		"write-String": {
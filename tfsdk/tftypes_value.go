@@ -186,7 +186,10 @@ func upsertChildValue(_ context.Context, parentPath path.Path, parentValue tftyp
 			return parentValue, diags
 		}
 
-		// Prevent duplicates
+		// Prevent duplicates. A single lookup cannot be faster than this
+		// scan, since building a valuehash.Index hashes every element, so
+		// an index is only used where many lookups share one set, such as
+		// the valuehash.PathLookup of the framework server.
 		var found bool
 
 		for _, parentElem := range parentElems {
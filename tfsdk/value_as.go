@@ -7,12 +7,63 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/reflect"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 )
 
+// ValueAsOptions is a collection of toggles to control the behavior of
+// ValueAsWithOptions and the GetWithOptions and GetAttributeWithOptions
+// methods of Config, Plan, and State. The zero value has the same behavior as
+// ValueAs, Get, and GetAttribute.
+type ValueAsOptions struct {
+	// UnhandledNullAsEmpty controls what happens when a null value needs to
+	// be put in a type that has no way to preserve that distinction, such as
+	// string. When set to true, the type's empty value will be used. When
+	// set to false, an error will be returned.
+	UnhandledNullAsEmpty bool
+
+	// UnhandledUnknownAsEmpty controls what happens when an unknown value
+	// needs to be put in a type that has no way to preserve that
+	// distinction, such as string or *int64. When set to true, the type's
+	// empty value will be used. When set to false, an error will be
+	// returned.
+	UnhandledUnknownAsEmpty bool
+
+	// AllowRoundingNumbers controls what happens when a number does not fit
+	// exactly in the type it is being put in, such as 1.5 in an int64. When
+	// set to true, numbers put in integer types are rounded towards zero,
+	// numbers put in float types are rounded to the nearest value, and
+	// numbers outside the range of the type are clamped to its minimum or
+	// maximum value. When set to false, an error will be returned.
+	AllowRoundingNumbers bool
+}
+
+// reflectOptions returns the equivalent internal reflection options.
+func (o ValueAsOptions) reflectOptions() reflect.Options {
+	return reflect.Options{
+		UnhandledNullAsEmpty:    o.UnhandledNullAsEmpty,
+		UnhandledUnknownAsEmpty: o.UnhandledUnknownAsEmpty,
+		AllowRoundingNumbers:    o.AllowRoundingNumbers,
+	}
+}
+
 // ValueAs takes the attr.Value `val` and populates the Go value `target` with its content.
 //
 // This is achieved using reflection rules provided by the internal/reflect package.
 func ValueAs(ctx context.Context, val attr.Value, target interface{}) diag.Diagnostics {
+	return ValueAsWithOptions(ctx, val, target, ValueAsOptions{})
+}
+
+// ValueAsWithOptions is the same as ValueAs, except `opts` controls how
+// null, unknown, and inexact number values are handled when `target` cannot
+// represent them. Diagnostics for values nested in `val` have paths relative
+// to `val`.
+func ValueAsWithOptions(ctx context.Context, val attr.Value, target interface{}, opts ValueAsOptions) diag.Diagnostics {
+	return valueAs(ctx, val, target, opts, path.Empty())
+}
+
+// valueAs populates `target` with the content of `val`, with diagnostics
+// relative to `path`.
+func valueAs(ctx context.Context, val attr.Value, target interface{}, opts ValueAsOptions, path path.Path) diag.Diagnostics {
 	if reflect.IsGenericAttrValue(ctx, target) {
 		*(target.(*attr.Value)) = val
 		return nil
@@ -22,5 +73,5 @@ func ValueAs(ctx context.Context, val attr.Value, target interface{}) diag.Diagn
 		return diag.Diagnostics{diag.NewErrorDiagnostic("Error converting value",
 			fmt.Sprintf("An unexpected error was encountered converting a %T to its equivalent Terraform representation. This is always a bug in the provider.\n\nError: %s", val, err))}
 	}
-	return reflect.Into(ctx, val.Type(ctx), raw, target, reflect.Options{})
+	return reflect.IntoAtPath(ctx, val.Type(ctx), raw, target, opts.reflectOptions(), path)
 }
@@ -12,37 +12,55 @@ var (
 	_ attr.Value = Bool{}
 )
 
+// BoolNull creates a Bool with a null value. Determine whether the value is
+// null via the Bool type IsNull method.
+func BoolNull() Bool {
+	return Bool{
+		state: attr.ValueStateNull,
+	}
+}
+
+// BoolUnknown creates a Bool with an unknown value. Determine whether the
+// value is unknown via the Bool type IsUnknown method.
+func BoolUnknown() Bool {
+	return Bool{
+		state: attr.ValueStateUnknown,
+	}
+}
+
+// BoolValue creates a Bool with a known value. Access the value via the Bool
+// type ValueBool method.
+func BoolValue(value bool) Bool {
+	return Bool{
+		state: attr.ValueStateKnown,
+		value: value,
+	}
+}
+
 func boolValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
 	if in.IsNull() {
-		return Bool{
-			Null: true,
-		}, nil
+		return BoolNull(), nil
 	}
 	if !in.IsKnown() {
-		return Bool{
-			Unknown: true,
-		}, nil
+		return BoolUnknown(), nil
 	}
 	var b bool
 	err := in.As(&b)
 	if err != nil {
 		return nil, err
 	}
-	return Bool{Value: b}, nil
+	return BoolValue(b), nil
 }
 
-// Bool represents a boolean value.
+// Bool represents a boolean value. The zero-value of Bool is null, use the
+// BoolNull, BoolUnknown, and BoolValue functions to create a Bool.
 type Bool struct {
-	// Unknown will be true if the value is not yet known.
-	Unknown bool
-
-	// Null will be true if the value was not set, or was explicitly set to
-	// null.
-	Null bool
+	// state represents whether the value is null, unknown, or known. The
+	// zero-value is null.
+	state attr.ValueState
 
-	// Value contains the set value, as long as Unknown and Null are both
-	// false.
-	Value bool
+	// value contains the known value, if not null or unknown.
+	value bool
 }
 
 // Type returns a BoolType.
@@ -52,16 +70,20 @@ func (b Bool) Type(_ context.Context) attr.Type {
 
 // ToTerraformValue returns the data contained in the Bool as a tftypes.Value.
 func (b Bool) ToTerraformValue(_ context.Context) (tftypes.Value, error) {
-	if b.Null {
+	switch b.state {
+	case attr.ValueStateKnown:
+		if err := tftypes.ValidateValue(tftypes.Bool, b.value); err != nil {
+			return tftypes.NewValue(tftypes.Bool, tftypes.UnknownValue), err
+		}
+
+		return tftypes.NewValue(tftypes.Bool, b.value), nil
+	case attr.ValueStateNull:
 		return tftypes.NewValue(tftypes.Bool, nil), nil
-	}
-	if b.Unknown {
+	case attr.ValueStateUnknown:
 		return tftypes.NewValue(tftypes.Bool, tftypes.UnknownValue), nil
+	default:
+		panic(fmt.Sprintf("unhandled Bool state in ToTerraformValue: %s", b.state))
 	}
-	if err := tftypes.ValidateValue(tftypes.Bool, b.Value); err != nil {
-		return tftypes.NewValue(tftypes.Bool, tftypes.UnknownValue), err
-	}
-	return tftypes.NewValue(tftypes.Bool, b.Value), nil
 }
 
 // Equal returns true if `other` is a *Bool and has the same value as `b`.
@@ -70,36 +92,42 @@ func (b Bool) Equal(other attr.Value) bool {
 	if !ok {
 		return false
 	}
-	if b.Unknown != o.Unknown {
+	if b.state != o.state {
 		return false
 	}
-	if b.Null != o.Null {
-		return false
+	if b.state != attr.ValueStateKnown {
+		return true
 	}
-	return b.Value == o.Value
+	return b.value == o.value
 }
 
 // IsNull returns true if the Bool represents a null value.
 func (b Bool) IsNull() bool {
-	return b.Null
+	return b.state == attr.ValueStateNull
 }
 
 // IsUnknown returns true if the Bool represents a currently unknown value.
 func (b Bool) IsUnknown() bool {
-	return b.Unknown
+	return b.state == attr.ValueStateUnknown
 }
 
 // String returns a human-readable representation of the Bool value.
 // The string returned here is not protected by any compatibility guarantees,
 // and is intended for logging and error reporting.
 func (b Bool) String() string {
-	if b.Unknown {
+	if b.IsUnknown() {
 		return attr.UnknownValueString
 	}
 
-	if b.Null {
+	if b.IsNull() {
 		return attr.NullValueString
 	}
 
-	return fmt.Sprintf("%t", b.Value)
+	return fmt.Sprintf("%t", b.value)
+}
+
+// ValueBool returns the known bool value. If Bool is null or unknown, returns
+// false.
+func (b Bool) ValueBool() bool {
+	return b.value
 }
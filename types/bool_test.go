@@ -24,19 +24,19 @@ func testBoolValueFromTerraform(t *testing.T, direct bool) {
 	tests := map[string]testCase{
 		"true": {
 			input:       tftypes.NewValue(tftypes.Bool, true),
-			expectation: Bool{Value: true},
+			expectation: BoolValue(true),
 		},
 		"false": {
 			input:       tftypes.NewValue(tftypes.Bool, false),
-			expectation: Bool{Value: false},
+			expectation: BoolValue(false),
 		},
 		"unknown": {
 			input:       tftypes.NewValue(tftypes.Bool, tftypes.UnknownValue),
-			expectation: Bool{Unknown: true},
+			expectation: BoolUnknown(),
 		},
 		"null": {
 			input:       tftypes.NewValue(tftypes.Bool, nil),
-			expectation: Bool{Null: true},
+			expectation: BoolNull(),
 		},
 		"wrongType": {
 			input:       tftypes.NewValue(tftypes.String, "oops"),
@@ -93,19 +93,19 @@ func TestBoolToTerraformValue(t *testing.T) {
 	}
 	tests := map[string]testCase{
 		"true": {
-			input:       Bool{Value: true},
+			input:       BoolValue(true),
 			expectation: tftypes.NewValue(tftypes.Bool, true),
 		},
 		"false": {
-			input:       Bool{Value: false},
+			input:       BoolValue(false),
 			expectation: tftypes.NewValue(tftypes.Bool, false),
 		},
 		"unknown": {
-			input:       Bool{Unknown: true},
+			input:       BoolUnknown(),
 			expectation: tftypes.NewValue(tftypes.Bool, tftypes.UnknownValue),
 		},
 		"null": {
-			input:       Bool{Null: true},
+			input:       BoolNull(),
 			expectation: tftypes.NewValue(tftypes.Bool, nil),
 		},
 	}
@@ -137,122 +137,122 @@ func TestBoolEqual(t *testing.T) {
 	}
 	tests := map[string]testCase{
 		"true-true": {
-			input:       Bool{Value: true},
-			candidate:   Bool{Value: true},
+			input:       BoolValue(true),
+			candidate:   BoolValue(true),
 			expectation: true,
 		},
 		"true-false": {
-			input:       Bool{Value: true},
-			candidate:   Bool{Value: false},
+			input:       BoolValue(true),
+			candidate:   BoolValue(false),
 			expectation: false,
 		},
 		"true-unknown": {
-			input:       Bool{Value: true},
-			candidate:   Bool{Unknown: true},
+			input:       BoolValue(true),
+			candidate:   BoolUnknown(),
 			expectation: false,
 		},
 		"true-null": {
-			input:       Bool{Value: true},
-			candidate:   Bool{Null: true},
+			input:       BoolValue(true),
+			candidate:   BoolNull(),
 			expectation: false,
 		},
 		"true-wrongType": {
-			input:       Bool{Value: true},
-			candidate:   &String{Value: "oops"},
+			input:       BoolValue(true),
+			candidate:   StringValue("oops"),
 			expectation: false,
 		},
 		"true-nil": {
-			input:       Bool{Value: true},
+			input:       BoolValue(true),
 			candidate:   nil,
 			expectation: false,
 		},
 		"false-true": {
-			input:       Bool{Value: false},
-			candidate:   Bool{Value: true},
+			input:       BoolValue(false),
+			candidate:   BoolValue(true),
 			expectation: false,
 		},
 		"false-false": {
-			input:       Bool{Value: false},
-			candidate:   Bool{Value: false},
+			input:       BoolValue(false),
+			candidate:   BoolValue(false),
 			expectation: true,
 		},
 		"false-unknown": {
-			input:       Bool{Value: false},
-			candidate:   Bool{Unknown: true},
+			input:       BoolValue(false),
+			candidate:   BoolUnknown(),
 			expectation: false,
 		},
 		"false-null": {
-			input:       Bool{Value: false},
-			candidate:   Bool{Null: true},
+			input:       BoolValue(false),
+			candidate:   BoolNull(),
 			expectation: false,
 		},
 		"false-wrongType": {
-			input:       Bool{Value: false},
-			candidate:   &String{Value: "oops"},
+			input:       BoolValue(false),
+			candidate:   StringValue("oops"),
 			expectation: false,
 		},
 		"false-nil": {
-			input:       Bool{Value: false},
+			input:       BoolValue(false),
 			candidate:   nil,
 			expectation: false,
 		},
 		"unknown-true": {
-			input:       Bool{Unknown: true},
-			candidate:   Bool{Value: true},
+			input:       BoolUnknown(),
+			candidate:   BoolValue(true),
 			expectation: false,
 		},
 		"unknown-false": {
-			input:       Bool{Unknown: true},
-			candidate:   Bool{Value: false},
+			input:       BoolUnknown(),
+			candidate:   BoolValue(false),
 			expectation: false,
 		},
 		"unknown-unknown": {
-			input:       Bool{Unknown: true},
-			candidate:   Bool{Unknown: true},
+			input:       BoolUnknown(),
+			candidate:   BoolUnknown(),
 			expectation: true,
 		},
 		"unknown-null": {
-			input:       Bool{Unknown: true},
-			candidate:   Bool{Null: true},
+			input:       BoolUnknown(),
+			candidate:   BoolNull(),
 			expectation: false,
 		},
 		"unknown-wrongType": {
-			input:       Bool{Unknown: true},
-			candidate:   &String{Value: "oops"},
+			input:       BoolUnknown(),
+			candidate:   StringValue("oops"),
 			expectation: false,
 		},
 		"unknown-nil": {
-			input:       Bool{Unknown: true},
+			input:       BoolUnknown(),
 			candidate:   nil,
 			expectation: false,
 		},
 		"null-true": {
-			input:       Bool{Null: true},
-			candidate:   Bool{Value: true},
+			input:       BoolNull(),
+			candidate:   BoolValue(true),
 			expectation: false,
 		},
 		"null-false": {
-			input:       Bool{Null: true},
-			candidate:   Bool{Value: false},
+			input:       BoolNull(),
+			candidate:   BoolValue(false),
 			expectation: false,
 		},
 		"null-unknown": {
-			input:       Bool{Null: true},
-			candidate:   Bool{Unknown: true},
+			input:       BoolNull(),
+			candidate:   BoolUnknown(),
 			expectation: false,
 		},
 		"null-null": {
-			input:       Bool{Null: true},
-			candidate:   Bool{Null: true},
+			input:       BoolNull(),
+			candidate:   BoolNull(),
 			expectation: true,
 		},
 		"null-wrongType": {
-			input:       Bool{Null: true},
-			candidate:   &String{Value: "oops"},
+			input:       BoolNull(),
+			candidate:   StringValue("oops"),
 			expectation: false,
 		},
 		"null-nil": {
-			input:       Bool{Null: true},
+			input:       BoolNull(),
 			candidate:   nil,
 			expectation: false,
 		},
@@ -279,24 +279,24 @@ func TestBoolString(t *testing.T) {
 	}
 	tests := map[string]testCase{
 		"true": {
-			input:       Bool{Value: true},
+			input:       BoolValue(true),
 			expectation: "true",
 		},
 		"false": {
-			input:       Bool{Value: false},
+			input:       BoolValue(false),
 			expectation: "false",
 		},
 		"unknown": {
-			input:       Bool{Unknown: true},
+			input:       BoolUnknown(),
 			expectation: "<unknown>",
 		},
 		"null": {
-			input:       Bool{Null: true},
+			input:       BoolNull(),
 			expectation: "<null>",
 		},
-		"default-false": {
+		"zero-value": {
 			input:       Bool{},
-			expectation: "false",
+			expectation: "<null>",
 		},
 	}
 
@@ -312,3 +312,39 @@ func TestBoolString(t *testing.T) {
 		})
 	}
 }
+
+func TestBoolValueBool(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    Bool
+		expected bool
+	}{
+		"known": {
+			input:    BoolValue(true),
+			expected: true,
+		},
+		"null": {
+			input:    BoolNull(),
+			expected: false,
+		},
+		"unknown": {
+			input:    BoolUnknown(),
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.input.ValueBool()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,225 @@
+package types
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ attr.Value = Float32{}
+)
+
+func float32Validate(_ context.Context, in tftypes.Value, path path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if in.Type() == nil {
+		return diags
+	}
+
+	if !in.Type().Equal(tftypes.Number) {
+		diags.AddAttributeError(
+			path,
+			"Float32 Type Validation Error",
+			"An unexpected error was encountered trying to validate an attribute value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("Expected Number value, received %T with value: %v", in, in),
+		)
+		return diags
+	}
+
+	if !in.IsKnown() || in.IsNull() {
+		return diags
+	}
+
+	var value *big.Float
+	err := in.As(&value)
+
+	if err != nil {
+		diags.AddAttributeError(
+			path,
+			"Float32 Type Validation Error",
+			"An unexpected error was encountered trying to validate an attribute value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("Cannot convert value to big.Float: %s", err),
+		)
+		return diags
+	}
+
+	if _, ok := float32FromBigFloat(value); !ok {
+		diags.AddAttributeError(
+			path,
+			"Float32 Type Validation Error",
+			fmt.Sprintf("Value %s cannot be represented as a 32-bit floating point.", value.Text('g', -1)),
+		)
+		return diags
+	}
+
+	return diags
+}
+
+// Float32Null creates a Float32 with a null value. Determine whether the value
+// is null via the Float32 type IsNull method.
+func Float32Null() Float32 {
+	return Float32{
+		state: attr.ValueStateNull,
+	}
+}
+
+// Float32Unknown creates a Float32 with an unknown value. Determine whether
+// the value is unknown via the Float32 type IsUnknown method.
+func Float32Unknown() Float32 {
+	return Float32{
+		state: attr.ValueStateUnknown,
+	}
+}
+
+// Float32Value creates a Float32 with a known value. Access the value via
+// the Float32 type ValueFloat32 method.
+func Float32Value(value float32) Float32 {
+	return Float32{
+		state: attr.ValueStateKnown,
+		value: value,
+	}
+}
+
+func float32ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	if !in.IsKnown() {
+		return Float32Unknown(), nil
+	}
+
+	if in.IsNull() {
+		return Float32Null(), nil
+	}
+
+	var bigF *big.Float
+	err := in.As(&bigF)
+
+	if err != nil {
+		return nil, err
+	}
+
+	f, ok := float32FromBigFloat(bigF)
+
+	if !ok {
+		return nil, fmt.Errorf("Value %s cannot be represented as a 32-bit floating point.", bigF.Text('g', -1))
+	}
+
+	return Float32Value(f), nil
+}
+
+// float32FromBigFloat returns the nearest float32 to the given *big.Float.
+// Unlike Float64, precision loss is expected for most decimal values, so only
+// values outside the float32 range, which would overflow to infinity or
+// underflow to zero, are reported as not representable.
+func float32FromBigFloat(in *big.Float) (float32, bool) {
+	f, _ := in.Float32()
+
+	if math.IsInf(float64(f), 0) {
+		return 0, false
+	}
+
+	if f == 0 && in.Sign() != 0 {
+		return 0, false
+	}
+
+	return f, true
+}
+
+// Float32 represents a 32-bit floating point value, exposed as a float32. The
+// zero-value of Float32 is null, use the Float32Null, Float32Unknown, and
+// Float32Value functions to create a Float32.
+type Float32 struct {
+	// state represents whether the value is null, unknown, or known. The
+	// zero-value is null.
+	state attr.ValueState
+
+	// value contains the known value, if not null or unknown.
+	value float32
+}
+
+// Equal returns true if `other` is a Float32 and has the same value as `f`.
+func (f Float32) Equal(other attr.Value) bool {
+	o, ok := other.(Float32)
+
+	if !ok {
+		return false
+	}
+
+	if f.state != o.state {
+		return false
+	}
+
+	if f.state != attr.ValueStateKnown {
+		return true
+	}
+
+	return f.value == o.value
+}
+
+// ToTerraformValue returns the data contained in the Float32 as a tftypes.Value.
+func (f Float32) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	switch f.state {
+	case attr.ValueStateKnown:
+		// Use the shortest decimal representation of the float32, rather
+		// than its exact float64 expansion, so values such as 1.1 are not
+		// sent to Terraform as 1.100000023841858.
+		bf, _, err := big.ParseFloat(strconv.FormatFloat(float64(f.value), 'g', -1, 32), 10, 512, big.ToNearestEven)
+		if err != nil {
+			return tftypes.NewValue(tftypes.Number, tftypes.UnknownValue), err
+		}
+
+		if err := tftypes.ValidateValue(tftypes.Number, bf); err != nil {
+			return tftypes.NewValue(tftypes.Number, tftypes.UnknownValue), err
+		}
+
+		return tftypes.NewValue(tftypes.Number, bf), nil
+	case attr.ValueStateNull:
+		return tftypes.NewValue(tftypes.Number, nil), nil
+	case attr.ValueStateUnknown:
+		return tftypes.NewValue(tftypes.Number, tftypes.UnknownValue), nil
+	default:
+		panic(fmt.Sprintf("unhandled Float32 state in ToTerraformValue: %s", f.state))
+	}
+}
+
+// Type returns a Float32Type.
+func (f Float32) Type(ctx context.Context) attr.Type {
+	return Float32Type
+}
+
+// IsNull returns true if the Float32 represents a null value.
+func (f Float32) IsNull() bool {
+	return f.state == attr.ValueStateNull
+}
+
+// IsUnknown returns true if the Float32 represents a currently unknown value.
+func (f Float32) IsUnknown() bool {
+	return f.state == attr.ValueStateUnknown
+}
+
+// String returns a human-readable representation of the Float32 value.
+// The string returned here is not protected by any compatibility guarantees,
+// and is intended for logging and error reporting.
+func (f Float32) String() string {
+	if f.IsUnknown() {
+		return attr.UnknownValueString
+	}
+
+	if f.IsNull() {
+		return attr.NullValueString
+	}
+
+	return fmt.Sprintf("%f", f.value)
+}
+
+// ValueFloat32 returns the known float32 value. If Float32 is null or unknown,
+// returns 0.0.
+func (f Float32) ValueFloat32() float32 {
+	return f.value
+}
@@ -0,0 +1,346 @@
+package types
+
+import (
+	"context"
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestFloat32ValueFromTerraform(t *testing.T) {
+	t.Parallel()
+
+	testFloat32ValueFromTerraform(t, true)
+}
+
+func testFloat32ValueFromTerraform(t *testing.T, direct bool) {
+	type testCase struct {
+		input       tftypes.Value
+		expectation attr.Value
+		expectedErr string
+	}
+	tests := map[string]testCase{
+		"value-int": {
+			input:       tftypes.NewValue(tftypes.Number, 123),
+			expectation: Float32Value(123.0),
+		},
+		"value-float": {
+			input:       tftypes.NewValue(tftypes.Number, 123.456),
+			expectation: Float32Value(123.456),
+		},
+		"unknown": {
+			input:       tftypes.NewValue(tftypes.Number, tftypes.UnknownValue),
+			expectation: Float32Unknown(),
+		},
+		"null": {
+			input:       tftypes.NewValue(tftypes.Number, nil),
+			expectation: Float32Null(),
+		},
+		"wrongType": {
+			input:       tftypes.NewValue(tftypes.String, "oops"),
+			expectedErr: "can't unmarshal tftypes.String into *big.Float, expected *big.Float",
+		},
+		"overflow": {
+			input:       tftypes.NewValue(tftypes.Number, math.MaxFloat64),
+			expectedErr: "Value 1.7976931348623157e+308 cannot be represented as a 32-bit floating point.",
+		},
+		"underflow": {
+			input:       tftypes.NewValue(tftypes.Number, math.SmallestNonzeroFloat64),
+			expectedErr: "Value 4.940656458412465e-324 cannot be represented as a 32-bit floating point.",
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+
+			f := Float32Type.ValueFromTerraform
+			if direct {
+				f = float32ValueFromTerraform
+			}
+			got, err := f(ctx, test.input)
+			if err != nil {
+				if test.expectedErr == "" {
+					t.Errorf("Unexpected error: %s", err)
+					return
+				}
+				if test.expectedErr != err.Error() {
+					t.Errorf("Expected error to be %q, got %q", test.expectedErr, err.Error())
+					return
+				}
+				// we have an error, and it matches our
+				// expectations, we're good
+				return
+			}
+			if err == nil && test.expectedErr != "" {
+				t.Errorf("Expected error to be %q, didn't get an error", test.expectedErr)
+				return
+			}
+			if diff := cmp.Diff(got, test.expectation); diff != "" {
+				t.Errorf("Unexpected response (+wanted, -got): %s", diff)
+			}
+			if test.expectation.IsNull() != test.input.IsNull() {
+				t.Errorf("Expected null-ness match: expected %t, got %t", test.expectation.IsNull(), test.input.IsNull())
+			}
+			if test.expectation.IsUnknown() != !test.input.IsKnown() {
+				t.Errorf("Expected unknown-ness match: expected %t, got %t", test.expectation.IsUnknown(), !test.input.IsKnown())
+			}
+		})
+	}
+}
+
+func TestFloat32ToTerraformValue(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		input       Float32
+		expectation interface{}
+	}
+	tests := map[string]testCase{
+		"value-int": {
+			input:       Float32Value(123),
+			expectation: tftypes.NewValue(tftypes.Number, big.NewFloat(123.0)),
+		},
+		"value-float": {
+			input:       Float32Value(123.456),
+			expectation: tftypes.NewValue(tftypes.Number, testMustParseFloat("123.456")),
+		},
+		"unknown": {
+			input:       Float32Unknown(),
+			expectation: tftypes.NewValue(tftypes.Number, tftypes.UnknownValue),
+		},
+		"null": {
+			input:       Float32Null(),
+			expectation: tftypes.NewValue(tftypes.Number, nil),
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+
+			got, err := test.input.ToTerraformValue(ctx)
+			if err != nil {
+				t.Errorf("Unexpected error: %s", err)
+				return
+			}
+			if !cmp.Equal(got, test.expectation, cmp.Comparer(numberComparer)) {
+				t.Errorf("Expected %+v, got %+v", test.expectation, got)
+			}
+		})
+	}
+}
+
+func TestFloat32Equal(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		input       Float32
+		candidate   attr.Value
+		expectation bool
+	}
+	tests := map[string]testCase{
+		"value-value-same": {
+			input:       Float32Value(123),
+			candidate:   Float32Value(123),
+			expectation: true,
+		},
+		"value-value-diff": {
+			input:       Float32Value(123),
+			candidate:   Float32Value(456),
+			expectation: false,
+		},
+		"value-unknown": {
+			input:       Float32Value(123),
+			candidate:   Float32Unknown(),
+			expectation: false,
+		},
+		"value-null": {
+			input:       Float32Value(123),
+			candidate:   Float32Null(),
+			expectation: false,
+		},
+		"value-wrongType": {
+			input:       Float32Value(123),
+			candidate:   StringValue("oops"),
+			expectation: false,
+		},
+		"value-nil": {
+			input:       Float32Value(123),
+			candidate:   nil,
+			expectation: false,
+		},
+		"unknown-value": {
+			input:       Float32Unknown(),
+			candidate:   Float32Value(123),
+			expectation: false,
+		},
+		"unknown-unknown": {
+			input:       Float32Unknown(),
+			candidate:   Float32Unknown(),
+			expectation: true,
+		},
+		"unknown-null": {
+			input:       Float32Unknown(),
+			candidate:   Float32Null(),
+			expectation: false,
+		},
+		"unknown-wrongType": {
+			input:       Float32Unknown(),
+			candidate:   StringValue("oops"),
+			expectation: false,
+		},
+		"unknown-nil": {
+			input:       Float32Unknown(),
+			candidate:   nil,
+			expectation: false,
+		},
+		"null-value": {
+			input:       Float32Null(),
+			candidate:   Float32Value(123),
+			expectation: false,
+		},
+		"null-unknown": {
+			input:       Float32Null(),
+			candidate:   Float32Unknown(),
+			expectation: false,
+		},
+		"null-null": {
+			input:       Float32Null(),
+			candidate:   Float32Null(),
+			expectation: true,
+		},
+		"null-wrongType": {
+			input:       Float32Null(),
+			candidate:   StringValue("oops"),
+			expectation: false,
+		},
+		"null-nil": {
+			input:       Float32Null(),
+			candidate:   nil,
+			expectation: false,
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := test.input.Equal(test.candidate)
+			if !cmp.Equal(got, test.expectation) {
+				t.Errorf("Expected %v, got %v", test.expectation, got)
+			}
+		})
+	}
+}
+
+func TestFloat32String(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		input       Float32
+		expectation string
+	}
+	tests := map[string]testCase{
+		"less-than-one": {
+			input:       Float32Value(0.12340984302980000),
+			expectation: "0.123410",
+		},
+		"more-than-one": {
+			input:       Float32Value(92387938.173219),
+			expectation: "92387936.000000",
+		},
+		"negative-more-than-one": {
+			input:       Float32Value(-0.12340984302980000),
+			expectation: "-0.123410",
+		},
+		"negative-less-than-one": {
+			input:       Float32Value(-92387938.173219),
+			expectation: "-92387936.000000",
+		},
+		"min-float32": {
+			input:       Float32Value(math.SmallestNonzeroFloat32),
+			expectation: "0.000000",
+		},
+		"max-float32": {
+			input:       Float32Value(math.MaxFloat32),
+			expectation: "340282346638528859811704183484516925440.000000",
+		},
+		"unknown": {
+			input:       Float32Unknown(),
+			expectation: "<unknown>",
+		},
+		"null": {
+			input:       Float32Null(),
+			expectation: "<null>",
+		},
+		"zero-value": {
+			input:       Float32{},
+			expectation: "<null>",
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := test.input.String()
+			if !cmp.Equal(got, test.expectation) {
+				t.Errorf("Expected %q, got %q", test.expectation, got)
+			}
+		})
+	}
+}
+
+func TestFloat32ValueFloat32(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    Float32
+		expected float32
+	}{
+		"known": {
+			input:    Float32Value(2.4),
+			expected: 2.4,
+		},
+		"null": {
+			input:    Float32Null(),
+			expected: 0.0,
+		},
+		"unknown": {
+			input:    Float32Unknown(),
+			expected: 0.0,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.input.ValueFloat32()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func testMustParseFloat(s string) *big.Float {
+	f, _, err := big.ParseFloat(s, 10, 512, big.ToNearestEven)
+
+	if err != nil {
+		panic(err)
+	}
+
+	return f
+}
@@ -55,7 +55,7 @@ func float64Validate(_ context.Context, in tftypes.Value, path path.Path) diag.D
 		diags.AddAttributeError(
 			path,
 			"Float64 Type Validation Error",
-			fmt.Sprintf("Value %s cannot be represented as a 64-bit floating point.", value),
+			fmt.Sprintf("Value %s cannot be represented as a 64-bit floating point.", value.Text('g', -1)),
 		)
 		return diags
 	}
@@ -63,13 +63,38 @@ func float64Validate(_ context.Context, in tftypes.Value, path path.Path) diag.D
 	return diags
 }
 
+// Float64Null creates a Float64 with a null value. Determine whether the value
+// is null via the Float64 type IsNull method.
+func Float64Null() Float64 {
+	return Float64{
+		state: attr.ValueStateNull,
+	}
+}
+
+// Float64Unknown creates a Float64 with an unknown value. Determine whether
+// the value is unknown via the Float64 type IsUnknown method.
+func Float64Unknown() Float64 {
+	return Float64{
+		state: attr.ValueStateUnknown,
+	}
+}
+
+// Float64Value creates a Float64 with a known value. Access the value via
+// the Float64 type ValueFloat64 method.
+func Float64Value(value float64) Float64 {
+	return Float64{
+		state: attr.ValueStateKnown,
+		value: value,
+	}
+}
+
 func float64ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
 	if !in.IsKnown() {
-		return Float64{Unknown: true}, nil
+		return Float64Unknown(), nil
 	}
 
 	if in.IsNull() {
-		return Float64{Null: true}, nil
+		return Float64Null(), nil
 	}
 
 	var bigF *big.Float
@@ -82,24 +107,22 @@ func float64ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Valu
 	f, accuracy := bigF.Float64()
 
 	if accuracy != 0 {
-		return nil, fmt.Errorf("Value %s cannot be represented as a 64-bit floating point.", bigF)
+		return nil, fmt.Errorf("Value %s cannot be represented as a 64-bit floating point.", bigF.Text('g', -1))
 	}
 
-	return Float64{Value: f}, nil
+	return Float64Value(f), nil
 }
 
-// Float64 represents a 64-bit floating point value, exposed as a float64.
+// Float64 represents a 64-bit floating point value, exposed as a float64. The
+// zero-value of Float64 is null, use the Float64Null, Float64Unknown, and
+// Float64Value functions to create a Float64.
 type Float64 struct {
-	// Unknown will be true if the value is not yet known.
-	Unknown bool
+	// state represents whether the value is null, unknown, or known. The
+	// zero-value is null.
+	state attr.ValueState
 
-	// Null will be true if the value was not set, or was explicitly set to
-	// null.
-	Null bool
-
-	// Value contains the set value, as long as Unknown and Null are both
-	// false.
-	Value float64
+	// value contains the known value, if not null or unknown.
+	value float64
 }
 
 // Equal returns true if `other` is a Float64 and has the same value as `f`.
@@ -110,32 +133,34 @@ func (f Float64) Equal(other attr.Value) bool {
 		return false
 	}
 
-	if f.Unknown != o.Unknown {
+	if f.state != o.state {
 		return false
 	}
 
-	if f.Null != o.Null {
-		return false
+	if f.state != attr.ValueStateKnown {
+		return true
 	}
 
-	return f.Value == o.Value
+	return f.value == o.value
 }
 
 // ToTerraformValue returns the data contained in the Float64 as a tftypes.Value.
 func (f Float64) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
-	if f.Null {
+	switch f.state {
+	case attr.ValueStateKnown:
+		bf := big.NewFloat(f.value)
+		if err := tftypes.ValidateValue(tftypes.Number, bf); err != nil {
+			return tftypes.NewValue(tftypes.Number, tftypes.UnknownValue), err
+		}
+
+		return tftypes.NewValue(tftypes.Number, bf), nil
+	case attr.ValueStateNull:
 		return tftypes.NewValue(tftypes.Number, nil), nil
-	}
-
-	if f.Unknown {
+	case attr.ValueStateUnknown:
 		return tftypes.NewValue(tftypes.Number, tftypes.UnknownValue), nil
+	default:
+		panic(fmt.Sprintf("unhandled Float64 state in ToTerraformValue: %s", f.state))
 	}
-
-	bf := big.NewFloat(f.Value)
-	if err := tftypes.ValidateValue(tftypes.Number, bf); err != nil {
-		return tftypes.NewValue(tftypes.Number, tftypes.UnknownValue), err
-	}
-	return tftypes.NewValue(tftypes.Number, bf), nil
 }
 
 // Type returns a Float64Type.
@@ -145,25 +170,31 @@ func (f Float64) Type(ctx context.Context) attr.Type {
 
 // IsNull returns true if the Float64 represents a null value.
 func (f Float64) IsNull() bool {
-	return f.Null
+	return f.state == attr.ValueStateNull
 }
 
 // IsUnknown returns true if the Float64 represents a currently unknown value.
 func (f Float64) IsUnknown() bool {
-	return f.Unknown
+	return f.state == attr.ValueStateUnknown
 }
 
 // String returns a human-readable representation of the Float64 value.
 // The string returned here is not protected by any compatibility guarantees,
 // and is intended for logging and error reporting.
 func (f Float64) String() string {
-	if f.Unknown {
+	if f.IsUnknown() {
 		return attr.UnknownValueString
 	}
 
-	if f.Null {
+	if f.IsNull() {
 		return attr.NullValueString
 	}
 
-	return fmt.Sprintf("%f", f.Value)
+	return fmt.Sprintf("%f", f.value)
+}
+
+// ValueFloat64 returns the known float64 value. If Float64 is null or unknown,
+// returns 0.0.
+func (f Float64) ValueFloat64() float64 {
+	return f.value
 }
@@ -26,19 +26,19 @@ func testFloat64ValueFromTerraform(t *testing.T, direct bool) {
 	tests := map[string]testCase{
 		"value-int": {
 			input:       tftypes.NewValue(tftypes.Number, 123),
-			expectation: Float64{Value: 123.0},
+			expectation: Float64Value(123.0),
 		},
 		"value-float": {
 			input:       tftypes.NewValue(tftypes.Number, 123.456),
-			expectation: Float64{Value: 123.456},
+			expectation: Float64Value(123.456),
 		},
 		"unknown": {
 			input:       tftypes.NewValue(tftypes.Number, tftypes.UnknownValue),
-			expectation: Float64{Unknown: true},
+			expectation: Float64Unknown(),
 		},
 		"null": {
 			input:       tftypes.NewValue(tftypes.Number, nil),
-			expectation: Float64{Null: true},
+			expectation: Float64Null(),
 		},
 		"wrongType": {
 			input:       tftypes.NewValue(tftypes.String, "oops"),
@@ -95,19 +95,19 @@ func TestFloat64ToTerraformValue(t *testing.T) {
 	}
 	tests := map[string]testCase{
 		"value-int": {
-			input:       Float64{Value: 123},
+			input:       Float64Value(123),
 			expectation: tftypes.NewValue(tftypes.Number, big.NewFloat(123.0)),
 		},
 		"value-float": {
-			input:       Float64{Value: 123.456},
+			input:       Float64Value(123.456),
 			expectation: tftypes.NewValue(tftypes.Number, big.NewFloat(123.456)),
 		},
 		"unknown": {
-			input:       Float64{Unknown: true},
+			input:       Float64Unknown(),
 			expectation: tftypes.NewValue(tftypes.Number, tftypes.UnknownValue),
 		},
 		"null": {
-			input:       Float64{Null: true},
+			input:       Float64Null(),
 			expectation: tftypes.NewValue(tftypes.Number, nil),
 		},
 	}
@@ -139,82 +139,82 @@ func TestFloat64Equal(t *testing.T) {
 	}
 	tests := map[string]testCase{
 		"value-value-same": {
-			input:       Float64{Value: 123},
-			candidate:   Float64{Value: 123},
+			input:       Float64Value(123),
+			candidate:   Float64Value(123),
 			expectation: true,
 		},
 		"value-value-diff": {
-			input:       Float64{Value: 123},
-			candidate:   Float64{Value: 456},
+			input:       Float64Value(123),
+			candidate:   Float64Value(456),
 			expectation: false,
 		},
 		"value-unknown": {
-			input:       Float64{Value: 123},
-			candidate:   Float64{Unknown: true},
+			input:       Float64Value(123),
+			candidate:   Float64Unknown(),
 			expectation: false,
 		},
 		"value-null": {
-			input:       Float64{Value: 123},
-			candidate:   Float64{Null: true},
+			input:       Float64Value(123),
+			candidate:   Float64Null(),
 			expectation: false,
 		},
 		"value-wrongType": {
-			input:       Float64{Value: 123},
-			candidate:   &String{Value: "oops"},
+			input:       Float64Value(123),
+			candidate:   StringValue("oops"),
 			expectation: false,
 		},
 		"value-nil": {
-			input:       Float64{Value: 123},
+			input:       Float64Value(123),
 			candidate:   nil,
 			expectation: false,
 		},
 		"unknown-value": {
-			input:       Float64{Unknown: true},
-			candidate:   Float64{Value: 123},
+			input:       Float64Unknown(),
+			candidate:   Float64Value(123),
 			expectation: false,
 		},
 		"unknown-unknown": {
-			input:       Float64{Unknown: true},
-			candidate:   Float64{Unknown: true},
+			input:       Float64Unknown(),
+			candidate:   Float64Unknown(),
 			expectation: true,
 		},
 		"unknown-null": {
-			input:       Float64{Unknown: true},
-			candidate:   Float64{Null: true},
+			input:       Float64Unknown(),
+			candidate:   Float64Null(),
 			expectation: false,
 		},
 		"unknown-wrongType": {
-			input:       Float64{Unknown: true},
-			candidate:   &String{Value: "oops"},
+			input:       Float64Unknown(),
+			candidate:   StringValue("oops"),
 			expectation: false,
 		},
 		"unknown-nil": {
-			input:       Float64{Unknown: true},
+			input:       Float64Unknown(),
 			candidate:   nil,
 			expectation: false,
 		},
 		"null-value": {
-			input:       Float64{Null: true},
-			candidate:   Float64{Value: 123},
+			input:       Float64Null(),
+			candidate:   Float64Value(123),
 			expectation: false,
 		},
 		"null-unknown": {
-			input:       Float64{Null: true},
-			candidate:   Float64{Unknown: true},
+			input:       Float64Null(),
+			candidate:   Float64Unknown(),
 			expectation: false,
 		},
 		"null-null": {
-			input:       Float64{Null: true},
-			candidate:   Float64{Null: true},
+			input:       Float64Null(),
+			candidate:   Float64Null(),
 			expectation: true,
 		},
 		"null-wrongType": {
-			input:       Float64{Null: true},
-			candidate:   &String{Value: "oops"},
+			input:       Float64Null(),
+			candidate:   StringValue("oops"),
 			expectation: false,
 		},
 		"null-nil": {
-			input:       Float64{Null: true},
+			input:       Float64Null(),
 			candidate:   nil,
 			expectation: false,
 		},
@@ -241,40 +241,40 @@ func TestFloat64String(t *testing.T) {
 	}
 	tests := map[string]testCase{
 		"less-than-one": {
-			input:       Float64{Value: 0.12340984302980000},
+			input:       Float64Value(0.12340984302980000),
 			expectation: "0.123410",
 		},
 		"more-than-one": {
-			input:       Float64{Value: 92387938173219.327663},
+			input:       Float64Value(92387938173219.327663),
 			expectation: "92387938173219.328125",
 		},
 		"negative-more-than-one": {
-			input:       Float64{Value: -0.12340984302980000},
+			input:       Float64Value(-0.12340984302980000),
 			expectation: "-0.123410",
 		},
 		"negative-less-than-one": {
-			input:       Float64{Value: -92387938173219.327663},
+			input:       Float64Value(-92387938173219.327663),
 			expectation: "-92387938173219.328125",
 		},
 		"min-float64": {
-			input:       Float64{Value: math.SmallestNonzeroFloat64},
+			input:       Float64Value(math.SmallestNonzeroFloat64),
 			expectation: "0.000000",
 		},
 		"max-float64": {
-			input:       Float64{Value: math.MaxFloat64},
+			input:       Float64Value(math.MaxFloat64),
 			expectation: "179769313486231570814527423731704356798070567525844996598917476803157260780028538760589558632766878171540458953514382464234321326889464182768467546703537516986049910576551282076245490090389328944075868508455133942304583236903222948165808559332123348274797826204144723168738177180919299881250404026184124858368.000000",
 		},
 		"unknown": {
-			input:       Float64{Unknown: true},
+			input:       Float64Unknown(),
 			expectation: "<unknown>",
 		},
 		"null": {
-			input:       Float64{Null: true},
+			input:       Float64Null(),
 			expectation: "<null>",
 		},
-		"default-0": {
+		"zero-value": {
 			input:       Float64{},
-			expectation: "0.000000",
+			expectation: "<null>",
 		},
 	}
 
@@ -290,3 +290,39 @@ func TestFloat64String(t *testing.T) {
 		})
 	}
 }
+
+func TestFloat64ValueFloat64(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    Float64
+		expected float64
+	}{
+		"known": {
+			input:    Float64Value(2.4),
+			expected: 2.4,
+		},
+		"null": {
+			input:    Float64Null(),
+			expected: 0.0,
+		},
+		"unknown": {
+			input:    Float64Unknown(),
+			expected: 0.0,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.input.ValueFloat64()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,214 @@
+package types
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ attr.Value = Int32{}
+)
+
+func int32Validate(_ context.Context, in tftypes.Value, path path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if in.Type() == nil {
+		return diags
+	}
+
+	if !in.Type().Equal(tftypes.Number) {
+		diags.AddAttributeError(
+			path,
+			"Int32 Type Validation Error",
+			"An unexpected error was encountered trying to validate an attribute value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("Expected Number value, received %T with value: %v", in, in),
+		)
+		return diags
+	}
+
+	if !in.IsKnown() || in.IsNull() {
+		return diags
+	}
+
+	var value *big.Float
+	err := in.As(&value)
+
+	if err != nil {
+		diags.AddAttributeError(
+			path,
+			"Int32 Type Validation Error",
+			"An unexpected error was encountered trying to validate an attribute value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("Cannot convert value to big.Float: %s", err),
+		)
+		return diags
+	}
+
+	if !value.IsInt() {
+		diags.AddAttributeError(
+			path,
+			"Int32 Type Validation Error",
+			fmt.Sprintf("Value %s is not an integer.", value.Text('f', -1)),
+		)
+		return diags
+	}
+
+	i, accuracy := value.Int64()
+
+	if accuracy != 0 || i < math.MinInt32 || i > math.MaxInt32 {
+		diags.AddAttributeError(
+			path,
+			"Int32 Type Validation Error",
+			fmt.Sprintf("Value %s cannot be represented as a 32-bit integer.", value.Text('f', -1)),
+		)
+		return diags
+	}
+
+	return diags
+}
+
+// Int32Null creates an Int32 with a null value. Determine whether the value
+// is null via the Int32 type IsNull method.
+func Int32Null() Int32 {
+	return Int32{
+		state: attr.ValueStateNull,
+	}
+}
+
+// Int32Unknown creates an Int32 with an unknown value. Determine whether
+// the value is unknown via the Int32 type IsUnknown method.
+func Int32Unknown() Int32 {
+	return Int32{
+		state: attr.ValueStateUnknown,
+	}
+}
+
+// Int32Value creates an Int32 with a known value. Access the value via
+// the Int32 type ValueInt32 method.
+func Int32Value(value int32) Int32 {
+	return Int32{
+		state: attr.ValueStateKnown,
+		value: value,
+	}
+}
+
+func int32ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	if !in.IsKnown() {
+		return Int32Unknown(), nil
+	}
+
+	if in.IsNull() {
+		return Int32Null(), nil
+	}
+
+	var bigF *big.Float
+	err := in.As(&bigF)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !bigF.IsInt() {
+		return nil, fmt.Errorf("Value %s is not an integer.", bigF.Text('f', -1))
+	}
+
+	i, accuracy := bigF.Int64()
+
+	if accuracy != 0 || i < math.MinInt32 || i > math.MaxInt32 {
+		return nil, fmt.Errorf("Value %s cannot be represented as a 32-bit integer.", bigF.Text('f', -1))
+	}
+
+	return Int32Value(int32(i)), nil
+}
+
+// Int32 represents a 32-bit integer value, exposed as an int32. The
+// zero-value of Int32 is null, use the Int32Null, Int32Unknown, and Int32Value
+// functions to create an Int32.
+type Int32 struct {
+	// state represents whether the value is null, unknown, or known. The
+	// zero-value is null.
+	state attr.ValueState
+
+	// value contains the known value, if not null or unknown.
+	value int32
+}
+
+// Equal returns true if `other` is an Int32 and has the same value as `i`.
+func (i Int32) Equal(other attr.Value) bool {
+	o, ok := other.(Int32)
+
+	if !ok {
+		return false
+	}
+
+	if i.state != o.state {
+		return false
+	}
+
+	if i.state != attr.ValueStateKnown {
+		return true
+	}
+
+	return i.value == o.value
+}
+
+// ToTerraformValue returns the data contained in the Int32 as a tftypes.Value.
+func (i Int32) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	switch i.state {
+	case attr.ValueStateKnown:
+		bf := new(big.Float).SetInt64(int64(i.value))
+		if err := tftypes.ValidateValue(tftypes.Number, bf); err != nil {
+			return tftypes.NewValue(tftypes.Number, tftypes.UnknownValue), err
+		}
+
+		return tftypes.NewValue(tftypes.Number, bf), nil
+	case attr.ValueStateNull:
+		return tftypes.NewValue(tftypes.Number, nil), nil
+	case attr.ValueStateUnknown:
+		return tftypes.NewValue(tftypes.Number, tftypes.UnknownValue), nil
+	default:
+		panic(fmt.Sprintf("unhandled Int32 state in ToTerraformValue: %s", i.state))
+	}
+}
+
+// Type returns a Int32Type.
+func (i Int32) Type(ctx context.Context) attr.Type {
+	return Int32Type
+}
+
+// IsNull returns true if the Int32 represents a null value.
+func (i Int32) IsNull() bool {
+	return i.state == attr.ValueStateNull
+}
+
+// IsUnknown returns true if the Int32 represents a currently unknown value.
+func (i Int32) IsUnknown() bool {
+	return i.state == attr.ValueStateUnknown
+}
+
+// String returns a human-readable representation of the Int32 value.
+// The string returned here is not protected by any compatibility guarantees,
+// and is intended for logging and error reporting.
+func (i Int32) String() string {
+	if i.IsUnknown() {
+		return attr.UnknownValueString
+	}
+
+	if i.IsNull() {
+		return attr.NullValueString
+	}
+
+	return fmt.Sprintf("%d", i.value)
+}
+
+// ValueInt32 returns the known int32 value. If Int32 is null or unknown,
+// returns 0.
+func (i Int32) ValueInt32() int32 {
+	return i.value
+}
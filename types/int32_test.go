@@ -0,0 +1,324 @@
+package types
+
+import (
+	"context"
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestInt32ValueFromTerraform(t *testing.T) {
+	t.Parallel()
+
+	testInt32ValueFromTerraform(t, true)
+}
+
+func testInt32ValueFromTerraform(t *testing.T, direct bool) {
+	type testCase struct {
+		input       tftypes.Value
+		expectation attr.Value
+		expectedErr string
+	}
+	tests := map[string]testCase{
+		"value": {
+			input:       tftypes.NewValue(tftypes.Number, 123),
+			expectation: Int32Value(123),
+		},
+		"unknown": {
+			input:       tftypes.NewValue(tftypes.Number, tftypes.UnknownValue),
+			expectation: Int32Unknown(),
+		},
+		"null": {
+			input:       tftypes.NewValue(tftypes.Number, nil),
+			expectation: Int32Null(),
+		},
+		"wrongType": {
+			input:       tftypes.NewValue(tftypes.String, "oops"),
+			expectedErr: "can't unmarshal tftypes.String into *big.Float, expected *big.Float",
+		},
+		"overflow": {
+			input:       tftypes.NewValue(tftypes.Number, int64(math.MaxInt32)+1),
+			expectedErr: "Value 2147483648 cannot be represented as a 32-bit integer.",
+		},
+		"underflow": {
+			input:       tftypes.NewValue(tftypes.Number, int64(math.MinInt32)-1),
+			expectedErr: "Value -2147483649 cannot be represented as a 32-bit integer.",
+		},
+		"not-integer": {
+			input:       tftypes.NewValue(tftypes.Number, 123.456),
+			expectedErr: "Value 123.456 is not an integer.",
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+
+			f := Int32Type.ValueFromTerraform
+			if direct {
+				f = int32ValueFromTerraform
+			}
+			got, err := f(ctx, test.input)
+			if err != nil {
+				if test.expectedErr == "" {
+					t.Errorf("Unexpected error: %s", err)
+					return
+				}
+				if test.expectedErr != err.Error() {
+					t.Errorf("Expected error to be %q, got %q", test.expectedErr, err.Error())
+					return
+				}
+				// we have an error, and it matches our
+				// expectations, we're good
+				return
+			}
+			if err == nil && test.expectedErr != "" {
+				t.Errorf("Expected error to be %q, didn't get an error", test.expectedErr)
+				return
+			}
+			if !got.Equal(test.expectation) {
+				t.Errorf("Expected %+v, got %+v", test.expectation, got)
+			}
+			if test.expectation.IsNull() != test.input.IsNull() {
+				t.Errorf("Expected null-ness match: expected %t, got %t", test.expectation.IsNull(), test.input.IsNull())
+			}
+			if test.expectation.IsUnknown() != !test.input.IsKnown() {
+				t.Errorf("Expected unknown-ness match: expected %t, got %t", test.expectation.IsUnknown(), !test.input.IsKnown())
+			}
+		})
+	}
+}
+
+func TestInt32ToTerraformValue(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		input       Int32
+		expectation interface{}
+	}
+	tests := map[string]testCase{
+		"value": {
+			input:       Int32Value(123),
+			expectation: tftypes.NewValue(tftypes.Number, big.NewFloat(123)),
+		},
+		"unknown": {
+			input:       Int32Unknown(),
+			expectation: tftypes.NewValue(tftypes.Number, tftypes.UnknownValue),
+		},
+		"null": {
+			input:       Int32Null(),
+			expectation: tftypes.NewValue(tftypes.Number, nil),
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+
+			got, err := test.input.ToTerraformValue(ctx)
+			if err != nil {
+				t.Errorf("Unexpected error: %s", err)
+				return
+			}
+			if !cmp.Equal(got, test.expectation, cmp.Comparer(numberComparer)) {
+				t.Errorf("Expected %+v, got %+v", test.expectation, got)
+			}
+		})
+	}
+}
+
+func TestInt32Equal(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		input       Int32
+		candidate   attr.Value
+		expectation bool
+	}
+	tests := map[string]testCase{
+		"value-value-same": {
+			input:       Int32Value(123),
+			candidate:   Int32Value(123),
+			expectation: true,
+		},
+		"value-value-diff": {
+			input:       Int32Value(123),
+			candidate:   Int32Value(456),
+			expectation: false,
+		},
+		"value-unknown": {
+			input:       Int32Value(123),
+			candidate:   Int32Unknown(),
+			expectation: false,
+		},
+		"value-null": {
+			input:       Int32Value(123),
+			candidate:   Int32Null(),
+			expectation: false,
+		},
+		"value-wrongType": {
+			input:       Int32Value(123),
+			candidate:   StringValue("oops"),
+			expectation: false,
+		},
+		"value-nil": {
+			input:       Int32Value(123),
+			candidate:   nil,
+			expectation: false,
+		},
+		"unknown-value": {
+			input:       Int32Unknown(),
+			candidate:   Int32Value(123),
+			expectation: false,
+		},
+		"unknown-unknown": {
+			input:       Int32Unknown(),
+			candidate:   Int32Unknown(),
+			expectation: true,
+		},
+		"unknown-null": {
+			input:       Int32Unknown(),
+			candidate:   Int32Null(),
+			expectation: false,
+		},
+		"unknown-wrongType": {
+			input:       Int32Unknown(),
+			candidate:   StringValue("oops"),
+			expectation: false,
+		},
+		"unknown-nil": {
+			input:       Int32Unknown(),
+			candidate:   nil,
+			expectation: false,
+		},
+		"null-value": {
+			input:       Int32Null(),
+			candidate:   Int32Value(123),
+			expectation: false,
+		},
+		"null-unknown": {
+			input:       Int32Null(),
+			candidate:   Int32Unknown(),
+			expectation: false,
+		},
+		"null-null": {
+			input:       Int32Null(),
+			candidate:   Int32Null(),
+			expectation: true,
+		},
+		"null-wrongType": {
+			input:       Int32Null(),
+			candidate:   StringValue("oops"),
+			expectation: false,
+		},
+		"null-nil": {
+			input:       Int32Null(),
+			candidate:   nil,
+			expectation: false,
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := test.input.Equal(test.candidate)
+			if !cmp.Equal(got, test.expectation) {
+				t.Errorf("Expected %v, got %v", test.expectation, got)
+			}
+		})
+	}
+}
+
+func TestInt32String(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		input       Int32
+		expectation string
+	}
+	tests := map[string]testCase{
+		"less-than-one": {
+			input:       Int32Value(-123409843),
+			expectation: "-123409843",
+		},
+		"more-than-one": {
+			input:       Int32Value(923879381),
+			expectation: "923879381",
+		},
+		"min-int32": {
+			input:       Int32Value(math.MinInt32),
+			expectation: "-2147483648",
+		},
+		"max-int32": {
+			input:       Int32Value(math.MaxInt32),
+			expectation: "2147483647",
+		},
+		"unknown": {
+			input:       Int32Unknown(),
+			expectation: "<unknown>",
+		},
+		"null": {
+			input:       Int32Null(),
+			expectation: "<null>",
+		},
+		"zero-value": {
+			input:       Int32{},
+			expectation: "<null>",
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := test.input.String()
+			if !cmp.Equal(got, test.expectation) {
+				t.Errorf("Expected %q, got %q", test.expectation, got)
+			}
+		})
+	}
+}
+
+func TestInt32ValueInt32(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    Int32
+		expected int32
+	}{
+		"known": {
+			input:    Int32Value(24),
+			expected: 24,
+		},
+		"null": {
+			input:    Int32Null(),
+			expected: 0,
+		},
+		"unknown": {
+			input:    Int32Unknown(),
+			expected: 0,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.input.ValueInt32()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
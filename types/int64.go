@@ -53,7 +53,7 @@ func int64Validate(_ context.Context, in tftypes.Value, path path.Path) diag.Dia
 		diags.AddAttributeError(
 			path,
 			"Int64 Type Validation Error",
-			fmt.Sprintf("Value %s is not an integer.", value),
+			fmt.Sprintf("Value %s is not an integer.", value.Text('f', -1)),
 		)
 		return diags
 	}
@@ -64,7 +64,7 @@ func int64Validate(_ context.Context, in tftypes.Value, path path.Path) diag.Dia
 		diags.AddAttributeError(
 			path,
 			"Int64 Type Validation Error",
-			fmt.Sprintf("Value %s cannot be represented as a 64-bit integer.", value),
+			fmt.Sprintf("Value %s cannot be represented as a 64-bit integer.", value.Text('f', -1)),
 		)
 		return diags
 	}
@@ -72,13 +72,38 @@ func int64Validate(_ context.Context, in tftypes.Value, path path.Path) diag.Dia
 	return diags
 }
 
+// Int64Null creates an Int64 with a null value. Determine whether the value
+// is null via the Int64 type IsNull method.
+func Int64Null() Int64 {
+	return Int64{
+		state: attr.ValueStateNull,
+	}
+}
+
+// Int64Unknown creates an Int64 with an unknown value. Determine whether
+// the value is unknown via the Int64 type IsUnknown method.
+func Int64Unknown() Int64 {
+	return Int64{
+		state: attr.ValueStateUnknown,
+	}
+}
+
+// Int64Value creates an Int64 with a known value. Access the value via
+// the Int64 type ValueInt64 method.
+func Int64Value(value int64) Int64 {
+	return Int64{
+		state: attr.ValueStateKnown,
+		value: value,
+	}
+}
+
 func int64ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
 	if !in.IsKnown() {
-		return Int64{Unknown: true}, nil
+		return Int64Unknown(), nil
 	}
 
 	if in.IsNull() {
-		return Int64{Null: true}, nil
+		return Int64Null(), nil
 	}
 
 	var bigF *big.Float
@@ -89,30 +114,28 @@ func int64ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value,
 	}
 
 	if !bigF.IsInt() {
-		return nil, fmt.Errorf("Value %s is not an integer.", bigF)
+		return nil, fmt.Errorf("Value %s is not an integer.", bigF.Text('f', -1))
 	}
 
 	i, accuracy := bigF.Int64()
 
 	if accuracy != 0 {
-		return nil, fmt.Errorf("Value %s cannot be represented as a 64-bit integer.", bigF)
+		return nil, fmt.Errorf("Value %s cannot be represented as a 64-bit integer.", bigF.Text('f', -1))
 	}
 
-	return Int64{Value: i}, nil
+	return Int64Value(i), nil
 }
 
-// Int64 represents a 64-bit integer value, exposed as an int64.
+// Int64 represents a 64-bit integer value, exposed as an int64. The
+// zero-value of Int64 is null, use the Int64Null, Int64Unknown, and Int64Value
+// functions to create an Int64.
 type Int64 struct {
-	// Unknown will be true if the value is not yet known.
-	Unknown bool
+	// state represents whether the value is null, unknown, or known. The
+	// zero-value is null.
+	state attr.ValueState
 
-	// Null will be true if the value was not set, or was explicitly set to
-	// null.
-	Null bool
-
-	// Value contains the set value, as long as Unknown and Null are both
-	// false.
-	Value int64
+	// value contains the known value, if not null or unknown.
+	value int64
 }
 
 // Equal returns true if `other` is an Int64 and has the same value as `i`.
@@ -123,32 +146,34 @@ func (i Int64) Equal(other attr.Value) bool {
 		return false
 	}
 
-	if i.Unknown != o.Unknown {
+	if i.state != o.state {
 		return false
 	}
 
-	if i.Null != o.Null {
-		return false
+	if i.state != attr.ValueStateKnown {
+		return true
 	}
 
-	return i.Value == o.Value
+	return i.value == o.value
 }
 
 // ToTerraformValue returns the data contained in the Int64 as a tftypes.Value.
 func (i Int64) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
-	if i.Null {
+	switch i.state {
+	case attr.ValueStateKnown:
+		bf := new(big.Float).SetInt64(i.value)
+		if err := tftypes.ValidateValue(tftypes.Number, bf); err != nil {
+			return tftypes.NewValue(tftypes.Number, tftypes.UnknownValue), err
+		}
+
+		return tftypes.NewValue(tftypes.Number, bf), nil
+	case attr.ValueStateNull:
 		return tftypes.NewValue(tftypes.Number, nil), nil
-	}
-
-	if i.Unknown {
+	case attr.ValueStateUnknown:
 		return tftypes.NewValue(tftypes.Number, tftypes.UnknownValue), nil
+	default:
+		panic(fmt.Sprintf("unhandled Int64 state in ToTerraformValue: %s", i.state))
 	}
-
-	bf := new(big.Float).SetInt64(i.Value)
-	if err := tftypes.ValidateValue(tftypes.Number, bf); err != nil {
-		return tftypes.NewValue(tftypes.Number, tftypes.UnknownValue), err
-	}
-	return tftypes.NewValue(tftypes.Number, bf), nil
 }
 
 // Type returns a Int64Type.
@@ -158,25 +183,31 @@ func (i Int64) Type(ctx context.Context) attr.Type {
 
 // IsNull returns true if the Int64 represents a null value.
 func (i Int64) IsNull() bool {
-	return i.Null
+	return i.state == attr.ValueStateNull
 }
 
 // IsUnknown returns true if the Int64 represents a currently unknown value.
 func (i Int64) IsUnknown() bool {
-	return i.Unknown
+	return i.state == attr.ValueStateUnknown
 }
 
 // String returns a human-readable representation of the Int64 value.
 // The string returned here is not protected by any compatibility guarantees,
 // and is intended for logging and error reporting.
 func (i Int64) String() string {
-	if i.Unknown {
+	if i.IsUnknown() {
 		return attr.UnknownValueString
 	}
 
-	if i.Null {
+	if i.IsNull() {
 		return attr.NullValueString
 	}
 
-	return fmt.Sprintf("%d", i.Value)
+	return fmt.Sprintf("%d", i.value)
+}
+
+// ValueInt64 returns the known int64 value. If Int64 is null or unknown,
+// returns 0.
+func (i Int64) ValueInt64() int64 {
+	return i.value
 }
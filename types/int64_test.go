@@ -26,15 +26,15 @@ func testInt64ValueFromTerraform(t *testing.T, direct bool) {
 	tests := map[string]testCase{
 		"value": {
 			input:       tftypes.NewValue(tftypes.Number, 123),
-			expectation: Int64{Value: 123},
+			expectation: Int64Value(123),
 		},
 		"unknown": {
 			input:       tftypes.NewValue(tftypes.Number, tftypes.UnknownValue),
-			expectation: Int64{Unknown: true},
+			expectation: Int64Unknown(),
 		},
 		"null": {
 			input:       tftypes.NewValue(tftypes.Number, nil),
-			expectation: Int64{Null: true},
+			expectation: Int64Null(),
 		},
 		"wrongType": {
 			input:       tftypes.NewValue(tftypes.String, "oops"),
@@ -91,15 +91,15 @@ func TestInt64ToTerraformValue(t *testing.T) {
 	}
 	tests := map[string]testCase{
 		"value": {
-			input:       Int64{Value: 123},
+			input:       Int64Value(123),
 			expectation: tftypes.NewValue(tftypes.Number, big.NewFloat(123)),
 		},
 		"unknown": {
-			input:       Int64{Unknown: true},
+			input:       Int64Unknown(),
 			expectation: tftypes.NewValue(tftypes.Number, tftypes.UnknownValue),
 		},
 		"null": {
-			input:       Int64{Null: true},
+			input:       Int64Null(),
 			expectation: tftypes.NewValue(tftypes.Number, nil),
 		},
 	}
@@ -131,82 +131,82 @@ func TestInt64Equal(t *testing.T) {
 	}
 	tests := map[string]testCase{
 		"value-value-same": {
-			input:       Int64{Value: 123},
-			candidate:   Int64{Value: 123},
+			input:       Int64Value(123),
+			candidate:   Int64Value(123),
 			expectation: true,
 		},
 		"value-value-diff": {
-			input:       Int64{Value: 123},
-			candidate:   Int64{Value: 456},
+			input:       Int64Value(123),
+			candidate:   Int64Value(456),
 			expectation: false,
 		},
 		"value-unknown": {
-			input:       Int64{Value: 123},
-			candidate:   Int64{Unknown: true},
+			input:       Int64Value(123),
+			candidate:   Int64Unknown(),
 			expectation: false,
 		},
 		"value-null": {
-			input:       Int64{Value: 123},
-			candidate:   Int64{Null: true},
+			input:       Int64Value(123),
+			candidate:   Int64Null(),
 			expectation: false,
 		},
 		"value-wrongType": {
-			input:       Int64{Value: 123},
-			candidate:   &String{Value: "oops"},
+			input:       Int64Value(123),
+			candidate:   StringValue("oops"),
 			expectation: false,
 		},
 		"value-nil": {
-			input:       Int64{Value: 123},
+			input:       Int64Value(123),
 			candidate:   nil,
 			expectation: false,
 		},
 		"unknown-value": {
-			input:       Int64{Unknown: true},
-			candidate:   Int64{Value: 123},
+			input:       Int64Unknown(),
+			candidate:   Int64Value(123),
 			expectation: false,
 		},
 		"unknown-unknown": {
-			input:       Int64{Unknown: true},
-			candidate:   Int64{Unknown: true},
+			input:       Int64Unknown(),
+			candidate:   Int64Unknown(),
 			expectation: true,
 		},
 		"unknown-null": {
-			input:       Int64{Unknown: true},
-			candidate:   Int64{Null: true},
+			input:       Int64Unknown(),
+			candidate:   Int64Null(),
 			expectation: false,
 		},
 		"unknown-wrongType": {
-			input:       Int64{Unknown: true},
-			candidate:   &String{Value: "oops"},
+			input:       Int64Unknown(),
+			candidate:   StringValue("oops"),
 			expectation: false,
 		},
 		"unknown-nil": {
-			input:       Int64{Unknown: true},
+			input:       Int64Unknown(),
 			candidate:   nil,
 			expectation: false,
 		},
 		"null-value": {
-			input:       Int64{Null: true},
-			candidate:   Int64{Value: 123},
+			input:       Int64Null(),
+			candidate:   Int64Value(123),
 			expectation: false,
 		},
 		"null-unknown": {
-			input:       Int64{Null: true},
-			candidate:   Int64{Unknown: true},
+			input:       Int64Null(),
+			candidate:   Int64Unknown(),
 			expectation: false,
 		},
 		"null-null": {
-			input:       Int64{Null: true},
-			candidate:   Int64{Null: true},
+			input:       Int64Null(),
+			candidate:   Int64Null(),
 			expectation: true,
 		},
 		"null-wrongType": {
-			input:       Int64{Null: true},
-			candidate:   &String{Value: "oops"},
+			input:       Int64Null(),
+			candidate:   StringValue("oops"),
 			expectation: false,
 		},
 		"null-nil": {
-			input:       Int64{Null: true},
+			input:       Int64Null(),
 			candidate:   nil,
 			expectation: false,
 		},
@@ -233,32 +233,32 @@ func TestInt64String(t *testing.T) {
 	}
 	tests := map[string]testCase{
 		"less-than-one": {
-			input:       Int64{Value: -12340984302980000},
+			input:       Int64Value(-12340984302980000),
 			expectation: "-12340984302980000",
 		},
 		"more-than-one": {
-			input:       Int64{Value: 92387938173219327},
+			input:       Int64Value(92387938173219327),
 			expectation: "92387938173219327",
 		},
 		"min-int64": {
-			input:       Int64{Value: math.MinInt64},
+			input:       Int64Value(math.MinInt64),
 			expectation: "-9223372036854775808",
 		},
 		"max-int64": {
-			input:       Int64{Value: math.MaxInt64},
+			input:       Int64Value(math.MaxInt64),
 			expectation: "9223372036854775807",
 		},
 		"unknown": {
-			input:       Int64{Unknown: true},
+			input:       Int64Unknown(),
 			expectation: "<unknown>",
 		},
 		"null": {
-			input:       Int64{Null: true},
+			input:       Int64Null(),
 			expectation: "<null>",
 		},
-		"default-0": {
+		"zero-value": {
 			input:       Int64{},
-			expectation: "0",
+			expectation: "<null>",
 		},
 	}
 
@@ -274,3 +274,39 @@ func TestInt64String(t *testing.T) {
 		})
 	}
 }
+
+func TestInt64ValueInt64(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    Int64
+		expected int64
+	}{
+		"known": {
+			input:    Int64Value(24),
+			expected: 24,
+		},
+		"null": {
+			input:    Int64Null(),
+			expected: 0,
+		},
+		"unknown": {
+			input:    Int64Unknown(),
+			expected: 0,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.input.ValueInt64()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
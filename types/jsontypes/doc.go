@@ -0,0 +1,4 @@
+// Package jsontypes contains attr.Type and attr.Value implementations for
+// attributes which hold JSON documents, such as policies or templates, as
+// Terraform strings.
+package jsontypes
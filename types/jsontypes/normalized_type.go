@@ -0,0 +1,135 @@
+package jsontypes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ xattr.TypeWithValidate            = NormalizedType{}
+	_ attr.TypeWithPlaintextDescription = NormalizedType{}
+	_ attr.TypeWithMarkdownDescription  = NormalizedType{}
+)
+
+// NormalizedType is an attribute type for strings containing a JSON document.
+// Values are validated as JSON (RFC 7159) and are represented by Normalized.
+//
+// Two documents which only differ in whitespace or object key order are
+// semantically equal, so the framework will keep the prior value instead of
+// reporting a difference when an API returns the document reformatted.
+type NormalizedType struct{}
+
+// TerraformType returns tftypes.String.
+func (t NormalizedType) TerraformType(_ context.Context) tftypes.Type {
+	return tftypes.String
+}
+
+// ValueFromTerraform returns a Normalized given a tftypes.Value.
+func (t NormalizedType) ValueFromTerraform(_ context.Context, in tftypes.Value) (attr.Value, error) {
+	if in.Type() == nil {
+		return NormalizedNull(), nil
+	}
+
+	if !in.Type().Equal(tftypes.String) {
+		return nil, fmt.Errorf("can't use %s as a JSON string, expected tftypes.String", in.Type())
+	}
+
+	if !in.IsKnown() {
+		return NormalizedUnknown(), nil
+	}
+
+	if in.IsNull() {
+		return NormalizedNull(), nil
+	}
+
+	var s string
+
+	if err := in.As(&s); err != nil {
+		return nil, err
+	}
+
+	return NormalizedValue(s), nil
+}
+
+// Equal returns true if `o` is also a NormalizedType.
+func (t NormalizedType) Equal(o attr.Type) bool {
+	_, ok := o.(NormalizedType)
+
+	return ok
+}
+
+// String returns a human-friendly description of the NormalizedType.
+func (t NormalizedType) String() string {
+	return "jsontypes.NormalizedType"
+}
+
+// ApplyTerraform5AttributePathStep always returns an error, as JSON strings
+// cannot be traversed with attribute paths.
+func (t NormalizedType) ApplyTerraform5AttributePathStep(step tftypes.AttributePathStep) (interface{}, error) {
+	return nil, fmt.Errorf("cannot apply AttributePathStep %T to %s", step, t.String())
+}
+
+// Validate returns an error diagnostic if the value is not a valid JSON
+// document.
+func (t NormalizedType) Validate(_ context.Context, in tftypes.Value, path path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if in.Type() == nil {
+		return diags
+	}
+
+	if !in.Type().Equal(tftypes.String) {
+		diags.AddAttributeError(
+			path,
+			"JSON String Type Validation Error",
+			"An unexpected error was encountered trying to validate an attribute value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("Expected String value, received %T with value: %v", in, in),
+		)
+		return diags
+	}
+
+	if !in.IsKnown() || in.IsNull() {
+		return diags
+	}
+
+	var s string
+
+	if err := in.As(&s); err != nil {
+		diags.AddAttributeError(
+			path,
+			"JSON String Type Validation Error",
+			"An unexpected error was encountered trying to validate an attribute value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("Cannot convert value to string: %s", err),
+		)
+		return diags
+	}
+
+	if !json.Valid([]byte(s)) {
+		diags.AddAttributeError(
+			path,
+			"Invalid JSON String Value",
+			"A string value was provided that is not valid JSON string format (RFC 7159).\n\n"+
+				"Given Value: "+s,
+		)
+		return diags
+	}
+
+	return diags
+}
+
+// Description returns a plain text description of the NormalizedType.
+func (t NormalizedType) Description(_ context.Context) string {
+	return "String containing a valid JSON document. Differences in whitespace and object key order are ignored."
+}
+
+// MarkdownDescription returns a Markdown description of the NormalizedType.
+func (t NormalizedType) MarkdownDescription(_ context.Context) string {
+	return "String containing a valid [JSON](https://www.rfc-editor.org/rfc/rfc7159) document. Differences in whitespace and object key order are ignored."
+}
@@ -0,0 +1,161 @@
+package jsontypes_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/jsontypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestNormalizedTypeValueFromTerraform(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		in          tftypes.Value
+		expected    attr.Value
+		expectedErr string
+	}{
+		"known": {
+			in:       tftypes.NewValue(tftypes.String, `{"a": 1}`),
+			expected: jsontypes.NormalizedValue(`{"a": 1}`),
+		},
+		"null": {
+			in:       tftypes.NewValue(tftypes.String, nil),
+			expected: jsontypes.NormalizedNull(),
+		},
+		"unknown": {
+			in:       tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			expected: jsontypes.NormalizedUnknown(),
+		},
+		"wrong-type": {
+			in:          tftypes.NewValue(tftypes.Number, 123),
+			expectedErr: "can't use tftypes.Number as a JSON string, expected tftypes.String",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := jsontypes.NormalizedType{}.ValueFromTerraform(context.Background(), testCase.in)
+
+			if err != nil {
+				if testCase.expectedErr == "" {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if err.Error() != testCase.expectedErr {
+					t.Fatalf("expected error %q, got %q", testCase.expectedErr, err)
+				}
+
+				return
+			}
+
+			if testCase.expectedErr != "" {
+				t.Fatalf("expected error %q, got none", testCase.expectedErr)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestNormalizedTypeValidate(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		in            tftypes.Value
+		expectedDiags diag.Diagnostics
+	}{
+		"object": {
+			in: tftypes.NewValue(tftypes.String, `{"a": [1, "b", null, true]}`),
+		},
+		"array": {
+			in: tftypes.NewValue(tftypes.String, `[]`),
+		},
+		"null": {
+			in: tftypes.NewValue(tftypes.String, nil),
+		},
+		"unknown": {
+			in: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		},
+		"invalid": {
+			in: tftypes.NewValue(tftypes.String, `{"a": }`),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid JSON String Value",
+					"A string value was provided that is not valid JSON string format (RFC 7159).\n\n"+
+						`Given Value: {"a": }`,
+				),
+			},
+		},
+		"wrong-type": {
+			in: tftypes.NewValue(tftypes.Number, 123),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"JSON String Type Validation Error",
+					"An unexpected error was encountered trying to validate an attribute value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Expected String value, received tftypes.Value with value: tftypes.Number<\"123\">",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := jsontypes.NormalizedType{}.Validate(context.Background(), testCase.in, path.Root("test"))
+
+			if diff := cmp.Diff(got, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestNormalizedTypeEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		other    attr.Type
+		expected bool
+	}{
+		"normalized": {
+			other:    jsontypes.NormalizedType{},
+			expected: true,
+		},
+		"string": {
+			other:    types.StringType,
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := jsontypes.NormalizedType{}.Equal(testCase.other)
+
+			if got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
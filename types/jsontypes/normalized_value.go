@@ -0,0 +1,239 @@
+package jsontypes
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ xattr.ValueWithSemanticEquals = Normalized{}
+)
+
+// NormalizedNull creates a Normalized with a null value. Determine whether
+// the value is null via the Normalized type IsNull method.
+func NormalizedNull() Normalized {
+	return Normalized{
+		state: attr.ValueStateNull,
+	}
+}
+
+// NormalizedUnknown creates a Normalized with an unknown value. Determine
+// whether the value is unknown via the Normalized type IsUnknown method.
+func NormalizedUnknown() Normalized {
+	return Normalized{
+		state: attr.ValueStateUnknown,
+	}
+}
+
+// NormalizedValue creates a Normalized with a known value. The value is not
+// validated as JSON, which instead happens when the value is received from
+// Terraform. Access the value via the Normalized type ValueString method.
+func NormalizedValue(value string) Normalized {
+	return Normalized{
+		state: attr.ValueStateKnown,
+		value: value,
+	}
+}
+
+// Normalized represents a JSON document as a string, such as a policy or
+// template. The zero-value of Normalized is null, use the NormalizedNull,
+// NormalizedUnknown, and NormalizedValue functions to create a Normalized.
+type Normalized struct {
+	// state represents whether the value is null, unknown, or known. The
+	// zero-value is null.
+	state attr.ValueState
+
+	// value contains the known value, if not null or unknown.
+	value string
+}
+
+// Type returns a NormalizedType.
+func (n Normalized) Type(_ context.Context) attr.Type {
+	return NormalizedType{}
+}
+
+// ToTerraformValue returns the data contained in the Normalized as a
+// tftypes.Value.
+func (n Normalized) ToTerraformValue(_ context.Context) (tftypes.Value, error) {
+	switch n.state {
+	case attr.ValueStateKnown:
+		if err := tftypes.ValidateValue(tftypes.String, n.value); err != nil {
+			return tftypes.NewValue(tftypes.String, tftypes.UnknownValue), err
+		}
+
+		return tftypes.NewValue(tftypes.String, n.value), nil
+	case attr.ValueStateNull:
+		return tftypes.NewValue(tftypes.String, nil), nil
+	case attr.ValueStateUnknown:
+		return tftypes.NewValue(tftypes.String, tftypes.UnknownValue), nil
+	default:
+		panic(fmt.Sprintf("unhandled Normalized state in ToTerraformValue: %s", n.state))
+	}
+}
+
+// Equal returns true if `other` is a Normalized and has exactly the same
+// string value as `n`. Use SemanticEquals to compare the JSON documents.
+func (n Normalized) Equal(other attr.Value) bool {
+	o, ok := other.(Normalized)
+
+	if !ok {
+		return false
+	}
+
+	if n.state != o.state {
+		return false
+	}
+
+	if n.state != attr.ValueStateKnown {
+		return true
+	}
+
+	return n.value == o.value
+}
+
+// SemanticEquals returns true if `other` is a Normalized containing the same
+// JSON document as `n`, ignoring differences in whitespace and object key
+// order. Numbers are compared by their textual representation, so 1 and 1.0
+// are not semantically equal.
+func (n Normalized) SemanticEquals(_ context.Context, other attr.Value) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	o, ok := other.(Normalized)
+
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				fmt.Sprintf("Expected Value Type: %T\n", n)+
+				fmt.Sprintf("Got Value Type: %T", other),
+		)
+
+		return false, diags
+	}
+
+	if n.state != attr.ValueStateKnown || o.state != attr.ValueStateKnown {
+		return n.Equal(o), diags
+	}
+
+	if n.value == o.value {
+		return true, diags
+	}
+
+	nDocument, err := decode(n.value)
+
+	if err != nil {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected error occurred while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return false, diags
+	}
+
+	oDocument, err := decode(o.value)
+
+	if err != nil {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected error occurred while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return false, diags
+	}
+
+	return reflect.DeepEqual(nDocument, oDocument), diags
+}
+
+// IsNull returns true if the Normalized represents a null value.
+func (n Normalized) IsNull() bool {
+	return n.state == attr.ValueStateNull
+}
+
+// IsUnknown returns true if the Normalized represents a currently unknown
+// value.
+func (n Normalized) IsUnknown() bool {
+	return n.state == attr.ValueStateUnknown
+}
+
+// String returns a human-readable representation of the Normalized value.
+// The string returned here is not protected by any compatibility guarantees,
+// and is intended for logging and error reporting.
+func (n Normalized) String() string {
+	if n.IsUnknown() {
+		return attr.UnknownValueString
+	}
+
+	if n.IsNull() {
+		return attr.NullValueString
+	}
+
+	return fmt.Sprintf("%q", n.value)
+}
+
+// ValueString returns the known string value. If Normalized is null or
+// unknown, returns "".
+func (n Normalized) ValueString() string {
+	return n.value
+}
+
+// Unmarshal decodes the JSON document into target, which must be a pointer,
+// using encoding/json. An error diagnostic is returned if the value is null
+// or unknown, or cannot be decoded into target.
+func (n Normalized) Unmarshal(target interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if n.IsNull() {
+		diags.AddError(
+			"Normalized JSON Unmarshal Error",
+			"JSON string value is null",
+		)
+
+		return diags
+	}
+
+	if n.IsUnknown() {
+		diags.AddError(
+			"Normalized JSON Unmarshal Error",
+			"JSON string value is unknown",
+		)
+
+		return diags
+	}
+
+	if err := json.Unmarshal([]byte(n.value), target); err != nil {
+		diags.AddError(
+			"Normalized JSON Unmarshal Error",
+			"Unable to unmarshal JSON string value: "+err.Error(),
+		)
+	}
+
+	return diags
+}
+
+// decode returns the generic Go representation of a JSON document, keeping
+// numbers as json.Number to prevent loss of precision.
+func decode(s string) (interface{}, error) {
+	var document interface{}
+
+	decoder := json.NewDecoder(bytes.NewReader([]byte(s)))
+	decoder.UseNumber()
+
+	if err := decoder.Decode(&document); err != nil {
+		return nil, err
+	}
+
+	return document, nil
+}
@@ -0,0 +1,229 @@
+package jsontypes_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/jsontypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestNormalizedToTerraformValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		normalized jsontypes.Normalized
+		expected   tftypes.Value
+	}{
+		"known": {
+			normalized: jsontypes.NormalizedValue(`{"a": 1}`),
+			expected:   tftypes.NewValue(tftypes.String, `{"a": 1}`),
+		},
+		"null": {
+			normalized: jsontypes.NormalizedNull(),
+			expected:   tftypes.NewValue(tftypes.String, nil),
+		},
+		"unknown": {
+			normalized: jsontypes.NormalizedUnknown(),
+			expected:   tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		},
+		"zero-value": {
+			normalized: jsontypes.Normalized{},
+			expected:   tftypes.NewValue(tftypes.String, nil),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.normalized.ToTerraformValue(context.Background())
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestNormalizedSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		normalized    jsontypes.Normalized
+		other         attr.Value
+		expected      bool
+		expectedDiags diag.Diagnostics
+	}{
+		"identical": {
+			normalized: jsontypes.NormalizedValue(`{"a": 1}`),
+			other:      jsontypes.NormalizedValue(`{"a": 1}`),
+			expected:   true,
+		},
+		"whitespace": {
+			normalized: jsontypes.NormalizedValue("{\n  \"a\": [1, 2]\n}"),
+			other:      jsontypes.NormalizedValue(`{"a":[1,2]}`),
+			expected:   true,
+		},
+		"key-order": {
+			normalized: jsontypes.NormalizedValue(`{"a": 1, "b": {"c": true, "d": null}}`),
+			other:      jsontypes.NormalizedValue(`{"b": {"d": null, "c": true}, "a": 1}`),
+			expected:   true,
+		},
+		"array-order": {
+			normalized: jsontypes.NormalizedValue(`[1, 2]`),
+			other:      jsontypes.NormalizedValue(`[2, 1]`),
+			expected:   false,
+		},
+		"different-value": {
+			normalized: jsontypes.NormalizedValue(`{"a": 1}`),
+			other:      jsontypes.NormalizedValue(`{"a": 2}`),
+			expected:   false,
+		},
+		"large-numbers": {
+			normalized: jsontypes.NormalizedValue(`{"a": 12345678901234567890}`),
+			other:      jsontypes.NormalizedValue(`{"a": 12345678901234567891}`),
+			expected:   false,
+		},
+		"null-null": {
+			normalized: jsontypes.NormalizedNull(),
+			other:      jsontypes.NormalizedNull(),
+			expected:   true,
+		},
+		"known-unknown": {
+			normalized: jsontypes.NormalizedValue(`{}`),
+			other:      jsontypes.NormalizedUnknown(),
+			expected:   false,
+		},
+		"invalid-json": {
+			normalized: jsontypes.NormalizedValue(`{}`),
+			other:      jsontypes.NormalizedValue(`{`),
+			expected:   false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected error occurred while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Error: unexpected EOF",
+				),
+			},
+		},
+		"wrong-type": {
+			normalized: jsontypes.NormalizedValue(`{}`),
+			other:      types.StringValue(`{}`),
+			expected:   false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: jsontypes.Normalized\n"+
+						"Got Value Type: types.String",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.normalized.SemanticEquals(context.Background(), testCase.other)
+
+			if got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestNormalizedUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	type policy struct {
+		Version   string   `json:"version"`
+		Actions   []string `json:"actions"`
+		Principal *string  `json:"principal"`
+	}
+
+	testCases := map[string]struct {
+		normalized    jsontypes.Normalized
+		expected      policy
+		expectedDiags diag.Diagnostics
+	}{
+		"known": {
+			normalized: jsontypes.NormalizedValue(`{"version": "1", "actions": ["read", "write"]}`),
+			expected: policy{
+				Version: "1",
+				Actions: []string{"read", "write"},
+			},
+		},
+		"null": {
+			normalized: jsontypes.NormalizedNull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Normalized JSON Unmarshal Error",
+					"JSON string value is null",
+				),
+			},
+		},
+		"unknown": {
+			normalized: jsontypes.NormalizedUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Normalized JSON Unmarshal Error",
+					"JSON string value is unknown",
+				),
+			},
+		},
+		"mismatched": {
+			normalized: jsontypes.NormalizedValue(`{"version": 1}`),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Normalized JSON Unmarshal Error",
+					"Unable to unmarshal JSON string value: json: cannot unmarshal number into Go struct field policy.version of type string",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got policy
+
+			diags := testCase.normalized.Unmarshal(&got)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diags.HasError() {
+				return
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/reflect"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
@@ -49,23 +50,17 @@ func (l ListType) TerraformType(ctx context.Context) tftypes.Type {
 // This is meant to convert the tftypes.Value into a more convenient Go
 // type for the provider to consume the data with.
 func (l ListType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
-	list := List{
-		ElemType: l.ElemType,
-	}
 	if in.Type() == nil {
-		list.Null = true
-		return list, nil
+		return ListNull(l.ElemType), nil
 	}
 	if !in.Type().Equal(l.TerraformType(ctx)) {
 		return nil, fmt.Errorf("can't use %s as value of List with ElementType %T, can only use %s values", in.String(), l.ElemType, l.ElemType.TerraformType(ctx).String())
 	}
 	if !in.IsKnown() {
-		list.Unknown = true
-		return list, nil
+		return ListUnknown(l.ElemType), nil
 	}
 	if in.IsNull() {
-		list.Null = true
-		return list, nil
+		return ListNull(l.ElemType), nil
 	}
 	val := []tftypes.Value{}
 	err := in.As(&val)
@@ -80,8 +75,13 @@ func (l ListType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (att
 		}
 		elems = append(elems, av)
 	}
-	list.Elems = elems
-	return list, nil
+	// Elements were created from the element type, so there is no need to
+	// validate them via ListValue.
+	return List{
+		elementType: l.ElemType,
+		elements:    elems,
+		state:       attr.ValueStateKnown,
+	}, nil
 }
 
 // Equal returns true if `o` is also a ListType and has the same ElemType.
@@ -111,28 +111,128 @@ func (l ListType) String() string {
 	return "types.ListType[" + l.ElemType.String() + "]"
 }
 
+// ListNull creates a List with a null value. Determine whether the value is
+// null via the List type IsNull method.
+func ListNull(elementType attr.Type) List {
+	return List{
+		elementType: elementType,
+		state:       attr.ValueStateNull,
+	}
+}
+
+// ListUnknown creates a List with an unknown value. Determine whether the
+// value is unknown via the List type IsUnknown method.
+func ListUnknown(elementType attr.Type) List {
+	return List{
+		elementType: elementType,
+		state:       attr.ValueStateUnknown,
+	}
+}
+
+// ListValue creates a List with a known value. Access the value via the List
+// type Elements or ElementsAs methods. Every element must be of the given
+// element type, otherwise an error diagnostic is returned.
+func ListValue(elementType attr.Type, elements []attr.Value) (List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// The creation functions do not accept a context, as they are typically
+	// used in places where one is not available, such as tests.
+	ctx := context.Background()
+
+	for idx, element := range elements {
+		if !elementType.Equal(element.Type(ctx)) {
+			diags.AddError(
+				"Invalid List Element Type",
+				"While creating a List value, an invalid element was detected. "+
+					"A List must use the single, given element type. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("List Element Type: %s\n", elementType)+
+					fmt.Sprintf("List Index (%d) Element Type: %s", idx, element.Type(ctx)),
+			)
+		}
+	}
+
+	if diags.HasError() {
+		return ListUnknown(elementType), diags
+	}
+
+	return List{
+		elementType: elementType,
+		elements:    copyElements(elements),
+		state:       attr.ValueStateKnown,
+	}, nil
+}
+
+// ListValueFrom creates a List with a known value, using reflection rules.
+// The elements must be a slice which can convert into the given element type.
+// Access the value via the List type Elements or ElementsAs methods.
+func ListValueFrom(ctx context.Context, elementType attr.Type, elements interface{}) (List, diag.Diagnostics) {
+	attrValue, diags := reflect.FromValue(
+		ctx,
+		ListType{ElemType: elementType},
+		elements,
+		path.Empty(),
+	)
+
+	if diags.HasError() {
+		return ListUnknown(elementType), diags
+	}
+
+	list, ok := attrValue.(List)
+
+	// Reflection should only return List, but guard against a panic.
+	if !ok {
+		diags.AddError(
+			"List Conversion Error",
+			"An unexpected error was encountered trying to convert the elements into a List value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("expected types.List, got: %T", attrValue),
+		)
+
+		return ListUnknown(elementType), diags
+	}
+
+	return list, diags
+}
+
+// ListValueMust creates a List with a known value, converting any diagnostics
+// into a panic at runtime. Access the value via the List type Elements or
+// ElementsAs methods.
+//
+// This creation function is only recommended to create List values which
+// will not potentially affect practitioners, such as testing, or exhaustively
+// tested provider logic.
+func ListValueMust(elementType attr.Type, elements []attr.Value) List {
+	list, diags := ListValue(elementType, elements)
+
+	if diags.HasError() {
+		panic(diagsPanicMessage("ListValueMust", diags))
+	}
+
+	return list
+}
+
 // List represents a list of attr.Values, all of the same type, indicated
-// by ElemType.
+// by the element type. The zero-value of List is null without an element
+// type, use the ListNull, ListUnknown, and ListValue functions to create a
+// List.
 type List struct {
-	// Unknown will be set to true if the entire list is an unknown value.
-	// If only some of the elements in the list are unknown, their known or
-	// unknown status will be represented however that attr.Value
-	// surfaces that information. The List's Unknown property only tracks
-	// if the number of elements in a List is known, not whether the
-	// elements that are in the list are known.
-	Unknown bool
-
-	// Null will be set to true if the list is null, either because it was
-	// omitted from the configuration, state, or plan, or because it was
-	// explicitly set to null.
-	Null bool
-
-	// Elems are the elements in the list.
-	Elems []attr.Value
-
-	// ElemType is the tftypes.Type of the elements in the list. All
-	// elements in the list must be of this type.
-	ElemType attr.Type
+	// elements are the known elements in the list. If only some of the
+	// elements in the list are unknown, their known or unknown status will
+	// be represented however that attr.Value surfaces that information.
+	elements []attr.Value
+
+	// elementType is the type of the elements in the list. All elements in
+	// the list must be of this type.
+	elementType attr.Type
+
+	// state represents whether the value is null, unknown, or known. The
+	// zero-value is null.
+	state attr.ValueState
+}
+
+// Elements returns a copy of the collection of elements for the List.
+func (l List) Elements() []attr.Value {
+	return copyElements(l.elements)
 }
 
 // ElementsAs populates `target` with the elements of the List, throwing an
@@ -149,41 +249,55 @@ func (l List) ElementsAs(ctx context.Context, target interface{}, allowUnhandled
 			),
 		}
 	}
-	return reflect.Into(ctx, ListType{ElemType: l.ElemType}, values, target, reflect.Options{
+	return reflect.Into(ctx, ListType{ElemType: l.elementType}, values, target, reflect.Options{
 		UnhandledNullAsEmpty:    allowUnhandled,
 		UnhandledUnknownAsEmpty: allowUnhandled,
 	})
 }
 
+// ElementType returns the element type for the List.
+func (l List) ElementType(_ context.Context) attr.Type {
+	return l.elementType
+}
+
 // Type returns a ListType with the same element type as `l`.
 func (l List) Type(ctx context.Context) attr.Type {
-	return ListType{ElemType: l.ElemType}
+	return ListType{ElemType: l.elementType}
 }
 
 // ToTerraformValue returns the data contained in the List as a tftypes.Value.
 func (l List) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
-	if l.ElemType == nil {
-		return tftypes.Value{}, fmt.Errorf("cannot convert List to tftypes.Value if ElemType field is not set")
+	if l.elementType == nil {
+		return tftypes.Value{}, fmt.Errorf("cannot convert List to tftypes.Value if element type is not set")
 	}
-	listType := tftypes.List{ElementType: l.ElemType.TerraformType(ctx)}
-	if l.Unknown {
-		return tftypes.NewValue(listType, tftypes.UnknownValue), nil
-	}
-	if l.Null {
-		return tftypes.NewValue(listType, nil), nil
-	}
-	vals := make([]tftypes.Value, 0, len(l.Elems))
-	for _, elem := range l.Elems {
-		val, err := elem.ToTerraformValue(ctx)
-		if err != nil {
+	listType := tftypes.List{ElementType: l.elementType.TerraformType(ctx)}
+
+	switch l.state {
+	case attr.ValueStateKnown:
+		vals := make([]tftypes.Value, 0, len(l.elements))
+
+		for _, elem := range l.elements {
+			val, err := elem.ToTerraformValue(ctx)
+
+			if err != nil {
+				return tftypes.NewValue(listType, tftypes.UnknownValue), err
+			}
+
+			vals = append(vals, val)
+		}
+
+		if err := tftypes.ValidateValue(listType, vals); err != nil {
 			return tftypes.NewValue(listType, tftypes.UnknownValue), err
 		}
-		vals = append(vals, val)
-	}
-	if err := tftypes.ValidateValue(listType, vals); err != nil {
-		return tftypes.NewValue(listType, tftypes.UnknownValue), err
+
+		return tftypes.NewValue(listType, vals), nil
+	case attr.ValueStateNull:
+		return tftypes.NewValue(listType, nil), nil
+	case attr.ValueStateUnknown:
+		return tftypes.NewValue(listType, tftypes.UnknownValue), nil
+	default:
+		panic(fmt.Sprintf("unhandled List state in ToTerraformValue: %s", l.state))
 	}
-	return tftypes.NewValue(listType, vals), nil
 }
 
 // Equal returns true if the List is considered semantically equal
@@ -193,23 +307,23 @@ func (l List) Equal(o attr.Value) bool {
 	if !ok {
 		return false
 	}
-	if l.Unknown != other.Unknown {
+	if l.state != other.state {
 		return false
 	}
-	if l.Null != other.Null {
+	if l.elementType == nil && other.elementType != nil {
 		return false
 	}
-	if l.ElemType == nil && other.ElemType != nil {
+	if l.elementType != nil && !l.elementType.Equal(other.elementType) {
 		return false
 	}
-	if l.ElemType != nil && !l.ElemType.Equal(other.ElemType) {
-		return false
+	if l.state != attr.ValueStateKnown {
+		return true
 	}
-	if len(l.Elems) != len(other.Elems) {
+	if len(l.elements) != len(other.elements) {
 		return false
 	}
-	for pos, lElem := range l.Elems {
-		oElem := other.Elems[pos]
+	for pos, lElem := range l.elements {
+		oElem := other.elements[pos]
 		if !lElem.Equal(oElem) {
 			return false
 		}
@@ -219,30 +333,30 @@ func (l List) Equal(o attr.Value) bool {
 
 // IsNull returns true if the List represents a null value.
 func (l List) IsNull() bool {
-	return l.Null
+	return l.state == attr.ValueStateNull
 }
 
 // IsUnknown returns true if the List represents a currently unknown value.
 func (l List) IsUnknown() bool {
-	return l.Unknown
+	return l.state == attr.ValueStateUnknown
 }
 
 // String returns a human-readable representation of the List value.
 // The string returned here is not protected by any compatibility guarantees,
 // and is intended for logging and error reporting.
 func (l List) String() string {
-	if l.Unknown {
+	if l.IsUnknown() {
 		return attr.UnknownValueString
 	}
 
-	if l.Null {
+	if l.IsNull() {
 		return attr.NullValueString
 	}
 
 	var res strings.Builder
 
 	res.WriteString("[")
-	for i, e := range l.Elems {
+	for i, e := range l.elements {
 		if i != 0 {
 			res.WriteString(",")
 		}
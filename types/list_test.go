@@ -6,6 +6,8 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
@@ -85,13 +87,10 @@ func TestListTypeValueFromTerraform(t *testing.T) {
 				tftypes.NewValue(tftypes.String, "hello"),
 				tftypes.NewValue(tftypes.String, "world"),
 			}),
-			expected: List{
-				ElemType: StringType,
-				Elems: []attr.Value{
-					String{Value: "hello"},
-					String{Value: "world"},
-				},
-			},
+			expected: ListValueMust(StringType, []attr.Value{
+				StringValue("hello"),
+				StringValue("world"),
+			}),
 		},
 		"unknown-list": {
 			receiver: ListType{
@@ -100,10 +99,7 @@ func TestListTypeValueFromTerraform(t *testing.T) {
 			input: tftypes.NewValue(tftypes.List{
 				ElementType: tftypes.String,
 			}, tftypes.UnknownValue),
-			expected: List{
-				ElemType: StringType,
-				Unknown:  true,
-			},
+			expected: ListUnknown(StringType),
 		},
 		"partially-unknown-list": {
 			receiver: ListType{
@@ -115,13 +111,10 @@ func TestListTypeValueFromTerraform(t *testing.T) {
 				tftypes.NewValue(tftypes.String, "hello"),
 				tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
 			}),
-			expected: List{
-				ElemType: StringType,
-				Elems: []attr.Value{
-					String{Value: "hello"},
-					String{Unknown: true},
-				},
-			},
+			expected: ListValueMust(StringType, []attr.Value{
+				StringValue("hello"),
+				StringUnknown(),
+			}),
 		},
 		"null-list": {
 			receiver: ListType{
@@ -130,10 +123,7 @@ func TestListTypeValueFromTerraform(t *testing.T) {
 			input: tftypes.NewValue(tftypes.List{
 				ElementType: tftypes.String,
 			}, nil),
-			expected: List{
-				ElemType: StringType,
-				Null:     true,
-			},
+			expected: ListNull(StringType),
 		},
 		"partially-null-list": {
 			receiver: ListType{
@@ -145,13 +135,10 @@ func TestListTypeValueFromTerraform(t *testing.T) {
 				tftypes.NewValue(tftypes.String, "hello"),
 				tftypes.NewValue(tftypes.String, nil),
 			}),
-			expected: List{
-				ElemType: StringType,
-				Elems: []attr.Value{
-					String{Value: "hello"},
-					String{Null: true},
-				},
-			},
+			expected: ListValueMust(StringType, []attr.Value{
+				StringValue("hello"),
+				StringNull(),
+			}),
 		},
 		"wrong-type": {
 			receiver: ListType{
@@ -175,11 +162,8 @@ func TestListTypeValueFromTerraform(t *testing.T) {
 			receiver: ListType{
 				ElemType: StringType,
 			},
-			input: tftypes.NewValue(nil, nil),
-			expected: List{
-				ElemType: StringType,
-				Null:     true,
-			},
+			input:    tftypes.NewValue(nil, nil),
+			expected: ListNull(StringType),
 		},
 	}
 	for name, test := range tests {
@@ -269,12 +253,10 @@ func TestListElementsAs_stringSlice(t *testing.T) {
 	var stringSlice []string
 	expected := []string{"hello", "world"}
 
-	diags := (List{
-		ElemType: StringType,
-		Elems: []attr.Value{
-			String{Value: "hello"},
-			String{Value: "world"},
-		}}).ElementsAs(context.Background(), &stringSlice, false)
+	diags := (ListValueMust(StringType, []attr.Value{
+		StringValue("hello"),
+		StringValue("world"),
+	})).ElementsAs(context.Background(), &stringSlice, false)
 	if diags.HasError() {
 		t.Errorf("Unexpected error: %v", diags)
 	}
@@ -288,16 +270,14 @@ func TestListElementsAs_attributeValueSlice(t *testing.T) {
 
 	var stringSlice []String
 	expected := []String{
-		{Value: "hello"},
-		{Value: "world"},
+		StringValue("hello"),
+		StringValue("world"),
 	}
 
-	diags := (List{
-		ElemType: StringType,
-		Elems: []attr.Value{
-			String{Value: "hello"},
-			String{Value: "world"},
-		}}).ElementsAs(context.Background(), &stringSlice, false)
+	diags := (ListValueMust(StringType, []attr.Value{
+		StringValue("hello"),
+		StringValue("world"),
+	})).ElementsAs(context.Background(), &stringSlice, false)
 	if diags.HasError() {
 		t.Errorf("Unexpected error: %v", diags)
 	}
@@ -316,60 +296,46 @@ func TestListToTerraformValue(t *testing.T) {
 	}
 	tests := map[string]testCase{
 		"value": {
-			input: List{
-				ElemType: StringType,
-				Elems: []attr.Value{
-					String{Value: "hello"},
-					String{Value: "world"},
-				},
-			},
+			input: ListValueMust(StringType, []attr.Value{
+				StringValue("hello"),
+				StringValue("world"),
+			}),
 			expectation: tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
 				tftypes.NewValue(tftypes.String, "hello"),
 				tftypes.NewValue(tftypes.String, "world"),
 			}),
 		},
 		"unknown": {
-			input:       List{ElemType: StringType, Unknown: true},
+			input:       ListUnknown(StringType),
 			expectation: tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, tftypes.UnknownValue),
 		},
 		"null": {
-			input:       List{ElemType: StringType, Null: true},
+			input:       ListNull(StringType),
 			expectation: tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
 		},
 		"partial-unknown": {
-			input: List{
-				ElemType: StringType,
-				Elems: []attr.Value{
-					String{Unknown: true},
-					String{Value: "hello, world"},
-				},
-			},
+			input: ListValueMust(StringType, []attr.Value{
+				StringUnknown(),
+				StringValue("hello, world"),
+			}),
 			expectation: tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
 				tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
 				tftypes.NewValue(tftypes.String, "hello, world"),
 			}),
 		},
 		"partial-null": {
-			input: List{
-				ElemType: StringType,
-				Elems: []attr.Value{
-					String{Null: true},
-					String{Value: "hello, world"},
-				},
-			},
+			input: ListValueMust(StringType, []attr.Value{
+				StringNull(),
+				StringValue("hello, world"),
+			}),
 			expectation: tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
 				tftypes.NewValue(tftypes.String, nil),
 				tftypes.NewValue(tftypes.String, "hello, world"),
 			}),
 		},
 		"no-elem-type": {
-			input: List{
-				Elems: []attr.Value{
-					String{Null: true},
-					String{Value: "hello, world"},
-				},
-			},
-			expectedErr: "cannot convert List to tftypes.Value if ElemType field is not set",
+			input:       List{},
+			expectedErr: "cannot convert List to tftypes.Value if element type is not set",
 			expectation: tftypes.Value{},
 		},
 	}
@@ -414,275 +380,187 @@ func TestListEqual(t *testing.T) {
 	}
 	tests := map[string]testCase{
 		"list-value-list-value": {
-			receiver: List{
-				ElemType: StringType,
-				Elems: []attr.Value{
-					String{Value: "hello"},
-					String{Value: "world"},
-				},
-			},
-			input: List{
-				ElemType: StringType,
-				Elems: []attr.Value{
-					String{Value: "hello"},
-					String{Value: "world"},
-				},
-			},
+			receiver: ListValueMust(StringType, []attr.Value{
+				StringValue("hello"),
+				StringValue("world"),
+			}),
+			input: ListValueMust(StringType, []attr.Value{
+				StringValue("hello"),
+				StringValue("world"),
+			}),
 			expected: true,
 		},
 		"list-value-diff": {
-			receiver: List{
-				ElemType: StringType,
-				Elems: []attr.Value{
-					String{Value: "hello"},
-					String{Value: "world"},
-				},
-			},
-			input: List{
-				ElemType: StringType,
-				Elems: []attr.Value{
-					String{Value: "goodnight"},
-					String{Value: "moon"},
-				},
-			},
+			receiver: ListValueMust(StringType, []attr.Value{
+				StringValue("hello"),
+				StringValue("world"),
+			}),
+			input: ListValueMust(StringType, []attr.Value{
+				StringValue("goodnight"),
+				StringValue("moon"),
+			}),
 			expected: false,
 		},
 		"list-value-count-diff": {
-			receiver: List{
-				ElemType: StringType,
-				Elems: []attr.Value{
-					String{Value: "hello"},
-					String{Value: "world"},
-				},
-			},
-			input: List{
-				ElemType: StringType,
-				Elems: []attr.Value{
-					String{Value: "hello"},
-					String{Value: "world"},
-					String{Value: "test"},
-				},
-			},
+			receiver: ListValueMust(StringType, []attr.Value{
+				StringValue("hello"),
+				StringValue("world"),
+			}),
+			input: ListValueMust(StringType, []attr.Value{
+				StringValue("hello"),
+				StringValue("world"),
+				StringValue("test"),
+			}),
 			expected: false,
 		},
 		"list-value-type-diff": {
-			receiver: List{
-				ElemType: StringType,
-				Elems: []attr.Value{
-					String{Value: "hello"},
-					String{Value: "world"},
-				},
-			},
-			input: List{
-				ElemType: BoolType,
-				Elems: []attr.Value{
-					Bool{Value: false},
-					Bool{Value: true},
-				},
-			},
+			receiver: ListValueMust(StringType, []attr.Value{
+				StringValue("hello"),
+				StringValue("world"),
+			}),
+			input: ListValueMust(BoolType, []attr.Value{
+				BoolValue(false),
+				BoolValue(true),
+			}),
 			expected: false,
 		},
 		"list-value-unknown": {
-			receiver: List{
-				ElemType: StringType,
-				Elems: []attr.Value{
-					String{Value: "hello"},
-					String{Value: "world"},
-				},
-			},
-			input:    List{Unknown: true},
+			receiver: ListValueMust(StringType, []attr.Value{
+				StringValue("hello"),
+				StringValue("world"),
+			}),
+			input:    ListUnknown(StringType),
 			expected: false,
 		},
 		"list-value-null": {
-			receiver: List{
-				ElemType: StringType,
-				Elems: []attr.Value{
-					String{Value: "hello"},
-					String{Value: "world"},
-				},
-			},
-			input:    List{Null: true},
+			receiver: ListValueMust(StringType, []attr.Value{
+				StringValue("hello"),
+				StringValue("world"),
+			}),
+			input:    ListNull(StringType),
 			expected: false,
 		},
 		"list-value-wrongType": {
-			receiver: List{
-				ElemType: StringType,
-				Elems: []attr.Value{
-					String{Value: "hello"},
-					String{Value: "world"},
-				},
-			},
-			input:    String{Value: "hello, world"},
+			receiver: ListValueMust(StringType, []attr.Value{
+				StringValue("hello"),
+				StringValue("world"),
+			}),
+			input:    StringValue("hello, world"),
 			expected: false,
 		},
 		"list-value-nil": {
-			receiver: List{
-				ElemType: StringType,
-				Elems: []attr.Value{
-					String{Value: "hello"},
-					String{Value: "world"},
-				},
-			},
+			receiver: ListValueMust(StringType, []attr.Value{
+				StringValue("hello"),
+				StringValue("world"),
+			}),
 			input:    nil,
 			expected: false,
 		},
 		"partially-known-list-value-list-value": {
-			receiver: List{
-				ElemType: StringType,
-				Elems: []attr.Value{
-					String{Value: "hello"},
-					String{Unknown: true},
-				},
-			},
-			input: List{
-				ElemType: StringType,
-				Elems: []attr.Value{
-					String{Value: "hello"},
-					String{Unknown: true},
-				},
-			},
+			receiver: ListValueMust(StringType, []attr.Value{
+				StringValue("hello"),
+				StringUnknown(),
+			}),
+			input: ListValueMust(StringType, []attr.Value{
+				StringValue("hello"),
+				StringUnknown(),
+			}),
 			expected: true,
 		},
 		"partially-known-list-value-diff": {
-			receiver: List{
-				ElemType: StringType,
-				Elems: []attr.Value{
-					String{Value: "hello"},
-					String{Unknown: true},
-				},
-			},
-			input: List{
-				ElemType: StringType,
-				Elems: []attr.Value{
-					String{Value: "hello"},
-					String{Value: "world"},
-				},
-			},
+			receiver: ListValueMust(StringType, []attr.Value{
+				StringValue("hello"),
+				StringUnknown(),
+			}),
+			input: ListValueMust(StringType, []attr.Value{
+				StringValue("hello"),
+				StringValue("world"),
+			}),
 			expected: false,
 		},
 		"partially-known-list-value-unknown": {
-			receiver: List{
-				ElemType: StringType,
-				Elems: []attr.Value{
-					String{Value: "hello"},
-					String{Unknown: true},
-				},
-			},
-			input:    List{Unknown: true},
+			receiver: ListValueMust(StringType, []attr.Value{
+				StringValue("hello"),
+				StringUnknown(),
+			}),
+			input:    ListUnknown(StringType),
 			expected: false,
 		},
 		"partially-known-list-value-null": {
-			receiver: List{
-				ElemType: StringType,
-				Elems: []attr.Value{
-					String{Value: "hello"},
-					String{Unknown: true},
-				},
-			},
-			input:    List{Null: true},
+			receiver: ListValueMust(StringType, []attr.Value{
+				StringValue("hello"),
+				StringUnknown(),
+			}),
+			input:    ListNull(StringType),
 			expected: false,
 		},
 		"partially-known-list-value-wrongType": {
-			receiver: List{
-				ElemType: StringType,
-				Elems: []attr.Value{
-					String{Value: "hello"},
-					String{Unknown: true},
-				},
-			},
-			input:    String{Value: "hello, world"},
+			receiver: ListValueMust(StringType, []attr.Value{
+				StringValue("hello"),
+				StringUnknown(),
+			}),
+			input:    StringValue("hello, world"),
 			expected: false,
 		},
 		"partially-known-list-value-nil": {
-			receiver: List{
-				ElemType: StringType,
-				Elems: []attr.Value{
-					String{Value: "hello"},
-					String{Unknown: true},
-				},
-			},
+			receiver: ListValueMust(StringType, []attr.Value{
+				StringValue("hello"),
+				StringUnknown(),
+			}),
 			input:    nil,
 			expected: false,
 		},
 		"partially-null-list-value-list-value": {
-			receiver: List{
-				ElemType: StringType,
-				Elems: []attr.Value{
-					String{Value: "hello"},
-					String{Null: true},
-				},
-			},
-			input: List{
-				ElemType: StringType,
-				Elems: []attr.Value{
-					String{Value: "hello"},
-					String{Null: true},
-				},
-			},
+			receiver: ListValueMust(StringType, []attr.Value{
+				StringValue("hello"),
+				StringNull(),
+			}),
+			input: ListValueMust(StringType, []attr.Value{
+				StringValue("hello"),
+				StringNull(),
+			}),
 			expected: true,
 		},
 		"partially-null-list-value-diff": {
-			receiver: List{
-				ElemType: StringType,
-				Elems: []attr.Value{
-					String{Value: "hello"},
-					String{Null: true},
-				},
-			},
-			input: List{
-				ElemType: StringType,
-				Elems: []attr.Value{
-					String{Value: "hello"},
-					String{Value: "world"},
-				},
-			},
+			receiver: ListValueMust(StringType, []attr.Value{
+				StringValue("hello"),
+				StringNull(),
+			}),
+			input: ListValueMust(StringType, []attr.Value{
+				StringValue("hello"),
+				StringValue("world"),
+			}),
 			expected: false,
 		},
 		"partially-null-list-value-unknown": {
-			receiver: List{
-				ElemType: StringType,
-				Elems: []attr.Value{
-					String{Value: "hello"},
-					String{Null: true},
-				},
-			},
-			input: List{
-				Unknown: true,
-			},
+			receiver: ListValueMust(StringType, []attr.Value{
+				StringValue("hello"),
+				StringNull(),
+			}),
+			input:    ListUnknown(StringType),
 			expected: false,
 		},
 		"partially-null-list-value-null": {
-			receiver: List{
-				ElemType: StringType,
-				Elems: []attr.Value{
-					String{Value: "hello"},
-					String{Null: true},
-				},
-			},
-			input: List{
-				Null: true,
-			},
+			receiver: ListValueMust(StringType, []attr.Value{
+				StringValue("hello"),
+				StringNull(),
+			}),
+			input:    ListNull(StringType),
 			expected: false,
 		},
 		"partially-null-list-value-wrongType": {
-			receiver: List{
-				ElemType: StringType,
-				Elems: []attr.Value{
-					String{Value: "hello"},
-					String{Null: true},
-				},
-			},
-			input:    String{Value: "hello, world"},
+			receiver: ListValueMust(StringType, []attr.Value{
+				StringValue("hello"),
+				StringNull(),
+			}),
+			input:    StringValue("hello, world"),
 			expected: false,
 		},
 		"partially-null-list-value-nil": {
-			receiver: List{
-				ElemType: StringType,
-				Elems: []attr.Value{
-					String{Value: "hello"},
-					String{Null: true},
-				},
-			},
+			receiver: ListValueMust(StringType, []attr.Value{
+				StringValue("hello"),
+				StringNull(),
+			}),
 			input:    nil,
 			expected: false,
 		},
@@ -709,50 +587,38 @@ func TestListString(t *testing.T) {
 	}
 	tests := map[string]testCase{
 		"simple": {
-			input: List{
-				ElemType: StringType,
-				Elems: []attr.Value{
-					String{Value: "hello"},
-					String{Value: "world"},
-				},
-			},
+			input: ListValueMust(StringType, []attr.Value{
+				StringValue("hello"),
+				StringValue("world"),
+			}),
 			expectation: `["hello","world"]`,
 		},
 		"list-of-lists": {
-			input: List{
-				ElemType: ListType{
-					ElemType: StringType,
-				},
-				Elems: []attr.Value{
-					List{
-						ElemType: StringType,
-						Elems: []attr.Value{
-							String{Value: "hello"},
-							String{Value: "world"},
-						},
-					},
-					List{
-						ElemType: StringType,
-						Elems: []attr.Value{
-							String{Value: "foo"},
-							String{Value: "bar"},
-						},
-					},
-				},
-			},
+			input: ListValueMust(ListType{
+				ElemType: StringType,
+			}, []attr.Value{
+				ListValueMust(StringType, []attr.Value{
+					StringValue("hello"),
+					StringValue("world"),
+				}),
+				ListValueMust(StringType, []attr.Value{
+					StringValue("foo"),
+					StringValue("bar"),
+				}),
+			}),
 			expectation: `[["hello","world"],["foo","bar"]]`,
 		},
 		"unknown": {
-			input:       List{Unknown: true},
+			input:       ListUnknown(StringType),
 			expectation: "<unknown>",
 		},
 		"null": {
-			input:       List{Null: true},
+			input:       ListNull(StringType),
 			expectation: "<null>",
 		},
-		"default-empty": {
+		"zero-value": {
 			input:       List{},
-			expectation: "[]",
+			expectation: "<null>",
 		},
 	}
 
@@ -768,3 +634,153 @@ func TestListString(t *testing.T) {
 		})
 	}
 }
+
+func TestListValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		elementType   attr.Type
+		elements      []attr.Value
+		expected      List
+		expectedDiags diag.Diagnostics
+	}{
+		"valid-no-elements": {
+			elementType: StringType,
+			elements:    []attr.Value{},
+			expected:    ListValueMust(StringType, []attr.Value{}),
+		},
+		"valid-elements": {
+			elementType: StringType,
+			elements: []attr.Value{
+				StringNull(),
+				StringUnknown(),
+				StringValue("test"),
+			},
+			expected: ListValueMust(StringType, []attr.Value{
+				StringNull(),
+				StringUnknown(),
+				StringValue("test"),
+			}),
+		},
+		"invalid-element-type": {
+			elementType: StringType,
+			elements: []attr.Value{
+				StringValue("test"),
+				BoolValue(true),
+			},
+			expected: ListUnknown(StringType),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid List Element Type",
+					"While creating a List value, an invalid element was detected. "+
+						"A List must use the single, given element type. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"List Element Type: types.StringType\n"+
+						"List Index (1) Element Type: types.BoolType",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := ListValue(testCase.elementType, testCase.elements)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestListValueFrom(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		elementType   attr.Type
+		elements      interface{}
+		expected      List
+		expectedDiags diag.Diagnostics
+	}{
+		"valid-StringType-[]attr.Value": {
+			elementType: StringType,
+			elements: []attr.Value{
+				StringValue("test1"),
+				StringValue("test2"),
+			},
+			expected: ListValueMust(StringType, []attr.Value{
+				StringValue("test1"),
+				StringValue("test2"),
+			}),
+		},
+		"valid-StringType-[]string": {
+			elementType: StringType,
+			elements:    []string{"test1", "test2"},
+			expected: ListValueMust(StringType, []attr.Value{
+				StringValue("test1"),
+				StringValue("test2"),
+			}),
+		},
+		"valid-StringType-[]*string": {
+			elementType: StringType,
+			elements:    []*string{nil},
+			expected: ListValueMust(StringType, []attr.Value{
+				StringNull(),
+			}),
+		},
+		"invalid-not-slice": {
+			elementType: StringType,
+			elements:    "oops",
+			expected:    ListUnknown(StringType),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Empty(),
+					"Value Conversion Error",
+					"An unexpected error was encountered trying to convert the Terraform value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"can't use tftypes.String<\"oops\"> as value of List with ElementType types.primitive, can only use tftypes.String values",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := ListValueFrom(context.Background(), testCase.elementType, testCase.elements)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestListElements(t *testing.T) {
+	t.Parallel()
+
+	list := ListValueMust(StringType, []attr.Value{StringValue("test")})
+
+	elements := list.Elements()
+	elements[0] = StringValue("modified")
+
+	expected := []attr.Value{StringValue("test")}
+
+	if diff := cmp.Diff(list.Elements(), expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
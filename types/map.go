@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/reflect"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
@@ -50,12 +51,8 @@ func (m MapType) TerraformType(ctx context.Context) tftypes.Type {
 // meant to convert the tftypes.Value into a more convenient Go type for the
 // provider to consume the data with.
 func (m MapType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
-	ma := Map{
-		ElemType: m.ElemType,
-	}
 	if in.Type() == nil {
-		ma.Null = true
-		return ma, nil
+		return MapNull(m.ElemType), nil
 	}
 	if !in.Type().Is(tftypes.Map{}) {
 		return nil, fmt.Errorf("can't use %s as value of Map, can only use tftypes.Map values", in.String())
@@ -64,12 +61,10 @@ func (m MapType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr
 		return nil, fmt.Errorf("can't use %s as value of Map with ElementType %T, can only use %s values", in.String(), m.ElemType, m.ElemType.TerraformType(ctx).String())
 	}
 	if !in.IsKnown() {
-		ma.Unknown = true
-		return ma, nil
+		return MapUnknown(m.ElemType), nil
 	}
 	if in.IsNull() {
-		ma.Null = true
-		return ma, nil
+		return MapNull(m.ElemType), nil
 	}
 	val := map[string]tftypes.Value{}
 	err := in.As(&val)
@@ -84,8 +79,13 @@ func (m MapType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr
 		}
 		elems[key] = av
 	}
-	ma.Elems = elems
-	return ma, nil
+	// Elements were created from the element type, so there is no need to
+	// validate them via MapValue.
+	return Map{
+		elementType: m.ElemType,
+		elements:    elems,
+		state:       attr.ValueStateKnown,
+	}, nil
 }
 
 // Equal returns true if `o` is also a MapType and has the same ElemType.
@@ -115,28 +115,132 @@ func (m MapType) String() string {
 	return "types.MapType[" + m.ElemType.String() + "]"
 }
 
-// Map represents a map of attr.Values, all of the same type, indicated by
-// ElemType. Keys for the map will always be strings.
+// MapNull creates a Map with a null value. Determine whether the value is
+// null via the Map type IsNull method.
+func MapNull(elementType attr.Type) Map {
+	return Map{
+		elementType: elementType,
+		state:       attr.ValueStateNull,
+	}
+}
+
+// MapUnknown creates a Map with an unknown value. Determine whether the
+// value is unknown via the Map type IsUnknown method.
+func MapUnknown(elementType attr.Type) Map {
+	return Map{
+		elementType: elementType,
+		state:       attr.ValueStateUnknown,
+	}
+}
+
+// MapValue creates a Map with a known value. Access the value via the Map
+// type Elements or ElementsAs methods. Every element must be of the given
+// element type, otherwise an error diagnostic is returned.
+func MapValue(elementType attr.Type, elements map[string]attr.Value) (Map, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// The creation functions do not accept a context, as they are typically
+	// used in places where one is not available, such as tests.
+	ctx := context.Background()
+
+	// Sort for consistent diagnostics ordering.
+	for _, key := range sortedValueKeys(elements) {
+		element := elements[key]
+
+		if !elementType.Equal(element.Type(ctx)) {
+			diags.AddError(
+				"Invalid Map Element Type",
+				"While creating a Map value, an invalid element was detected. "+
+					"A Map must use the single, given element type. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("Map Element Type: %s\n", elementType)+
+					fmt.Sprintf("Map Key (%s) Element Type: %s", key, element.Type(ctx)),
+			)
+		}
+	}
+
+	if diags.HasError() {
+		return MapUnknown(elementType), diags
+	}
+
+	return Map{
+		elementType: elementType,
+		elements:    copyElementsMap(elements),
+		state:       attr.ValueStateKnown,
+	}, nil
+}
+
+// MapValueFrom creates a Map with a known value, using reflection rules.
+// The elements must be a map of string keys to values which can convert into
+// the given element type. Access the value via the Map type Elements or
+// ElementsAs methods.
+func MapValueFrom(ctx context.Context, elementType attr.Type, elements interface{}) (Map, diag.Diagnostics) {
+	attrValue, diags := reflect.FromValue(
+		ctx,
+		MapType{ElemType: elementType},
+		elements,
+		path.Empty(),
+	)
+
+	if diags.HasError() {
+		return MapUnknown(elementType), diags
+	}
+
+	m, ok := attrValue.(Map)
+
+	// Reflection should only return Map, but guard against a panic.
+	if !ok {
+		diags.AddError(
+			"Map Conversion Error",
+			"An unexpected error was encountered trying to convert the elements into a Map value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("expected types.Map, got: %T", attrValue),
+		)
+
+		return MapUnknown(elementType), diags
+	}
+
+	return m, diags
+}
+
+// MapValueMust creates a Map with a known value, converting any diagnostics
+// into a panic at runtime. Access the value via the Map type Elements or
+// ElementsAs methods.
+//
+// This creation function is only recommended to create Map values which
+// will not potentially affect practitioners, such as testing, or exhaustively
+// tested provider logic.
+func MapValueMust(elementType attr.Type, elements map[string]attr.Value) Map {
+	m, diags := MapValue(elementType, elements)
+
+	if diags.HasError() {
+		panic(diagsPanicMessage("MapValueMust", diags))
+	}
+
+	return m
+}
+
+// Map represents a map of attr.Values, all of the same type, indicated by the
+// element type. Keys for the map will always be strings. The zero-value of
+// Map is null without an element type, use the MapNull, MapUnknown, and
+// MapValue functions to create a Map.
 type Map struct {
-	// Unknown will be set to true if the entire map is an unknown value.
-	// If only some of the elements in the map are unknown, their known or
-	// unknown status will be represented however that attr.Value
-	// surfaces that information. The Map's Unknown property only tracks if
-	// the number of elements in a Map is known, not whether the elements
-	// that are in the map are known.
-	Unknown bool
-
-	// Null will be set to true if the map is null, either because it was
-	// omitted from the configuration, state, or plan, or because it was
-	// explicitly set to null.
-	Null bool
-
-	// Elems are the elements in the map.
-	Elems map[string]attr.Value
-
-	// ElemType is the AttributeType of the elements in the map. All
-	// elements in the map must be of this type.
-	ElemType attr.Type
+	// elements are the known elements in the map. If only some of the
+	// elements in the map are unknown, their known or unknown status will
+	// be represented however that attr.Value surfaces that information.
+	elements map[string]attr.Value
+
+	// elementType is the type of the elements in the map. All elements in
+	// the map must be of this type.
+	elementType attr.Type
+
+	// state represents whether the value is null, unknown, or known. The
+	// zero-value is null.
+	state attr.ValueState
+}
+
+// Elements returns a copy of the mapping of elements for the Map.
+func (m Map) Elements() map[string]attr.Value {
+	return copyElementsMap(m.elements)
 }
 
 // ElementsAs populates `target` with the elements of the Map, throwing an
@@ -154,41 +258,55 @@ func (m Map) ElementsAs(ctx context.Context, target interface{}, allowUnhandled
 			),
 		}
 	}
-	return reflect.Into(ctx, MapType{ElemType: m.ElemType}, val, target, reflect.Options{
+	return reflect.Into(ctx, MapType{ElemType: m.elementType}, val, target, reflect.Options{
 		UnhandledNullAsEmpty:    allowUnhandled,
 		UnhandledUnknownAsEmpty: allowUnhandled,
 	})
 }
 
+// ElementType returns the element type for the Map.
+func (m Map) ElementType(_ context.Context) attr.Type {
+	return m.elementType
+}
+
 // Type returns a MapType with the same element type as `m`.
 func (m Map) Type(ctx context.Context) attr.Type {
-	return MapType{ElemType: m.ElemType}
+	return MapType{ElemType: m.elementType}
 }
 
 // ToTerraformValue returns the data contained in the List as a tftypes.Value.
 func (m Map) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
-	if m.ElemType == nil {
-		return tftypes.Value{}, fmt.Errorf("cannot convert Map to tftypes.Value if ElemType field is not set")
-	}
-	mapType := tftypes.Map{ElementType: m.ElemType.TerraformType(ctx)}
-	if m.Unknown {
-		return tftypes.NewValue(mapType, tftypes.UnknownValue), nil
+	if m.elementType == nil {
+		return tftypes.Value{}, fmt.Errorf("cannot convert Map to tftypes.Value if element type is not set")
 	}
-	if m.Null {
-		return tftypes.NewValue(mapType, nil), nil
-	}
-	vals := make(map[string]tftypes.Value, len(m.Elems))
-	for key, elem := range m.Elems {
-		val, err := elem.ToTerraformValue(ctx)
-		if err != nil {
+	mapType := tftypes.Map{ElementType: m.elementType.TerraformType(ctx)}
+
+	switch m.state {
+	case attr.ValueStateKnown:
+		vals := make(map[string]tftypes.Value, len(m.elements))
+
+		for key, elem := range m.elements {
+			val, err := elem.ToTerraformValue(ctx)
+
+			if err != nil {
+				return tftypes.NewValue(mapType, tftypes.UnknownValue), err
+			}
+
+			vals[key] = val
+		}
+
+		if err := tftypes.ValidateValue(mapType, vals); err != nil {
 			return tftypes.NewValue(mapType, tftypes.UnknownValue), err
 		}
-		vals[key] = val
-	}
-	if err := tftypes.ValidateValue(mapType, vals); err != nil {
-		return tftypes.NewValue(mapType, tftypes.UnknownValue), err
+
+		return tftypes.NewValue(mapType, vals), nil
+	case attr.ValueStateNull:
+		return tftypes.NewValue(mapType, nil), nil
+	case attr.ValueStateUnknown:
+		return tftypes.NewValue(mapType, tftypes.UnknownValue), nil
+	default:
+		panic(fmt.Sprintf("unhandled Map state in ToTerraformValue: %s", m.state))
 	}
-	return tftypes.NewValue(mapType, vals), nil
 }
 
 // Equal returns true if the Map is considered semantically equal
@@ -198,20 +316,23 @@ func (m Map) Equal(o attr.Value) bool {
 	if !ok {
 		return false
 	}
-	if m.Unknown != other.Unknown {
+	if m.state != other.state {
 		return false
 	}
-	if m.Null != other.Null {
+	if m.elementType == nil && other.elementType != nil {
 		return false
 	}
-	if !m.ElemType.Equal(other.ElemType) {
+	if m.elementType != nil && !m.elementType.Equal(other.elementType) {
 		return false
 	}
-	if len(m.Elems) != len(other.Elems) {
+	if m.state != attr.ValueStateKnown {
+		return true
+	}
+	if len(m.elements) != len(other.elements) {
 		return false
 	}
-	for key, mElem := range m.Elems {
-		oElem, ok := other.Elems[key]
+	for key, mElem := range m.elements {
+		oElem, ok := other.elements[key]
 		if !ok {
 			return false
 		}
@@ -224,29 +345,29 @@ func (m Map) Equal(o attr.Value) bool {
 
 // IsNull returns true if the Map represents a null value.
 func (m Map) IsNull() bool {
-	return m.Null
+	return m.state == attr.ValueStateNull
 }
 
 // IsUnknown returns true if the Map represents a currently unknown value.
 func (m Map) IsUnknown() bool {
-	return m.Unknown
+	return m.state == attr.ValueStateUnknown
 }
 
 // String returns a human-readable representation of the Map value.
 // The string returned here is not protected by any compatibility guarantees,
 // and is intended for logging and error reporting.
 func (m Map) String() string {
-	if m.Unknown {
+	if m.IsUnknown() {
 		return attr.UnknownValueString
 	}
 
-	if m.Null {
+	if m.IsNull() {
 		return attr.NullValueString
 	}
 
 	// We want the output to be consistent, so we sort the output by key
-	keys := make([]string, 0, len(m.Elems))
-	for k := range m.Elems {
+	keys := make([]string, 0, len(m.elements))
+	for k := range m.elements {
 		keys = append(keys, k)
 	}
 	sort.Strings(keys)
@@ -258,7 +379,7 @@ func (m Map) String() string {
 		if i != 0 {
 			res.WriteString(",")
 		}
-		res.WriteString(fmt.Sprintf("%q:%s", k, m.Elems[k].String()))
+		res.WriteString(fmt.Sprintf("%q:%s", k, m.elements[k].String()))
 	}
 	res.WriteString("}")
 
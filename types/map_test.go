@@ -7,6 +7,8 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
@@ -87,14 +89,11 @@ func TestMapTypeValueFromTerraform(t *testing.T) {
 				"two":   tftypes.NewValue(tftypes.Number, 2),
 				"three": tftypes.NewValue(tftypes.Number, 3),
 			}),
-			expected: Map{
-				ElemType: NumberType,
-				Elems: map[string]attr.Value{
-					"one":   Number{Value: big.NewFloat(1)},
-					"two":   Number{Value: big.NewFloat(2)},
-					"three": Number{Value: big.NewFloat(3)},
-				},
-			},
+			expected: MapValueMust(NumberType, map[string]attr.Value{
+				"one":   NumberValue(big.NewFloat(1)),
+				"two":   NumberValue(big.NewFloat(2)),
+				"three": NumberValue(big.NewFloat(3)),
+			}),
 		},
 		"wrong-type": {
 			receiver: MapType{
@@ -107,11 +106,8 @@ func TestMapTypeValueFromTerraform(t *testing.T) {
 			receiver: MapType{
 				ElemType: NumberType,
 			},
-			input: tftypes.NewValue(nil, nil),
-			expected: Map{
-				ElemType: NumberType,
-				Null:     true,
-			},
+			input:    tftypes.NewValue(nil, nil),
+			expected: MapNull(NumberType),
 		},
 		"unknown": {
 			receiver: MapType{
@@ -120,10 +116,7 @@ func TestMapTypeValueFromTerraform(t *testing.T) {
 			input: tftypes.NewValue(tftypes.Map{
 				ElementType: tftypes.Number,
 			}, tftypes.UnknownValue),
-			expected: Map{
-				ElemType: NumberType,
-				Unknown:  true,
-			},
+			expected: MapUnknown(NumberType),
 		},
 		"null": {
 			receiver: MapType{
@@ -132,10 +125,7 @@ func TestMapTypeValueFromTerraform(t *testing.T) {
 			input: tftypes.NewValue(tftypes.Map{
 				ElementType: tftypes.Number,
 			}, nil),
-			expected: Map{
-				ElemType: NumberType,
-				Null:     true,
-			},
+			expected: MapNull(NumberType),
 		},
 	}
 
@@ -251,12 +241,10 @@ func TestMapElementsAs_mapStringString(t *testing.T) {
 		"w": "world",
 	}
 
-	diags := (Map{
-		ElemType: StringType,
-		Elems: map[string]attr.Value{
-			"h": String{Value: "hello"},
-			"w": String{Value: "world"},
-		}}).ElementsAs(context.Background(), &stringSlice, false)
+	diags := (MapValueMust(StringType, map[string]attr.Value{
+		"h": StringValue("hello"),
+		"w": StringValue("world"),
+	})).ElementsAs(context.Background(), &stringSlice, false)
 	if diags.HasError() {
 		t.Errorf("Unexpected error: %v", diags)
 	}
@@ -270,16 +258,14 @@ func TestMapElementsAs_mapStringAttributeValue(t *testing.T) {
 
 	var stringSlice map[string]String
 	expected := map[string]String{
-		"h": {Value: "hello"},
-		"w": {Value: "world"},
+		"h": StringValue("hello"),
+		"w": StringValue("world"),
 	}
 
-	diags := (Map{
-		ElemType: StringType,
-		Elems: map[string]attr.Value{
-			"h": String{Value: "hello"},
-			"w": String{Value: "world"},
-		}}).ElementsAs(context.Background(), &stringSlice, false)
+	diags := (MapValueMust(StringType, map[string]attr.Value{
+		"h": StringValue("hello"),
+		"w": StringValue("world"),
+	})).ElementsAs(context.Background(), &stringSlice, false)
 	if diags.HasError() {
 		t.Errorf("Unexpected error: %v", diags)
 	}
@@ -298,60 +284,46 @@ func TestMapToTerraformValue(t *testing.T) {
 	}
 	tests := map[string]testCase{
 		"value": {
-			input: Map{
-				ElemType: StringType,
-				Elems: map[string]attr.Value{
-					"h": String{Value: "hello"},
-					"w": String{Value: "world"},
-				},
-			},
+			input: MapValueMust(StringType, map[string]attr.Value{
+				"h": StringValue("hello"),
+				"w": StringValue("world"),
+			}),
 			expectation: tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
 				"h": tftypes.NewValue(tftypes.String, "hello"),
 				"w": tftypes.NewValue(tftypes.String, "world"),
 			}),
 		},
 		"unknown": {
-			input:       Map{ElemType: StringType, Unknown: true},
+			input:       MapUnknown(StringType),
 			expectation: tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, tftypes.UnknownValue),
 		},
 		"null": {
-			input:       Map{ElemType: StringType, Null: true},
+			input:       MapNull(StringType),
 			expectation: tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
 		},
 		"partial-unknown": {
-			input: Map{
-				ElemType: StringType,
-				Elems: map[string]attr.Value{
-					"unk": String{Unknown: true},
-					"hw":  String{Value: "hello, world"},
-				},
-			},
+			input: MapValueMust(StringType, map[string]attr.Value{
+				"unk": StringUnknown(),
+				"hw":  StringValue("hello, world"),
+			}),
 			expectation: tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
 				"unk": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
 				"hw":  tftypes.NewValue(tftypes.String, "hello, world"),
 			}),
 		},
 		"partial-null": {
-			input: Map{
-				ElemType: StringType,
-				Elems: map[string]attr.Value{
-					"n":  String{Null: true},
-					"hw": String{Value: "hello, world"},
-				},
-			},
+			input: MapValueMust(StringType, map[string]attr.Value{
+				"n":  StringNull(),
+				"hw": StringValue("hello, world"),
+			}),
 			expectation: tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
 				"n":  tftypes.NewValue(tftypes.String, nil),
 				"hw": tftypes.NewValue(tftypes.String, "hello, world"),
 			}),
 		},
 		"no-elem-type": {
-			input: Map{
-				Elems: map[string]attr.Value{
-					"n":  String{Null: true},
-					"hw": String{Value: "hello, world"},
-				},
-			},
-			expectedErr: "cannot convert Map to tftypes.Value if ElemType field is not set",
+			input:       Map{},
+			expectedErr: "cannot convert Map to tftypes.Value if element type is not set",
 			expectation: tftypes.Value{},
 		},
 	}
@@ -396,292 +368,198 @@ func TestMapEqual(t *testing.T) {
 	}
 	tests := map[string]testCase{
 		"equal": {
-			receiver: Map{
-				ElemType: StringType,
-				Elems: map[string]attr.Value{
-					"h": String{Value: "hello"},
-					"w": String{Value: "world"},
-				},
-			},
-			input: Map{
-				ElemType: StringType,
-				Elems: map[string]attr.Value{
-					"h": String{Value: "hello"},
-					"w": String{Value: "world"},
-				},
-			},
+			receiver: MapValueMust(StringType, map[string]attr.Value{
+				"h": StringValue("hello"),
+				"w": StringValue("world"),
+			}),
+			input: MapValueMust(StringType, map[string]attr.Value{
+				"h": StringValue("hello"),
+				"w": StringValue("world"),
+			}),
 			expected: true,
 		},
 		"elem-value-diff": {
-			receiver: Map{
-				ElemType: StringType,
-				Elems: map[string]attr.Value{
-					"h": String{Value: "hello"},
-					"w": String{Value: "world"},
-				},
-			},
-			input: Map{
-				ElemType: StringType,
-				Elems: map[string]attr.Value{
-					"h": String{Value: "goodnight"},
-					"w": String{Value: "moon"},
-				},
-			},
+			receiver: MapValueMust(StringType, map[string]attr.Value{
+				"h": StringValue("hello"),
+				"w": StringValue("world"),
+			}),
+			input: MapValueMust(StringType, map[string]attr.Value{
+				"h": StringValue("goodnight"),
+				"w": StringValue("moon"),
+			}),
 			expected: false,
 		},
 		"elem-key-diff": {
-			receiver: Map{
-				ElemType: StringType,
-				Elems: map[string]attr.Value{
-					"h": String{Value: "hello"},
-					"w": String{Value: "world"},
-				},
-			},
-			input: Map{
-				ElemType: StringType,
-				Elems: map[string]attr.Value{
-					"no": String{Value: "hello"},
-					"w":  String{Value: "world"},
-				},
-			},
+			receiver: MapValueMust(StringType, map[string]attr.Value{
+				"h": StringValue("hello"),
+				"w": StringValue("world"),
+			}),
+			input: MapValueMust(StringType, map[string]attr.Value{
+				"no": StringValue("hello"),
+				"w":  StringValue("world"),
+			}),
 			expected: false,
 		},
 		"elem-count-diff": {
-			receiver: Map{
-				ElemType: StringType,
-				Elems: map[string]attr.Value{
-					"h": String{Value: "hello"},
-					"w": String{Value: "world"},
-				},
-			},
-			input: Map{
-				ElemType: StringType,
-				Elems: map[string]attr.Value{
-					"h": String{Value: "hello"},
-					"w": String{Value: "world"},
-					"t": String{Value: "test"},
-				},
-			},
+			receiver: MapValueMust(StringType, map[string]attr.Value{
+				"h": StringValue("hello"),
+				"w": StringValue("world"),
+			}),
+			input: MapValueMust(StringType, map[string]attr.Value{
+				"h": StringValue("hello"),
+				"w": StringValue("world"),
+				"t": StringValue("test"),
+			}),
 			expected: false,
 		},
 		"elem-value-type-diff": {
-			receiver: Map{
-				ElemType: StringType,
-				Elems: map[string]attr.Value{
-					"h": String{Value: "hello"},
-					"w": String{Value: "world"},
-				},
-			},
-			input: Map{
-				ElemType: BoolType,
-				Elems: map[string]attr.Value{
-					"h": Bool{Value: false},
-					"w": Bool{Value: true},
-				},
-			},
+			receiver: MapValueMust(StringType, map[string]attr.Value{
+				"h": StringValue("hello"),
+				"w": StringValue("world"),
+			}),
+			input: MapValueMust(BoolType, map[string]attr.Value{
+				"h": BoolValue(false),
+				"w": BoolValue(true),
+			}),
 			expected: false,
 		},
 		"map-value-unknown": {
-			receiver: Map{
-				ElemType: StringType,
-				Elems: map[string]attr.Value{
-					"h": String{Value: "hello"},
-					"w": String{Value: "world"},
-				},
-			},
-			input:    Map{Unknown: true},
+			receiver: MapValueMust(StringType, map[string]attr.Value{
+				"h": StringValue("hello"),
+				"w": StringValue("world"),
+			}),
+			input:    MapUnknown(StringType),
 			expected: false,
 		},
 		"map-value-null": {
-			receiver: Map{
-				ElemType: StringType,
-				Elems: map[string]attr.Value{
-					"h": String{Value: "hello"},
-					"w": String{Value: "world"},
-				},
-			},
-			input:    Map{Null: true},
+			receiver: MapValueMust(StringType, map[string]attr.Value{
+				"h": StringValue("hello"),
+				"w": StringValue("world"),
+			}),
+			input:    MapNull(StringType),
 			expected: false,
 		},
 		"map-elem-wrongType": {
-			receiver: Map{
-				ElemType: StringType,
-				Elems: map[string]attr.Value{
-					"h": String{Value: "hello"},
-					"w": String{Value: "world"},
-				},
-			},
-			input:    String{Value: "hello, world"},
+			receiver: MapValueMust(StringType, map[string]attr.Value{
+				"h": StringValue("hello"),
+				"w": StringValue("world"),
+			}),
+			input:    StringValue("hello, world"),
 			expected: false,
 		},
 		"value-nil": {
-			receiver: Map{
-				ElemType: StringType,
-				Elems: map[string]attr.Value{
-					"h": String{Value: "hello"},
-					"w": String{Value: "world"},
-				},
-			},
+			receiver: MapValueMust(StringType, map[string]attr.Value{
+				"h": StringValue("hello"),
+				"w": StringValue("world"),
+			}),
 			input:    nil,
 			expected: false,
 		},
 		"partially-known": {
-			receiver: Map{
-				ElemType: StringType,
-				Elems: map[string]attr.Value{
-					"h": String{Value: "hello"},
-					"w": String{Unknown: true},
-				},
-			},
-			input: Map{
-				ElemType: StringType,
-				Elems: map[string]attr.Value{
-					"h": String{Value: "hello"},
-					"w": String{Unknown: true},
-				},
-			},
+			receiver: MapValueMust(StringType, map[string]attr.Value{
+				"h": StringValue("hello"),
+				"w": StringUnknown(),
+			}),
+			input: MapValueMust(StringType, map[string]attr.Value{
+				"h": StringValue("hello"),
+				"w": StringUnknown(),
+			}),
 			expected: true,
 		},
 		"partially-known-value-diff": {
-			receiver: Map{
-				ElemType: StringType,
-				Elems: map[string]attr.Value{
-					"h": String{Value: "hello"},
-					"w": String{Unknown: true},
-				},
-			},
-			input: Map{
-				ElemType: StringType,
-				Elems: map[string]attr.Value{
-					"h": String{Value: "hello"},
-					"w": String{Value: "world"},
-				},
-			},
+			receiver: MapValueMust(StringType, map[string]attr.Value{
+				"h": StringValue("hello"),
+				"w": StringUnknown(),
+			}),
+			input: MapValueMust(StringType, map[string]attr.Value{
+				"h": StringValue("hello"),
+				"w": StringValue("world"),
+			}),
 			expected: false,
 		},
 		"partially-known-map-value-unknown": {
-			receiver: Map{
-				ElemType: StringType,
-				Elems: map[string]attr.Value{
-					"h": String{Value: "hello"},
-					"w": String{Unknown: true},
-				},
-			},
-			input:    Map{Unknown: true},
+			receiver: MapValueMust(StringType, map[string]attr.Value{
+				"h": StringValue("hello"),
+				"w": StringUnknown(),
+			}),
+			input:    MapUnknown(StringType),
 			expected: false,
 		},
 		"partially-known-map-value-null": {
-			receiver: Map{
-				ElemType: StringType,
-				Elems: map[string]attr.Value{
-					"h": String{Value: "hello"},
-					"w": String{Unknown: true},
-				},
-			},
-			input:    Map{Null: true},
+			receiver: MapValueMust(StringType, map[string]attr.Value{
+				"h": StringValue("hello"),
+				"w": StringUnknown(),
+			}),
+			input:    MapNull(StringType),
 			expected: false,
 		},
 		"partially-known-map-value-wrongType": {
-			receiver: Map{
-				ElemType: StringType,
-				Elems: map[string]attr.Value{
-					"h": String{Value: "hello"},
-					"w": String{Unknown: true},
-				},
-			},
-			input:    String{Value: "hello, world"},
+			receiver: MapValueMust(StringType, map[string]attr.Value{
+				"h": StringValue("hello"),
+				"w": StringUnknown(),
+			}),
+			input:    StringValue("hello, world"),
 			expected: false,
 		},
 		"partially-known-map-value-nil": {
-			receiver: Map{
-				ElemType: StringType,
-				Elems: map[string]attr.Value{
-					"h": String{Value: "hello"},
-					"w": String{Unknown: true},
-				},
-			},
+			receiver: MapValueMust(StringType, map[string]attr.Value{
+				"h": StringValue("hello"),
+				"w": StringUnknown(),
+			}),
 			input:    nil,
 			expected: false,
 		},
 		"partially-null-map-value-map-value": {
-			receiver: Map{
-				ElemType: StringType,
-				Elems: map[string]attr.Value{
-					"h": String{Value: "hello"},
-					"w": String{Null: true},
-				},
-			},
-			input: Map{
-				ElemType: StringType,
-				Elems: map[string]attr.Value{
-					"h": String{Value: "hello"},
-					"w": String{Null: true},
-				},
-			},
+			receiver: MapValueMust(StringType, map[string]attr.Value{
+				"h": StringValue("hello"),
+				"w": StringNull(),
+			}),
+			input: MapValueMust(StringType, map[string]attr.Value{
+				"h": StringValue("hello"),
+				"w": StringNull(),
+			}),
 			expected: true,
 		},
 		"partially-null-map-value-diff": {
-			receiver: Map{
-				ElemType: StringType,
-				Elems: map[string]attr.Value{
-					"h": String{Value: "hello"},
-					"w": String{Null: true},
-				},
-			},
-			input: Map{
-				ElemType: StringType,
-				Elems: map[string]attr.Value{
-					"h": String{Value: "hello"},
-					"w": String{Value: "world"},
-				},
-			},
+			receiver: MapValueMust(StringType, map[string]attr.Value{
+				"h": StringValue("hello"),
+				"w": StringNull(),
+			}),
+			input: MapValueMust(StringType, map[string]attr.Value{
+				"h": StringValue("hello"),
+				"w": StringValue("world"),
+			}),
 			expected: false,
 		},
 		"partially-null-map-value-unknown": {
-			receiver: Map{
-				ElemType: StringType,
-				Elems: map[string]attr.Value{
-					"h": String{Value: "hello"},
-					"w": String{Null: true},
-				},
-			},
-			input: Map{
-				Unknown: true,
-			},
+			receiver: MapValueMust(StringType, map[string]attr.Value{
+				"h": StringValue("hello"),
+				"w": StringNull(),
+			}),
+			input:    MapUnknown(StringType),
 			expected: false,
 		},
 		"partially-null-map-value-null": {
-			receiver: Map{
-				ElemType: StringType,
-				Elems: map[string]attr.Value{
-					"h": String{Value: "hello"},
-					"w": String{Null: true},
-				},
-			},
-			input: Map{
-				Null: true,
-			},
+			receiver: MapValueMust(StringType, map[string]attr.Value{
+				"h": StringValue("hello"),
+				"w": StringNull(),
+			}),
+			input:    MapNull(StringType),
 			expected: false,
 		},
 		"partially-null-map-value-wrongType": {
-			receiver: Map{
-				ElemType: StringType,
-				Elems: map[string]attr.Value{
-					"h": String{Value: "hello"},
-					"w": String{Null: true},
-				},
-			},
-			input:    String{Value: "hello, world"},
+			receiver: MapValueMust(StringType, map[string]attr.Value{
+				"h": StringValue("hello"),
+				"w": StringNull(),
+			}),
+			input:    StringValue("hello, world"),
 			expected: false,
 		},
 		"partially-null-map-value-nil": {
-			receiver: Map{
-				ElemType: StringType,
-				Elems: map[string]attr.Value{
-					"h": String{Value: "hello"},
-					"w": String{Null: true},
-				},
-			},
+			receiver: MapValueMust(StringType, map[string]attr.Value{
+				"h": StringValue("hello"),
+				"w": StringNull(),
+			}),
 			input:    nil,
 			expected: false,
 		},
@@ -708,65 +586,50 @@ func TestMapString(t *testing.T) {
 	}
 	tests := map[string]testCase{
 		"simple": {
-			input: Map{
-				ElemType: Int64Type,
-				Elems: map[string]attr.Value{
-					"alpha": Int64{Value: 1234},
-					"beta":  Int64{Value: 56789},
-					"gamma": Int64{Value: 9817},
-					"sigma": Int64{Value: 62534},
-				},
-			},
+			input: MapValueMust(Int64Type, map[string]attr.Value{
+				"alpha": Int64Value(1234),
+				"beta":  Int64Value(56789),
+				"gamma": Int64Value(9817),
+				"sigma": Int64Value(62534),
+			}),
 			expectation: `{"alpha":1234,"beta":56789,"gamma":9817,"sigma":62534}`,
 		},
 		"map-of-maps": {
-			input: Map{
-				ElemType: MapType{
-					ElemType: StringType,
-				},
-				Elems: map[string]attr.Value{
-					"first": Map{
-						ElemType: StringType,
-						Elems: map[string]attr.Value{
-							"alpha": String{Value: "hello"},
-							"beta":  String{Value: "world"},
-							"gamma": String{Value: "foo"},
-							"sigma": String{Value: "bar"},
-						},
-					},
-					"second": Map{
-						ElemType: Int64Type,
-						Elems: map[string]attr.Value{
-							"x": Int64{Value: 0},
-							"y": Int64{Value: 0},
-							"z": Int64{Value: 0},
-							"t": Int64{Value: 0},
-						},
-					},
-				},
-			},
-			expectation: `{"first":{"alpha":"hello","beta":"world","gamma":"foo","sigma":"bar"},"second":{"t":0,"x":0,"y":0,"z":0}}`,
+			input: MapValueMust(MapType{
+				ElemType: StringType,
+			}, map[string]attr.Value{
+				"first": MapValueMust(StringType, map[string]attr.Value{
+					"alpha": StringValue("hello"),
+					"beta":  StringValue("world"),
+					"gamma": StringValue("foo"),
+					"sigma": StringValue("bar"),
+				}),
+				"second": MapValueMust(StringType, map[string]attr.Value{
+					"x": StringValue("0"),
+					"y": StringValue("0"),
+					"z": StringValue("0"),
+					"t": StringValue("0"),
+				}),
+			}),
+			expectation: `{"first":{"alpha":"hello","beta":"world","gamma":"foo","sigma":"bar"},"second":{"t":"0","x":"0","y":"0","z":"0"}}`,
 		},
 		"key-quotes": {
-			input: Map{
-				ElemType: BoolType,
-				Elems: map[string]attr.Value{
-					`testing is "fun"`: Bool{Value: true},
-				},
-			},
+			input: MapValueMust(BoolType, map[string]attr.Value{
+				`testing is "fun"`: BoolValue(true),
+			}),
 			expectation: `{"testing is \"fun\"":true}`,
 		},
 		"unknown": {
-			input:       Map{Unknown: true},
+			input:       MapUnknown(StringType),
 			expectation: "<unknown>",
 		},
 		"null": {
-			input:       Map{Null: true},
+			input:       MapNull(StringType),
 			expectation: "<null>",
 		},
-		"default-empty": {
+		"zero-value": {
 			input:       Map{},
-			expectation: "{}",
+			expectation: "<null>",
 		},
 	}
 
@@ -782,3 +645,136 @@ func TestMapString(t *testing.T) {
 		})
 	}
 }
+
+func TestMapValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		elementType   attr.Type
+		elements      map[string]attr.Value
+		expected      Map
+		expectedDiags diag.Diagnostics
+	}{
+		"valid-no-elements": {
+			elementType: StringType,
+			elements:    map[string]attr.Value{},
+			expected:    MapValueMust(StringType, map[string]attr.Value{}),
+		},
+		"valid-elements": {
+			elementType: StringType,
+			elements: map[string]attr.Value{
+				"key0": StringNull(),
+				"key1": StringUnknown(),
+				"key2": StringValue("test"),
+			},
+			expected: MapValueMust(StringType, map[string]attr.Value{
+				"key0": StringNull(),
+				"key1": StringUnknown(),
+				"key2": StringValue("test"),
+			}),
+		},
+		"invalid-element-type": {
+			elementType: StringType,
+			elements: map[string]attr.Value{
+				"key0": StringValue("test"),
+				"key1": BoolValue(true),
+			},
+			expected: MapUnknown(StringType),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Map Element Type",
+					"While creating a Map value, an invalid element was detected. "+
+						"A Map must use the single, given element type. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"Map Element Type: types.StringType\n"+
+						"Map Key (key1) Element Type: types.BoolType",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := MapValue(testCase.elementType, testCase.elements)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestMapValueFrom(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		elementType   attr.Type
+		elements      interface{}
+		expected      Map
+		expectedDiags diag.Diagnostics
+	}{
+		"valid-StringType-map[string]attr.Value": {
+			elementType: StringType,
+			elements: map[string]attr.Value{
+				"key0": StringValue("test1"),
+				"key1": StringValue("test2"),
+			},
+			expected: MapValueMust(StringType, map[string]attr.Value{
+				"key0": StringValue("test1"),
+				"key1": StringValue("test2"),
+			}),
+		},
+		"valid-StringType-map[string]string": {
+			elementType: StringType,
+			elements: map[string]string{
+				"key0": "test1",
+				"key1": "test2",
+			},
+			expected: MapValueMust(StringType, map[string]attr.Value{
+				"key0": StringValue("test1"),
+				"key1": StringValue("test2"),
+			}),
+		},
+		"invalid-element-type": {
+			elementType: BoolType,
+			elements: map[string]string{
+				"key0": "test1",
+			},
+			expected: MapUnknown(BoolType),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Empty().AtMapKey("key0"),
+					"Value Conversion Error",
+					"An unexpected error was encountered trying to convert the Terraform value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"can't unmarshal tftypes.String into *bool, expected boolean",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := MapValueFrom(context.Background(), testCase.elementType, testCase.elements)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,123 @@
+package nettypes
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ xattr.ValueWithSemanticEquals = CIDR{}
+)
+
+// CIDRNull creates a CIDR with a null value. Determine whether the value is
+// null via the CIDR type IsNull method.
+func CIDRNull() CIDR {
+	return CIDR{
+		state: attr.ValueStateNull,
+	}
+}
+
+// CIDRUnknown creates a CIDR with an unknown value. Determine whether the value
+// is unknown via the CIDR type IsUnknown method.
+func CIDRUnknown() CIDR {
+	return CIDR{
+		state: attr.ValueStateUnknown,
+	}
+}
+
+// CIDRValue creates a CIDR with a known value. The value is not validated,
+// which instead happens when the value is received from Terraform. Access the
+// value via the CIDR type ValueString method.
+func CIDRValue(value string) CIDR {
+	return CIDR{
+		state: attr.ValueStateKnown,
+		value: value,
+	}
+}
+
+// CIDR represents an IPv4 or IPv6 address and prefix length in CIDR notation,
+// such as 192.0.2.0/24 or 2001:db8::/32. The zero-value of CIDR is null, use
+// the CIDRNull, CIDRUnknown, and CIDRValue functions to create a CIDR.
+type CIDR struct {
+	// state represents whether the value is null, unknown, or known. The
+	// zero-value is null.
+	state attr.ValueState
+
+	// value contains the known value, if not null or unknown.
+	value string
+}
+
+// Type returns a CIDRType.
+func (c CIDR) Type(_ context.Context) attr.Type {
+	return CIDRType
+}
+
+// ToTerraformValue returns the data contained in the CIDR as a tftypes.Value.
+func (c CIDR) ToTerraformValue(_ context.Context) (tftypes.Value, error) {
+	return toTerraformValue(CIDRType, c.state, c.value)
+}
+
+// Equal returns true if `other` is a CIDR and has exactly the same string value
+// as `c`. Use SemanticEquals to compare the addresses.
+func (c CIDR) Equal(other attr.Value) bool {
+	o, ok := other.(CIDR)
+
+	if !ok {
+		return false
+	}
+
+	if c.state != o.state {
+		return false
+	}
+
+	if c.state != attr.ValueStateKnown {
+		return true
+	}
+
+	return c.value == o.value
+}
+
+// SemanticEquals returns true if `other` is a CIDR representing the same
+// address and prefix length as `c`, such as 2001:db8::/32 and 2001:0db8:0::/32.
+// Host bits are compared as written, so 192.0.2.1/24 is not semantically equal
+// to 192.0.2.0/24.
+func (c CIDR) SemanticEquals(_ context.Context, other attr.Value) (bool, diag.Diagnostics) {
+	o, ok := other.(CIDR)
+
+	if !ok {
+		return false, semanticEqualsTypeDiags(c, other)
+	}
+
+	if c.state != attr.ValueStateKnown || o.state != attr.ValueStateKnown {
+		return c.Equal(o), nil
+	}
+
+	return semanticEquals(CIDRType, c.value, o.value)
+}
+
+// IsNull returns true if the CIDR represents a null value.
+func (c CIDR) IsNull() bool {
+	return c.state == attr.ValueStateNull
+}
+
+// IsUnknown returns true if the CIDR represents a currently unknown value.
+func (c CIDR) IsUnknown() bool {
+	return c.state == attr.ValueStateUnknown
+}
+
+// String returns a human-readable representation of the CIDR value. The string
+// returned here is not protected by any compatibility guarantees, and is
+// intended for logging and error reporting.
+func (c CIDR) String() string {
+	return valueString(c.state, c.value)
+}
+
+// ValueString returns the known string value. If CIDR is null or unknown,
+// returns "".
+func (c CIDR) ValueString() string {
+	return c.value
+}
@@ -0,0 +1,13 @@
+// Package nettypes contains attr.Type and attr.Value implementations for
+// network addresses, such as IP addresses, CIDR prefixes, and MAC addresses.
+//
+// Values are stored as Terraform strings and validated when received from
+// Terraform. Values which are formatted differently but represent the same
+// address, such as the IPv6 addresses 2001:db8::1 and 2001:0db8:0:0::1, are
+// semantically equal, so the framework keeps the prior value instead of
+// reporting a difference.
+//
+// Values can be converted to net/netip types, such as netip.Addr and
+// netip.Prefix, with tfsdk.ValueAs or in schema data models used with the Get
+// and Set methods.
+package nettypes
@@ -0,0 +1,123 @@
+package nettypes
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ xattr.ValueWithSemanticEquals = IPAddress{}
+)
+
+// IPAddressNull creates an IPAddress with a null value. Determine whether the
+// value is null via the IPAddress type IsNull method.
+func IPAddressNull() IPAddress {
+	return IPAddress{
+		state: attr.ValueStateNull,
+	}
+}
+
+// IPAddressUnknown creates an IPAddress with an unknown value. Determine
+// whether the value is unknown via the IPAddress type IsUnknown method.
+func IPAddressUnknown() IPAddress {
+	return IPAddress{
+		state: attr.ValueStateUnknown,
+	}
+}
+
+// IPAddressValue creates an IPAddress with a known value. The value is not
+// validated, which instead happens when the value is received from Terraform.
+// Access the value via the IPAddress type ValueString method.
+func IPAddressValue(value string) IPAddress {
+	return IPAddress{
+		state: attr.ValueStateKnown,
+		value: value,
+	}
+}
+
+// IPAddress represents an IPv4 or IPv6 address, such as 192.0.2.1 or
+// 2001:db8::1. The zero-value of IPAddress is null, use the IPAddressNull,
+// IPAddressUnknown, and IPAddressValue functions to create an IPAddress.
+type IPAddress struct {
+	// state represents whether the value is null, unknown, or known. The
+	// zero-value is null.
+	state attr.ValueState
+
+	// value contains the known value, if not null or unknown.
+	value string
+}
+
+// Type returns an IPAddressType.
+func (a IPAddress) Type(_ context.Context) attr.Type {
+	return IPAddressType
+}
+
+// ToTerraformValue returns the data contained in the IPAddress as a
+// tftypes.Value.
+func (a IPAddress) ToTerraformValue(_ context.Context) (tftypes.Value, error) {
+	return toTerraformValue(IPAddressType, a.state, a.value)
+}
+
+// Equal returns true if `other` is an IPAddress and has exactly the same string
+// value as `a`. Use SemanticEquals to compare the addresses.
+func (a IPAddress) Equal(other attr.Value) bool {
+	o, ok := other.(IPAddress)
+
+	if !ok {
+		return false
+	}
+
+	if a.state != o.state {
+		return false
+	}
+
+	if a.state != attr.ValueStateKnown {
+		return true
+	}
+
+	return a.value == o.value
+}
+
+// SemanticEquals returns true if `other` is an IPAddress representing the same
+// address as `a`, such as 2001:db8::1 and 2001:0db8:0:0::1. IPv4 and IPv6
+// addresses are never semantically equal.
+func (a IPAddress) SemanticEquals(_ context.Context, other attr.Value) (bool, diag.Diagnostics) {
+	o, ok := other.(IPAddress)
+
+	if !ok {
+		return false, semanticEqualsTypeDiags(a, other)
+	}
+
+	if a.state != attr.ValueStateKnown || o.state != attr.ValueStateKnown {
+		return a.Equal(o), nil
+	}
+
+	return semanticEquals(IPAddressType, a.value, o.value)
+}
+
+// IsNull returns true if the IPAddress represents a null value.
+func (a IPAddress) IsNull() bool {
+	return a.state == attr.ValueStateNull
+}
+
+// IsUnknown returns true if the IPAddress represents a currently unknown value.
+func (a IPAddress) IsUnknown() bool {
+	return a.state == attr.ValueStateUnknown
+}
+
+// String returns a human-readable representation of the IPAddress value. The
+// string returned here is not protected by any compatibility guarantees, and is
+// intended for logging and error reporting.
+func (a IPAddress) String() string {
+	return valueString(a.state, a.value)
+}
+
+// ValueString returns the known string value. If IPAddress is null or unknown,
+// returns "".
+func (a IPAddress) ValueString() string {
+	return a.value
+}
@@ -0,0 +1,125 @@
+package nettypes
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ xattr.ValueWithSemanticEquals = IPv4Address{}
+)
+
+// IPv4AddressNull creates an IPv4Address with a null value. Determine whether
+// the value is null via the IPv4Address type IsNull method.
+func IPv4AddressNull() IPv4Address {
+	return IPv4Address{
+		state: attr.ValueStateNull,
+	}
+}
+
+// IPv4AddressUnknown creates an IPv4Address with an unknown value. Determine
+// whether the value is unknown via the IPv4Address type IsUnknown method.
+func IPv4AddressUnknown() IPv4Address {
+	return IPv4Address{
+		state: attr.ValueStateUnknown,
+	}
+}
+
+// IPv4AddressValue creates an IPv4Address with a known value. The value is not
+// validated, which instead happens when the value is received from Terraform.
+// Access the value via the IPv4Address type ValueString method.
+func IPv4AddressValue(value string) IPv4Address {
+	return IPv4Address{
+		state: attr.ValueStateKnown,
+		value: value,
+	}
+}
+
+// IPv4Address represents an IPv4 address, such as 192.0.2.1. The zero-value of
+// IPv4Address is null, use the IPv4AddressNull, IPv4AddressUnknown, and
+// IPv4AddressValue functions to create an IPv4Address.
+type IPv4Address struct {
+	// state represents whether the value is null, unknown, or known. The
+	// zero-value is null.
+	state attr.ValueState
+
+	// value contains the known value, if not null or unknown.
+	value string
+}
+
+// Type returns an IPv4AddressType.
+func (a IPv4Address) Type(_ context.Context) attr.Type {
+	return IPv4AddressType
+}
+
+// ToTerraformValue returns the data contained in the IPv4Address as a
+// tftypes.Value.
+func (a IPv4Address) ToTerraformValue(_ context.Context) (tftypes.Value, error) {
+	return toTerraformValue(IPv4AddressType, a.state, a.value)
+}
+
+// Equal returns true if `other` is an IPv4Address and has exactly the same
+// string value as `a`. Use SemanticEquals to compare the addresses.
+func (a IPv4Address) Equal(other attr.Value) bool {
+	o, ok := other.(IPv4Address)
+
+	if !ok {
+		return false
+	}
+
+	if a.state != o.state {
+		return false
+	}
+
+	if a.state != attr.ValueStateKnown {
+		return true
+	}
+
+	return a.value == o.value
+}
+
+// SemanticEquals returns true if `other` is an IPv4Address representing the
+// same address as `a`. IPv4 addresses have a single valid representation, as
+// leading zeros are not accepted, so this is equivalent to Equal for valid
+// addresses.
+func (a IPv4Address) SemanticEquals(_ context.Context, other attr.Value) (bool, diag.Diagnostics) {
+	o, ok := other.(IPv4Address)
+
+	if !ok {
+		return false, semanticEqualsTypeDiags(a, other)
+	}
+
+	if a.state != attr.ValueStateKnown || o.state != attr.ValueStateKnown {
+		return a.Equal(o), nil
+	}
+
+	return semanticEquals(IPv4AddressType, a.value, o.value)
+}
+
+// IsNull returns true if the IPv4Address represents a null value.
+func (a IPv4Address) IsNull() bool {
+	return a.state == attr.ValueStateNull
+}
+
+// IsUnknown returns true if the IPv4Address represents a currently unknown
+// value.
+func (a IPv4Address) IsUnknown() bool {
+	return a.state == attr.ValueStateUnknown
+}
+
+// String returns a human-readable representation of the IPv4Address value. The
+// string returned here is not protected by any compatibility guarantees, and is
+// intended for logging and error reporting.
+func (a IPv4Address) String() string {
+	return valueString(a.state, a.value)
+}
+
+// ValueString returns the known string value. If IPv4Address is null or
+// unknown, returns "".
+func (a IPv4Address) ValueString() string {
+	return a.value
+}
@@ -0,0 +1,123 @@
+package nettypes
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ xattr.ValueWithSemanticEquals = IPv6Address{}
+)
+
+// IPv6AddressNull creates an IPv6Address with a null value. Determine whether
+// the value is null via the IPv6Address type IsNull method.
+func IPv6AddressNull() IPv6Address {
+	return IPv6Address{
+		state: attr.ValueStateNull,
+	}
+}
+
+// IPv6AddressUnknown creates an IPv6Address with an unknown value. Determine
+// whether the value is unknown via the IPv6Address type IsUnknown method.
+func IPv6AddressUnknown() IPv6Address {
+	return IPv6Address{
+		state: attr.ValueStateUnknown,
+	}
+}
+
+// IPv6AddressValue creates an IPv6Address with a known value. The value is not
+// validated, which instead happens when the value is received from Terraform.
+// Access the value via the IPv6Address type ValueString method.
+func IPv6AddressValue(value string) IPv6Address {
+	return IPv6Address{
+		state: attr.ValueStateKnown,
+		value: value,
+	}
+}
+
+// IPv6Address represents an IPv6 address, such as 2001:db8::1. The zero-value
+// of IPv6Address is null, use the IPv6AddressNull, IPv6AddressUnknown, and
+// IPv6AddressValue functions to create an IPv6Address.
+type IPv6Address struct {
+	// state represents whether the value is null, unknown, or known. The
+	// zero-value is null.
+	state attr.ValueState
+
+	// value contains the known value, if not null or unknown.
+	value string
+}
+
+// Type returns an IPv6AddressType.
+func (a IPv6Address) Type(_ context.Context) attr.Type {
+	return IPv6AddressType
+}
+
+// ToTerraformValue returns the data contained in the IPv6Address as a
+// tftypes.Value.
+func (a IPv6Address) ToTerraformValue(_ context.Context) (tftypes.Value, error) {
+	return toTerraformValue(IPv6AddressType, a.state, a.value)
+}
+
+// Equal returns true if `other` is an IPv6Address and has exactly the same
+// string value as `a`. Use SemanticEquals to compare the addresses.
+func (a IPv6Address) Equal(other attr.Value) bool {
+	o, ok := other.(IPv6Address)
+
+	if !ok {
+		return false
+	}
+
+	if a.state != o.state {
+		return false
+	}
+
+	if a.state != attr.ValueStateKnown {
+		return true
+	}
+
+	return a.value == o.value
+}
+
+// SemanticEquals returns true if `other` is an IPv6Address representing the
+// same address as `a`, such as 2001:db8::1 and 2001:0db8:0:0::1.
+func (a IPv6Address) SemanticEquals(_ context.Context, other attr.Value) (bool, diag.Diagnostics) {
+	o, ok := other.(IPv6Address)
+
+	if !ok {
+		return false, semanticEqualsTypeDiags(a, other)
+	}
+
+	if a.state != attr.ValueStateKnown || o.state != attr.ValueStateKnown {
+		return a.Equal(o), nil
+	}
+
+	return semanticEquals(IPv6AddressType, a.value, o.value)
+}
+
+// IsNull returns true if the IPv6Address represents a null value.
+func (a IPv6Address) IsNull() bool {
+	return a.state == attr.ValueStateNull
+}
+
+// IsUnknown returns true if the IPv6Address represents a currently unknown
+// value.
+func (a IPv6Address) IsUnknown() bool {
+	return a.state == attr.ValueStateUnknown
+}
+
+// String returns a human-readable representation of the IPv6Address value. The
+// string returned here is not protected by any compatibility guarantees, and is
+// intended for logging and error reporting.
+func (a IPv6Address) String() string {
+	return valueString(a.state, a.value)
+}
+
+// ValueString returns the known string value. If IPv6Address is null or
+// unknown, returns "".
+func (a IPv6Address) ValueString() string {
+	return a.value
+}
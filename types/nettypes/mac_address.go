@@ -0,0 +1,123 @@
+package nettypes
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ xattr.ValueWithSemanticEquals = MACAddress{}
+)
+
+// MACAddressNull creates a MACAddress with a null value. Determine whether the
+// value is null via the MACAddress type IsNull method.
+func MACAddressNull() MACAddress {
+	return MACAddress{
+		state: attr.ValueStateNull,
+	}
+}
+
+// MACAddressUnknown creates a MACAddress with an unknown value. Determine
+// whether the value is unknown via the MACAddress type IsUnknown method.
+func MACAddressUnknown() MACAddress {
+	return MACAddress{
+		state: attr.ValueStateUnknown,
+	}
+}
+
+// MACAddressValue creates a MACAddress with a known value. The value is not
+// validated, which instead happens when the value is received from Terraform.
+// Access the value via the MACAddress type ValueString method.
+func MACAddressValue(value string) MACAddress {
+	return MACAddress{
+		state: attr.ValueStateKnown,
+		value: value,
+	}
+}
+
+// MACAddress represents a MAC address, such as 00:00:5e:00:53:01. The
+// zero-value of MACAddress is null, use the MACAddressNull, MACAddressUnknown,
+// and MACAddressValue functions to create a MACAddress.
+type MACAddress struct {
+	// state represents whether the value is null, unknown, or known. The
+	// zero-value is null.
+	state attr.ValueState
+
+	// value contains the known value, if not null or unknown.
+	value string
+}
+
+// Type returns a MACAddressType.
+func (m MACAddress) Type(_ context.Context) attr.Type {
+	return MACAddressType
+}
+
+// ToTerraformValue returns the data contained in the MACAddress as a
+// tftypes.Value.
+func (m MACAddress) ToTerraformValue(_ context.Context) (tftypes.Value, error) {
+	return toTerraformValue(MACAddressType, m.state, m.value)
+}
+
+// Equal returns true if `other` is a MACAddress and has exactly the same string
+// value as `m`. Use SemanticEquals to compare the addresses.
+func (m MACAddress) Equal(other attr.Value) bool {
+	o, ok := other.(MACAddress)
+
+	if !ok {
+		return false
+	}
+
+	if m.state != o.state {
+		return false
+	}
+
+	if m.state != attr.ValueStateKnown {
+		return true
+	}
+
+	return m.value == o.value
+}
+
+// SemanticEquals returns true if `other` is a MACAddress representing the same
+// hardware address as `m`, such as 00:00:5e:00:53:01 and 00-00-5E-00-53-01.
+func (m MACAddress) SemanticEquals(_ context.Context, other attr.Value) (bool, diag.Diagnostics) {
+	o, ok := other.(MACAddress)
+
+	if !ok {
+		return false, semanticEqualsTypeDiags(m, other)
+	}
+
+	if m.state != attr.ValueStateKnown || o.state != attr.ValueStateKnown {
+		return m.Equal(o), nil
+	}
+
+	return semanticEquals(MACAddressType, m.value, o.value)
+}
+
+// IsNull returns true if the MACAddress represents a null value.
+func (m MACAddress) IsNull() bool {
+	return m.state == attr.ValueStateNull
+}
+
+// IsUnknown returns true if the MACAddress represents a currently unknown
+// value.
+func (m MACAddress) IsUnknown() bool {
+	return m.state == attr.ValueStateUnknown
+}
+
+// String returns a human-readable representation of the MACAddress value. The
+// string returned here is not protected by any compatibility guarantees, and is
+// intended for logging and error reporting.
+func (m MACAddress) String() string {
+	return valueString(m.state, m.value)
+}
+
+// ValueString returns the known string value. If MACAddress is null or unknown,
+// returns "".
+func (m MACAddress) ValueString() string {
+	return m.value
+}
@@ -0,0 +1,87 @@
+//go:build go1.18
+
+package nettypes_test
+
+import (
+	"context"
+	"net/netip"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types/nettypes"
+)
+
+func TestValueAsNetip(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	var addr netip.Addr
+
+	if diags := tfsdk.ValueAs(ctx, nettypes.IPv6AddressValue("2001:0db8::1"), &addr); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if diff := cmp.Diff(addr.String(), "2001:db8::1"); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+
+	var prefix netip.Prefix
+
+	if diags := tfsdk.ValueAs(ctx, nettypes.CIDRValue("192.0.2.0/24"), &prefix); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if diff := cmp.Diff(prefix, netip.MustParsePrefix("192.0.2.0/24"), cmp.Comparer(func(a, b netip.Prefix) bool { return a == b })); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+
+	var invalid netip.Addr
+
+	if diags := tfsdk.ValueAs(ctx, nettypes.IPAddressValue("192.0.2.256"), &invalid); !diags.HasError() {
+		t.Errorf("expected error diagnostics, got none")
+	}
+}
+
+func TestValueFromNetip(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := map[string]struct {
+		val        interface{}
+		targetType attr.Type
+		expected   attr.Value
+	}{
+		"addr": {
+			val:        netip.MustParseAddr("192.0.2.1"),
+			targetType: nettypes.IPv4AddressType,
+			expected:   nettypes.IPv4AddressValue("192.0.2.1"),
+		},
+		"prefix": {
+			val:        netip.MustParsePrefix("2001:db8::/32"),
+			targetType: nettypes.CIDRType,
+			expected:   nettypes.CIDRValue("2001:db8::/32"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got attr.Value
+
+			if diags := tfsdk.ValueFrom(ctx, testCase.val, testCase.targetType, &got); diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package nettypes
+
+import (
+	"errors"
+	"net"
+	"strings"
+)
+
+// parseIP returns the IPv4 or IPv6 address in s.
+func parseIP(s string) (net.IP, error) {
+	ip := net.ParseIP(s)
+
+	if ip == nil {
+		return nil, errors.New("invalid IP address syntax")
+	}
+
+	return ip, nil
+}
+
+// parseIPv4 returns the IPv4 address in s. IPv4-mapped IPv6 addresses, such as
+// ::ffff:192.0.2.1, are not accepted.
+func parseIPv4(s string) (net.IP, error) {
+	ip, err := parseIP(s)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if isIPv6(s) {
+		return nil, errors.New("address is IPv6, expected IPv4")
+	}
+
+	return ip, nil
+}
+
+// parseIPv6 returns the IPv6 address in s.
+func parseIPv6(s string) (net.IP, error) {
+	ip, err := parseIP(s)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !isIPv6(s) {
+		return nil, errors.New("address is IPv4, expected IPv6")
+	}
+
+	return ip, nil
+}
+
+// parseCIDR returns the IPv4 or IPv6 address and prefix length in s. The
+// address is returned as written, without host bits being masked.
+func parseCIDR(s string) (net.IP, int, error) {
+	ip, ipNet, err := net.ParseCIDR(s)
+
+	if err != nil {
+		return nil, 0, errors.New("invalid CIDR syntax")
+	}
+
+	ones, _ := ipNet.Mask.Size()
+
+	return ip, ones, nil
+}
+
+// parseMAC returns the hardware address in s.
+func parseMAC(s string) (net.HardwareAddr, error) {
+	mac, err := net.ParseMAC(s)
+
+	if err != nil {
+		return nil, errors.New("invalid MAC address syntax")
+	}
+
+	return mac, nil
+}
+
+// isIPv6 returns true if the address in s is written as an IPv6 address. The
+// net package represents all addresses using 16 bytes, so the address family is
+// determined by the syntax.
+func isIPv6(s string) bool {
+	return strings.Contains(s, ":")
+}
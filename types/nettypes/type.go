@@ -0,0 +1,228 @@
+package nettypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+type addressType uint8
+
+const (
+	// IPAddressType represents an IPv4 or IPv6 address, such as 192.0.2.1
+	// or 2001:db8::1.
+	IPAddressType addressType = iota
+
+	// IPv4AddressType represents an IPv4 address, such as 192.0.2.1.
+	IPv4AddressType
+
+	// IPv6AddressType represents an IPv6 address, such as 2001:db8::1.
+	IPv6AddressType
+
+	// CIDRType represents an IPv4 or IPv6 address and prefix length in CIDR
+	// notation (RFC 4632 and RFC 4291), such as 192.0.2.0/24 or
+	// 2001:db8::/32.
+	CIDRType
+
+	// MACAddressType represents an IEEE 802 MAC-48, EUI-48, EUI-64, or 20-octet
+	// IP over InfiniBand link-layer address, such as 00:00:5e:00:53:01.
+	MACAddressType
+)
+
+var (
+	_ xattr.TypeWithValidate            = IPAddressType
+	_ attr.TypeWithPlaintextDescription = IPAddressType
+	_ attr.TypeWithMarkdownDescription  = IPAddressType
+)
+
+func (t addressType) String() string {
+	switch t {
+	case IPAddressType:
+		return "nettypes.IPAddressType"
+	case IPv4AddressType:
+		return "nettypes.IPv4AddressType"
+	case IPv6AddressType:
+		return "nettypes.IPv6AddressType"
+	case CIDRType:
+		return "nettypes.CIDRType"
+	case MACAddressType:
+		return "nettypes.MACAddressType"
+	default:
+		return fmt.Sprintf("unknown address type %d", t)
+	}
+}
+
+// TerraformType returns tftypes.String.
+func (t addressType) TerraformType(_ context.Context) tftypes.Type {
+	return tftypes.String
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value. The string is not
+// validated, which instead happens in Validate.
+func (t addressType) ValueFromTerraform(_ context.Context, in tftypes.Value) (attr.Value, error) {
+	if in.Type() != nil && !in.Type().Equal(tftypes.String) {
+		return nil, fmt.Errorf("can't use %s as %s, expected tftypes.String", in.Type(), t)
+	}
+
+	state := attr.ValueStateKnown
+	var value string
+
+	switch {
+	case in.Type() == nil || in.IsNull():
+		state = attr.ValueStateNull
+	case !in.IsKnown():
+		state = attr.ValueStateUnknown
+	default:
+		if err := in.As(&value); err != nil {
+			return nil, err
+		}
+	}
+
+	switch t {
+	case IPAddressType:
+		return IPAddress{state: state, value: value}, nil
+	case IPv4AddressType:
+		return IPv4Address{state: state, value: value}, nil
+	case IPv6AddressType:
+		return IPv6Address{state: state, value: value}, nil
+	case CIDRType:
+		return CIDR{state: state, value: value}, nil
+	case MACAddressType:
+		return MACAddress{state: state, value: value}, nil
+	default:
+		panic(fmt.Sprintf("unknown address type %d", t))
+	}
+}
+
+// Equal returns true if `o` is the same address type as `t`.
+func (t addressType) Equal(o attr.Type) bool {
+	other, ok := o.(addressType)
+
+	if !ok {
+		return false
+	}
+
+	switch t {
+	case IPAddressType, IPv4AddressType, IPv6AddressType, CIDRType, MACAddressType:
+		return t == other
+	default:
+		// unrecognized types are never equal to anything.
+		return false
+	}
+}
+
+// ApplyTerraform5AttributePathStep always returns an error, as addresses cannot
+// be traversed with attribute paths.
+func (t addressType) ApplyTerraform5AttributePathStep(step tftypes.AttributePathStep) (interface{}, error) {
+	return nil, fmt.Errorf("cannot apply AttributePathStep %T to %s", step, t.String())
+}
+
+// Validate returns an error diagnostic if the value is not a valid address of
+// the type.
+func (t addressType) Validate(_ context.Context, in tftypes.Value, path path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if in.Type() == nil {
+		return diags
+	}
+
+	if !in.Type().Equal(tftypes.String) {
+		diags.AddAttributeError(
+			path,
+			"Network Address Type Validation Error",
+			"An unexpected error was encountered trying to validate an attribute value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("Expected String value, received %T with value: %v", in, in),
+		)
+		return diags
+	}
+
+	if !in.IsKnown() || in.IsNull() {
+		return diags
+	}
+
+	var s string
+
+	if err := in.As(&s); err != nil {
+		diags.AddAttributeError(
+			path,
+			"Network Address Type Validation Error",
+			"An unexpected error was encountered trying to validate an attribute value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("Cannot convert value to string: %s", err),
+		)
+		return diags
+	}
+
+	var err error
+
+	switch t {
+	case IPAddressType:
+		_, err = parseIP(s)
+	case IPv4AddressType:
+		_, err = parseIPv4(s)
+	case IPv6AddressType:
+		_, err = parseIPv6(s)
+	case CIDRType:
+		_, _, err = parseCIDR(s)
+	case MACAddressType:
+		_, err = parseMAC(s)
+	}
+
+	if err != nil {
+		diags.AddAttributeError(
+			path,
+			"Invalid "+t.description()+" Value",
+			fmt.Sprintf("A string value was provided that is not a valid %s: %s\n\n", t.description(), err)+
+				"Given Value: "+s,
+		)
+		return diags
+	}
+
+	return diags
+}
+
+// Description returns a plain text description of the address type.
+func (t addressType) Description(_ context.Context) string {
+	switch t {
+	case IPAddressType:
+		return "String containing an IPv4 address, such as 192.0.2.1, or an IPv6 address, such as 2001:db8::1."
+	case IPv4AddressType:
+		return "String containing an IPv4 address, such as 192.0.2.1."
+	case IPv6AddressType:
+		return "String containing an IPv6 address, such as 2001:db8::1."
+	case CIDRType:
+		return "String containing an IPv4 or IPv6 address and prefix length in CIDR notation, such as 192.0.2.0/24 or 2001:db8::/32."
+	case MACAddressType:
+		return "String containing a MAC address, such as 00:00:5e:00:53:01."
+	default:
+		return ""
+	}
+}
+
+// MarkdownDescription returns a Markdown description of the address type.
+func (t addressType) MarkdownDescription(ctx context.Context) string {
+	return t.Description(ctx)
+}
+
+// description returns the human-readable name of the address type, used in
+// diagnostics.
+func (t addressType) description() string {
+	switch t {
+	case IPAddressType:
+		return "IP Address"
+	case IPv4AddressType:
+		return "IPv4 Address"
+	case IPv6AddressType:
+		return "IPv6 Address"
+	case CIDRType:
+		return "CIDR"
+	case MACAddressType:
+		return "MAC Address"
+	default:
+		return t.String()
+	}
+}
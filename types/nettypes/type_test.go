@@ -0,0 +1,329 @@
+package nettypes_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/nettypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestTypeValueFromTerraform(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ         attr.Type
+		in          tftypes.Value
+		expected    attr.Value
+		expectedErr string
+	}{
+		"ip-known": {
+			typ:      nettypes.IPAddressType,
+			in:       tftypes.NewValue(tftypes.String, "192.0.2.1"),
+			expected: nettypes.IPAddressValue("192.0.2.1"),
+		},
+		"ip-null": {
+			typ:      nettypes.IPAddressType,
+			in:       tftypes.NewValue(tftypes.String, nil),
+			expected: nettypes.IPAddressNull(),
+		},
+		"ip-unknown": {
+			typ:      nettypes.IPAddressType,
+			in:       tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			expected: nettypes.IPAddressUnknown(),
+		},
+		"ipv4-known": {
+			typ:      nettypes.IPv4AddressType,
+			in:       tftypes.NewValue(tftypes.String, "192.0.2.1"),
+			expected: nettypes.IPv4AddressValue("192.0.2.1"),
+		},
+		"ipv6-known": {
+			typ:      nettypes.IPv6AddressType,
+			in:       tftypes.NewValue(tftypes.String, "2001:db8::1"),
+			expected: nettypes.IPv6AddressValue("2001:db8::1"),
+		},
+		"cidr-known": {
+			typ:      nettypes.CIDRType,
+			in:       tftypes.NewValue(tftypes.String, "192.0.2.0/24"),
+			expected: nettypes.CIDRValue("192.0.2.0/24"),
+		},
+		"cidr-null": {
+			typ:      nettypes.CIDRType,
+			in:       tftypes.NewValue(tftypes.String, nil),
+			expected: nettypes.CIDRNull(),
+		},
+		"mac-known": {
+			typ:      nettypes.MACAddressType,
+			in:       tftypes.NewValue(tftypes.String, "00:00:5e:00:53:01"),
+			expected: nettypes.MACAddressValue("00:00:5e:00:53:01"),
+		},
+		"mac-unknown": {
+			typ:      nettypes.MACAddressType,
+			in:       tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			expected: nettypes.MACAddressUnknown(),
+		},
+		"wrong-type": {
+			typ:         nettypes.IPv4AddressType,
+			in:          tftypes.NewValue(tftypes.Number, 123),
+			expectedErr: "can't use tftypes.Number as nettypes.IPv4AddressType, expected tftypes.String",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.typ.ValueFromTerraform(context.Background(), testCase.in)
+
+			if err != nil {
+				if testCase.expectedErr == "" {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if err.Error() != testCase.expectedErr {
+					t.Fatalf("expected error %q, got %q", testCase.expectedErr, err)
+				}
+
+				return
+			}
+
+			if testCase.expectedErr != "" {
+				t.Fatalf("expected error %q, got none", testCase.expectedErr)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestTypeValidate(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ           xattr.TypeWithValidate
+		in            tftypes.Value
+		expectedDiags diag.Diagnostics
+	}{
+		"ip-ipv4": {
+			typ: nettypes.IPAddressType,
+			in:  tftypes.NewValue(tftypes.String, "192.0.2.1"),
+		},
+		"ip-ipv6": {
+			typ: nettypes.IPAddressType,
+			in:  tftypes.NewValue(tftypes.String, "2001:db8::1"),
+		},
+		"ip-null": {
+			typ: nettypes.IPAddressType,
+			in:  tftypes.NewValue(tftypes.String, nil),
+		},
+		"ip-unknown": {
+			typ: nettypes.IPAddressType,
+			in:  tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		},
+		"ip-invalid": {
+			typ: nettypes.IPAddressType,
+			in:  tftypes.NewValue(tftypes.String, "192.0.2.256"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid IP Address Value",
+					"A string value was provided that is not a valid IP Address: invalid IP address syntax\n\n"+
+						"Given Value: 192.0.2.256",
+				),
+			},
+		},
+		"ip-cidr": {
+			typ: nettypes.IPAddressType,
+			in:  tftypes.NewValue(tftypes.String, "192.0.2.0/24"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid IP Address Value",
+					"A string value was provided that is not a valid IP Address: invalid IP address syntax\n\n"+
+						"Given Value: 192.0.2.0/24",
+				),
+			},
+		},
+		"ipv4": {
+			typ: nettypes.IPv4AddressType,
+			in:  tftypes.NewValue(tftypes.String, "192.0.2.1"),
+		},
+		"ipv4-ipv6": {
+			typ: nettypes.IPv4AddressType,
+			in:  tftypes.NewValue(tftypes.String, "2001:db8::1"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid IPv4 Address Value",
+					"A string value was provided that is not a valid IPv4 Address: address is IPv6, expected IPv4\n\n"+
+						"Given Value: 2001:db8::1",
+				),
+			},
+		},
+		"ipv4-ipv4-mapped-ipv6": {
+			typ: nettypes.IPv4AddressType,
+			in:  tftypes.NewValue(tftypes.String, "::ffff:192.0.2.1"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid IPv4 Address Value",
+					"A string value was provided that is not a valid IPv4 Address: address is IPv6, expected IPv4\n\n"+
+						"Given Value: ::ffff:192.0.2.1",
+				),
+			},
+		},
+		"ipv6": {
+			typ: nettypes.IPv6AddressType,
+			in:  tftypes.NewValue(tftypes.String, "2001:db8::1"),
+		},
+		"ipv6-ipv4": {
+			typ: nettypes.IPv6AddressType,
+			in:  tftypes.NewValue(tftypes.String, "192.0.2.1"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid IPv6 Address Value",
+					"A string value was provided that is not a valid IPv6 Address: address is IPv4, expected IPv6\n\n"+
+						"Given Value: 192.0.2.1",
+				),
+			},
+		},
+		"cidr-ipv4": {
+			typ: nettypes.CIDRType,
+			in:  tftypes.NewValue(tftypes.String, "192.0.2.0/24"),
+		},
+		"cidr-ipv6": {
+			typ: nettypes.CIDRType,
+			in:  tftypes.NewValue(tftypes.String, "2001:db8::/32"),
+		},
+		"cidr-address": {
+			typ: nettypes.CIDRType,
+			in:  tftypes.NewValue(tftypes.String, "192.0.2.0"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid CIDR Value",
+					"A string value was provided that is not a valid CIDR: invalid CIDR syntax\n\n"+
+						"Given Value: 192.0.2.0",
+				),
+			},
+		},
+		"cidr-prefix-length": {
+			typ: nettypes.CIDRType,
+			in:  tftypes.NewValue(tftypes.String, "192.0.2.0/33"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid CIDR Value",
+					"A string value was provided that is not a valid CIDR: invalid CIDR syntax\n\n"+
+						"Given Value: 192.0.2.0/33",
+				),
+			},
+		},
+		"mac-colons": {
+			typ: nettypes.MACAddressType,
+			in:  tftypes.NewValue(tftypes.String, "00:00:5e:00:53:01"),
+		},
+		"mac-hyphens": {
+			typ: nettypes.MACAddressType,
+			in:  tftypes.NewValue(tftypes.String, "00-00-5E-00-53-01"),
+		},
+		"mac-dots": {
+			typ: nettypes.MACAddressType,
+			in:  tftypes.NewValue(tftypes.String, "0000.5e00.5301"),
+		},
+		"mac-invalid": {
+			typ: nettypes.MACAddressType,
+			in:  tftypes.NewValue(tftypes.String, "00:00:5e:00:53"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid MAC Address Value",
+					"A string value was provided that is not a valid MAC Address: invalid MAC address syntax\n\n"+
+						"Given Value: 00:00:5e:00:53",
+				),
+			},
+		},
+		"wrong-type": {
+			typ: nettypes.IPAddressType,
+			in:  tftypes.NewValue(tftypes.Number, 123),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Network Address Type Validation Error",
+					"An unexpected error was encountered trying to validate an attribute value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Expected String value, received tftypes.Value with value: tftypes.Number<\"123\">",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.typ.Validate(context.Background(), testCase.in, path.Root("test"))
+
+			if diff := cmp.Diff(got, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestTypeEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ      attr.Type
+		other    attr.Type
+		expected bool
+	}{
+		"ip-ip": {
+			typ:      nettypes.IPAddressType,
+			other:    nettypes.IPAddressType,
+			expected: true,
+		},
+		"ip-ipv4": {
+			typ:      nettypes.IPAddressType,
+			other:    nettypes.IPv4AddressType,
+			expected: false,
+		},
+		"cidr-cidr": {
+			typ:      nettypes.CIDRType,
+			other:    nettypes.CIDRType,
+			expected: true,
+		},
+		"mac-string": {
+			typ:      nettypes.MACAddressType,
+			other:    types.StringType,
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.typ.Equal(testCase.other)
+
+			if got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
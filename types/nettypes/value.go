@@ -0,0 +1,112 @@
+package nettypes
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// toTerraformValue returns the tftypes.Value of a string based address value.
+func toTerraformValue(t addressType, state attr.ValueState, value string) (tftypes.Value, error) {
+	switch state {
+	case attr.ValueStateKnown:
+		if err := tftypes.ValidateValue(tftypes.String, value); err != nil {
+			return tftypes.NewValue(tftypes.String, tftypes.UnknownValue), err
+		}
+
+		return tftypes.NewValue(tftypes.String, value), nil
+	case attr.ValueStateNull:
+		return tftypes.NewValue(tftypes.String, nil), nil
+	case attr.ValueStateUnknown:
+		return tftypes.NewValue(tftypes.String, tftypes.UnknownValue), nil
+	default:
+		panic(fmt.Sprintf("unhandled %s state in ToTerraformValue: %s", t.description(), state))
+	}
+}
+
+// valueString returns the human-readable representation of a string based
+// address value.
+func valueString(state attr.ValueState, value string) string {
+	switch state {
+	case attr.ValueStateNull:
+		return attr.NullValueString
+	case attr.ValueStateUnknown:
+		return attr.UnknownValueString
+	default:
+		return fmt.Sprintf("%q", value)
+	}
+}
+
+// semanticEquals returns true if the known address strings a and b of the
+// address type represent the same address.
+func semanticEquals(t addressType, a string, b string) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if a == b {
+		return true, diags
+	}
+
+	var equal bool
+	var errA, errB error
+
+	switch t {
+	case IPAddressType, IPv4AddressType, IPv6AddressType:
+		ipA, err := parseIP(a)
+		errA = err
+
+		ipB, err := parseIP(b)
+		errB = err
+
+		equal = isIPv6(a) == isIPv6(b) && ipA.Equal(ipB)
+	case CIDRType:
+		ipA, onesA, err := parseCIDR(a)
+		errA = err
+
+		ipB, onesB, err := parseCIDR(b)
+		errB = err
+
+		equal = isIPv6(a) == isIPv6(b) && ipA.Equal(ipB) && onesA == onesB
+	case MACAddressType:
+		macA, err := parseMAC(a)
+		errA = err
+
+		macB, err := parseMAC(b)
+		errB = err
+
+		equal = bytes.Equal(macA, macB)
+	}
+
+	for _, err := range []error{errA, errB} {
+		if err == nil {
+			continue
+		}
+
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected error occurred while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return false, diags
+	}
+
+	return equal, diags
+}
+
+// semanticEqualsTypeDiags returns the error diagnostics for a semantic equality
+// check against a value of an unexpected type.
+func semanticEqualsTypeDiags(expected attr.Value, got attr.Value) diag.Diagnostics {
+	return diag.Diagnostics{
+		diag.NewErrorDiagnostic(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				fmt.Sprintf("Expected Value Type: %T\n", expected)+
+				fmt.Sprintf("Got Value Type: %T", got),
+		),
+	}
+}
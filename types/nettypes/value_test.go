@@ -0,0 +1,281 @@
+package nettypes_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/nettypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestValueToTerraformValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    attr.Value
+		expected tftypes.Value
+	}{
+		"ip-known": {
+			value:    nettypes.IPAddressValue("192.0.2.1"),
+			expected: tftypes.NewValue(tftypes.String, "192.0.2.1"),
+		},
+		"ip-null": {
+			value:    nettypes.IPAddressNull(),
+			expected: tftypes.NewValue(tftypes.String, nil),
+		},
+		"ip-unknown": {
+			value:    nettypes.IPAddressUnknown(),
+			expected: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		},
+		"ip-zero-value": {
+			value:    nettypes.IPAddress{},
+			expected: tftypes.NewValue(tftypes.String, nil),
+		},
+		"ipv4-known": {
+			value:    nettypes.IPv4AddressValue("192.0.2.1"),
+			expected: tftypes.NewValue(tftypes.String, "192.0.2.1"),
+		},
+		"ipv6-known": {
+			value:    nettypes.IPv6AddressValue("2001:db8::1"),
+			expected: tftypes.NewValue(tftypes.String, "2001:db8::1"),
+		},
+		"cidr-known": {
+			value:    nettypes.CIDRValue("192.0.2.0/24"),
+			expected: tftypes.NewValue(tftypes.String, "192.0.2.0/24"),
+		},
+		"cidr-unknown": {
+			value:    nettypes.CIDRUnknown(),
+			expected: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		},
+		"mac-known": {
+			value:    nettypes.MACAddressValue("00:00:5e:00:53:01"),
+			expected: tftypes.NewValue(tftypes.String, "00:00:5e:00:53:01"),
+		},
+		"mac-null": {
+			value:    nettypes.MACAddressNull(),
+			expected: tftypes.NewValue(tftypes.String, nil),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.value.ToTerraformValue(context.Background())
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestValueSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value         xattr.ValueWithSemanticEquals
+		other         attr.Value
+		expected      bool
+		expectedDiags diag.Diagnostics
+	}{
+		"ip-identical": {
+			value:    nettypes.IPAddressValue("192.0.2.1"),
+			other:    nettypes.IPAddressValue("192.0.2.1"),
+			expected: true,
+		},
+		"ip-ipv6-compressed": {
+			value:    nettypes.IPAddressValue("2001:db8::1"),
+			other:    nettypes.IPAddressValue("2001:0db8:0:0::1"),
+			expected: true,
+		},
+		"ip-ipv6-case": {
+			value:    nettypes.IPAddressValue("2001:db8::a"),
+			other:    nettypes.IPAddressValue("2001:DB8::A"),
+			expected: true,
+		},
+		"ip-ipv4-mapped-ipv6": {
+			value:    nettypes.IPAddressValue("192.0.2.1"),
+			other:    nettypes.IPAddressValue("::ffff:192.0.2.1"),
+			expected: false,
+		},
+		"ip-different": {
+			value:    nettypes.IPAddressValue("192.0.2.1"),
+			other:    nettypes.IPAddressValue("192.0.2.2"),
+			expected: false,
+		},
+		"ip-null-null": {
+			value:    nettypes.IPAddressNull(),
+			other:    nettypes.IPAddressNull(),
+			expected: true,
+		},
+		"ip-known-unknown": {
+			value:    nettypes.IPAddressValue("192.0.2.1"),
+			other:    nettypes.IPAddressUnknown(),
+			expected: false,
+		},
+		"ip-invalid": {
+			value:    nettypes.IPAddressValue("192.0.2.1"),
+			other:    nettypes.IPAddressValue("not-an-address"),
+			expected: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected error occurred while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Error: invalid IP address syntax",
+				),
+			},
+		},
+		"ip-wrong-type": {
+			value:    nettypes.IPAddressValue("192.0.2.1"),
+			other:    types.StringValue("192.0.2.1"),
+			expected: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: nettypes.IPAddress\n"+
+						"Got Value Type: types.String",
+				),
+			},
+		},
+		"ipv4-leading-zeroes": {
+			value:    nettypes.IPv4AddressValue("192.0.2.1"),
+			other:    nettypes.IPv4AddressValue("192.0.2.01"),
+			expected: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected error occurred while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Error: invalid IP address syntax",
+				),
+			},
+		},
+		"ipv6-expanded": {
+			value:    nettypes.IPv6AddressValue("2001:db8::1"),
+			other:    nettypes.IPv6AddressValue("2001:0db8:0000:0000:0000:0000:0000:0001"),
+			expected: true,
+		},
+		"ipv6-different": {
+			value:    nettypes.IPv6AddressValue("2001:db8::1"),
+			other:    nettypes.IPv6AddressValue("2001:db8::2"),
+			expected: false,
+		},
+		"cidr-ipv6-compressed": {
+			value:    nettypes.CIDRValue("2001:db8::/32"),
+			other:    nettypes.CIDRValue("2001:0DB8:0::/32"),
+			expected: true,
+		},
+		"cidr-prefix-length": {
+			value:    nettypes.CIDRValue("192.0.2.0/24"),
+			other:    nettypes.CIDRValue("192.0.2.0/25"),
+			expected: false,
+		},
+		"cidr-host-bits": {
+			value:    nettypes.CIDRValue("192.0.2.0/24"),
+			other:    nettypes.CIDRValue("192.0.2.1/24"),
+			expected: false,
+		},
+		"cidr-ipv4-mapped-ipv6": {
+			value:    nettypes.CIDRValue("192.0.2.0/24"),
+			other:    nettypes.CIDRValue("::ffff:192.0.2.0/120"),
+			expected: false,
+		},
+		"mac-separators": {
+			value:    nettypes.MACAddressValue("00:00:5e:00:53:01"),
+			other:    nettypes.MACAddressValue("00-00-5E-00-53-01"),
+			expected: true,
+		},
+		"mac-dots": {
+			value:    nettypes.MACAddressValue("00:00:5e:00:53:01"),
+			other:    nettypes.MACAddressValue("0000.5e00.5301"),
+			expected: true,
+		},
+		"mac-different": {
+			value:    nettypes.MACAddressValue("00:00:5e:00:53:01"),
+			other:    nettypes.MACAddressValue("00:00:5e:00:53:02"),
+			expected: false,
+		},
+		"mac-different-length": {
+			value:    nettypes.MACAddressValue("00:00:5e:00:53:01"),
+			other:    nettypes.MACAddressValue("02:00:5e:10:00:00:00:01"),
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.value.SemanticEquals(context.Background(), testCase.other)
+
+			if got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestValueString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    attr.Value
+		expected string
+	}{
+		"ip-known": {
+			value:    nettypes.IPAddressValue("192.0.2.1"),
+			expected: `"192.0.2.1"`,
+		},
+		"ipv4-null": {
+			value:    nettypes.IPv4AddressNull(),
+			expected: "<null>",
+		},
+		"ipv6-unknown": {
+			value:    nettypes.IPv6AddressUnknown(),
+			expected: "<unknown>",
+		},
+		"cidr-known": {
+			value:    nettypes.CIDRValue("192.0.2.0/24"),
+			expected: `"192.0.2.0/24"`,
+		},
+		"mac-zero-value": {
+			value:    nettypes.MACAddress{},
+			expected: "<null>",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.value.String()
+
+			if got != testCase.expected {
+				t.Errorf("expected %q, got %q", testCase.expected, got)
+			}
+		})
+	}
+}
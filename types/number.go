@@ -2,6 +2,7 @@ package types
 
 import (
 	"context"
+	"fmt"
 	"math/big"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -12,34 +13,61 @@ var (
 	_ attr.Value = Number{}
 )
 
+// NumberNull creates a Number with a null value. Determine whether the value
+// is null via the Number type IsNull method.
+func NumberNull() Number {
+	return Number{
+		state: attr.ValueStateNull,
+	}
+}
+
+// NumberUnknown creates a Number with an unknown value. Determine whether the
+// value is unknown via the Number type IsUnknown method.
+func NumberUnknown() Number {
+	return Number{
+		state: attr.ValueStateUnknown,
+	}
+}
+
+// NumberValue creates a Number with a known value. Access the value via the
+// Number type ValueBigFloat method. If the given value is nil, a null Number
+// is created.
+func NumberValue(value *big.Float) Number {
+	if value == nil {
+		return NumberNull()
+	}
+
+	return Number{
+		state: attr.ValueStateKnown,
+		value: value,
+	}
+}
+
 func numberValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
 	if !in.IsKnown() {
-		return Number{Unknown: true}, nil
+		return NumberUnknown(), nil
 	}
 	if in.IsNull() {
-		return Number{Null: true}, nil
+		return NumberNull(), nil
 	}
 	n := big.NewFloat(0)
 	err := in.As(&n)
 	if err != nil {
 		return nil, err
 	}
-	return Number{Value: n}, nil
+	return NumberValue(n), nil
 }
 
 // Number represents a number value, exposed as a *big.Float. Numbers can be
-// floats or integers.
+// floats or integers. The zero-value of Number is null, use the NumberNull,
+// NumberUnknown, and NumberValue functions to create a Number.
 type Number struct {
-	// Unknown will be true if the value is not yet known.
-	Unknown bool
-
-	// Null will be true if the value was not set, or was explicitly set to
-	// null.
-	Null bool
+	// state represents whether the value is null, unknown, or known. The
+	// zero-value is null.
+	state attr.ValueState
 
-	// Value contains the set value, as long as Unknown and Null are both
-	// false.
-	Value *big.Float
+	// value contains the known value, if not null or unknown.
+	value *big.Float
 }
 
 // Type returns a NumberType.
@@ -49,19 +77,24 @@ func (n Number) Type(_ context.Context) attr.Type {
 
 // ToTerraformValue returns the data contained in the Number as a tftypes.Value.
 func (n Number) ToTerraformValue(_ context.Context) (tftypes.Value, error) {
-	if n.Null {
+	switch n.state {
+	case attr.ValueStateKnown:
+		if n.value == nil {
+			return tftypes.NewValue(tftypes.Number, nil), nil
+		}
+
+		if err := tftypes.ValidateValue(tftypes.Number, n.value); err != nil {
+			return tftypes.NewValue(tftypes.Number, tftypes.UnknownValue), err
+		}
+
+		return tftypes.NewValue(tftypes.Number, n.value), nil
+	case attr.ValueStateNull:
 		return tftypes.NewValue(tftypes.Number, nil), nil
-	}
-	if n.Unknown {
+	case attr.ValueStateUnknown:
 		return tftypes.NewValue(tftypes.Number, tftypes.UnknownValue), nil
+	default:
+		panic(fmt.Sprintf("unhandled Number state in ToTerraformValue: %s", n.state))
 	}
-	if n.Value == nil {
-		return tftypes.NewValue(tftypes.Number, nil), nil
-	}
-	if err := tftypes.ValidateValue(tftypes.Number, n.Value); err != nil {
-		return tftypes.NewValue(tftypes.Number, tftypes.UnknownValue), err
-	}
-	return tftypes.NewValue(tftypes.Number, n.Value), nil
 }
 
 // Equal returns true if `other` is a Number and has the same value as `n`.
@@ -70,36 +103,30 @@ func (n Number) Equal(other attr.Value) bool {
 	if !ok {
 		return false
 	}
-	if n.Unknown != o.Unknown {
-		return false
-	}
-	if n.Null != o.Null {
+	if n.state != o.state {
 		return false
 	}
-	if n.Value == nil && o.Value == nil {
+	if n.state != attr.ValueStateKnown {
 		return true
 	}
-	if n.Value == nil || o.Value == nil {
-		return false
-	}
-	return n.Value.Cmp(o.Value) == 0
+	return n.value.Cmp(o.value) == 0
 }
 
 // IsNull returns true if the Number represents a null value.
 func (n Number) IsNull() bool {
-	return n.Null || (!n.Unknown && n.Value == nil)
+	return n.state == attr.ValueStateNull
 }
 
 // IsUnknown returns true if the Number represents a currently unknown value.
 func (n Number) IsUnknown() bool {
-	return n.Unknown
+	return n.state == attr.ValueStateUnknown
 }
 
 // String returns a human-readable representation of the Number value.
 // The string returned here is not protected by any compatibility guarantees,
 // and is intended for logging and error reporting.
 func (n Number) String() string {
-	if n.Unknown {
+	if n.IsUnknown() {
 		return attr.UnknownValueString
 	}
 
@@ -107,5 +134,16 @@ func (n Number) String() string {
 		return attr.NullValueString
 	}
 
-	return n.Value.String()
+	return n.value.String()
+}
+
+// ValueBigFloat returns the known *big.Float value. If Number is null or
+// unknown, returns nil. The returned *big.Float is a copy, so modifying it
+// does not change the Number.
+func (n Number) ValueBigFloat() *big.Float {
+	if n.value == nil {
+		return nil
+	}
+
+	return new(big.Float).Copy(n.value)
 }
@@ -30,15 +30,15 @@ func testNumberValueFromTerraform(t *testing.T, direct bool) {
 	tests := map[string]testCase{
 		"value": {
 			input:       tftypes.NewValue(tftypes.Number, 123),
-			expectation: Number{Value: big.NewFloat(123)},
+			expectation: NumberValue(big.NewFloat(123)),
 		},
 		"unknown": {
 			input:       tftypes.NewValue(tftypes.Number, tftypes.UnknownValue),
-			expectation: Number{Unknown: true},
+			expectation: NumberUnknown(),
 		},
 		"null": {
 			input:       tftypes.NewValue(tftypes.Number, nil),
-			expectation: Number{Null: true},
+			expectation: NumberNull(),
 		},
 		"wrongType": {
 			input:       tftypes.NewValue(tftypes.String, "oops"),
@@ -95,19 +95,19 @@ func TestNumberToTerraformValue(t *testing.T) {
 	}
 	tests := map[string]testCase{
 		"value": {
-			input:       Number{Value: big.NewFloat(123)},
+			input:       NumberValue(big.NewFloat(123)),
 			expectation: tftypes.NewValue(tftypes.Number, big.NewFloat(123)),
 		},
 		"value-nil": {
-			input:       Number{Value: nil},
+			input:       NumberValue(nil),
 			expectation: tftypes.NewValue(tftypes.Number, nil),
 		},
 		"unknown": {
-			input:       Number{Unknown: true},
+			input:       NumberUnknown(),
 			expectation: tftypes.NewValue(tftypes.Number, tftypes.UnknownValue),
 		},
 		"null": {
-			input:       Number{Null: true},
+			input:       NumberNull(),
 			expectation: tftypes.NewValue(tftypes.Number, nil),
 		},
 	}
@@ -139,99 +139,99 @@ func TestNumberEqual(t *testing.T) {
 	}
 	tests := map[string]testCase{
 		"value-value-same": {
-			input:       Number{Value: big.NewFloat(123)},
-			candidate:   Number{Value: big.NewFloat(123)},
+			input:       NumberValue(big.NewFloat(123)),
+			candidate:   NumberValue(big.NewFloat(123)),
 			expectation: true,
 		},
 		"value-value-diff": {
-			input:       Number{Value: big.NewFloat(123)},
-			candidate:   Number{Value: big.NewFloat(456)},
+			input:       NumberValue(big.NewFloat(123)),
+			candidate:   NumberValue(big.NewFloat(456)),
 			expectation: false,
 		},
 		"value-unknown": {
-			input:       Number{Value: big.NewFloat(123)},
-			candidate:   Number{Unknown: true},
+			input:       NumberValue(big.NewFloat(123)),
+			candidate:   NumberUnknown(),
 			expectation: false,
 		},
 		"value-null": {
-			input:       Number{Value: big.NewFloat(123)},
-			candidate:   Number{Null: true},
+			input:       NumberValue(big.NewFloat(123)),
+			candidate:   NumberNull(),
 			expectation: false,
 		},
 		"value-wrongType": {
-			input:       Number{Value: big.NewFloat(123)},
-			candidate:   &String{Value: "oops"},
+			input:       NumberValue(big.NewFloat(123)),
+			candidate:   StringValue("oops"),
 			expectation: false,
 		},
 		"value-nil": {
-			input:       Number{Value: big.NewFloat(123)},
+			input:       NumberValue(big.NewFloat(123)),
 			candidate:   nil,
 			expectation: false,
 		},
 		"value-nilValue": {
-			input:       Number{Value: big.NewFloat(123)},
-			candidate:   Number{Value: nil},
+			input:       NumberValue(big.NewFloat(123)),
+			candidate:   NumberValue(nil),
 			expectation: false,
 		},
 		"unknown-value": {
-			input:       Number{Unknown: true},
-			candidate:   Number{Value: big.NewFloat(123)},
+			input:       NumberUnknown(),
+			candidate:   NumberValue(big.NewFloat(123)),
 			expectation: false,
 		},
 		"unknown-unknown": {
-			input:       Number{Unknown: true},
-			candidate:   Number{Unknown: true},
+			input:       NumberUnknown(),
+			candidate:   NumberUnknown(),
 			expectation: true,
 		},
 		"unknown-null": {
-			input:       Number{Unknown: true},
-			candidate:   Number{Null: true},
+			input:       NumberUnknown(),
+			candidate:   NumberNull(),
 			expectation: false,
 		},
 		"unknown-wrongType": {
-			input:       Number{Unknown: true},
-			candidate:   &String{Value: "oops"},
+			input:       NumberUnknown(),
+			candidate:   StringValue("oops"),
 			expectation: false,
 		},
 		"unknown-nil": {
-			input:       Number{Unknown: true},
+			input:       NumberUnknown(),
 			candidate:   nil,
 			expectation: false,
 		},
 		"unknown-nilValue": {
-			input:       Number{Unknown: true},
-			candidate:   Number{Value: nil},
+			input:       NumberUnknown(),
+			candidate:   NumberValue(nil),
 			expectation: false,
 		},
 		"null-value": {
-			input:       Number{Null: true},
-			candidate:   Number{Value: big.NewFloat(123)},
+			input:       NumberNull(),
+			candidate:   NumberValue(big.NewFloat(123)),
 			expectation: false,
 		},
 		"null-unknown": {
-			input:       Number{Null: true},
-			candidate:   Number{Unknown: true},
+			input:       NumberNull(),
+			candidate:   NumberUnknown(),
 			expectation: false,
 		},
 		"null-null": {
-			input:       Number{Null: true},
-			candidate:   Number{Null: true},
+			input:       NumberNull(),
+			candidate:   NumberNull(),
 			expectation: true,
 		},
 		"null-wrongType": {
-			input:       Number{Null: true},
-			candidate:   &String{Value: "oops"},
+			input:       NumberNull(),
+			candidate:   StringValue("oops"),
 			expectation: false,
 		},
 		"null-nil": {
-			input:       Number{Null: true},
+			input:       NumberNull(),
 			candidate:   nil,
 			expectation: false,
 		},
 		"null-nilValue": {
-			input:       Number{Null: true},
-			candidate:   Number{Value: nil},
-			expectation: false,
+			input:       NumberNull(),
+			candidate:   NumberValue(nil),
+			expectation: true,
 		},
 	}
 	for name, test := range tests {
@@ -256,38 +256,38 @@ func TestNumberString(t *testing.T) {
 	}
 	tests := map[string]testCase{
 		"less-than-one": {
-			input:       Number{Value: big.NewFloat(0.12340984302980000)},
+			input:       NumberValue(big.NewFloat(0.12340984302980000)),
 			expectation: "0.123409843",
 		},
 		"more-than-one": {
-			input:       Number{Value: big.NewFloat(92387938173219.327663)},
+			input:       NumberValue(big.NewFloat(92387938173219.327663)),
 			expectation: "9.238793817e+13",
 		},
 		"negative-more-than-one": {
-			input:       Number{Value: big.NewFloat(-0.12340984302980000)},
+			input:       NumberValue(big.NewFloat(-0.12340984302980000)),
 			expectation: "-0.123409843",
 		},
 		"negative-less-than-one": {
-			input:       Number{Value: big.NewFloat(-92387938173219.327663)},
+			input:       NumberValue(big.NewFloat(-92387938173219.327663)),
 			expectation: "-9.238793817e+13",
 		},
 		"min-float64": {
-			input:       Number{Value: big.NewFloat(math.SmallestNonzeroFloat64)},
+			input:       NumberValue(big.NewFloat(math.SmallestNonzeroFloat64)),
 			expectation: "4.940656458e-324",
 		},
 		"max-float64": {
-			input:       Number{Value: big.NewFloat(math.MaxFloat64)},
+			input:       NumberValue(big.NewFloat(math.MaxFloat64)),
 			expectation: "1.797693135e+308",
 		},
 		"unknown": {
-			input:       Number{Unknown: true},
+			input:       NumberUnknown(),
 			expectation: "<unknown>",
 		},
 		"null": {
-			input:       Number{Null: true},
+			input:       NumberNull(),
 			expectation: "<null>",
 		},
-		"default-null": {
+		"zero-value": {
 			input:       Number{},
 			expectation: "<null>",
 		},
@@ -305,3 +305,59 @@ func TestNumberString(t *testing.T) {
 		})
 	}
 }
+
+func TestNumberValueBigFloat(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    Number
+		expected *big.Float
+	}{
+		"known": {
+			input:    NumberValue(big.NewFloat(2.4)),
+			expected: big.NewFloat(2.4),
+		},
+		"known-nil": {
+			input:    NumberValue(nil),
+			expected: nil,
+		},
+		"null": {
+			input:    NumberNull(),
+			expected: nil,
+		},
+		"unknown": {
+			input:    NumberUnknown(),
+			expected: nil,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.input.ValueBigFloat()
+
+			if got == nil && testCase.expected == nil {
+				return
+			}
+
+			if got == nil || testCase.expected == nil || got.Cmp(testCase.expected) != 0 {
+				t.Errorf("expected %s, got %s", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestNumberValueBigFloat_copy(t *testing.T) {
+	t.Parallel()
+
+	number := NumberValue(big.NewFloat(2.4))
+
+	number.ValueBigFloat().SetFloat64(4.2)
+
+	if number.ValueBigFloat().Cmp(big.NewFloat(2.4)) != 0 {
+		t.Errorf("expected value to be unchanged, got %s", number.ValueBigFloat())
+	}
+}
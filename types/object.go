@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/reflect"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
@@ -54,23 +55,17 @@ func (o ObjectType) TerraformType(ctx context.Context) tftypes.Type {
 // This is meant to convert the tftypes.Value into a more convenient Go
 // type for the provider to consume the data with.
 func (o ObjectType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
-	object := Object{
-		AttrTypes: o.AttrTypes,
-	}
 	if in.Type() == nil {
-		object.Null = true
-		return object, nil
+		return ObjectNull(o.AttrTypes), nil
 	}
 	if !in.Type().Equal(o.TerraformType(ctx)) {
 		return nil, fmt.Errorf("expected %s, got %s", o.TerraformType(ctx), in.Type())
 	}
 	if !in.IsKnown() {
-		object.Unknown = true
-		return object, nil
+		return ObjectUnknown(o.AttrTypes), nil
 	}
 	if in.IsNull() {
-		object.Null = true
-		return object, nil
+		return ObjectNull(o.AttrTypes), nil
 	}
 	attributes := map[string]attr.Value{}
 
@@ -81,14 +76,19 @@ func (o ObjectType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (a
 	}
 
 	for k, v := range val {
-		a, err := object.AttrTypes[k].ValueFromTerraform(ctx, v)
+		a, err := o.AttrTypes[k].ValueFromTerraform(ctx, v)
 		if err != nil {
 			return nil, err
 		}
 		attributes[k] = a
 	}
-	object.Attrs = attributes
-	return object, nil
+	// Attributes were created from the attribute types, so there is no need
+	// to validate them via ObjectValue.
+	return Object{
+		attributeTypes: o.AttrTypes,
+		attributes:     attributes,
+		state:          attr.ValueStateKnown,
+	}, nil
 }
 
 // Equal returns true if `candidate` is also an ObjectType and has the same
@@ -143,24 +143,163 @@ func (o ObjectType) String() string {
 	return res.String()
 }
 
-// Object represents an object
-type Object struct {
-	// Unknown will be set to true if the entire object is an unknown value.
-	// If only some of the elements in the object are unknown, their known or
-	// unknown status will be represented however that attr.Value
-	// surfaces that information. The Object's Unknown property only tracks
-	// if the number of elements in a Object is known, not whether the
-	// elements that are in the object are known.
-	Unknown bool
+// ObjectNull creates an Object with a null value. Determine whether the value
+// is null via the Object type IsNull method.
+func ObjectNull(attributeTypes map[string]attr.Type) Object {
+	return Object{
+		attributeTypes: copyAttributeTypes(attributeTypes),
+		state:          attr.ValueStateNull,
+	}
+}
 
-	// Null will be set to true if the object is null, either because it was
-	// omitted from the configuration, state, or plan, or because it was
-	// explicitly set to null.
-	Null bool
+// ObjectUnknown creates an Object with an unknown value. Determine whether
+// the value is unknown via the Object type IsUnknown method.
+func ObjectUnknown(attributeTypes map[string]attr.Type) Object {
+	return Object{
+		attributeTypes: copyAttributeTypes(attributeTypes),
+		state:          attr.ValueStateUnknown,
+	}
+}
 
-	Attrs map[string]attr.Value
+// ObjectValue creates an Object with a known value. Access the value via the
+// Object type Attributes or As methods. Every attribute type must have a
+// value of that type and there must be no values without an attribute type,
+// otherwise an error diagnostic is returned.
+func ObjectValue(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) (Object, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// The creation functions do not accept a context, as they are typically
+	// used in places where one is not available, such as tests.
+	ctx := context.Background()
+
+	for _, name := range sortedValueKeys(attributes) {
+		if _, ok := attributeTypes[name]; !ok {
+			diags.AddError(
+				"Extra Object Attribute Value",
+				"While creating an Object value, an extra attribute value was detected. "+
+					"An Object must not contain values beyond the expected attribute types. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("Extra Object Attribute Name (%s) Value Type: %s", name, attributes[name].Type(ctx)),
+			)
+		}
+	}
 
-	AttrTypes map[string]attr.Type
+	for _, name := range sortedTypeKeys(attributeTypes) {
+		attributeType := attributeTypes[name]
+		attribute, ok := attributes[name]
+
+		if !ok {
+			diags.AddError(
+				"Missing Object Attribute Value",
+				"While creating an Object value, a missing attribute value was detected. "+
+					"An Object must contain values for all attributes, even if null or unknown. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("Object Attribute Name (%s) Expected Type: %s", name, attributeType),
+			)
+
+			continue
+		}
+
+		if !attributeType.Equal(attribute.Type(ctx)) {
+			diags.AddError(
+				"Invalid Object Attribute Type",
+				"While creating an Object value, an invalid attribute value was detected. "+
+					"An Object must use a matching attribute type for the value. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("Object Attribute Name (%s) Expected Type: %s\n", name, attributeType)+
+					fmt.Sprintf("Object Attribute Name (%s) Given Type: %s", name, attribute.Type(ctx)),
+			)
+		}
+	}
+
+	if diags.HasError() {
+		return ObjectUnknown(attributeTypes), diags
+	}
+
+	return Object{
+		attributeTypes: copyAttributeTypes(attributeTypes),
+		attributes:     copyElementsMap(attributes),
+		state:          attr.ValueStateKnown,
+	}, nil
+}
+
+// ObjectValueFrom creates an Object with a known value, using reflection
+// rules. The attributes must be a struct with tfsdk field tags, whose fields
+// can convert into the given attribute types. Access the value via the Object
+// type Attributes or As methods.
+func ObjectValueFrom(ctx context.Context, attributeTypes map[string]attr.Type, attributes interface{}) (Object, diag.Diagnostics) {
+	attrValue, diags := reflect.FromValue(
+		ctx,
+		ObjectType{AttrTypes: attributeTypes},
+		attributes,
+		path.Empty(),
+	)
+
+	if diags.HasError() {
+		return ObjectUnknown(attributeTypes), diags
+	}
+
+	object, ok := attrValue.(Object)
+
+	// Reflection should only return Object, but guard against a panic.
+	if !ok {
+		diags.AddError(
+			"Object Conversion Error",
+			"An unexpected error was encountered trying to convert the attributes into an Object value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("expected types.Object, got: %T", attrValue),
+		)
+
+		return ObjectUnknown(attributeTypes), diags
+	}
+
+	return object, diags
+}
+
+// ObjectValueMust creates an Object with a known value, converting any
+// diagnostics into a panic at runtime. Access the value via the Object type
+// Attributes or As methods.
+//
+// This creation function is only recommended to create Object values which
+// will not potentially affect practitioners, such as testing, or exhaustively
+// tested provider logic.
+func ObjectValueMust(attributeTypes map[string]attr.Type, attributes map[string]attr.Value) Object {
+	object, diags := ObjectValue(attributeTypes, attributes)
+
+	if diags.HasError() {
+		panic(diagsPanicMessage("ObjectValueMust", diags))
+	}
+
+	return object
+}
+
+// Object represents an object. The zero-value of Object is null without
+// attribute types, use the ObjectNull, ObjectUnknown, and ObjectValue
+// functions to create an Object.
+type Object struct {
+	// attributes are the known attribute values of the object. If only
+	// some of the attributes in the object are unknown, their known or
+	// unknown status will be represented however that attr.Value surfaces
+	// that information.
+	attributes map[string]attr.Value
+
+	// attributeTypes are the types of the attributes in the object.
+	attributeTypes map[string]attr.Type
+
+	// state represents whether the value is null, unknown, or known. The
+	// zero-value is null.
+	state attr.ValueState
+}
+
+// Attributes returns a copy of the mapping of known attribute values for the
+// Object.
+func (o Object) Attributes() map[string]attr.Value {
+	return copyElementsMap(o.attributes)
+}
+
+// AttributeTypes returns a copy of the mapping of attribute types for the
+// Object.
+func (o Object) AttributeTypes(_ context.Context) map[string]attr.Type {
+	return copyAttributeTypes(o.attributeTypes)
 }
 
 // ObjectAsOptions is a collection of toggles to control the behavior of
@@ -184,7 +323,7 @@ type ObjectAsOptions struct {
 func (o Object) As(ctx context.Context, target interface{}, opts ObjectAsOptions) diag.Diagnostics {
 	// we need a tftypes.Value for this Object to be able to use it with
 	// our reflection code
-	obj := ObjectType{AttrTypes: o.AttrTypes}
+	obj := ObjectType{AttrTypes: o.attributeTypes}
 	val, err := o.ToTerraformValue(ctx)
 	if err != nil {
 		return diag.Diagnostics{
@@ -202,39 +341,47 @@ func (o Object) As(ctx context.Context, target interface{}, opts ObjectAsOptions
 
 // Type returns an ObjectType with the same attribute types as `o`.
 func (o Object) Type(_ context.Context) attr.Type {
-	return ObjectType{AttrTypes: o.AttrTypes}
+	return ObjectType{AttrTypes: o.attributeTypes}
 }
 
 // ToTerraformValue returns the data contained in the attr.Value as
 // a tftypes.Value.
 func (o Object) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
-	if o.AttrTypes == nil {
-		return tftypes.Value{}, fmt.Errorf("cannot convert Object to tftypes.Value if AttrTypes field is not set")
+	if o.attributeTypes == nil {
+		return tftypes.Value{}, fmt.Errorf("cannot convert Object to tftypes.Value if attribute types are not set")
 	}
 	attrTypes := map[string]tftypes.Type{}
-	for attr, typ := range o.AttrTypes {
+	for attr, typ := range o.attributeTypes {
 		attrTypes[attr] = typ.TerraformType(ctx)
 	}
 	objectType := tftypes.Object{AttributeTypes: attrTypes}
-	if o.Unknown {
-		return tftypes.NewValue(objectType, tftypes.UnknownValue), nil
-	}
-	if o.Null {
-		return tftypes.NewValue(objectType, nil), nil
-	}
-	vals := map[string]tftypes.Value{}
 
-	for k, v := range o.Attrs {
-		val, err := v.ToTerraformValue(ctx)
-		if err != nil {
+	switch o.state {
+	case attr.ValueStateKnown:
+		vals := make(map[string]tftypes.Value, len(o.attributes))
+
+		for name, v := range o.attributes {
+			val, err := v.ToTerraformValue(ctx)
+
+			if err != nil {
+				return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+			}
+
+			vals[name] = val
+		}
+
+		if err := tftypes.ValidateValue(objectType, vals); err != nil {
 			return tftypes.NewValue(objectType, tftypes.UnknownValue), err
 		}
-		vals[k] = val
-	}
-	if err := tftypes.ValidateValue(objectType, vals); err != nil {
-		return tftypes.NewValue(objectType, tftypes.UnknownValue), err
+
+		return tftypes.NewValue(objectType, vals), nil
+	case attr.ValueStateNull:
+		return tftypes.NewValue(objectType, nil), nil
+	case attr.ValueStateUnknown:
+		return tftypes.NewValue(objectType, tftypes.UnknownValue), nil
+	default:
+		panic(fmt.Sprintf("unhandled Object state in ToTerraformValue: %s", o.state))
 	}
-	return tftypes.NewValue(objectType, vals), nil
 }
 
 // Equal returns true if the Object is considered semantically equal
@@ -244,17 +391,14 @@ func (o Object) Equal(c attr.Value) bool {
 	if !ok {
 		return false
 	}
-	if o.Unknown != other.Unknown {
-		return false
-	}
-	if o.Null != other.Null {
+	if o.state != other.state {
 		return false
 	}
-	if len(o.AttrTypes) != len(other.AttrTypes) {
+	if len(o.attributeTypes) != len(other.attributeTypes) {
 		return false
 	}
-	for k, v := range o.AttrTypes {
-		attr, ok := other.AttrTypes[k]
+	for k, v := range o.attributeTypes {
+		attr, ok := other.attributeTypes[k]
 		if !ok {
 			return false
 		}
@@ -262,11 +406,14 @@ func (o Object) Equal(c attr.Value) bool {
 			return false
 		}
 	}
-	if len(o.Attrs) != len(other.Attrs) {
+	if o.state != attr.ValueStateKnown {
+		return true
+	}
+	if len(o.attributes) != len(other.attributes) {
 		return false
 	}
-	for k, v := range o.Attrs {
-		attr, ok := other.Attrs[k]
+	for k, v := range o.attributes {
+		attr, ok := other.attributes[k]
 		if !ok {
 			return false
 		}
@@ -280,29 +427,29 @@ func (o Object) Equal(c attr.Value) bool {
 
 // IsNull returns true if the Object represents a null value.
 func (o Object) IsNull() bool {
-	return o.Null
+	return o.state == attr.ValueStateNull
 }
 
 // IsUnknown returns true if the Object represents a currently unknown value.
 func (o Object) IsUnknown() bool {
-	return o.Unknown
+	return o.state == attr.ValueStateUnknown
 }
 
 // String returns a human-readable representation of the Object value.
 // The string returned here is not protected by any compatibility guarantees,
 // and is intended for logging and error reporting.
 func (o Object) String() string {
-	if o.Unknown {
+	if o.IsUnknown() {
 		return attr.UnknownValueString
 	}
 
-	if o.Null {
+	if o.IsNull() {
 		return attr.NullValueString
 	}
 
 	// We want the output to be consistent, so we sort the output by key
-	keys := make([]string, 0, len(o.Attrs))
-	for k := range o.Attrs {
+	keys := make([]string, 0, len(o.attributes))
+	for k := range o.attributes {
 		keys = append(keys, k)
 	}
 	sort.Strings(keys)
@@ -314,7 +461,7 @@ func (o Object) String() string {
 		if i != 0 {
 			res.WriteString(",")
 		}
-		res.WriteString(fmt.Sprintf(`"%s":%s`, k, o.Attrs[k].String()))
+		res.WriteString(fmt.Sprintf(`"%s":%s`, k, o.attributes[k].String()))
 	}
 	res.WriteString("}")
 
@@ -7,6 +7,7 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
@@ -69,18 +70,15 @@ func TestObjectTypeValueFromTerraform(t *testing.T) {
 				"b": tftypes.NewValue(tftypes.Bool, true),
 				"c": tftypes.NewValue(tftypes.Number, 123),
 			}),
-			expected: Object{
-				Attrs: map[string]attr.Value{
-					"a": String{Value: "red"},
-					"b": Bool{Value: true},
-					"c": Number{Value: big.NewFloat(123)},
-				},
-				AttrTypes: map[string]attr.Type{
-					"a": StringType,
-					"b": BoolType,
-					"c": NumberType,
-				},
-			},
+			expected: ObjectValueMust(map[string]attr.Type{
+				"a": StringType,
+				"b": BoolType,
+				"c": NumberType,
+			}, map[string]attr.Value{
+				"a": StringValue("red"),
+				"b": BoolValue(true),
+				"c": NumberValue(big.NewFloat(123)),
+			}),
 		},
 		"extra-attribute": {
 			receiver: ObjectType{
@@ -131,12 +129,9 @@ func TestObjectTypeValueFromTerraform(t *testing.T) {
 				},
 			},
 			input: tftypes.NewValue(nil, nil),
-			expected: Object{
-				AttrTypes: map[string]attr.Type{
-					"a": StringType,
-				},
-				Null: true,
-			},
+			expected: ObjectNull(map[string]attr.Type{
+				"a": StringType,
+			}),
 		},
 		"unknown": {
 			receiver: ObjectType{
@@ -149,12 +144,9 @@ func TestObjectTypeValueFromTerraform(t *testing.T) {
 					"a": tftypes.String,
 				},
 			}, tftypes.UnknownValue),
-			expected: Object{
-				AttrTypes: map[string]attr.Type{
-					"a": StringType,
-				},
-				Unknown: true,
-			},
+			expected: ObjectUnknown(map[string]attr.Type{
+				"a": StringType,
+			}),
 		},
 		"null": {
 			receiver: ObjectType{
@@ -167,12 +159,9 @@ func TestObjectTypeValueFromTerraform(t *testing.T) {
 					"a": tftypes.String,
 				},
 			}, nil),
-			expected: Object{
-				AttrTypes: map[string]attr.Type{
-					"a": StringType,
-				},
-				Null: true,
-			},
+			expected: ObjectNull(map[string]attr.Type{
+				"a": StringType,
+			}),
 		},
 	}
 
@@ -387,152 +376,116 @@ func TestObjectAs_struct(t *testing.T) {
 		H myEmbeddedStruct `tfsdk:"h"`
 		I Object           `tfsdk:"i"`
 	}
-	object := Object{
-		AttrTypes: map[string]attr.Type{
-			"a": StringType,
-			"b": BoolType,
-			"c": ListType{ElemType: StringType},
-			"d": ListType{ElemType: StringType},
-			"e": ListType{ElemType: BoolType},
-			"f": ListType{ElemType: ListType{ElemType: StringType}},
-			"g": ObjectType{
-				AttrTypes: map[string]attr.Type{
-					"dogs":  NumberType,
-					"cats":  NumberType,
-					"names": ListType{ElemType: StringType},
-				},
-			},
-			"h": ObjectType{
-				AttrTypes: map[string]attr.Type{
-					"red":    StringType,
-					"blue":   ListType{ElemType: NumberType},
-					"green":  NumberType,
-					"yellow": NumberType,
-				},
-			},
-			"i": ObjectType{
-				AttrTypes: map[string]attr.Type{
-					"name":     StringType,
-					"age":      NumberType,
-					"opted_in": BoolType,
-				},
+	object := ObjectValueMust(map[string]attr.Type{
+		"a": StringType,
+		"b": BoolType,
+		"c": ListType{ElemType: StringType},
+		"d": ListType{ElemType: StringType},
+		"e": ListType{ElemType: BoolType},
+		"f": ListType{ElemType: ListType{ElemType: StringType}},
+		"g": ObjectType{
+			AttrTypes: map[string]attr.Type{
+				"dogs":  NumberType,
+				"cats":  NumberType,
+				"names": ListType{ElemType: StringType},
 			},
 		},
-		Attrs: map[string]attr.Value{
-			"a": String{Value: "hello"},
-			"b": Bool{Value: true},
-			"c": List{
-				ElemType: StringType,
-				Elems: []attr.Value{
-					String{Value: "into"},
-					String{Value: "the"},
-					String{Unknown: true},
-					String{Null: true},
-				},
-			},
-			"d": List{
-				ElemType: StringType,
-				Elems: []attr.Value{
-					String{Value: "it's"},
-					String{Value: "getting"},
-					String{Value: "hard"},
-					String{Value: "to"},
-					String{Value: "come"},
-					String{Value: "up"},
-					String{Value: "with"},
-					String{Value: "test"},
-					String{Value: "values"},
-				},
-			},
-			"e": List{
-				ElemType: BoolType,
-				Elems: []attr.Value{
-					Bool{Value: true},
-					Bool{Value: false},
-					Bool{Value: false},
-					Bool{Value: true},
-				},
-			},
-			"f": List{
-				ElemType: ListType{
-					ElemType: StringType,
-				},
-				Elems: []attr.Value{
-					List{
-						ElemType: StringType,
-						Elems: []attr.Value{
-							String{Value: "head"},
-							String{Value: "empty"},
-						},
-					},
-					List{
-						ElemType: StringType,
-						Elems: []attr.Value{
-							String{Value: "no"},
-							String{Value: "thoughts"},
-						},
-					},
-				},
-			},
-			"g": Object{
-				AttrTypes: map[string]attr.Type{
-					"dogs":  NumberType,
-					"cats":  NumberType,
-					"names": ListType{ElemType: StringType},
-				},
-				Attrs: map[string]attr.Value{
-					"dogs": Number{Value: big.NewFloat(3)},
-					"cats": Number{Value: big.NewFloat(5)},
-					"names": List{
-						ElemType: StringType,
-						Elems: []attr.Value{
-							String{Value: "Roxy"},
-							String{Value: "Jpeg"},
-							String{Value: "Kupo"},
-							String{Value: "Clawde"},
-							String{Value: "Yeti"},
-							String{Value: "Abby"},
-							String{Value: "Ellie"},
-							String{Value: "Lexi"},
-						},
-					},
-				},
-			},
-			"h": Object{
-				AttrTypes: map[string]attr.Type{
-					"red":    StringType,
-					"blue":   ListType{ElemType: NumberType},
-					"green":  NumberType,
-					"yellow": NumberType,
-				},
-				Attrs: map[string]attr.Value{
-					"red": String{Value: "judge me not too harshly, future maintainers, this much random data is hard to come up with without getting weird."},
-					"blue": List{
-						ElemType: NumberType,
-						Elems: []attr.Value{
-							Number{Value: big.NewFloat(1)},
-							Number{Value: big.NewFloat(2)},
-							Number{Value: big.NewFloat(3)},
-						},
-					},
-					"green":  Number{Value: big.NewFloat(123.456)},
-					"yellow": Number{Value: big.NewFloat(123)},
-				},
+		"h": ObjectType{
+			AttrTypes: map[string]attr.Type{
+				"red":    StringType,
+				"blue":   ListType{ElemType: NumberType},
+				"green":  NumberType,
+				"yellow": NumberType,
 			},
-			"i": Object{
-				AttrTypes: map[string]attr.Type{
-					"name":     StringType,
-					"age":      NumberType,
-					"opted_in": BoolType,
-				},
-				Attrs: map[string]attr.Value{
-					"name":     String{Value: "J Doe"},
-					"age":      Number{Value: big.NewFloat(28)},
-					"opted_in": Bool{Value: true},
-				},
+		},
+		"i": ObjectType{
+			AttrTypes: map[string]attr.Type{
+				"name":     StringType,
+				"age":      NumberType,
+				"opted_in": BoolType,
 			},
 		},
-	}
+	}, map[string]attr.Value{
+		"a": StringValue("hello"),
+		"b": BoolValue(true),
+		"c": ListValueMust(StringType, []attr.Value{
+			StringValue("into"),
+			StringValue("the"),
+			StringUnknown(),
+			StringNull(),
+		}),
+		"d": ListValueMust(StringType, []attr.Value{
+			StringValue("it's"),
+			StringValue("getting"),
+			StringValue("hard"),
+			StringValue("to"),
+			StringValue("come"),
+			StringValue("up"),
+			StringValue("with"),
+			StringValue("test"),
+			StringValue("values"),
+		}),
+		"e": ListValueMust(BoolType, []attr.Value{
+			BoolValue(true),
+			BoolValue(false),
+			BoolValue(false),
+			BoolValue(true),
+		}),
+		"f": ListValueMust(ListType{
+			ElemType: StringType,
+		}, []attr.Value{
+			ListValueMust(StringType, []attr.Value{
+				StringValue("head"),
+				StringValue("empty"),
+			}),
+			ListValueMust(StringType, []attr.Value{
+				StringValue("no"),
+				StringValue("thoughts"),
+			}),
+		}),
+		"g": ObjectValueMust(map[string]attr.Type{
+			"dogs":  NumberType,
+			"cats":  NumberType,
+			"names": ListType{ElemType: StringType},
+		}, map[string]attr.Value{
+			"dogs": NumberValue(big.NewFloat(3)),
+			"cats": NumberValue(big.NewFloat(5)),
+			"names": ListValueMust(StringType, []attr.Value{
+				StringValue("Roxy"),
+				StringValue("Jpeg"),
+				StringValue("Kupo"),
+				StringValue("Clawde"),
+				StringValue("Yeti"),
+				StringValue("Abby"),
+				StringValue("Ellie"),
+				StringValue("Lexi"),
+			}),
+		}),
+		"h": ObjectValueMust(map[string]attr.Type{
+			"red":    StringType,
+			"blue":   ListType{ElemType: NumberType},
+			"green":  NumberType,
+			"yellow": NumberType,
+		}, map[string]attr.Value{
+			"red": StringValue("judge me not too harshly, future maintainers, this much random data is hard to come up with without getting weird."),
+			"blue": ListValueMust(NumberType, []attr.Value{
+				NumberValue(big.NewFloat(1)),
+				NumberValue(big.NewFloat(2)),
+				NumberValue(big.NewFloat(3)),
+			}),
+			"green":  NumberValue(big.NewFloat(123.456)),
+			"yellow": NumberValue(big.NewFloat(123)),
+		}),
+		"i": ObjectValueMust(map[string]attr.Type{
+			"name":     StringType,
+			"age":      NumberType,
+			"opted_in": BoolType,
+		}, map[string]attr.Value{
+			"name":     StringValue("J Doe"),
+			"age":      NumberValue(big.NewFloat(28)),
+			"opted_in": BoolValue(true),
+		}),
+	})
 	var target myStruct
 	diags := object.As(context.Background(), &target, ObjectAsOptions{})
 	if diags.HasError() {
@@ -540,88 +493,67 @@ func TestObjectAs_struct(t *testing.T) {
 	}
 	expected := myStruct{
 		A: "hello",
-		B: Bool{Value: true},
-		C: List{
-			ElemType: StringType,
-			Elems: []attr.Value{
-				String{Value: "into"},
-				String{Value: "the"},
-				String{Unknown: true},
-				String{Null: true},
-			},
-		},
+		B: BoolValue(true),
+		C: ListValueMust(StringType, []attr.Value{
+			StringValue("into"),
+			StringValue("the"),
+			StringUnknown(),
+			StringNull(),
+		}),
 		D: []string{"it's", "getting", "hard", "to", "come", "up", "with", "test", "values"},
 		E: []Bool{
-			{Value: true},
-			{Value: false},
-			{Value: false},
-			{Value: true},
+			BoolValue(true),
+			BoolValue(false),
+			BoolValue(false),
+			BoolValue(true),
 		},
 		F: []List{
-			{
-				ElemType: StringType,
-				Elems: []attr.Value{
-					String{Value: "head"},
-					String{Value: "empty"},
-				},
-			},
-			{
-				ElemType: StringType,
-				Elems: []attr.Value{
-					String{Value: "no"},
-					String{Value: "thoughts"},
-				},
-			},
-		},
-		G: Object{
-			AttrTypes: map[string]attr.Type{
-				"dogs":  NumberType,
-				"cats":  NumberType,
-				"names": ListType{ElemType: StringType},
-			},
-			Attrs: map[string]attr.Value{
-				"dogs": Number{Value: big.NewFloat(3)},
-				"cats": Number{Value: big.NewFloat(5)},
-				"names": List{
-					ElemType: StringType,
-					Elems: []attr.Value{
-						String{Value: "Roxy"},
-						String{Value: "Jpeg"},
-						String{Value: "Kupo"},
-						String{Value: "Clawde"},
-						String{Value: "Yeti"},
-						String{Value: "Abby"},
-						String{Value: "Ellie"},
-						String{Value: "Lexi"},
-					},
-				},
-			},
+			ListValueMust(StringType, []attr.Value{
+				StringValue("head"),
+				StringValue("empty"),
+			}),
+			ListValueMust(StringType, []attr.Value{
+				StringValue("no"),
+				StringValue("thoughts"),
+			}),
 		},
+		G: ObjectValueMust(map[string]attr.Type{
+			"dogs":  NumberType,
+			"cats":  NumberType,
+			"names": ListType{ElemType: StringType},
+		}, map[string]attr.Value{
+			"dogs": NumberValue(big.NewFloat(3)),
+			"cats": NumberValue(big.NewFloat(5)),
+			"names": ListValueMust(StringType, []attr.Value{
+				StringValue("Roxy"),
+				StringValue("Jpeg"),
+				StringValue("Kupo"),
+				StringValue("Clawde"),
+				StringValue("Yeti"),
+				StringValue("Abby"),
+				StringValue("Ellie"),
+				StringValue("Lexi"),
+			}),
+		}),
 		H: myEmbeddedStruct{
 			Red: "judge me not too harshly, future maintainers, this much random data is hard to come up with without getting weird.",
-			Blue: List{
-				ElemType: NumberType,
-				Elems: []attr.Value{
-					Number{Value: big.NewFloat(1)},
-					Number{Value: big.NewFloat(2)},
-					Number{Value: big.NewFloat(3)},
-				},
-			},
-			Green:  Number{Value: big.NewFloat(123.456)},
+			Blue: ListValueMust(NumberType, []attr.Value{
+				NumberValue(big.NewFloat(1)),
+				NumberValue(big.NewFloat(2)),
+				NumberValue(big.NewFloat(3)),
+			}),
+			Green:  NumberValue(big.NewFloat(123.456)),
 			Yellow: 123,
 		},
-		I: Object{
-			AttrTypes: map[string]attr.Type{
-				"name":     StringType,
-				"age":      NumberType,
-				"opted_in": BoolType,
-			},
-			Attrs: map[string]attr.Value{
-				"name":     String{Value: "J Doe"},
-				"age":      Number{Value: big.NewFloat(28)},
-				"opted_in": Bool{Value: true},
-			},
-		},
+		I: ObjectValueMust(map[string]attr.Type{
+			"name":     StringType,
+			"age":      NumberType,
+			"opted_in": BoolType,
+		}, map[string]attr.Value{
+			"name":     StringValue("J Doe"),
+			"age":      NumberValue(big.NewFloat(28)),
+			"opted_in": BoolValue(true),
+		}),
 	}
 	if diff := cmp.Diff(expected, target); diff != "" {
 		t.Errorf("Unexpected diff (+wanted, -got): %s", diff)
@@ -637,47 +569,35 @@ func TestObjectToTerraformValue(t *testing.T) {
 	}
 	tests := map[string]testCase{
 		"value": {
-			receiver: Object{
-				AttrTypes: map[string]attr.Type{
-					"a": ListType{ElemType: StringType},
-					"b": StringType,
-					"c": BoolType,
-					"d": NumberType,
-					"e": ObjectType{
-						AttrTypes: map[string]attr.Type{
-							"name": StringType,
-						},
-					},
-					"f": SetType{ElemType: StringType},
-				},
-				Attrs: map[string]attr.Value{
-					"a": List{
-						ElemType: StringType,
-						Elems: []attr.Value{
-							String{Value: "hello"},
-							String{Value: "world"},
-						},
-					},
-					"b": String{Value: "woohoo"},
-					"c": Bool{Value: true},
-					"d": Number{Value: big.NewFloat(1234)},
-					"e": Object{
-						AttrTypes: map[string]attr.Type{
-							"name": StringType,
-						},
-						Attrs: map[string]attr.Value{
-							"name": String{Value: "testing123"},
-						},
-					},
-					"f": Set{
-						ElemType: StringType,
-						Elems: []attr.Value{
-							String{Value: "hello"},
-							String{Value: "world"},
-						},
+			receiver: ObjectValueMust(map[string]attr.Type{
+				"a": ListType{ElemType: StringType},
+				"b": StringType,
+				"c": BoolType,
+				"d": NumberType,
+				"e": ObjectType{
+					AttrTypes: map[string]attr.Type{
+						"name": StringType,
 					},
 				},
-			},
+				"f": SetType{ElemType: StringType},
+			}, map[string]attr.Value{
+				"a": ListValueMust(StringType, []attr.Value{
+					StringValue("hello"),
+					StringValue("world"),
+				}),
+				"b": StringValue("woohoo"),
+				"c": BoolValue(true),
+				"d": NumberValue(big.NewFloat(1234)),
+				"e": ObjectValueMust(map[string]attr.Type{
+					"name": StringType,
+				}, map[string]attr.Value{
+					"name": StringValue("testing123"),
+				}),
+				"f": SetValueMust(StringType, []attr.Value{
+					StringValue("hello"),
+					StringValue("world"),
+				}),
+			}),
 			expected: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
 					"a": tftypes.List{ElementType: tftypes.String},
@@ -709,21 +629,18 @@ func TestObjectToTerraformValue(t *testing.T) {
 			}),
 		},
 		"unknown": {
-			receiver: Object{
-				AttrTypes: map[string]attr.Type{
-					"a": ListType{ElemType: StringType},
-					"b": StringType,
-					"c": BoolType,
-					"d": NumberType,
-					"e": ObjectType{
-						AttrTypes: map[string]attr.Type{
-							"name": StringType,
-						},
+			receiver: ObjectUnknown(map[string]attr.Type{
+				"a": ListType{ElemType: StringType},
+				"b": StringType,
+				"c": BoolType,
+				"d": NumberType,
+				"e": ObjectType{
+					AttrTypes: map[string]attr.Type{
+						"name": StringType,
 					},
-					"f": SetType{ElemType: StringType},
 				},
-				Unknown: true,
-			},
+				"f": SetType{ElemType: StringType},
+			}),
 			expected: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
 					"a": tftypes.List{ElementType: tftypes.String},
@@ -740,21 +657,18 @@ func TestObjectToTerraformValue(t *testing.T) {
 			}, tftypes.UnknownValue),
 		},
 		"null": {
-			receiver: Object{
-				AttrTypes: map[string]attr.Type{
-					"a": ListType{ElemType: StringType},
-					"b": StringType,
-					"c": BoolType,
-					"d": NumberType,
-					"e": ObjectType{
-						AttrTypes: map[string]attr.Type{
-							"name": StringType,
-						},
+			receiver: ObjectNull(map[string]attr.Type{
+				"a": ListType{ElemType: StringType},
+				"b": StringType,
+				"c": BoolType,
+				"d": NumberType,
+				"e": ObjectType{
+					AttrTypes: map[string]attr.Type{
+						"name": StringType,
 					},
-					"f": SetType{ElemType: StringType},
 				},
-				Null: true,
-			},
+				"f": SetType{ElemType: StringType},
+			}),
 			expected: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
 					"a": tftypes.List{ElementType: tftypes.String},
@@ -771,47 +685,35 @@ func TestObjectToTerraformValue(t *testing.T) {
 			}, nil),
 		},
 		"partial-unknown": {
-			receiver: Object{
-				AttrTypes: map[string]attr.Type{
-					"a": ListType{ElemType: StringType},
-					"b": StringType,
-					"c": BoolType,
-					"d": NumberType,
-					"e": ObjectType{
-						AttrTypes: map[string]attr.Type{
-							"name": StringType,
-						},
-					},
-					"f": SetType{ElemType: StringType},
-				},
-				Attrs: map[string]attr.Value{
-					"a": List{
-						ElemType: StringType,
-						Elems: []attr.Value{
-							String{Value: "hello"},
-							String{Value: "world"},
-						},
-					},
-					"b": String{Unknown: true},
-					"c": Bool{Value: true},
-					"d": Number{Value: big.NewFloat(1234)},
-					"e": Object{
-						AttrTypes: map[string]attr.Type{
-							"name": StringType,
-						},
-						Attrs: map[string]attr.Value{
-							"name": String{Value: "testing123"},
-						},
-					},
-					"f": Set{
-						ElemType: StringType,
-						Elems: []attr.Value{
-							String{Value: "hello"},
-							String{Value: "world"},
-						},
+			receiver: ObjectValueMust(map[string]attr.Type{
+				"a": ListType{ElemType: StringType},
+				"b": StringType,
+				"c": BoolType,
+				"d": NumberType,
+				"e": ObjectType{
+					AttrTypes: map[string]attr.Type{
+						"name": StringType,
 					},
 				},
-			},
+				"f": SetType{ElemType: StringType},
+			}, map[string]attr.Value{
+				"a": ListValueMust(StringType, []attr.Value{
+					StringValue("hello"),
+					StringValue("world"),
+				}),
+				"b": StringUnknown(),
+				"c": BoolValue(true),
+				"d": NumberValue(big.NewFloat(1234)),
+				"e": ObjectValueMust(map[string]attr.Type{
+					"name": StringType,
+				}, map[string]attr.Value{
+					"name": StringValue("testing123"),
+				}),
+				"f": SetValueMust(StringType, []attr.Value{
+					StringValue("hello"),
+					StringValue("world"),
+				}),
+			}),
 			expected: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
 					"a": tftypes.List{ElementType: tftypes.String},
@@ -847,47 +749,35 @@ func TestObjectToTerraformValue(t *testing.T) {
 			}),
 		},
 		"partial-null": {
-			receiver: Object{
-				AttrTypes: map[string]attr.Type{
-					"a": ListType{ElemType: StringType},
-					"b": StringType,
-					"c": BoolType,
-					"d": NumberType,
-					"e": ObjectType{
-						AttrTypes: map[string]attr.Type{
-							"name": StringType,
-						},
-					},
-					"f": SetType{ElemType: StringType},
-				},
-				Attrs: map[string]attr.Value{
-					"a": List{
-						ElemType: StringType,
-						Elems: []attr.Value{
-							String{Value: "hello"},
-							String{Value: "world"},
-						},
-					},
-					"b": String{Null: true},
-					"c": Bool{Value: true},
-					"d": Number{Value: big.NewFloat(1234)},
-					"e": Object{
-						AttrTypes: map[string]attr.Type{
-							"name": StringType,
-						},
-						Attrs: map[string]attr.Value{
-							"name": String{Value: "testing123"},
-						},
-					},
-					"f": Set{
-						ElemType: StringType,
-						Elems: []attr.Value{
-							String{Value: "hello"},
-							String{Value: "world"},
-						},
+			receiver: ObjectValueMust(map[string]attr.Type{
+				"a": ListType{ElemType: StringType},
+				"b": StringType,
+				"c": BoolType,
+				"d": NumberType,
+				"e": ObjectType{
+					AttrTypes: map[string]attr.Type{
+						"name": StringType,
 					},
 				},
-			},
+				"f": SetType{ElemType: StringType},
+			}, map[string]attr.Value{
+				"a": ListValueMust(StringType, []attr.Value{
+					StringValue("hello"),
+					StringValue("world"),
+				}),
+				"b": StringNull(),
+				"c": BoolValue(true),
+				"d": NumberValue(big.NewFloat(1234)),
+				"e": ObjectValueMust(map[string]attr.Type{
+					"name": StringType,
+				}, map[string]attr.Value{
+					"name": StringValue("testing123"),
+				}),
+				"f": SetValueMust(StringType, []attr.Value{
+					StringValue("hello"),
+					StringValue("world"),
+				}),
+			}),
 			expected: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
 					"a": tftypes.List{ElementType: tftypes.String},
@@ -923,47 +813,35 @@ func TestObjectToTerraformValue(t *testing.T) {
 			}),
 		},
 		"deep-partial-unknown": {
-			receiver: Object{
-				AttrTypes: map[string]attr.Type{
-					"a": ListType{ElemType: StringType},
-					"b": StringType,
-					"c": BoolType,
-					"d": NumberType,
-					"e": ObjectType{
-						AttrTypes: map[string]attr.Type{
-							"name": StringType,
-						},
-					},
-					"f": SetType{ElemType: StringType},
-				},
-				Attrs: map[string]attr.Value{
-					"a": List{
-						ElemType: StringType,
-						Elems: []attr.Value{
-							String{Value: "hello"},
-							String{Value: "world"},
-						},
-					},
-					"b": String{Value: "woohoo"},
-					"c": Bool{Value: true},
-					"d": Number{Value: big.NewFloat(1234)},
-					"e": Object{
-						AttrTypes: map[string]attr.Type{
-							"name": StringType,
-						},
-						Attrs: map[string]attr.Value{
-							"name": String{Unknown: true},
-						},
-					},
-					"f": Set{
-						ElemType: StringType,
-						Elems: []attr.Value{
-							String{Value: "hello"},
-							String{Value: "world"},
-						},
+			receiver: ObjectValueMust(map[string]attr.Type{
+				"a": ListType{ElemType: StringType},
+				"b": StringType,
+				"c": BoolType,
+				"d": NumberType,
+				"e": ObjectType{
+					AttrTypes: map[string]attr.Type{
+						"name": StringType,
 					},
 				},
-			},
+				"f": SetType{ElemType: StringType},
+			}, map[string]attr.Value{
+				"a": ListValueMust(StringType, []attr.Value{
+					StringValue("hello"),
+					StringValue("world"),
+				}),
+				"b": StringValue("woohoo"),
+				"c": BoolValue(true),
+				"d": NumberValue(big.NewFloat(1234)),
+				"e": ObjectValueMust(map[string]attr.Type{
+					"name": StringType,
+				}, map[string]attr.Value{
+					"name": StringUnknown(),
+				}),
+				"f": SetValueMust(StringType, []attr.Value{
+					StringValue("hello"),
+					StringValue("world"),
+				}),
+			}),
 			expected: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
 					"a": tftypes.List{ElementType: tftypes.String},
@@ -999,47 +877,35 @@ func TestObjectToTerraformValue(t *testing.T) {
 			}),
 		},
 		"deep-partial-null": {
-			receiver: Object{
-				AttrTypes: map[string]attr.Type{
-					"a": ListType{ElemType: StringType},
-					"b": StringType,
-					"c": BoolType,
-					"d": NumberType,
-					"e": ObjectType{
-						AttrTypes: map[string]attr.Type{
-							"name": StringType,
-						},
-					},
-					"f": SetType{ElemType: StringType},
-				},
-				Attrs: map[string]attr.Value{
-					"a": List{
-						ElemType: StringType,
-						Elems: []attr.Value{
-							String{Value: "hello"},
-							String{Value: "world"},
-						},
-					},
-					"b": String{Value: "woohoo"},
-					"c": Bool{Value: true},
-					"d": Number{Value: big.NewFloat(1234)},
-					"e": Object{
-						AttrTypes: map[string]attr.Type{
-							"name": StringType,
-						},
-						Attrs: map[string]attr.Value{
-							"name": String{Null: true},
-						},
-					},
-					"f": Set{
-						ElemType: StringType,
-						Elems: []attr.Value{
-							String{Value: "hello"},
-							String{Value: "world"},
-						},
+			receiver: ObjectValueMust(map[string]attr.Type{
+				"a": ListType{ElemType: StringType},
+				"b": StringType,
+				"c": BoolType,
+				"d": NumberType,
+				"e": ObjectType{
+					AttrTypes: map[string]attr.Type{
+						"name": StringType,
 					},
 				},
-			},
+				"f": SetType{ElemType: StringType},
+			}, map[string]attr.Value{
+				"a": ListValueMust(StringType, []attr.Value{
+					StringValue("hello"),
+					StringValue("world"),
+				}),
+				"b": StringValue("woohoo"),
+				"c": BoolValue(true),
+				"d": NumberValue(big.NewFloat(1234)),
+				"e": ObjectValueMust(map[string]attr.Type{
+					"name": StringType,
+				}, map[string]attr.Value{
+					"name": StringNull(),
+				}),
+				"f": SetValueMust(StringType, []attr.Value{
+					StringValue("hello"),
+					StringValue("world"),
+				}),
+			}),
 			expected: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
 					"a": tftypes.List{ElementType: tftypes.String},
@@ -1075,37 +941,9 @@ func TestObjectToTerraformValue(t *testing.T) {
 			}),
 		},
 		"no-attr-types": {
-			receiver: Object{
-				Attrs: map[string]attr.Value{
-					"a": List{
-						ElemType: StringType,
-						Elems: []attr.Value{
-							String{Value: "hello"},
-							String{Value: "world"},
-						},
-					},
-					"b": String{Value: "woohoo"},
-					"c": Bool{Value: true},
-					"d": Number{Value: big.NewFloat(1234)},
-					"e": Object{
-						AttrTypes: map[string]attr.Type{
-							"name": StringType,
-						},
-						Attrs: map[string]attr.Value{
-							"name": String{Value: "testing123"},
-						},
-					},
-					"f": Set{
-						ElemType: StringType,
-						Elems: []attr.Value{
-							String{Value: "hello"},
-							String{Value: "world"},
-						},
-					},
-				},
-			},
+			receiver:    Object{},
 			expected:    tftypes.Value{},
-			expectedErr: "cannot convert Object to tftypes.Value if AttrTypes field is not set",
+			expectedErr: "cannot convert Object to tftypes.Value if attribute types are not set",
 		},
 	}
 
@@ -1149,348 +987,250 @@ func TestObjectEqual(t *testing.T) {
 	}
 	tests := map[string]testCase{
 		"equal": {
-			receiver: Object{
-				AttrTypes: map[string]attr.Type{
-					"string": StringType,
-					"bool":   BoolType,
-					"number": NumberType,
-				},
-				Attrs: map[string]attr.Value{
-					"string": String{Value: "hello"},
-					"bool":   Bool{Value: true},
-					"number": Number{Value: big.NewFloat(123)},
-				},
-			},
-			arg: Object{
-				AttrTypes: map[string]attr.Type{
-					"string": StringType,
-					"bool":   BoolType,
-					"number": NumberType,
-				},
-				Attrs: map[string]attr.Value{
-					"string": String{Value: "hello"},
-					"bool":   Bool{Value: true},
-					"number": Number{Value: big.NewFloat(123)},
-				},
-			},
+			receiver: ObjectValueMust(map[string]attr.Type{
+				"string": StringType,
+				"bool":   BoolType,
+				"number": NumberType,
+			}, map[string]attr.Value{
+				"string": StringValue("hello"),
+				"bool":   BoolValue(true),
+				"number": NumberValue(big.NewFloat(123)),
+			}),
+			arg: ObjectValueMust(map[string]attr.Type{
+				"string": StringType,
+				"bool":   BoolType,
+				"number": NumberType,
+			}, map[string]attr.Value{
+				"string": StringValue("hello"),
+				"bool":   BoolValue(true),
+				"number": NumberValue(big.NewFloat(123)),
+			}),
 			expected: true,
 		},
 		"diff": {
-			receiver: Object{
-				AttrTypes: map[string]attr.Type{
-					"string": StringType,
-					"bool":   BoolType,
-					"number": NumberType,
-				},
-				Attrs: map[string]attr.Value{
-					"string": String{Value: "hello"},
-					"bool":   Bool{Value: true},
-					"number": Number{Value: big.NewFloat(123)},
-				},
-			},
-			arg: Object{
-				AttrTypes: map[string]attr.Type{
-					"string": StringType,
-					"bool":   BoolType,
-					"number": NumberType,
-				},
-				Attrs: map[string]attr.Value{
-					"string": String{Value: "world"},
-					"bool":   Bool{Value: true},
-					"number": Number{Value: big.NewFloat(123)},
-				},
-			},
+			receiver: ObjectValueMust(map[string]attr.Type{
+				"string": StringType,
+				"bool":   BoolType,
+				"number": NumberType,
+			}, map[string]attr.Value{
+				"string": StringValue("hello"),
+				"bool":   BoolValue(true),
+				"number": NumberValue(big.NewFloat(123)),
+			}),
+			arg: ObjectValueMust(map[string]attr.Type{
+				"string": StringType,
+				"bool":   BoolType,
+				"number": NumberType,
+			}, map[string]attr.Value{
+				"string": StringValue("world"),
+				"bool":   BoolValue(true),
+				"number": NumberValue(big.NewFloat(123)),
+			}),
 			expected: false,
 		},
 		"equal-complex": {
-			receiver: Object{
-				AttrTypes: map[string]attr.Type{
-					"string": StringType,
-					"list":   ListType{ElemType: StringType},
-				},
-				Attrs: map[string]attr.Value{
-					"string": String{Value: "hello"},
-					"list": List{ElemType: StringType, Elems: []attr.Value{
-						String{Value: "a"},
-						String{Value: "b"},
-						String{Value: "c"},
-					}},
-				},
-			},
-			arg: Object{
-				AttrTypes: map[string]attr.Type{
-					"string": StringType,
-					"list":   ListType{ElemType: StringType},
-				},
-				Attrs: map[string]attr.Value{
-					"string": String{Value: "hello"},
-					"list": List{ElemType: StringType, Elems: []attr.Value{
-						String{Value: "a"},
-						String{Value: "b"},
-						String{Value: "c"},
-					}},
-				},
-			},
+			receiver: ObjectValueMust(map[string]attr.Type{
+				"string": StringType,
+				"list":   ListType{ElemType: StringType},
+			}, map[string]attr.Value{
+				"string": StringValue("hello"),
+				"list": ListValueMust(StringType, []attr.Value{
+					StringValue("a"),
+					StringValue("b"),
+					StringValue("c"),
+				}),
+			}),
+			arg: ObjectValueMust(map[string]attr.Type{
+				"string": StringType,
+				"list":   ListType{ElemType: StringType},
+			}, map[string]attr.Value{
+				"string": StringValue("hello"),
+				"list": ListValueMust(StringType, []attr.Value{
+					StringValue("a"),
+					StringValue("b"),
+					StringValue("c"),
+				}),
+			}),
 			expected: true,
 		},
 		"diff-complex": {
-			receiver: Object{
-				AttrTypes: map[string]attr.Type{
-					"string": StringType,
-					"list":   ListType{ElemType: StringType},
-				},
-				Attrs: map[string]attr.Value{
-					"string": String{Value: "hello"},
-					"list": List{ElemType: StringType, Elems: []attr.Value{
-						String{Value: "a"},
-						String{Value: "b"},
-						String{Value: "c"},
-					}},
-				},
-			},
-			arg: Object{
-				AttrTypes: map[string]attr.Type{
-					"string": StringType,
-					"list":   ListType{ElemType: StringType},
-				},
-				Attrs: map[string]attr.Value{
-					"string": String{Value: "hello"},
-					"list": List{ElemType: StringType, Elems: []attr.Value{
-						String{Value: "a"},
-						String{Value: "b"},
-						String{Value: "c"},
-						String{Value: "d"},
-					}},
-				},
-			},
+			receiver: ObjectValueMust(map[string]attr.Type{
+				"string": StringType,
+				"list":   ListType{ElemType: StringType},
+			}, map[string]attr.Value{
+				"string": StringValue("hello"),
+				"list": ListValueMust(StringType, []attr.Value{
+					StringValue("a"),
+					StringValue("b"),
+					StringValue("c"),
+				}),
+			}),
+			arg: ObjectValueMust(map[string]attr.Type{
+				"string": StringType,
+				"list":   ListType{ElemType: StringType},
+			}, map[string]attr.Value{
+				"string": StringValue("hello"),
+				"list": ListValueMust(StringType, []attr.Value{
+					StringValue("a"),
+					StringValue("b"),
+					StringValue("c"),
+					StringValue("d"),
+				}),
+			}),
 			expected: false,
 		},
 		"both-unknown": {
-			receiver: Object{
-				AttrTypes: map[string]attr.Type{
-					"string": StringType,
-					"bool":   BoolType,
-					"number": NumberType,
-				},
-				Unknown: true,
-			},
-			arg: Object{
-				AttrTypes: map[string]attr.Type{
-					"string": StringType,
-					"bool":   BoolType,
-					"number": NumberType,
-				},
-				Unknown: true,
-			},
+			receiver: ObjectUnknown(map[string]attr.Type{
+				"string": StringType,
+				"bool":   BoolType,
+				"number": NumberType,
+			}),
+			arg: ObjectUnknown(map[string]attr.Type{
+				"string": StringType,
+				"bool":   BoolType,
+				"number": NumberType,
+			}),
 			expected: true,
 		},
 		"unknown": {
-			receiver: Object{
-				AttrTypes: map[string]attr.Type{
-					"string": StringType,
-					"bool":   BoolType,
-					"number": NumberType,
-				},
-				Attrs: map[string]attr.Value{
-					"string": String{Value: "hello"},
-					"bool":   Bool{Value: true},
-					"number": Number{Value: big.NewFloat(123)},
-				},
-			},
-			arg: Object{
-				AttrTypes: map[string]attr.Type{
-					"string": StringType,
-					"bool":   BoolType,
-					"number": NumberType,
-				},
-				Unknown: true,
-			},
+			receiver: ObjectValueMust(map[string]attr.Type{
+				"string": StringType,
+				"bool":   BoolType,
+				"number": NumberType,
+			}, map[string]attr.Value{
+				"string": StringValue("hello"),
+				"bool":   BoolValue(true),
+				"number": NumberValue(big.NewFloat(123)),
+			}),
+			arg: ObjectUnknown(map[string]attr.Type{
+				"string": StringType,
+				"bool":   BoolType,
+				"number": NumberType,
+			}),
 			expected: false,
 		},
 		"both-null": {
-			receiver: Object{
-				AttrTypes: map[string]attr.Type{
-					"string": StringType,
-					"bool":   BoolType,
-					"number": NumberType,
-				},
-				Null: true,
-			},
-			arg: Object{
-				AttrTypes: map[string]attr.Type{
-					"string": StringType,
-					"bool":   BoolType,
-					"number": NumberType,
-				},
-				Null: true,
-			},
+			receiver: ObjectNull(map[string]attr.Type{
+				"string": StringType,
+				"bool":   BoolType,
+				"number": NumberType,
+			}),
+			arg: ObjectNull(map[string]attr.Type{
+				"string": StringType,
+				"bool":   BoolType,
+				"number": NumberType,
+			}),
 			expected: true,
 		},
 		"null": {
-			receiver: Object{
-				AttrTypes: map[string]attr.Type{
-					"string": StringType,
-					"bool":   BoolType,
-					"number": NumberType,
-				},
-				Attrs: map[string]attr.Value{
-					"string": String{Value: "hello"},
-					"bool":   Bool{Value: true},
-					"number": Number{Value: big.NewFloat(123)},
-				},
-			},
-			arg: Object{
-				AttrTypes: map[string]attr.Type{
-					"string": StringType,
-					"bool":   BoolType,
-					"number": NumberType,
-				},
-				Null: true,
-			},
+			receiver: ObjectValueMust(map[string]attr.Type{
+				"string": StringType,
+				"bool":   BoolType,
+				"number": NumberType,
+			}, map[string]attr.Value{
+				"string": StringValue("hello"),
+				"bool":   BoolValue(true),
+				"number": NumberValue(big.NewFloat(123)),
+			}),
+			arg: ObjectNull(map[string]attr.Type{
+				"string": StringType,
+				"bool":   BoolType,
+				"number": NumberType,
+			}),
 			expected: false,
 		},
 		"wrong-type": {
-			receiver: Object{
-				AttrTypes: map[string]attr.Type{
-					"string": StringType,
-					"bool":   BoolType,
-					"number": NumberType,
-				},
-				Attrs: map[string]attr.Value{
-					"string": String{Value: "hello"},
-					"bool":   Bool{Value: true},
-					"number": Number{Value: big.NewFloat(123)},
-				},
-			},
-			arg:      String{Value: "whoops"},
+			receiver: ObjectValueMust(map[string]attr.Type{
+				"string": StringType,
+				"bool":   BoolType,
+				"number": NumberType,
+			}, map[string]attr.Value{
+				"string": StringValue("hello"),
+				"bool":   BoolValue(true),
+				"number": NumberValue(big.NewFloat(123)),
+			}),
+			arg:      StringValue("whoops"),
 			expected: false,
 		},
 		"wrong-type-complex": {
-			receiver: Object{
-				AttrTypes: map[string]attr.Type{
-					"string": StringType,
-					"list":   ListType{ElemType: StringType},
-				},
-				Attrs: map[string]attr.Value{
-					"string": String{Value: "hello"},
-					"list": List{ElemType: StringType, Elems: []attr.Value{
-						String{Value: "a"},
-						String{Value: "b"},
-						String{Value: "c"},
-					}},
-				},
-			},
-			arg: Object{
-				AttrTypes: map[string]attr.Type{
-					"string": StringType,
-					"list":   ListType{ElemType: StringType},
-				},
-				Attrs: map[string]attr.Value{
-					"string": String{Value: "hello"},
-					"list": List{ElemType: BoolType, Elems: []attr.Value{
-						Bool{Value: true},
-						Bool{Value: false},
-					}},
-				},
-			},
+			receiver: ObjectValueMust(map[string]attr.Type{
+				"string": StringType,
+				"list":   ListType{ElemType: StringType},
+			}, map[string]attr.Value{
+				"string": StringValue("hello"),
+				"list": ListValueMust(StringType, []attr.Value{
+					StringValue("a"),
+					StringValue("b"),
+					StringValue("c"),
+				}),
+			}),
+			arg: ObjectValueMust(map[string]attr.Type{
+				"string": StringType,
+				"list":   ListType{ElemType: BoolType},
+			}, map[string]attr.Value{
+				"string": StringValue("hello"),
+				"list": ListValueMust(BoolType, []attr.Value{
+					BoolValue(true),
+					BoolValue(false),
+				}),
+			}),
 			expected: false,
 		},
 		"diff-attribute-types": {
-			receiver: Object{
-				AttrTypes: map[string]attr.Type{
-					"string": StringType,
-				},
-				Attrs: map[string]attr.Value{
-					"string": String{Value: "hello"},
-				},
-			},
-			arg: Object{
-				AttrTypes: map[string]attr.Type{
-					"number": NumberType,
-				},
-				Attrs: map[string]attr.Value{
-					"number": Number{Value: big.NewFloat(123)},
-				},
-			},
+			receiver: ObjectValueMust(map[string]attr.Type{
+				"string": StringType,
+			}, map[string]attr.Value{
+				"string": StringValue("hello"),
+			}),
+			arg: ObjectValueMust(map[string]attr.Type{
+				"number": NumberType,
+			}, map[string]attr.Value{
+				"number": NumberValue(big.NewFloat(123)),
+			}),
 			expected: false,
 		},
 		"diff-attribute-types-count": {
-			receiver: Object{
-				AttrTypes: map[string]attr.Type{
-					"string": StringType,
-				},
-				Attrs: map[string]attr.Value{
-					"string": String{Value: "hello"},
-				},
-			},
-			arg: Object{
-				AttrTypes: map[string]attr.Type{
-					"string": StringType,
-					"list":   ListType{ElemType: StringType},
-				},
-				Attrs: map[string]attr.Value{
-					"string": String{Value: "hello"},
-					"list": List{ElemType: BoolType, Elems: []attr.Value{
-						Bool{Value: true},
-						Bool{Value: false},
-					}},
-				},
-			},
+			receiver: ObjectValueMust(map[string]attr.Type{
+				"string": StringType,
+			}, map[string]attr.Value{
+				"string": StringValue("hello"),
+			}),
+			arg: ObjectValueMust(map[string]attr.Type{
+				"string": StringType,
+				"list":   ListType{ElemType: BoolType},
+			}, map[string]attr.Value{
+				"string": StringValue("hello"),
+				"list": ListValueMust(BoolType, []attr.Value{
+					BoolValue(true),
+					BoolValue(false),
+				}),
+			}),
 			expected: false,
 		},
 		"diff-attribute-types-value": {
-			receiver: Object{
-				AttrTypes: map[string]attr.Type{
-					"string": StringType,
-				},
-				Attrs: map[string]attr.Value{
-					"string": String{Value: "hello"},
-				},
-			},
-			arg: Object{
-				AttrTypes: map[string]attr.Type{
-					"string": NumberType,
-				},
-				Attrs: map[string]attr.Value{
-					"string": Number{Value: big.NewFloat(123)},
-				},
-			},
-			expected: false,
-		},
-		"diff-attribute-count": {
-			receiver: Object{
-				AttrTypes: map[string]attr.Type{
-					"string": StringType,
-				},
-				Attrs: map[string]attr.Value{
-					"string": String{Value: "hello"},
-				},
-			},
-			arg: Object{
-				AttrTypes: map[string]attr.Type{
-					"string": StringType,
-				},
-				Attrs: map[string]attr.Value{},
-			},
+			receiver: ObjectValueMust(map[string]attr.Type{
+				"string": StringType,
+			}, map[string]attr.Value{
+				"string": StringValue("hello"),
+			}),
+			arg: ObjectValueMust(map[string]attr.Type{
+				"string": NumberType,
+			}, map[string]attr.Value{
+				"string": NumberValue(big.NewFloat(123)),
+			}),
 			expected: false,
 		},
 		"diff-attribute-names": {
-			receiver: Object{
-				AttrTypes: map[string]attr.Type{
-					"string": StringType,
-				},
-				Attrs: map[string]attr.Value{
-					"string": String{Value: "hello"},
-				},
-			},
-			arg: Object{
-				AttrTypes: map[string]attr.Type{
-					"string": StringType,
-				},
-				Attrs: map[string]attr.Value{
-					"strng": String{Value: "hello"},
-				},
-			},
+			receiver: ObjectValueMust(map[string]attr.Type{
+				"string": StringType,
+			}, map[string]attr.Value{
+				"string": StringValue("hello"),
+			}),
+			arg: ObjectValueMust(map[string]attr.Type{
+				"strng": StringType,
+			}, map[string]attr.Value{
+				"strng": StringValue("hello"),
+			}),
 			expected: false,
 		},
 	}
@@ -1517,78 +1257,76 @@ func TestObjectString(t *testing.T) {
 	}
 	tests := map[string]testCase{
 		"simple": {
-			input: Object{
-				AttrTypes: map[string]attr.Type{
-					"alpha": StringType,
-					"beta":  Int64Type,
-					"gamma": Float64Type,
-					"sigma": NumberType,
-					"theta": BoolType,
-				},
-				Attrs: map[string]attr.Value{
-					"alpha": String{Value: "hello"},
-					"beta":  Int64{Value: 98719827987189},
-					"gamma": Float64{Value: -9876.782378},
-					"sigma": Number{Unknown: true},
-					"theta": Bool{Null: true},
-				},
-			},
+			input: ObjectValueMust(map[string]attr.Type{
+				"alpha": StringType,
+				"beta":  Int64Type,
+				"gamma": Float64Type,
+				"sigma": NumberType,
+				"theta": BoolType,
+			}, map[string]attr.Value{
+				"alpha": StringValue("hello"),
+				"beta":  Int64Value(98719827987189),
+				"gamma": Float64Value(-9876.782378),
+				"sigma": NumberUnknown(),
+				"theta": BoolNull(),
+			}),
 			expectation: `{"alpha":"hello","beta":98719827987189,"gamma":-9876.782378,"sigma":<unknown>,"theta":<null>}`,
 		},
 		"object-of-objects": {
-			input: Object{
-				AttrTypes: map[string]attr.Type{
-					"alpha": ObjectType{
-						AttrTypes: map[string]attr.Type{
-							"one":   StringType,
-							"two":   BoolType,
-							"three": NumberType,
-						},
+			input: ObjectValueMust(map[string]attr.Type{
+				"alpha": ObjectType{
+					AttrTypes: map[string]attr.Type{
+						"one":   StringType,
+						"two":   BoolType,
+						"three": NumberType,
 					},
-					"beta": ObjectType{
-						AttrTypes: map[string]attr.Type{
-							"uno": Int64Type,
-							"due": BoolType,
-							"tre": StringType,
-						},
-					},
-					"gamma": Float64Type,
-					"sigma": NumberType,
-					"theta": BoolType,
 				},
-				Attrs: map[string]attr.Value{
-					"alpha": Object{
-						Attrs: map[string]attr.Value{
-							"one":   String{Value: "1"},
-							"two":   Bool{Value: true},
-							"three": Number{Value: big.NewFloat(0.3)},
-						},
+				"beta": ObjectType{
+					AttrTypes: map[string]attr.Type{
+						"uno": Int64Type,
+						"due": BoolType,
+						"tre": StringType,
 					},
-					"beta": Object{
-						Attrs: map[string]attr.Value{
-							"uno": Int64{Value: 1},
-							"due": Bool{Value: false},
-							"tre": String{Value: "3"},
-						},
-					},
-					"gamma": Float64{Value: -9876.782378},
-					"sigma": Number{Unknown: true},
-					"theta": Bool{Null: true},
 				},
-			},
+				"gamma": Float64Type,
+				"sigma": NumberType,
+				"theta": BoolType,
+			}, map[string]attr.Value{
+				"alpha": ObjectValueMust(map[string]attr.Type{
+					"one":   StringType,
+					"two":   BoolType,
+					"three": NumberType,
+				}, map[string]attr.Value{
+					"one":   StringValue("1"),
+					"two":   BoolValue(true),
+					"three": NumberValue(big.NewFloat(0.3)),
+				}),
+				"beta": ObjectValueMust(map[string]attr.Type{
+					"uno": Int64Type,
+					"due": BoolType,
+					"tre": StringType,
+				}, map[string]attr.Value{
+					"uno": Int64Value(1),
+					"due": BoolValue(false),
+					"tre": StringValue("3"),
+				}),
+				"gamma": Float64Value(-9876.782378),
+				"sigma": NumberUnknown(),
+				"theta": BoolNull(),
+			}),
 			expectation: `{"alpha":{"one":"1","three":0.3,"two":true},"beta":{"due":false,"tre":"3","uno":1},"gamma":-9876.782378,"sigma":<unknown>,"theta":<null>}`,
 		},
 		"unknown": {
-			input:       Object{Unknown: true},
+			input:       ObjectUnknown(map[string]attr.Type{"test_attr": StringType}),
 			expectation: "<unknown>",
 		},
 		"null": {
-			input:       Object{Null: true},
+			input:       ObjectNull(map[string]attr.Type{"test_attr": StringType}),
 			expectation: "<null>",
 		},
-		"default-empty": {
+		"zero-value": {
 			input:       Object{},
-			expectation: "{}",
+			expectation: "<null>",
 		},
 	}
 
@@ -1604,3 +1342,183 @@ func TestObjectString(t *testing.T) {
 		})
 	}
 }
+
+func TestObjectValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attributeTypes map[string]attr.Type
+		attributes     map[string]attr.Value
+		expected       Object
+		expectedDiags  diag.Diagnostics
+	}{
+		"valid-no-attributes": {
+			attributeTypes: map[string]attr.Type{},
+			attributes:     map[string]attr.Value{},
+			expected:       ObjectValueMust(map[string]attr.Type{}, map[string]attr.Value{}),
+		},
+		"valid-attributes": {
+			attributeTypes: map[string]attr.Type{
+				"null":    StringType,
+				"unknown": StringType,
+				"known":   StringType,
+			},
+			attributes: map[string]attr.Value{
+				"null":    StringNull(),
+				"unknown": StringUnknown(),
+				"known":   StringValue("test"),
+			},
+			expected: ObjectValueMust(
+				map[string]attr.Type{
+					"null":    StringType,
+					"unknown": StringType,
+					"known":   StringType,
+				},
+				map[string]attr.Value{
+					"null":    StringNull(),
+					"unknown": StringUnknown(),
+					"known":   StringValue("test"),
+				},
+			),
+		},
+		"invalid-attribute-value": {
+			attributeTypes: map[string]attr.Type{
+				"string": StringType,
+				"bool":   BoolType,
+			},
+			attributes: map[string]attr.Value{
+				"string": StringValue("test"),
+				"bool":   StringValue("test"),
+			},
+			expected: ObjectUnknown(map[string]attr.Type{
+				"string": StringType,
+				"bool":   BoolType,
+			}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Object Attribute Type",
+					"While creating an Object value, an invalid attribute value was detected. "+
+						"An Object must use a matching attribute type for the value. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"Object Attribute Name (bool) Expected Type: types.BoolType\n"+
+						"Object Attribute Name (bool) Given Type: types.StringType",
+				),
+			},
+		},
+		"invalid-extra-attribute": {
+			attributeTypes: map[string]attr.Type{
+				"string": StringType,
+			},
+			attributes: map[string]attr.Value{
+				"string": StringValue("test"),
+				"bool":   BoolValue(true),
+			},
+			expected: ObjectUnknown(map[string]attr.Type{
+				"string": StringType,
+			}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Extra Object Attribute Value",
+					"While creating an Object value, an extra attribute value was detected. "+
+						"An Object must not contain values beyond the expected attribute types. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"Extra Object Attribute Name (bool) Value Type: types.BoolType",
+				),
+			},
+		},
+		"invalid-missing-attribute": {
+			attributeTypes: map[string]attr.Type{
+				"string": StringType,
+				"bool":   BoolType,
+			},
+			attributes: map[string]attr.Value{
+				"string": StringValue("test"),
+			},
+			expected: ObjectUnknown(map[string]attr.Type{
+				"string": StringType,
+				"bool":   BoolType,
+			}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Missing Object Attribute Value",
+					"While creating an Object value, a missing attribute value was detected. "+
+						"An Object must contain values for all attributes, even if null or unknown. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"Object Attribute Name (bool) Expected Type: types.BoolType",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := ObjectValue(testCase.attributeTypes, testCase.attributes)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestObjectValueFrom(t *testing.T) {
+	t.Parallel()
+
+	type testStruct struct {
+		Bool   bool   `tfsdk:"bool"`
+		String string `tfsdk:"string"`
+	}
+
+	testAttributeTypes := map[string]attr.Type{
+		"bool":   BoolType,
+		"string": StringType,
+	}
+
+	testCases := map[string]struct {
+		attributeTypes map[string]attr.Type
+		attributes     interface{}
+		expected       Object
+		expectedDiags  diag.Diagnostics
+	}{
+		"valid-struct": {
+			attributeTypes: testAttributeTypes,
+			attributes: testStruct{
+				Bool:   true,
+				String: "test",
+			},
+			expected: ObjectValueMust(
+				testAttributeTypes,
+				map[string]attr.Value{
+					"bool":   BoolValue(true),
+					"string": StringValue("test"),
+				},
+			),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := ObjectValueFrom(context.Background(), testCase.attributeTypes, testCase.attributes)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
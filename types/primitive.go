@@ -28,6 +28,15 @@ const (
 
 	// Float64Type represents a 64-bit floating point.
 	Float64Type
+
+	// Int32Type represents a 32-bit integer.
+	Int32Type
+
+	// Float32Type represents a 32-bit floating point.
+	Float32Type
+
+	// Uint64Type represents an unsigned 64-bit integer.
+	Uint64Type
 )
 
 var (
@@ -36,6 +45,9 @@ var (
 	_ attr.Type              = BoolType
 	_ xattr.TypeWithValidate = Int64Type
 	_ xattr.TypeWithValidate = Float64Type
+	_ xattr.TypeWithValidate = Int32Type
+	_ xattr.TypeWithValidate = Float32Type
+	_ xattr.TypeWithValidate = Uint64Type
 )
 
 func (p primitive) String() string {
@@ -50,6 +62,12 @@ func (p primitive) String() string {
 		return "types.Int64Type"
 	case Float64Type:
 		return "types.Float64Type"
+	case Int32Type:
+		return "types.Int32Type"
+	case Float32Type:
+		return "types.Float32Type"
+	case Uint64Type:
+		return "types.Uint64Type"
 	default:
 		return fmt.Sprintf("unknown primitive %d", p)
 	}
@@ -63,7 +81,7 @@ func (p primitive) TerraformType(_ context.Context) tftypes.Type {
 	switch p {
 	case StringType:
 		return tftypes.String
-	case NumberType, Int64Type, Float64Type:
+	case NumberType, Int64Type, Float64Type, Int32Type, Float32Type, Uint64Type:
 		return tftypes.Number
 	case BoolType:
 		return tftypes.Bool
@@ -87,6 +105,12 @@ func (p primitive) ValueFromTerraform(ctx context.Context, in tftypes.Value) (at
 		return int64ValueFromTerraform(ctx, in)
 	case Float64Type:
 		return float64ValueFromTerraform(ctx, in)
+	case Int32Type:
+		return int32ValueFromTerraform(ctx, in)
+	case Float32Type:
+		return float32ValueFromTerraform(ctx, in)
+	case Uint64Type:
+		return uint64ValueFromTerraform(ctx, in)
 	default:
 		panic(fmt.Sprintf("unknown primitive %d", p))
 	}
@@ -100,7 +124,7 @@ func (p primitive) Equal(o attr.Type) bool {
 		return false
 	}
 	switch p {
-	case StringType, NumberType, BoolType, Int64Type, Float64Type:
+	case StringType, NumberType, BoolType, Int64Type, Float64Type, Int32Type, Float32Type, Uint64Type:
 		return p == other
 	default:
 		// unrecognized types are never equal to anything.
@@ -123,6 +147,12 @@ func (p primitive) Validate(ctx context.Context, in tftypes.Value, path path.Pat
 		diags.Append(int64Validate(ctx, in, path)...)
 	case Float64Type:
 		diags.Append(float64Validate(ctx, in, path)...)
+	case Int32Type:
+		diags.Append(int32Validate(ctx, in, path)...)
+	case Float32Type:
+		diags.Append(float32Validate(ctx, in, path)...)
+	case Uint64Type:
+		diags.Append(uint64Validate(ctx, in, path)...)
 	}
 
 	return diags
@@ -2,9 +2,14 @@ package types
 
 import (
 	"context"
+	"math"
+	"math/big"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
@@ -17,6 +22,9 @@ func TestPrimitiveTerraformType(t *testing.T) {
 		BoolType:    tftypes.Bool,
 		Int64Type:   tftypes.Number,
 		Float64Type: tftypes.Number,
+		Int32Type:   tftypes.Number,
+		Float32Type: tftypes.Number,
+		Uint64Type:  tftypes.Number,
 	}
 	for prim, expected := range tests {
 		prim, expected := prim, expected
@@ -63,6 +71,24 @@ func TestPrimitiveValueFromTerraform(t *testing.T) {
 
 		testFloat64ValueFromTerraform(t, false)
 	})
+
+	t.Run(Int32Type.String(), func(t *testing.T) {
+		t.Parallel()
+
+		testInt32ValueFromTerraform(t, false)
+	})
+
+	t.Run(Float32Type.String(), func(t *testing.T) {
+		t.Parallel()
+
+		testFloat32ValueFromTerraform(t, false)
+	})
+
+	t.Run(Uint64Type.String(), func(t *testing.T) {
+		t.Parallel()
+
+		testUint64ValueFromTerraform(t, false)
+	})
 }
 
 // testAttributeType is a dummy attribute type to compare against with Equal to
@@ -204,6 +230,36 @@ func TestPrimitiveEqual(t *testing.T) {
 			candidate: testAttributeType{},
 			expected:  false,
 		},
+		"int32-int32": {
+			prim:      Int32Type,
+			candidate: Int32Type,
+			expected:  true,
+		},
+		"int32-int64": {
+			prim:      Int32Type,
+			candidate: Int64Type,
+			expected:  false,
+		},
+		"float32-float32": {
+			prim:      Float32Type,
+			candidate: Float32Type,
+			expected:  true,
+		},
+		"float32-float64": {
+			prim:      Float32Type,
+			candidate: Float64Type,
+			expected:  false,
+		},
+		"uint64-uint64": {
+			prim:      Uint64Type,
+			candidate: Uint64Type,
+			expected:  true,
+		},
+		"uint64-int64": {
+			prim:      Uint64Type,
+			candidate: Int64Type,
+			expected:  false,
+		},
 		"unknown-string": {
 			prim:      100,
 			candidate: StringType,
@@ -252,3 +308,104 @@ func TestPrimitiveEqual(t *testing.T) {
 		})
 	}
 }
+
+func TestPrimitiveValidate(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		prim     primitive
+		in       tftypes.Value
+		expected diag.Diagnostics
+	}{
+		"int32-valid": {
+			prim: Int32Type,
+			in:   tftypes.NewValue(tftypes.Number, int64(math.MaxInt32)),
+		},
+		"int32-overflow": {
+			prim: Int32Type,
+			in:   tftypes.NewValue(tftypes.Number, int64(math.MaxInt32)+1),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Int32 Type Validation Error",
+					"Value 2147483648 cannot be represented as a 32-bit integer.",
+				),
+			},
+		},
+		"int32-not-integer": {
+			prim: Int32Type,
+			in:   tftypes.NewValue(tftypes.Number, 1.5),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Int32 Type Validation Error",
+					"Value 1.5 is not an integer.",
+				),
+			},
+		},
+		"int32-unknown": {
+			prim: Int32Type,
+			in:   tftypes.NewValue(tftypes.Number, tftypes.UnknownValue),
+		},
+		"float32-valid": {
+			prim: Float32Type,
+			in:   tftypes.NewValue(tftypes.Number, 1.1),
+		},
+		"float32-overflow": {
+			prim: Float32Type,
+			in:   tftypes.NewValue(tftypes.Number, -math.MaxFloat64),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Float32 Type Validation Error",
+					"Value -1.7976931348623157e+308 cannot be represented as a 32-bit floating point.",
+				),
+			},
+		},
+		"float32-null": {
+			prim: Float32Type,
+			in:   tftypes.NewValue(tftypes.Number, nil),
+		},
+		"uint64-valid": {
+			prim: Uint64Type,
+			in:   tftypes.NewValue(tftypes.Number, new(big.Float).SetUint64(math.MaxUint64)),
+		},
+		"uint64-negative": {
+			prim: Uint64Type,
+			in:   tftypes.NewValue(tftypes.Number, -1),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Uint64 Type Validation Error",
+					"Value -1 cannot be represented as an unsigned 64-bit integer.",
+				),
+			},
+		},
+		"uint64-wrong-type": {
+			prim: Uint64Type,
+			in:   tftypes.NewValue(tftypes.String, "oops"),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Uint64 Type Validation Error",
+					"An unexpected error was encountered trying to validate an attribute value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Expected Number value, received tftypes.Value with value: tftypes.String<\"oops\">",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.prim.Validate(context.Background(), testCase.in, path.Root("test"))
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/reflect"
+	"github.com/hashicorp/terraform-plugin-framework/internal/valuehash"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
@@ -52,23 +53,17 @@ func (st SetType) TerraformType(ctx context.Context) tftypes.Type {
 // This is meant to convert the tftypes.Value into a more convenient Go
 // type for the provider to consume the data with.
 func (st SetType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
-	set := Set{
-		ElemType: st.ElemType,
-	}
 	if in.Type() == nil {
-		set.Null = true
-		return set, nil
+		return SetNull(st.ElemType), nil
 	}
 	if !in.Type().Equal(st.TerraformType(ctx)) {
 		return nil, fmt.Errorf("can't use %s as value of Set with ElementType %T, can only use %s values", in.String(), st.ElemType, st.ElemType.TerraformType(ctx).String())
 	}
 	if !in.IsKnown() {
-		set.Unknown = true
-		return set, nil
+		return SetUnknown(st.ElemType), nil
 	}
 	if in.IsNull() {
-		set.Null = true
-		return set, nil
+		return SetNull(st.ElemType), nil
 	}
 	val := []tftypes.Value{}
 	err := in.As(&val)
@@ -83,8 +78,13 @@ func (st SetType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (att
 		}
 		elems = append(elems, av)
 	}
-	set.Elems = elems
-	return set, nil
+	// Elements were created from the element type, so there is no need to
+	// validate them via SetValue.
+	return Set{
+		elementType: st.ElemType,
+		elements:    elems,
+		state:       attr.ValueStateKnown,
+	}, nil
 }
 
 // Equal returns true if `o` is also a SetType and has the same ElemType.
@@ -115,7 +115,8 @@ func (st SetType) String() string {
 }
 
 // Validate implements type validation. This type requires all elements to be
-// unique.
+// unique. Duplicate element diagnostics point at the path of the duplicate
+// element.
 func (st SetType) Validate(ctx context.Context, in tftypes.Value, path path.Path) diag.Diagnostics {
 	var diags diag.Diagnostics
 
@@ -148,57 +149,154 @@ func (st SetType) Validate(ctx context.Context, in tftypes.Value, path path.Path
 		return diags
 	}
 
-	// Attempting to use map[tftypes.Value]struct{} for duplicate detection yields:
-	//   panic: runtime error: hash of unhashable type tftypes.primitive
-	// Instead, use for loops.
-	for indexOuter, elemOuter := range elems {
-		// Only evaluate fully known values for duplicates.
-		if !elemOuter.IsFullyKnown() {
-			continue
-		}
+	for _, index := range valuehash.Duplicates(elems) {
+		diags.AddAttributeError(
+			st.elementPath(ctx, path, elems[index]),
+			"Duplicate Set Element",
+			fmt.Sprintf("This attribute contains duplicate values of: %s", elems[index]),
+		)
+	}
+
+	return diags
+}
+
+// elementPath returns the path of the set element, or the set path if the
+// element cannot be converted into an attr.Value.
+func (st SetType) elementPath(ctx context.Context, setPath path.Path, elem tftypes.Value) path.Path {
+	if st.ElemType == nil {
+		return setPath
+	}
 
-		for indexInner := indexOuter + 1; indexInner < len(elems); indexInner++ {
-			elemInner := elems[indexInner]
+	elemValue, err := st.ElemType.ValueFromTerraform(ctx, elem)
 
-			if !elemInner.Equal(elemOuter) {
-				continue
-			}
+	if err != nil {
+		return setPath
+	}
+
+	return setPath.AtSetValue(elemValue)
+}
+
+// SetNull creates a Set with a null value. Determine whether the value is
+// null via the Set type IsNull method.
+func SetNull(elementType attr.Type) Set {
+	return Set{
+		elementType: elementType,
+		state:       attr.ValueStateNull,
+	}
+}
+
+// SetUnknown creates a Set with an unknown value. Determine whether the
+// value is unknown via the Set type IsUnknown method.
+func SetUnknown(elementType attr.Type) Set {
+	return Set{
+		elementType: elementType,
+		state:       attr.ValueStateUnknown,
+	}
+}
+
+// SetValue creates a Set with a known value. Access the value via the Set
+// type Elements or ElementsAs methods. Every element must be of the given
+// element type, otherwise an error diagnostic is returned.
+func SetValue(elementType attr.Type, elements []attr.Value) (Set, diag.Diagnostics) {
+	var diags diag.Diagnostics
 
-			// TODO: Point at element attr.Value when Validate method is converted to attr.Value
-			// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/172
-			diags.AddAttributeError(
-				path,
-				"Duplicate Set Element",
-				fmt.Sprintf("This attribute contains duplicate values of: %s", elemInner),
+	// The creation functions do not accept a context, as they are typically
+	// used in places where one is not available, such as tests.
+	ctx := context.Background()
+
+	for idx, element := range elements {
+		if !elementType.Equal(element.Type(ctx)) {
+			diags.AddError(
+				"Invalid Set Element Type",
+				"While creating a Set value, an invalid element was detected. "+
+					"A Set must use the single, given element type. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("Set Element Type: %s\n", elementType)+
+					fmt.Sprintf("Set Index (%d) Element Type: %s", idx, element.Type(ctx)),
 			)
 		}
 	}
 
-	return diags
+	if diags.HasError() {
+		return SetUnknown(elementType), diags
+	}
+
+	return Set{
+		elementType: elementType,
+		elements:    copyElements(elements),
+		state:       attr.ValueStateKnown,
+	}, nil
 }
 
-// Set represents a set of attr.Value, all of the same type,
-// indicated by ElemType.
+// SetValueFrom creates a Set with a known value, using reflection rules.
+// The elements must be a slice which can convert into the given element type.
+// Access the value via the Set type Elements or ElementsAs methods.
+func SetValueFrom(ctx context.Context, elementType attr.Type, elements interface{}) (Set, diag.Diagnostics) {
+	attrValue, diags := reflect.FromValue(
+		ctx,
+		SetType{ElemType: elementType},
+		elements,
+		path.Empty(),
+	)
+
+	if diags.HasError() {
+		return SetUnknown(elementType), diags
+	}
+
+	set, ok := attrValue.(Set)
+
+	// Reflection should only return Set, but guard against a panic.
+	if !ok {
+		diags.AddError(
+			"Set Conversion Error",
+			"An unexpected error was encountered trying to convert the elements into a Set value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("expected types.Set, got: %T", attrValue),
+		)
+
+		return SetUnknown(elementType), diags
+	}
+
+	return set, diags
+}
+
+// SetValueMust creates a Set with a known value, converting any diagnostics
+// into a panic at runtime. Access the value via the Set type Elements or
+// ElementsAs methods.
+//
+// This creation function is only recommended to create Set values which
+// will not potentially affect practitioners, such as testing, or exhaustively
+// tested provider logic.
+func SetValueMust(elementType attr.Type, elements []attr.Value) Set {
+	set, diags := SetValue(elementType, elements)
+
+	if diags.HasError() {
+		panic(diagsPanicMessage("SetValueMust", diags))
+	}
+
+	return set
+}
+
+// Set represents a set of attr.Value, all of the same type, indicated by the
+// element type. The zero-value of Set is null without an element type, use
+// the SetNull, SetUnknown, and SetValue functions to create a Set.
 type Set struct {
-	// Unknown will be set to true if the entire set is an unknown value.
-	// If only some of the elements in the set are unknown, their known or
-	// unknown status will be represented however that attr.Value
-	// surfaces that information. The Set's Unknown property only tracks
-	// if the number of elements in a Set is known, not whether the
-	// elements that are in the set are known.
-	Unknown bool
-
-	// Null will be set to true if the set is null, either because it was
-	// omitted from the configuration, state, or plan, or because it was
-	// explicitly set to null.
-	Null bool
-
-	// Elems are the elements in the set.
-	Elems []attr.Value
-
-	// ElemType is the tftypes.Type of the elements in the set. All
-	// elements in the set must be of this type.
-	ElemType attr.Type
+	// elements are the known elements in the set. If only some of the
+	// elements in the set are unknown, their known or unknown status will
+	// be represented however that attr.Value surfaces that information.
+	elements []attr.Value
+
+	// elementType is the type of the elements in the set. All elements in
+	// the set must be of this type.
+	elementType attr.Type
+
+	// state represents whether the value is null, unknown, or known. The
+	// zero-value is null.
+	state attr.ValueState
+}
+
+// Elements returns a copy of the collection of elements for the Set.
+func (s Set) Elements() []attr.Value {
+	return copyElements(s.elements)
 }
 
 // ElementsAs populates `target` with the elements of the Set, throwing an
@@ -221,35 +319,49 @@ func (s Set) ElementsAs(ctx context.Context, target interface{}, allowUnhandled
 	})
 }
 
+// ElementType returns the element type for the Set.
+func (s Set) ElementType(_ context.Context) attr.Type {
+	return s.elementType
+}
+
 // Type returns a SetType with the same element type as `s`.
 func (s Set) Type(ctx context.Context) attr.Type {
-	return SetType{ElemType: s.ElemType}
+	return SetType{ElemType: s.elementType}
 }
 
 // ToTerraformValue returns the data contained in the Set as a tftypes.Value.
 func (s Set) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
-	if s.ElemType == nil {
-		return tftypes.Value{}, fmt.Errorf("cannot convert Set to tftypes.Value if ElemType field is not set")
-	}
-	setType := tftypes.Set{ElementType: s.ElemType.TerraformType(ctx)}
-	if s.Unknown {
-		return tftypes.NewValue(setType, tftypes.UnknownValue), nil
-	}
-	if s.Null {
-		return tftypes.NewValue(setType, nil), nil
+	if s.elementType == nil {
+		return tftypes.Value{}, fmt.Errorf("cannot convert Set to tftypes.Value if element type is not set")
 	}
-	vals := make([]tftypes.Value, 0, len(s.Elems))
-	for _, elem := range s.Elems {
-		val, err := elem.ToTerraformValue(ctx)
-		if err != nil {
+	setType := tftypes.Set{ElementType: s.elementType.TerraformType(ctx)}
+
+	switch s.state {
+	case attr.ValueStateKnown:
+		vals := make([]tftypes.Value, 0, len(s.elements))
+
+		for _, elem := range s.elements {
+			val, err := elem.ToTerraformValue(ctx)
+
+			if err != nil {
+				return tftypes.NewValue(setType, tftypes.UnknownValue), err
+			}
+
+			vals = append(vals, val)
+		}
+
+		if err := tftypes.ValidateValue(setType, vals); err != nil {
 			return tftypes.NewValue(setType, tftypes.UnknownValue), err
 		}
-		vals = append(vals, val)
-	}
-	if err := tftypes.ValidateValue(setType, vals); err != nil {
-		return tftypes.NewValue(setType, tftypes.UnknownValue), err
+
+		return tftypes.NewValue(setType, vals), nil
+	case attr.ValueStateNull:
+		return tftypes.NewValue(setType, nil), nil
+	case attr.ValueStateUnknown:
+		return tftypes.NewValue(setType, tftypes.UnknownValue), nil
+	default:
+		panic(fmt.Sprintf("unhandled Set state in ToTerraformValue: %s", s.state))
 	}
-	return tftypes.NewValue(setType, vals), nil
 }
 
 // Equal returns true if the Set is considered semantically equal
@@ -259,31 +371,100 @@ func (s Set) Equal(o attr.Value) bool {
 	if !ok {
 		return false
 	}
-	if s.Unknown != other.Unknown {
+	if s.state != other.state {
 		return false
 	}
-	if s.Null != other.Null {
+	if s.elementType == nil && other.elementType != nil {
 		return false
 	}
-	if s.ElemType == nil && other.ElemType != nil {
+	if s.elementType != nil && !s.elementType.Equal(other.elementType) {
 		return false
 	}
-	if s.ElemType != nil && !s.ElemType.Equal(other.ElemType) {
-		return false
+	if s.state != attr.ValueStateKnown {
+		return true
 	}
-	if len(s.Elems) != len(other.Elems) {
+	if len(s.elements) != len(other.elements) {
 		return false
 	}
-	for _, elem := range s.Elems {
-		if !other.contains(elem) {
+
+	index, ok := other.elemIndex()
+
+	for _, elem := range s.elements {
+		if !other.contains(index, ok, elem) {
 			return false
 		}
 	}
 	return true
 }
 
-func (s Set) contains(v attr.Value) bool {
-	for _, elem := range s.Elems {
+// elemIndex returns a valuehash.Index of the element Terraform values, so
+// elements can be found without comparing every pair of elements. The
+// boolean is false if the element type is not hashable or any element cannot
+// be converted.
+func (s Set) elemIndex() (*valuehash.Index, bool) {
+	if len(s.elements) == 0 || !hashableType(s.elementType) {
+		return nil, false
+	}
+
+	ctx := context.Background()
+	values := make([]tftypes.Value, 0, len(s.elements))
+
+	for _, elem := range s.elements {
+		value, err := elem.ToTerraformValue(ctx)
+
+		if err != nil {
+			return nil, false
+		}
+
+		values = append(values, value)
+	}
+
+	return valuehash.NewIndex(values), true
+}
+
+// hashableType returns true if values of the type are equal exactly when
+// their Terraform values are equal, which is true for the types in this
+// package. Custom types may implement Equal differently, such as comparing
+// strings case-insensitively, so their values are always compared with Equal.
+func hashableType(typ attr.Type) bool {
+	switch t := typ.(type) {
+	case primitive:
+		return true
+	case ListType:
+		return hashableType(t.ElemType)
+	case MapType:
+		return hashableType(t.ElemType)
+	case SetType:
+		return hashableType(t.ElemType)
+	case ObjectType:
+		for _, attrType := range t.AttrTypes {
+			if !hashableType(attrType) {
+				return false
+			}
+		}
+
+		return true
+	default:
+		return false
+	}
+}
+
+// contains returns true if any element is equal to `v`. If the index is
+// available, only elements with the same Terraform value hash are compared.
+func (s Set) contains(index *valuehash.Index, indexOk bool, v attr.Value) bool {
+	if indexOk {
+		if value, err := v.ToTerraformValue(context.Background()); err == nil {
+			for _, candidate := range index.Candidates(value) {
+				if s.elements[candidate].Equal(v) {
+					return true
+				}
+			}
+
+			return false
+		}
+	}
+
+	for _, elem := range s.elements {
 		if elem.Equal(v) {
 			return true
 		}
@@ -294,30 +475,30 @@ func (s Set) contains(v attr.Value) bool {
 
 // IsNull returns true if the Set represents a null value.
 func (s Set) IsNull() bool {
-	return s.Null
+	return s.state == attr.ValueStateNull
 }
 
 // IsUnknown returns true if the Set represents a currently unknown value.
 func (s Set) IsUnknown() bool {
-	return s.Unknown
+	return s.state == attr.ValueStateUnknown
 }
 
 // String returns a human-readable representation of the Set value.
 // The string returned here is not protected by any compatibility guarantees,
 // and is intended for logging and error reporting.
 func (s Set) String() string {
-	if s.Unknown {
+	if s.IsUnknown() {
 		return attr.UnknownValueString
 	}
 
-	if s.Null {
+	if s.IsNull() {
 		return attr.NullValueString
 	}
 
 	var res strings.Builder
 
 	res.WriteString("[")
-	for i, e := range s.Elems {
+	for i, e := range s.elements {
 		if i != 0 {
 			res.WriteString(",")
 		}
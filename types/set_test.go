@@ -3,6 +3,7 @@ package types
 import (
 	"context"
 	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -90,13 +91,10 @@ func TestSetTypeValueFromTerraform(t *testing.T) {
 				tftypes.NewValue(tftypes.String, "hello"),
 				tftypes.NewValue(tftypes.String, "world"),
 			}),
-			expected: Set{
-				ElemType: StringType,
-				Elems: []attr.Value{
-					String{Value: "hello"},
-					String{Value: "world"},
-				},
-			},
+			expected: SetValueMust(StringType, []attr.Value{
+				StringValue("hello"),
+				StringValue("world"),
+			}),
 		},
 		"set-of-duplicate-strings": {
 			receiver: SetType{
@@ -110,13 +108,10 @@ func TestSetTypeValueFromTerraform(t *testing.T) {
 			}),
 			// Duplicate validation does not occur during this method.
 			// This is okay, as tftypes allows duplicates.
-			expected: Set{
-				ElemType: StringType,
-				Elems: []attr.Value{
-					String{Value: "hello"},
-					String{Value: "hello"},
-				},
-			},
+			expected: SetValueMust(StringType, []attr.Value{
+				StringValue("hello"),
+				StringValue("hello"),
+			}),
 		},
 		"unknown-set": {
 			receiver: SetType{
@@ -125,10 +120,7 @@ func TestSetTypeValueFromTerraform(t *testing.T) {
 			input: tftypes.NewValue(tftypes.Set{
 				ElementType: tftypes.String,
 			}, tftypes.UnknownValue),
-			expected: Set{
-				ElemType: StringType,
-				Unknown:  true,
-			},
+			expected: SetUnknown(StringType),
 		},
 		"partially-unknown-set": {
 			receiver: SetType{
@@ -140,13 +132,10 @@ func TestSetTypeValueFromTerraform(t *testing.T) {
 				tftypes.NewValue(tftypes.String, "hello"),
 				tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
 			}),
-			expected: Set{
-				ElemType: StringType,
-				Elems: []attr.Value{
-					String{Value: "hello"},
-					String{Unknown: true},
-				},
-			},
+			expected: SetValueMust(StringType, []attr.Value{
+				StringValue("hello"),
+				StringUnknown(),
+			}),
 		},
 		"null-set": {
 			receiver: SetType{
@@ -155,10 +144,7 @@ func TestSetTypeValueFromTerraform(t *testing.T) {
 			input: tftypes.NewValue(tftypes.Set{
 				ElementType: tftypes.String,
 			}, nil),
-			expected: Set{
-				ElemType: StringType,
-				Null:     true,
-			},
+			expected: SetNull(StringType),
 		},
 		"partially-null-set": {
 			receiver: SetType{
@@ -170,13 +156,10 @@ func TestSetTypeValueFromTerraform(t *testing.T) {
 				tftypes.NewValue(tftypes.String, "hello"),
 				tftypes.NewValue(tftypes.String, nil),
 			}),
-			expected: Set{
-				ElemType: StringType,
-				Elems: []attr.Value{
-					String{Value: "hello"},
-					String{Null: true},
-				},
-			},
+			expected: SetValueMust(StringType, []attr.Value{
+				StringValue("hello"),
+				StringNull(),
+			}),
 		},
 		"wrong-type": {
 			receiver: SetType{
@@ -200,11 +183,8 @@ func TestSetTypeValueFromTerraform(t *testing.T) {
 			receiver: SetType{
 				ElemType: StringType,
 			},
-			input: tftypes.NewValue(nil, nil),
-			expected: Set{
-				ElemType: StringType,
-				Null:     true,
-			},
+			input:    tftypes.NewValue(nil, nil),
+			expected: SetNull(StringType),
 		},
 	}
 	for name, test := range tests {
@@ -296,12 +276,10 @@ func TestSetElementsAs_stringSlice(t *testing.T) {
 	var stringSlice []string
 	expected := []string{"hello", "world"}
 
-	diags := (Set{
-		ElemType: StringType,
-		Elems: []attr.Value{
-			String{Value: "hello"},
-			String{Value: "world"},
-		}}).ElementsAs(context.Background(), &stringSlice, false)
+	diags := (SetValueMust(StringType, []attr.Value{
+		StringValue("hello"),
+		StringValue("world"),
+	})).ElementsAs(context.Background(), &stringSlice, false)
 	if diags.HasError() {
 		t.Errorf("Unexpected error: %s", diags)
 	}
@@ -315,16 +293,14 @@ func TestSetElementsAs_attributeValueSlice(t *testing.T) {
 
 	var stringSlice []String
 	expected := []String{
-		{Value: "hello"},
-		{Value: "world"},
+		StringValue("hello"),
+		StringValue("world"),
 	}
 
-	diags := (Set{
-		ElemType: StringType,
-		Elems: []attr.Value{
-			String{Value: "hello"},
-			String{Value: "world"},
-		}}).ElementsAs(context.Background(), &stringSlice, false)
+	diags := (SetValueMust(StringType, []attr.Value{
+		StringValue("hello"),
+		StringValue("world"),
+	})).ElementsAs(context.Background(), &stringSlice, false)
 	if diags.HasError() {
 		t.Errorf("Unexpected error: %s", diags)
 	}
@@ -336,7 +312,7 @@ func TestSetElementsAs_attributeValueSlice(t *testing.T) {
 var benchDiags diag.Diagnostics // Prevent compiler optimization
 
 func benchmarkSetTypeValidate(b *testing.B, elementCount int) {
-	elements := make([]tftypes.Value, 0, elementCount)
+	elements := make([]tftypes.Value, elementCount)
 
 	for idx := range elements {
 		elements[idx] = tftypes.NewValue(tftypes.String, strconv.Itoa(idx))
@@ -351,7 +327,7 @@ func benchmarkSetTypeValidate(b *testing.B, elementCount int) {
 		elements,
 	)
 	path := path.Root("test")
-	set := SetType{}
+	set := SetType{ElemType: StringType}
 
 	for n := 0; n < b.N; n++ {
 		diags = set.Validate(ctx, in, path)
@@ -384,10 +360,53 @@ func BenchmarkSetTypeValidate1000000(b *testing.B) {
 	benchmarkSetTypeValidate(b, 1000000)
 }
 
+var benchEqual bool // Prevent compiler optimization
+
+func benchmarkSetEqual(b *testing.B, elementCount int) {
+	elements := make([]attr.Value, elementCount)
+	otherElements := make([]attr.Value, elementCount)
+
+	for idx := range elements {
+		elements[idx] = StringValue(strconv.Itoa(idx))
+		otherElements[elementCount-idx-1] = StringValue(strconv.Itoa(idx))
+	}
+
+	var equal bool // Prevent compiler optimization
+	set := SetValueMust(StringType, elements)
+	other := SetValueMust(StringType, otherElements)
+
+	for n := 0; n < b.N; n++ {
+		equal = set.Equal(other)
+	}
+
+	benchEqual = equal
+}
+
+func BenchmarkSetEqual10(b *testing.B) {
+	benchmarkSetEqual(b, 10)
+}
+
+func BenchmarkSetEqual100(b *testing.B) {
+	benchmarkSetEqual(b, 100)
+}
+
+func BenchmarkSetEqual1000(b *testing.B) {
+	benchmarkSetEqual(b, 1000)
+}
+
+func BenchmarkSetEqual10000(b *testing.B) {
+	benchmarkSetEqual(b, 10000)
+}
+
+func BenchmarkSetEqual100000(b *testing.B) {
+	benchmarkSetEqual(b, 100000)
+}
+
 func TestSetTypeValidate(t *testing.T) {
 	t.Parallel()
 
 	testCases := map[string]struct {
+		setType       SetType
 		in            tftypes.Value
 		expectedDiags diag.Diagnostics
 	}{
@@ -540,13 +559,56 @@ func TestSetTypeValidate(t *testing.T) {
 				),
 			},
 		},
+		"duplicate-element-path": {
+			setType: SetType{ElemType: StringType},
+			in: tftypes.NewValue(
+				tftypes.Set{
+					ElementType: tftypes.String,
+				},
+				[]tftypes.Value{
+					tftypes.NewValue(tftypes.String, "hello"),
+					tftypes.NewValue(tftypes.String, "world"),
+					tftypes.NewValue(tftypes.String, "hello"),
+				},
+			),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test").AtSetValue(StringValue("hello")),
+					"Duplicate Set Element",
+					"This attribute contains duplicate values of: tftypes.String<\"hello\">",
+				),
+			},
+		},
+		"duplicate-element-path-object": {
+			setType: SetType{ElemType: ObjectType{AttrTypes: map[string]attr.Type{"name": StringType}}},
+			in: tftypes.NewValue(
+				tftypes.Set{
+					ElementType: tftypes.Object{AttributeTypes: map[string]tftypes.Type{"name": tftypes.String}},
+				},
+				[]tftypes.Value{
+					tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"name": tftypes.String}}, map[string]tftypes.Value{
+						"name": tftypes.NewValue(tftypes.String, "hello"),
+					}),
+					tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"name": tftypes.String}}, map[string]tftypes.Value{
+						"name": tftypes.NewValue(tftypes.String, "hello"),
+					}),
+				},
+			),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test").AtSetValue(ObjectValueMust(map[string]attr.Type{"name": StringType}, map[string]attr.Value{"name": StringValue("hello")})),
+					"Duplicate Set Element",
+					"This attribute contains duplicate values of: tftypes.Object[\"name\":tftypes.String]<\"name\":tftypes.String<\"hello\">>",
+				),
+			},
+		},
 	}
 	for name, testCase := range testCases {
 		name, testCase := name, testCase
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			diags := SetType{}.Validate(context.Background(), testCase.in, path.Root("test"))
+			diags := testCase.setType.Validate(context.Background(), testCase.in, path.Root("test"))
 
 			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
 				t.Errorf("Unexpected diagnostics (+got, -expected): %s", diff)
@@ -565,26 +627,20 @@ func TestSetToTerraformValue(t *testing.T) {
 	}
 	tests := map[string]testCase{
 		"value": {
-			input: Set{
-				ElemType: StringType,
-				Elems: []attr.Value{
-					String{Value: "hello"},
-					String{Value: "world"},
-				},
-			},
+			input: SetValueMust(StringType, []attr.Value{
+				StringValue("hello"),
+				StringValue("world"),
+			}),
 			expectation: tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
 				tftypes.NewValue(tftypes.String, "hello"),
 				tftypes.NewValue(tftypes.String, "world"),
 			}),
 		},
 		"value-duplicates": {
-			input: Set{
-				ElemType: StringType,
-				Elems: []attr.Value{
-					String{Value: "hello"},
-					String{Value: "hello"},
-				},
-			},
+			input: SetValueMust(StringType, []attr.Value{
+				StringValue("hello"),
+				StringValue("hello"),
+			}),
 			// Duplicate validation does not occur during this method.
 			// This is okay, as tftypes allows duplicates.
 			expectation: tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
@@ -593,48 +649,37 @@ func TestSetToTerraformValue(t *testing.T) {
 			}),
 		},
 		"unknown": {
-			input:       Set{ElemType: StringType, Unknown: true},
+			input:       SetUnknown(StringType),
 			expectation: tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, tftypes.UnknownValue),
 		},
 		"null": {
-			input:       Set{ElemType: StringType, Null: true},
+			input:       SetNull(StringType),
 			expectation: tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
 		},
 		"partial-unknown": {
-			input: Set{
-				ElemType: StringType,
-				Elems: []attr.Value{
-					String{Unknown: true},
-					String{Value: "hello, world"},
-				},
-			},
+			input: SetValueMust(StringType, []attr.Value{
+				StringUnknown(),
+				StringValue("hello, world"),
+			}),
 			expectation: tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
 				tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
 				tftypes.NewValue(tftypes.String, "hello, world"),
 			}),
 		},
 		"partial-null": {
-			input: Set{
-				ElemType: StringType,
-				Elems: []attr.Value{
-					String{Null: true},
-					String{Value: "hello, world"},
-				},
-			},
+			input: SetValueMust(StringType, []attr.Value{
+				StringNull(),
+				StringValue("hello, world"),
+			}),
 			expectation: tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
 				tftypes.NewValue(tftypes.String, nil),
 				tftypes.NewValue(tftypes.String, "hello, world"),
 			}),
 		},
 		"no-elem-type": {
-			input: Set{
-				Elems: []attr.Value{
-					String{Value: "hello"},
-					String{Value: "world"},
-				},
-			},
+			input:       Set{},
 			expectation: tftypes.Value{},
-			expectedErr: "cannot convert Set to tftypes.Value if ElemType field is not set",
+			expectedErr: "cannot convert Set to tftypes.Value if element type is not set",
 		},
 	}
 	for name, test := range tests {
@@ -678,275 +723,198 @@ func TestSetEqual(t *testing.T) {
 	}
 	tests := map[string]testCase{
 		"set-value-set-value": {
-			receiver: Set{
-				ElemType: StringType,
-				Elems: []attr.Value{
-					String{Value: "hello"},
-					String{Value: "world"},
-				},
-			},
-			input: Set{
-				ElemType: StringType,
-				Elems: []attr.Value{
-					String{Value: "hello"},
-					String{Value: "world"},
-				},
-			},
+			receiver: SetValueMust(StringType, []attr.Value{
+				StringValue("hello"),
+				StringValue("world"),
+			}),
+			input: SetValueMust(StringType, []attr.Value{
+				StringValue("hello"),
+				StringValue("world"),
+			}),
 			expected: true,
 		},
 		"set-value-diff": {
-			receiver: Set{
-				ElemType: StringType,
-				Elems: []attr.Value{
-					String{Value: "hello"},
-					String{Value: "world"},
-				},
-			},
-			input: Set{
-				ElemType: StringType,
-				Elems: []attr.Value{
-					String{Value: "goodnight"},
-					String{Value: "moon"},
-				},
-			},
+			receiver: SetValueMust(StringType, []attr.Value{
+				StringValue("hello"),
+				StringValue("world"),
+			}),
+			input: SetValueMust(StringType, []attr.Value{
+				StringValue("goodnight"),
+				StringValue("moon"),
+			}),
 			expected: false,
 		},
 		"set-value-count-diff": {
-			receiver: Set{
-				ElemType: StringType,
-				Elems: []attr.Value{
-					String{Value: "hello"},
-					String{Value: "world"},
-				},
-			},
-			input: Set{
-				ElemType: StringType,
-				Elems: []attr.Value{
-					String{Value: "hello"},
-					String{Value: "world"},
-					String{Value: "test"},
-				},
-			},
+			receiver: SetValueMust(StringType, []attr.Value{
+				StringValue("hello"),
+				StringValue("world"),
+			}),
+			input: SetValueMust(StringType, []attr.Value{
+				StringValue("hello"),
+				StringValue("world"),
+				StringValue("test"),
+			}),
 			expected: false,
 		},
+		"set-value-custom-equal": {
+			receiver: SetValueMust(testCaseInsensitiveStringType{StringType}, []attr.Value{
+				testCaseInsensitiveString{StringValue("Hello")},
+				testCaseInsensitiveString{StringValue("World")},
+			}),
+			input: SetValueMust(testCaseInsensitiveStringType{StringType}, []attr.Value{
+				testCaseInsensitiveString{StringValue("world")},
+				testCaseInsensitiveString{StringValue("hello")},
+			}),
+			expected: true,
+		},
 		"set-value-type-diff": {
-			receiver: Set{
-				ElemType: StringType,
-				Elems: []attr.Value{
-					String{Value: "hello"},
-					String{Value: "world"},
-				},
-			},
-			input: Set{
-				ElemType: BoolType,
-				Elems: []attr.Value{
-					Bool{Value: false},
-					Bool{Value: true},
-				},
-			},
+			receiver: SetValueMust(StringType, []attr.Value{
+				StringValue("hello"),
+				StringValue("world"),
+			}),
+			input: SetValueMust(BoolType, []attr.Value{
+				BoolValue(false),
+				BoolValue(true),
+			}),
 			expected: false,
 		},
 		"set-value-unknown": {
-			receiver: Set{
-				ElemType: StringType,
-				Elems: []attr.Value{
-					String{Value: "hello"},
-					String{Value: "world"},
-				},
-			},
-			input:    Set{Unknown: true},
+			receiver: SetValueMust(StringType, []attr.Value{
+				StringValue("hello"),
+				StringValue("world"),
+			}),
+			input:    SetUnknown(StringType),
 			expected: false,
 		},
 		"set-value-null": {
-			receiver: Set{
-				ElemType: StringType,
-				Elems: []attr.Value{
-					String{Value: "hello"},
-					String{Value: "world"},
-				},
-			},
-			input:    Set{Null: true},
+			receiver: SetValueMust(StringType, []attr.Value{
+				StringValue("hello"),
+				StringValue("world"),
+			}),
+			input:    SetNull(StringType),
 			expected: false,
 		},
 		"set-value-wrongType": {
-			receiver: Set{
-				ElemType: StringType,
-				Elems: []attr.Value{
-					String{Value: "hello"},
-					String{Value: "world"},
-				},
-			},
-			input:    String{Value: "hello, world"},
+			receiver: SetValueMust(StringType, []attr.Value{
+				StringValue("hello"),
+				StringValue("world"),
+			}),
+			input:    StringValue("hello, world"),
 			expected: false,
 		},
 		"set-value-nil": {
-			receiver: Set{
-				ElemType: StringType,
-				Elems: []attr.Value{
-					String{Value: "hello"},
-					String{Value: "world"},
-				},
-			},
+			receiver: SetValueMust(StringType, []attr.Value{
+				StringValue("hello"),
+				StringValue("world"),
+			}),
 			input:    nil,
 			expected: false,
 		},
 		"partially-known-set-value-set-value": {
-			receiver: Set{
-				ElemType: StringType,
-				Elems: []attr.Value{
-					String{Value: "hello"},
-					String{Unknown: true},
-				},
-			},
-			input: Set{
-				ElemType: StringType,
-				Elems: []attr.Value{
-					String{Value: "hello"},
-					String{Unknown: true},
-				},
-			},
+			receiver: SetValueMust(StringType, []attr.Value{
+				StringValue("hello"),
+				StringUnknown(),
+			}),
+			input: SetValueMust(StringType, []attr.Value{
+				StringValue("hello"),
+				StringUnknown(),
+			}),
 			expected: true,
 		},
 		"partially-known-set-value-diff": {
-			receiver: Set{
-				ElemType: StringType,
-				Elems: []attr.Value{
-					String{Value: "hello"},
-					String{Unknown: true},
-				},
-			},
-			input: Set{
-				ElemType: StringType,
-				Elems: []attr.Value{
-					String{Value: "hello"},
-					String{Value: "world"},
-				},
-			},
+			receiver: SetValueMust(StringType, []attr.Value{
+				StringValue("hello"),
+				StringUnknown(),
+			}),
+			input: SetValueMust(StringType, []attr.Value{
+				StringValue("hello"),
+				StringValue("world"),
+			}),
 			expected: false,
 		},
 		"partially-known-set-value-unknown": {
-			receiver: Set{
-				ElemType: StringType,
-				Elems: []attr.Value{
-					String{Value: "hello"},
-					String{Unknown: true},
-				},
-			},
-			input:    Set{Unknown: true},
+			receiver: SetValueMust(StringType, []attr.Value{
+				StringValue("hello"),
+				StringUnknown(),
+			}),
+			input:    SetUnknown(StringType),
 			expected: false,
 		},
 		"partially-known-set-value-null": {
-			receiver: Set{
-				ElemType: StringType,
-				Elems: []attr.Value{
-					String{Value: "hello"},
-					String{Unknown: true},
-				},
-			},
-			input:    Set{Null: true},
+			receiver: SetValueMust(StringType, []attr.Value{
+				StringValue("hello"),
+				StringUnknown(),
+			}),
+			input:    SetNull(StringType),
 			expected: false,
 		},
 		"partially-known-set-value-wrongType": {
-			receiver: Set{
-				ElemType: StringType,
-				Elems: []attr.Value{
-					String{Value: "hello"},
-					String{Unknown: true},
-				},
-			},
-			input:    String{Value: "hello, world"},
+			receiver: SetValueMust(StringType, []attr.Value{
+				StringValue("hello"),
+				StringUnknown(),
+			}),
+			input:    StringValue("hello, world"),
 			expected: false,
 		},
 		"partially-known-set-value-nil": {
-			receiver: Set{
-				ElemType: StringType,
-				Elems: []attr.Value{
-					String{Value: "hello"},
-					String{Unknown: true},
-				},
-			},
+			receiver: SetValueMust(StringType, []attr.Value{
+				StringValue("hello"),
+				StringUnknown(),
+			}),
 			input:    nil,
 			expected: false,
 		},
 		"partially-null-set-value-set-value": {
-			receiver: Set{
-				ElemType: StringType,
-				Elems: []attr.Value{
-					String{Value: "hello"},
-					String{Null: true},
-				},
-			},
-			input: Set{
-				ElemType: StringType,
-				Elems: []attr.Value{
-					String{Value: "hello"},
-					String{Null: true},
-				},
-			},
+			receiver: SetValueMust(StringType, []attr.Value{
+				StringValue("hello"),
+				StringNull(),
+			}),
+			input: SetValueMust(StringType, []attr.Value{
+				StringValue("hello"),
+				StringNull(),
+			}),
 			expected: true,
 		},
 		"partially-null-set-value-diff": {
-			receiver: Set{
-				ElemType: StringType,
-				Elems: []attr.Value{
-					String{Value: "hello"},
-					String{Null: true},
-				},
-			},
-			input: Set{
-				ElemType: StringType,
-				Elems: []attr.Value{
-					String{Value: "hello"},
-					String{Value: "world"},
-				},
-			},
+			receiver: SetValueMust(StringType, []attr.Value{
+				StringValue("hello"),
+				StringNull(),
+			}),
+			input: SetValueMust(StringType, []attr.Value{
+				StringValue("hello"),
+				StringValue("world"),
+			}),
 			expected: false,
 		},
 		"partially-null-set-value-unknown": {
-			receiver: Set{
-				ElemType: StringType,
-				Elems: []attr.Value{
-					String{Value: "hello"},
-					String{Null: true},
-				},
-			},
-			input: Set{
-				Unknown: true,
-			},
+			receiver: SetValueMust(StringType, []attr.Value{
+				StringValue("hello"),
+				StringNull(),
+			}),
+			input:    SetUnknown(StringType),
 			expected: false,
 		},
 		"partially-null-set-value-null": {
-			receiver: Set{
-				ElemType: StringType,
-				Elems: []attr.Value{
-					String{Value: "hello"},
-					String{Null: true},
-				},
-			},
-			input: Set{
-				Null: true,
-			},
+			receiver: SetValueMust(StringType, []attr.Value{
+				StringValue("hello"),
+				StringNull(),
+			}),
+			input:    SetNull(StringType),
 			expected: false,
 		},
 		"partially-null-set-value-wrongType": {
-			receiver: Set{
-				ElemType: StringType,
-				Elems: []attr.Value{
-					String{Value: "hello"},
-					String{Null: true},
-				},
-			},
-			input:    String{Value: "hello, world"},
+			receiver: SetValueMust(StringType, []attr.Value{
+				StringValue("hello"),
+				StringNull(),
+			}),
+			input:    StringValue("hello, world"),
 			expected: false,
 		},
 		"partially-null-set-value-nil": {
-			receiver: Set{
-				ElemType: StringType,
-				Elems: []attr.Value{
-					String{Value: "hello"},
-					String{Null: true},
-				},
-			},
+			receiver: SetValueMust(StringType, []attr.Value{
+				StringValue("hello"),
+				StringNull(),
+			}),
 			input:    nil,
 			expected: false,
 		},
@@ -973,50 +941,38 @@ func TestSetString(t *testing.T) {
 	}
 	tests := map[string]testCase{
 		"simple": {
-			input: Set{
-				ElemType: StringType,
-				Elems: []attr.Value{
-					String{Value: "hello"},
-					String{Value: "world"},
-				},
-			},
+			input: SetValueMust(StringType, []attr.Value{
+				StringValue("hello"),
+				StringValue("world"),
+			}),
 			expectation: `["hello","world"]`,
 		},
 		"set-of-sets": {
-			input: Set{
-				ElemType: SetType{
-					ElemType: StringType,
-				},
-				Elems: []attr.Value{
-					Set{
-						ElemType: StringType,
-						Elems: []attr.Value{
-							String{Value: "hello"},
-							String{Value: "world"},
-						},
-					},
-					Set{
-						ElemType: StringType,
-						Elems: []attr.Value{
-							String{Value: "foo"},
-							String{Value: "bar"},
-						},
-					},
-				},
-			},
+			input: SetValueMust(SetType{
+				ElemType: StringType,
+			}, []attr.Value{
+				SetValueMust(StringType, []attr.Value{
+					StringValue("hello"),
+					StringValue("world"),
+				}),
+				SetValueMust(StringType, []attr.Value{
+					StringValue("foo"),
+					StringValue("bar"),
+				}),
+			}),
 			expectation: `[["hello","world"],["foo","bar"]]`,
 		},
 		"unknown": {
-			input:       Set{Unknown: true},
+			input:       SetUnknown(StringType),
 			expectation: "<unknown>",
 		},
 		"null": {
-			input:       Set{Null: true},
+			input:       SetNull(StringType),
 			expectation: "<null>",
 		},
-		"default-empty": {
+		"zero-value": {
 			input:       Set{},
-			expectation: "[]",
+			expectation: "<null>",
 		},
 	}
 
@@ -1032,3 +988,179 @@ func TestSetString(t *testing.T) {
 		})
 	}
 }
+
+func TestSetValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		elementType   attr.Type
+		elements      []attr.Value
+		expected      Set
+		expectedDiags diag.Diagnostics
+	}{
+		"valid-no-elements": {
+			elementType: StringType,
+			elements:    []attr.Value{},
+			expected:    SetValueMust(StringType, []attr.Value{}),
+		},
+		"valid-elements": {
+			elementType: StringType,
+			elements: []attr.Value{
+				StringNull(),
+				StringUnknown(),
+				StringValue("test"),
+			},
+			expected: SetValueMust(StringType, []attr.Value{
+				StringNull(),
+				StringUnknown(),
+				StringValue("test"),
+			}),
+		},
+		"invalid-element-type": {
+			elementType: StringType,
+			elements: []attr.Value{
+				StringValue("test"),
+				BoolValue(true),
+			},
+			expected: SetUnknown(StringType),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Set Element Type",
+					"While creating a Set value, an invalid element was detected. "+
+						"A Set must use the single, given element type. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"Set Element Type: types.StringType\n"+
+						"Set Index (1) Element Type: types.BoolType",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := SetValue(testCase.elementType, testCase.elements)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestSetValueFrom(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		elementType   attr.Type
+		elements      interface{}
+		expected      Set
+		expectedDiags diag.Diagnostics
+	}{
+		"valid-StringType-[]attr.Value": {
+			elementType: StringType,
+			elements: []attr.Value{
+				StringValue("test1"),
+				StringValue("test2"),
+			},
+			expected: SetValueMust(StringType, []attr.Value{
+				StringValue("test1"),
+				StringValue("test2"),
+			}),
+		},
+		"valid-StringType-[]string": {
+			elementType: StringType,
+			elements: []string{
+				"test1",
+				"test2",
+			},
+			expected: SetValueMust(StringType, []attr.Value{
+				StringValue("test1"),
+				StringValue("test2"),
+			}),
+		},
+		"invalid-element-type": {
+			elementType: BoolType,
+			elements: []string{
+				"test1",
+			},
+			expected: SetUnknown(BoolType),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Empty().AtListIndex(0),
+					"Value Conversion Error",
+					"An unexpected error was encountered trying to convert the Terraform value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"can't unmarshal tftypes.String into *bool, expected boolean",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := SetValueFrom(context.Background(), testCase.elementType, testCase.elements)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+// testCaseInsensitiveStringType is a custom string type whose values are
+// compared case-insensitively, so values with different Terraform values may
+// be equal.
+type testCaseInsensitiveStringType struct {
+	primitive
+}
+
+func (t testCaseInsensitiveStringType) Equal(o attr.Type) bool {
+	_, ok := o.(testCaseInsensitiveStringType)
+
+	return ok
+}
+
+type testCaseInsensitiveString struct {
+	value String
+}
+
+func (s testCaseInsensitiveString) Type(_ context.Context) attr.Type {
+	return testCaseInsensitiveStringType{StringType}
+}
+
+func (s testCaseInsensitiveString) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	return s.value.ToTerraformValue(ctx)
+}
+
+func (s testCaseInsensitiveString) Equal(o attr.Value) bool {
+	other, ok := o.(testCaseInsensitiveString)
+
+	return ok && strings.EqualFold(s.value.ValueString(), other.value.ValueString())
+}
+
+func (s testCaseInsensitiveString) IsNull() bool {
+	return s.value.IsNull()
+}
+
+func (s testCaseInsensitiveString) IsUnknown() bool {
+	return s.value.IsUnknown()
+}
+
+func (s testCaseInsensitiveString) String() string {
+	return s.value.String()
+}
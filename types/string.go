@@ -12,33 +12,56 @@ var (
 	_ attr.Value = String{}
 )
 
+// StringNull creates a String with a null value. Determine whether the value
+// is null via the String type IsNull method.
+func StringNull() String {
+	return String{
+		state: attr.ValueStateNull,
+	}
+}
+
+// StringUnknown creates a String with an unknown value. Determine whether the
+// value is unknown via the String type IsUnknown method.
+func StringUnknown() String {
+	return String{
+		state: attr.ValueStateUnknown,
+	}
+}
+
+// StringValue creates a String with a known value. Access the value via the
+// String type ValueString method.
+func StringValue(value string) String {
+	return String{
+		state: attr.ValueStateKnown,
+		value: value,
+	}
+}
+
 func stringValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
 	if !in.IsKnown() {
-		return String{Unknown: true}, nil
+		return StringUnknown(), nil
 	}
 	if in.IsNull() {
-		return String{Null: true}, nil
+		return StringNull(), nil
 	}
 	var s string
 	err := in.As(&s)
 	if err != nil {
 		return nil, err
 	}
-	return String{Value: s}, nil
+	return StringValue(s), nil
 }
 
-// String represents a UTF-8 string value.
+// String represents a UTF-8 string value. The zero-value of String is null,
+// use the StringNull, StringUnknown, and StringValue functions to create a
+// String.
 type String struct {
-	// Unknown will be true if the value is not yet known.
-	Unknown bool
-
-	// Null will be true if the value was not set, or was explicitly set to
-	// null.
-	Null bool
+	// state represents whether the value is null, unknown, or known. The
+	// zero-value is null.
+	state attr.ValueState
 
-	// Value contains the set value, as long as Unknown and Null are both
-	// false.
-	Value string
+	// value contains the known value, if not null or unknown.
+	value string
 }
 
 // Type returns a StringType.
@@ -48,16 +71,20 @@ func (s String) Type(_ context.Context) attr.Type {
 
 // ToTerraformValue returns the data contained in the *String as a tftypes.Value.
 func (s String) ToTerraformValue(_ context.Context) (tftypes.Value, error) {
-	if s.Null {
+	switch s.state {
+	case attr.ValueStateKnown:
+		if err := tftypes.ValidateValue(tftypes.String, s.value); err != nil {
+			return tftypes.NewValue(tftypes.String, tftypes.UnknownValue), err
+		}
+
+		return tftypes.NewValue(tftypes.String, s.value), nil
+	case attr.ValueStateNull:
 		return tftypes.NewValue(tftypes.String, nil), nil
-	}
-	if s.Unknown {
+	case attr.ValueStateUnknown:
 		return tftypes.NewValue(tftypes.String, tftypes.UnknownValue), nil
+	default:
+		panic(fmt.Sprintf("unhandled String state in ToTerraformValue: %s", s.state))
 	}
-	if err := tftypes.ValidateValue(tftypes.String, s.Value); err != nil {
-		return tftypes.NewValue(tftypes.String, tftypes.UnknownValue), err
-	}
-	return tftypes.NewValue(tftypes.String, s.Value), nil
 }
 
 // Equal returns true if `other` is a String and has the same value as `s`.
@@ -66,36 +93,42 @@ func (s String) Equal(other attr.Value) bool {
 	if !ok {
 		return false
 	}
-	if s.Unknown != o.Unknown {
+	if s.state != o.state {
 		return false
 	}
-	if s.Null != o.Null {
-		return false
+	if s.state != attr.ValueStateKnown {
+		return true
 	}
-	return s.Value == o.Value
+	return s.value == o.value
 }
 
 // IsNull returns true if the String represents a null value.
 func (s String) IsNull() bool {
-	return s.Null
+	return s.state == attr.ValueStateNull
 }
 
 // IsUnknown returns true if the String represents a currently unknown value.
 func (s String) IsUnknown() bool {
-	return s.Unknown
+	return s.state == attr.ValueStateUnknown
 }
 
 // String returns a human-readable representation of the String value.
 // The string returned here is not protected by any compatibility guarantees,
 // and is intended for logging and error reporting.
 func (s String) String() string {
-	if s.Unknown {
+	if s.IsUnknown() {
 		return attr.UnknownValueString
 	}
 
-	if s.Null {
+	if s.IsNull() {
 		return attr.NullValueString
 	}
 
-	return fmt.Sprintf("%q", s.Value)
+	return fmt.Sprintf("%q", s.value)
+}
+
+// ValueString returns the known string value. If String is null or unknown,
+// returns "".
+func (s String) ValueString() string {
+	return s.value
 }
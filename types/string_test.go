@@ -25,15 +25,15 @@ func testStringValueFromTerraform(t *testing.T, direct bool) {
 	tests := map[string]testCase{
 		"true": {
 			input:       tftypes.NewValue(tftypes.String, "hello"),
-			expectation: String{Value: "hello"},
+			expectation: StringValue("hello"),
 		},
 		"unknown": {
 			input:       tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
-			expectation: String{Unknown: true},
+			expectation: StringUnknown(),
 		},
 		"null": {
 			input:       tftypes.NewValue(tftypes.String, nil),
-			expectation: String{Null: true},
+			expectation: StringNull(),
 		},
 		"wrongType": {
 			input:       tftypes.NewValue(tftypes.Number, 123),
@@ -90,15 +90,15 @@ func TestStringToTerraformValue(t *testing.T) {
 	}
 	tests := map[string]testCase{
 		"value": {
-			input:       String{Value: "hello"},
+			input:       StringValue("hello"),
 			expectation: tftypes.NewValue(tftypes.String, "hello"),
 		},
 		"unknown": {
-			input:       String{Unknown: true},
+			input:       StringUnknown(),
 			expectation: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
 		},
 		"null": {
-			input:       String{Null: true},
+			input:       StringNull(),
 			expectation: tftypes.NewValue(tftypes.String, nil),
 		},
 	}
@@ -130,82 +130,82 @@ func TestStringEqual(t *testing.T) {
 	}
 	tests := map[string]testCase{
 		"value-value": {
-			input:       String{Value: "hello"},
-			candidate:   String{Value: "hello"},
+			input:       StringValue("hello"),
+			candidate:   StringValue("hello"),
 			expectation: true,
 		},
 		"value-diff": {
-			input:       String{Value: "hello"},
-			candidate:   String{Value: "world"},
+			input:       StringValue("hello"),
+			candidate:   StringValue("world"),
 			expectation: false,
 		},
 		"value-unknown": {
-			input:       String{Value: "hello"},
-			candidate:   String{Unknown: true},
+			input:       StringValue("hello"),
+			candidate:   StringUnknown(),
 			expectation: false,
 		},
 		"value-null": {
-			input:       String{Value: "hello"},
-			candidate:   String{Null: true},
+			input:       StringValue("hello"),
+			candidate:   StringNull(),
 			expectation: false,
 		},
 		"value-wrongType": {
-			input:       String{Value: "hello"},
-			candidate:   Number{Value: big.NewFloat(123)},
+			input:       StringValue("hello"),
+			candidate:   NumberValue(big.NewFloat(123)),
 			expectation: false,
 		},
 		"value-nil": {
-			input:       String{Value: "hello"},
+			input:       StringValue("hello"),
 			candidate:   nil,
 			expectation: false,
 		},
 		"unknown-value": {
-			input:       String{Unknown: true},
-			candidate:   String{Value: "hello"},
+			input:       StringUnknown(),
+			candidate:   StringValue("hello"),
 			expectation: false,
 		},
 		"unknown-unknown": {
-			input:       String{Unknown: true},
-			candidate:   String{Unknown: true},
+			input:       StringUnknown(),
+			candidate:   StringUnknown(),
 			expectation: true,
 		},
 		"unknown-null": {
-			input:       String{Unknown: true},
-			candidate:   String{Null: true},
+			input:       StringUnknown(),
+			candidate:   StringNull(),
 			expectation: false,
 		},
 		"unknown-wrongType": {
-			input:       String{Unknown: true},
-			candidate:   Number{Value: big.NewFloat(123)},
+			input:       StringUnknown(),
+			candidate:   NumberValue(big.NewFloat(123)),
 			expectation: false,
 		},
 		"unknown-nil": {
-			input:       String{Unknown: true},
+			input:       StringUnknown(),
 			candidate:   nil,
 			expectation: false,
 		},
 		"null-value": {
-			input:       String{Null: true},
-			candidate:   String{Value: "hello"},
+			input:       StringNull(),
+			candidate:   StringValue("hello"),
 			expectation: false,
 		},
 		"null-unknown": {
-			input:       String{Null: true},
-			candidate:   String{Unknown: true},
+			input:       StringNull(),
+			candidate:   StringUnknown(),
 			expectation: false,
 		},
 		"null-null": {
-			input:       String{Null: true},
-			candidate:   String{Null: true},
+			input:       StringNull(),
+			candidate:   StringNull(),
 			expectation: true,
 		},
 		"null-wrongType": {
-			input:       String{Null: true},
-			candidate:   Number{Value: big.NewFloat(123)},
+			input:       StringNull(),
+			candidate:   NumberValue(big.NewFloat(123)),
 			expectation: false,
 		},
 		"null-nil": {
-			input:       String{Null: true},
+			input:       StringNull(),
 			candidate:   nil,
 			expectation: false,
 		},
@@ -232,32 +232,32 @@ func TestStringString(t *testing.T) {
 	}
 	tests := map[string]testCase{
 		"simple": {
-			input:       String{Value: "simple"},
+			input:       StringValue("simple"),
 			expectation: `"simple"`,
 		},
 		"long-string": {
-			input:       String{Value: "a really, really, really long string"},
+			input:       StringValue("a really, really, really long string"),
 			expectation: `"a really, really, really long string"`,
 		},
 		"empty-string": {
-			input:       String{Value: ""},
+			input:       StringValue(""),
 			expectation: `""`,
 		},
 		"quotes": {
-			input:       String{Value: `testing is "fun"`},
+			input:       StringValue(`testing is "fun"`),
 			expectation: `"testing is \"fun\""`,
 		},
 		"unknown": {
-			input:       String{Unknown: true},
+			input:       StringUnknown(),
 			expectation: "<unknown>",
 		},
 		"null": {
-			input:       String{Null: true},
+			input:       StringNull(),
 			expectation: "<null>",
 		},
-		"default-0": {
+		"zero-value": {
 			input:       String{},
-			expectation: `""`,
+			expectation: "<null>",
 		},
 	}
 
@@ -273,3 +273,39 @@ func TestStringString(t *testing.T) {
 		})
 	}
 }
+
+func TestStringValueString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    String
+		expected string
+	}{
+		"known": {
+			input:    StringValue("test"),
+			expected: "test",
+		},
+		"null": {
+			input:    StringNull(),
+			expected: "",
+		},
+		"unknown": {
+			input:    StringUnknown(),
+			expected: "",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.input.ValueString()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
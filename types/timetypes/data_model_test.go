@@ -0,0 +1,85 @@
+package timetypes_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types/timetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+type testDataModel struct {
+	CreatedAt time.Time     `tfsdk:"created_at"`
+	Timeout   time.Duration `tfsdk:"timeout"`
+}
+
+var testSchema = tfsdk.Schema{
+	Attributes: map[string]tfsdk.Attribute{
+		"created_at": {
+			Type:     timetypes.RFC3339Type{},
+			Required: true,
+		},
+		"timeout": {
+			Type:     timetypes.GoDurationType{},
+			Required: true,
+		},
+	},
+}
+
+func TestConfigGet(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	config := tfsdk.Config{
+		Schema: testSchema,
+		Raw: tftypes.NewValue(testSchema.TerraformType(ctx), map[string]tftypes.Value{
+			"created_at": tftypes.NewValue(tftypes.String, "2006-01-02T15:04:05+07:00"),
+			"timeout":    tftypes.NewValue(tftypes.String, "90s"),
+		}),
+	}
+
+	var got testDataModel
+
+	if diags := config.Get(ctx, &got); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if expected := time.Date(2006, 1, 2, 8, 4, 5, 0, time.UTC); !got.CreatedAt.Equal(expected) {
+		t.Errorf("expected created_at %s, got %s", expected, got.CreatedAt)
+	}
+
+	if expected := 90 * time.Second; got.Timeout != expected {
+		t.Errorf("expected timeout %s, got %s", expected, got.Timeout)
+	}
+}
+
+func TestStateSet(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	state := tfsdk.State{
+		Schema: testSchema,
+		Raw:    tftypes.NewValue(testSchema.TerraformType(ctx), nil),
+	}
+
+	diags := state.Set(ctx, testDataModel{
+		CreatedAt: time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC),
+		Timeout:   90 * time.Second,
+	})
+
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	expected := tftypes.NewValue(testSchema.TerraformType(ctx), map[string]tftypes.Value{
+		"created_at": tftypes.NewValue(tftypes.String, "2006-01-02T15:04:05Z"),
+		"timeout":    tftypes.NewValue(tftypes.String, "1m30s"),
+	})
+
+	if diff := cmp.Diff(state.Raw, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
@@ -0,0 +1,11 @@
+// Package timetypes contains attr.Type and attr.Value implementations for
+// attributes which hold timestamps and durations as Terraform strings.
+//
+// Values which are formatted differently but represent the same instant or
+// duration, such as the timestamps 2006-01-02T15:04:05Z and
+// 2006-01-02T15:04:05+00:00, are semantically equal, so the framework keeps
+// the prior value instead of reporting a difference.
+//
+// Values can be converted to time.Time and time.Duration with tfsdk.ValueAs or
+// in schema data models used with the Get and Set methods.
+package timetypes
@@ -0,0 +1,83 @@
+package timetypes
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ xattr.TypeWithValidate            = GoDurationType{}
+	_ attr.TypeWithPlaintextDescription = GoDurationType{}
+	_ attr.TypeWithMarkdownDescription  = GoDurationType{}
+)
+
+// GoDurationType is an attribute type for strings containing a duration in
+// the format accepted by time.ParseDuration, such as 1m30s. Values are
+// represented by GoDuration.
+//
+// Two durations which represent the same length of time, such as 90s and
+// 1m30s, are semantically equal, so the framework will keep the prior value
+// instead of reporting a difference when an API returns the duration
+// formatted differently.
+type GoDurationType struct{}
+
+// TerraformType returns tftypes.String.
+func (t GoDurationType) TerraformType(_ context.Context) tftypes.Type {
+	return tftypes.String
+}
+
+// ValueFromTerraform returns a GoDuration given a tftypes.Value.
+func (t GoDurationType) ValueFromTerraform(_ context.Context, in tftypes.Value) (attr.Value, error) {
+	state, value, err := valueFromTerraform(in, "a Go duration string")
+
+	if err != nil {
+		return nil, err
+	}
+
+	return GoDuration{state: state, value: value}, nil
+}
+
+// Equal returns true if `o` is also a GoDurationType.
+func (t GoDurationType) Equal(o attr.Type) bool {
+	_, ok := o.(GoDurationType)
+
+	return ok
+}
+
+// String returns a human-friendly description of the GoDurationType.
+func (t GoDurationType) String() string {
+	return "timetypes.GoDurationType"
+}
+
+// ApplyTerraform5AttributePathStep always returns an error, as durations
+// cannot be traversed with attribute paths.
+func (t GoDurationType) ApplyTerraform5AttributePathStep(step tftypes.AttributePathStep) (interface{}, error) {
+	return nil, fmt.Errorf("cannot apply AttributePathStep %T to %s", step, t.String())
+}
+
+// Validate returns an error diagnostic if the value is not a valid Go
+// duration string.
+func (t GoDurationType) Validate(_ context.Context, in tftypes.Value, path path.Path) diag.Diagnostics {
+	return validate(in, path, "Go Duration", func(s string) error {
+		_, err := time.ParseDuration(s)
+
+		return err
+	}, "A string value was provided that is not a valid Go duration string, such as 1m30s")
+}
+
+// Description returns a plain text description of the GoDurationType.
+func (t GoDurationType) Description(_ context.Context) string {
+	return "String containing a duration, such as 1m30s. Valid time units are ns, us, ms, s, m, and h. Durations representing the same length of time are considered equal."
+}
+
+// MarkdownDescription returns a Markdown description of the GoDurationType.
+func (t GoDurationType) MarkdownDescription(_ context.Context) string {
+	return "String containing a duration, such as `1m30s`. Valid time units are `ns`, `us`, `ms`, `s`, `m`, and `h`. Durations representing the same length of time are considered equal."
+}
@@ -0,0 +1,168 @@
+package timetypes_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/timetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestGoDurationTypeValueFromTerraform(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		in          tftypes.Value
+		expected    attr.Value
+		expectedErr string
+	}{
+		"known": {
+			in:       tftypes.NewValue(tftypes.String, "1m30s"),
+			expected: timetypes.GoDurationValue("1m30s"),
+		},
+		"null": {
+			in:       tftypes.NewValue(tftypes.String, nil),
+			expected: timetypes.GoDurationNull(),
+		},
+		"unknown": {
+			in:       tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			expected: timetypes.GoDurationUnknown(),
+		},
+		"wrong-type": {
+			in:          tftypes.NewValue(tftypes.Number, 123),
+			expectedErr: "can't use tftypes.Number as a Go duration string, expected tftypes.String",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := timetypes.GoDurationType{}.ValueFromTerraform(context.Background(), testCase.in)
+
+			if err != nil {
+				if testCase.expectedErr == "" {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if err.Error() != testCase.expectedErr {
+					t.Fatalf("expected error %q, got %q", testCase.expectedErr, err)
+				}
+
+				return
+			}
+
+			if testCase.expectedErr != "" {
+				t.Fatalf("expected error %q, got none", testCase.expectedErr)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestGoDurationTypeValidate(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		in            tftypes.Value
+		expectedDiags diag.Diagnostics
+	}{
+		"seconds": {
+			in: tftypes.NewValue(tftypes.String, "90s"),
+		},
+		"multiple-units": {
+			in: tftypes.NewValue(tftypes.String, "1h2m3.5s"),
+		},
+		"negative": {
+			in: tftypes.NewValue(tftypes.String, "-1.5h"),
+		},
+		"null": {
+			in: tftypes.NewValue(tftypes.String, nil),
+		},
+		"unknown": {
+			in: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		},
+		"missing-unit": {
+			in: tftypes.NewValue(tftypes.String, "90"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Go Duration String Value",
+					`A string value was provided that is not a valid Go duration string, such as 1m30s: time: missing unit in duration "90"`+"\n\n"+
+						"Given Value: 90",
+				),
+			},
+		},
+		"wrong-type": {
+			in: tftypes.NewValue(tftypes.Number, 123),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Go Duration Type Validation Error",
+					"An unexpected error was encountered trying to validate an attribute value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Expected String value, received tftypes.Value with value: tftypes.Number<\"123\">",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := timetypes.GoDurationType{}.Validate(context.Background(), testCase.in, path.Root("test"))
+
+			if diff := cmp.Diff(got, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestGoDurationTypeEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		other    attr.Type
+		expected bool
+	}{
+		"go-duration": {
+			other:    timetypes.GoDurationType{},
+			expected: true,
+		},
+		"rfc3339": {
+			other:    timetypes.RFC3339Type{},
+			expected: false,
+		},
+		"string": {
+			other:    types.StringType,
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := timetypes.GoDurationType{}.Equal(testCase.other)
+
+			if got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,159 @@
+package timetypes
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ xattr.ValueWithSemanticEquals = GoDuration{}
+)
+
+// GoDurationNull creates a GoDuration with a null value. Determine whether
+// the value is null via the GoDuration type IsNull method.
+func GoDurationNull() GoDuration {
+	return GoDuration{
+		state: attr.ValueStateNull,
+	}
+}
+
+// GoDurationUnknown creates a GoDuration with an unknown value. Determine
+// whether the value is unknown via the GoDuration type IsUnknown method.
+func GoDurationUnknown() GoDuration {
+	return GoDuration{
+		state: attr.ValueStateUnknown,
+	}
+}
+
+// GoDurationValue creates a GoDuration with a known value. The value is not
+// validated, which instead happens when the value is received from Terraform.
+// Access the value via the GoDuration type ValueString method.
+func GoDurationValue(value string) GoDuration {
+	return GoDuration{
+		state: attr.ValueStateKnown,
+		value: value,
+	}
+}
+
+// GoDurationValueFromDuration creates a GoDuration with a known value,
+// formatting the time.Duration with its String method, such as 1m30s.
+func GoDurationValueFromDuration(value time.Duration) GoDuration {
+	return GoDurationValue(value.String())
+}
+
+// GoDuration represents a duration as a string in the format accepted by
+// time.ParseDuration, such as 1m30s. The zero-value of GoDuration is null, use
+// the GoDurationNull, GoDurationUnknown, and GoDurationValue functions to
+// create a GoDuration.
+type GoDuration struct {
+	// state represents whether the value is null, unknown, or known. The
+	// zero-value is null.
+	state attr.ValueState
+
+	// value contains the known value, if not null or unknown.
+	value string
+}
+
+// Type returns a GoDurationType.
+func (d GoDuration) Type(_ context.Context) attr.Type {
+	return GoDurationType{}
+}
+
+// ToTerraformValue returns the data contained in the GoDuration as a
+// tftypes.Value.
+func (d GoDuration) ToTerraformValue(_ context.Context) (tftypes.Value, error) {
+	return toTerraformValue("GoDuration", d.state, d.value)
+}
+
+// Equal returns true if `other` is a GoDuration and has exactly the same
+// string value as `d`. Use SemanticEquals to compare the durations.
+func (d GoDuration) Equal(other attr.Value) bool {
+	o, ok := other.(GoDuration)
+
+	if !ok {
+		return false
+	}
+
+	return equal(d.state, d.value, o.state, o.value)
+}
+
+// SemanticEquals returns true if `other` is a GoDuration representing the
+// same length of time as `d`, such as 90s and 1m30s.
+func (d GoDuration) SemanticEquals(_ context.Context, other attr.Value) (bool, diag.Diagnostics) {
+	o, ok := other.(GoDuration)
+
+	if !ok {
+		return false, semanticEqualsTypeDiags(d, other)
+	}
+
+	if d.state != attr.ValueStateKnown || o.state != attr.ValueStateKnown || d.value == o.value {
+		return d.Equal(o), nil
+	}
+
+	dDuration, err := time.ParseDuration(d.value)
+
+	if err != nil {
+		return false, semanticEqualsParseDiags(err)
+	}
+
+	oDuration, err := time.ParseDuration(o.value)
+
+	if err != nil {
+		return false, semanticEqualsParseDiags(err)
+	}
+
+	return dDuration == oDuration, nil
+}
+
+// IsNull returns true if the GoDuration represents a null value.
+func (d GoDuration) IsNull() bool {
+	return d.state == attr.ValueStateNull
+}
+
+// IsUnknown returns true if the GoDuration represents a currently unknown
+// value.
+func (d GoDuration) IsUnknown() bool {
+	return d.state == attr.ValueStateUnknown
+}
+
+// String returns a human-readable representation of the GoDuration value.
+// The string returned here is not protected by any compatibility guarantees,
+// and is intended for logging and error reporting.
+func (d GoDuration) String() string {
+	return valueString(d.state, d.value)
+}
+
+// ValueString returns the known string value. If GoDuration is null or
+// unknown, returns "".
+func (d GoDuration) ValueString() string {
+	return d.value
+}
+
+// ValueGoDuration returns the known value as a time.Duration. An error
+// diagnostic is returned if the value is null or unknown, or is not a valid
+// Go duration string.
+func (d GoDuration) ValueGoDuration() (time.Duration, diag.Diagnostics) {
+	diags := knownValueDiags("Go Duration Conversion Error", "Go duration string", d.state)
+
+	if diags.HasError() {
+		return 0, diags
+	}
+
+	duration, err := time.ParseDuration(d.value)
+
+	if err != nil {
+		diags.AddError(
+			"Go Duration Conversion Error",
+			"Unable to parse Go duration string value: "+err.Error(),
+		)
+
+		return 0, diags
+	}
+
+	return duration, diags
+}
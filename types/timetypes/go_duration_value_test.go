@@ -0,0 +1,214 @@
+package timetypes_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/timetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestGoDurationToTerraformValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		duration timetypes.GoDuration
+		expected tftypes.Value
+	}{
+		"known": {
+			duration: timetypes.GoDurationValue("90s"),
+			expected: tftypes.NewValue(tftypes.String, "90s"),
+		},
+		"known-duration": {
+			duration: timetypes.GoDurationValueFromDuration(90 * time.Second),
+			expected: tftypes.NewValue(tftypes.String, "1m30s"),
+		},
+		"null": {
+			duration: timetypes.GoDurationNull(),
+			expected: tftypes.NewValue(tftypes.String, nil),
+		},
+		"unknown": {
+			duration: timetypes.GoDurationUnknown(),
+			expected: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		},
+		"zero-value": {
+			duration: timetypes.GoDuration{},
+			expected: tftypes.NewValue(tftypes.String, nil),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.duration.ToTerraformValue(context.Background())
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestGoDurationSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		duration      timetypes.GoDuration
+		other         attr.Value
+		expected      bool
+		expectedDiags diag.Diagnostics
+	}{
+		"identical": {
+			duration: timetypes.GoDurationValue("90s"),
+			other:    timetypes.GoDurationValue("90s"),
+			expected: true,
+		},
+		"different-units": {
+			duration: timetypes.GoDurationValue("90s"),
+			other:    timetypes.GoDurationValue("1m30s"),
+			expected: true,
+		},
+		"fractional": {
+			duration: timetypes.GoDurationValue("1.5h"),
+			other:    timetypes.GoDurationValue("90m"),
+			expected: true,
+		},
+		"zero": {
+			duration: timetypes.GoDurationValue("0"),
+			other:    timetypes.GoDurationValue("0s"),
+			expected: true,
+		},
+		"different-duration": {
+			duration: timetypes.GoDurationValue("90s"),
+			other:    timetypes.GoDurationValue("1m31s"),
+			expected: false,
+		},
+		"null-null": {
+			duration: timetypes.GoDurationNull(),
+			other:    timetypes.GoDurationNull(),
+			expected: true,
+		},
+		"known-unknown": {
+			duration: timetypes.GoDurationValue("90s"),
+			other:    timetypes.GoDurationUnknown(),
+			expected: false,
+		},
+		"invalid": {
+			duration: timetypes.GoDurationValue("90s"),
+			other:    timetypes.GoDurationValue("90"),
+			expected: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected error occurred while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						`Error: time: missing unit in duration "90"`,
+				),
+			},
+		},
+		"wrong-type": {
+			duration: timetypes.GoDurationValue("90s"),
+			other:    types.StringValue("90s"),
+			expected: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: timetypes.GoDuration\n"+
+						"Got Value Type: types.String",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.duration.SemanticEquals(context.Background(), testCase.other)
+
+			if got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestGoDurationValueGoDuration(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		duration      timetypes.GoDuration
+		expected      time.Duration
+		expectedDiags diag.Diagnostics
+	}{
+		"known": {
+			duration: timetypes.GoDurationValue("1m30s"),
+			expected: 90 * time.Second,
+		},
+		"null": {
+			duration: timetypes.GoDurationNull(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Go Duration Conversion Error",
+					"Go duration string value is null",
+				),
+			},
+		},
+		"unknown": {
+			duration: timetypes.GoDurationUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Go Duration Conversion Error",
+					"Go duration string value is unknown",
+				),
+			},
+		},
+		"invalid": {
+			duration: timetypes.GoDurationValue("90"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Go Duration Conversion Error",
+					`Unable to parse Go duration string value: time: missing unit in duration "90"`,
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.duration.ValueGoDuration()
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if got != testCase.expected {
+				t.Errorf("expected %s, got %s", testCase.expected, got)
+			}
+		})
+	}
+}
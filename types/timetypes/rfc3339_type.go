@@ -0,0 +1,83 @@
+package timetypes
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ xattr.TypeWithValidate            = RFC3339Type{}
+	_ attr.TypeWithPlaintextDescription = RFC3339Type{}
+	_ attr.TypeWithMarkdownDescription  = RFC3339Type{}
+)
+
+// RFC3339Type is an attribute type for strings containing a timestamp in
+// RFC 3339 format, such as 2006-01-02T15:04:05Z. Values are represented by
+// RFC3339.
+//
+// Two timestamps which represent the same instant, such as
+// 2006-01-02T15:04:05Z and 2006-01-02T15:04:05+00:00, are semantically equal,
+// so the framework will keep the prior value instead of reporting a
+// difference when an API returns the timestamp in another time zone.
+type RFC3339Type struct{}
+
+// TerraformType returns tftypes.String.
+func (t RFC3339Type) TerraformType(_ context.Context) tftypes.Type {
+	return tftypes.String
+}
+
+// ValueFromTerraform returns an RFC3339 given a tftypes.Value.
+func (t RFC3339Type) ValueFromTerraform(_ context.Context, in tftypes.Value) (attr.Value, error) {
+	state, value, err := valueFromTerraform(in, "an RFC3339 string")
+
+	if err != nil {
+		return nil, err
+	}
+
+	return RFC3339{state: state, value: value}, nil
+}
+
+// Equal returns true if `o` is also an RFC3339Type.
+func (t RFC3339Type) Equal(o attr.Type) bool {
+	_, ok := o.(RFC3339Type)
+
+	return ok
+}
+
+// String returns a human-friendly description of the RFC3339Type.
+func (t RFC3339Type) String() string {
+	return "timetypes.RFC3339Type"
+}
+
+// ApplyTerraform5AttributePathStep always returns an error, as timestamps
+// cannot be traversed with attribute paths.
+func (t RFC3339Type) ApplyTerraform5AttributePathStep(step tftypes.AttributePathStep) (interface{}, error) {
+	return nil, fmt.Errorf("cannot apply AttributePathStep %T to %s", step, t.String())
+}
+
+// Validate returns an error diagnostic if the value is not a valid RFC 3339
+// timestamp.
+func (t RFC3339Type) Validate(_ context.Context, in tftypes.Value, path path.Path) diag.Diagnostics {
+	return validate(in, path, "RFC3339", func(s string) error {
+		_, err := time.Parse(time.RFC3339, s)
+
+		return err
+	}, "A string value was provided that is not valid RFC3339 string format")
+}
+
+// Description returns a plain text description of the RFC3339Type.
+func (t RFC3339Type) Description(_ context.Context) string {
+	return "String containing an RFC 3339 timestamp, such as 2006-01-02T15:04:05Z. Timestamps representing the same instant are considered equal."
+}
+
+// MarkdownDescription returns a Markdown description of the RFC3339Type.
+func (t RFC3339Type) MarkdownDescription(_ context.Context) string {
+	return "String containing an [RFC 3339](https://www.rfc-editor.org/rfc/rfc3339) timestamp, such as `2006-01-02T15:04:05Z`. Timestamps representing the same instant are considered equal."
+}
@@ -0,0 +1,168 @@
+package timetypes_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/timetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestRFC3339TypeValueFromTerraform(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		in          tftypes.Value
+		expected    attr.Value
+		expectedErr string
+	}{
+		"known": {
+			in:       tftypes.NewValue(tftypes.String, "2006-01-02T15:04:05Z"),
+			expected: timetypes.RFC3339Value("2006-01-02T15:04:05Z"),
+		},
+		"null": {
+			in:       tftypes.NewValue(tftypes.String, nil),
+			expected: timetypes.RFC3339Null(),
+		},
+		"unknown": {
+			in:       tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			expected: timetypes.RFC3339Unknown(),
+		},
+		"wrong-type": {
+			in:          tftypes.NewValue(tftypes.Number, 123),
+			expectedErr: "can't use tftypes.Number as an RFC3339 string, expected tftypes.String",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := timetypes.RFC3339Type{}.ValueFromTerraform(context.Background(), testCase.in)
+
+			if err != nil {
+				if testCase.expectedErr == "" {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if err.Error() != testCase.expectedErr {
+					t.Fatalf("expected error %q, got %q", testCase.expectedErr, err)
+				}
+
+				return
+			}
+
+			if testCase.expectedErr != "" {
+				t.Fatalf("expected error %q, got none", testCase.expectedErr)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestRFC3339TypeValidate(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		in            tftypes.Value
+		expectedDiags diag.Diagnostics
+	}{
+		"utc": {
+			in: tftypes.NewValue(tftypes.String, "2006-01-02T15:04:05Z"),
+		},
+		"offset": {
+			in: tftypes.NewValue(tftypes.String, "2006-01-02T15:04:05+07:00"),
+		},
+		"fractional-seconds": {
+			in: tftypes.NewValue(tftypes.String, "2006-01-02T15:04:05.999999999Z"),
+		},
+		"null": {
+			in: tftypes.NewValue(tftypes.String, nil),
+		},
+		"unknown": {
+			in: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		},
+		"missing-offset": {
+			in: tftypes.NewValue(tftypes.String, "2006-01-02T15:04:05"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid RFC3339 String Value",
+					`A string value was provided that is not valid RFC3339 string format: parsing time "2006-01-02T15:04:05" as "2006-01-02T15:04:05Z07:00": cannot parse "" as "Z07:00"`+"\n\n"+
+						"Given Value: 2006-01-02T15:04:05",
+				),
+			},
+		},
+		"wrong-type": {
+			in: tftypes.NewValue(tftypes.Number, 123),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"RFC3339 Type Validation Error",
+					"An unexpected error was encountered trying to validate an attribute value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Expected String value, received tftypes.Value with value: tftypes.Number<\"123\">",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := timetypes.RFC3339Type{}.Validate(context.Background(), testCase.in, path.Root("test"))
+
+			if diff := cmp.Diff(got, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestRFC3339TypeEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		other    attr.Type
+		expected bool
+	}{
+		"rfc3339": {
+			other:    timetypes.RFC3339Type{},
+			expected: true,
+		},
+		"go-duration": {
+			other:    timetypes.GoDurationType{},
+			expected: false,
+		},
+		"string": {
+			other:    types.StringType,
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := timetypes.RFC3339Type{}.Equal(testCase.other)
+
+			if got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
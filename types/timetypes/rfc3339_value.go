@@ -0,0 +1,159 @@
+package timetypes
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ xattr.ValueWithSemanticEquals = RFC3339{}
+)
+
+// RFC3339Null creates an RFC3339 with a null value. Determine whether the
+// value is null via the RFC3339 type IsNull method.
+func RFC3339Null() RFC3339 {
+	return RFC3339{
+		state: attr.ValueStateNull,
+	}
+}
+
+// RFC3339Unknown creates an RFC3339 with an unknown value. Determine whether
+// the value is unknown via the RFC3339 type IsUnknown method.
+func RFC3339Unknown() RFC3339 {
+	return RFC3339{
+		state: attr.ValueStateUnknown,
+	}
+}
+
+// RFC3339Value creates an RFC3339 with a known value. The value is not
+// validated, which instead happens when the value is received from Terraform.
+// Access the value via the RFC3339 type ValueString method.
+func RFC3339Value(value string) RFC3339 {
+	return RFC3339{
+		state: attr.ValueStateKnown,
+		value: value,
+	}
+}
+
+// RFC3339ValueFromTime creates an RFC3339 with a known value, formatting the
+// time.Time as an RFC 3339 timestamp with any fractional seconds.
+func RFC3339ValueFromTime(value time.Time) RFC3339 {
+	return RFC3339Value(value.Format(time.RFC3339Nano))
+}
+
+// RFC3339 represents an RFC 3339 timestamp as a string, such as
+// 2006-01-02T15:04:05Z. The zero-value of RFC3339 is null, use the
+// RFC3339Null, RFC3339Unknown, and RFC3339Value functions to create an
+// RFC3339.
+type RFC3339 struct {
+	// state represents whether the value is null, unknown, or known. The
+	// zero-value is null.
+	state attr.ValueState
+
+	// value contains the known value, if not null or unknown.
+	value string
+}
+
+// Type returns an RFC3339Type.
+func (r RFC3339) Type(_ context.Context) attr.Type {
+	return RFC3339Type{}
+}
+
+// ToTerraformValue returns the data contained in the RFC3339 as a
+// tftypes.Value.
+func (r RFC3339) ToTerraformValue(_ context.Context) (tftypes.Value, error) {
+	return toTerraformValue("RFC3339", r.state, r.value)
+}
+
+// Equal returns true if `other` is an RFC3339 and has exactly the same string
+// value as `r`. Use SemanticEquals to compare the instants.
+func (r RFC3339) Equal(other attr.Value) bool {
+	o, ok := other.(RFC3339)
+
+	if !ok {
+		return false
+	}
+
+	return equal(r.state, r.value, o.state, o.value)
+}
+
+// SemanticEquals returns true if `other` is an RFC3339 representing the same
+// instant as `r`, even if written with a different time zone offset, such as
+// 2006-01-02T15:04:05Z and 2006-01-02T17:04:05+02:00.
+func (r RFC3339) SemanticEquals(_ context.Context, other attr.Value) (bool, diag.Diagnostics) {
+	o, ok := other.(RFC3339)
+
+	if !ok {
+		return false, semanticEqualsTypeDiags(r, other)
+	}
+
+	if r.state != attr.ValueStateKnown || o.state != attr.ValueStateKnown || r.value == o.value {
+		return r.Equal(o), nil
+	}
+
+	rTime, err := time.Parse(time.RFC3339, r.value)
+
+	if err != nil {
+		return false, semanticEqualsParseDiags(err)
+	}
+
+	oTime, err := time.Parse(time.RFC3339, o.value)
+
+	if err != nil {
+		return false, semanticEqualsParseDiags(err)
+	}
+
+	return rTime.Equal(oTime), nil
+}
+
+// IsNull returns true if the RFC3339 represents a null value.
+func (r RFC3339) IsNull() bool {
+	return r.state == attr.ValueStateNull
+}
+
+// IsUnknown returns true if the RFC3339 represents a currently unknown value.
+func (r RFC3339) IsUnknown() bool {
+	return r.state == attr.ValueStateUnknown
+}
+
+// String returns a human-readable representation of the RFC3339 value. The
+// string returned here is not protected by any compatibility guarantees, and
+// is intended for logging and error reporting.
+func (r RFC3339) String() string {
+	return valueString(r.state, r.value)
+}
+
+// ValueString returns the known string value. If RFC3339 is null or unknown,
+// returns "".
+func (r RFC3339) ValueString() string {
+	return r.value
+}
+
+// ValueRFC3339Time returns the known value as a time.Time. An error diagnostic
+// is returned if the value is null or unknown, or is not a valid RFC 3339
+// timestamp.
+func (r RFC3339) ValueRFC3339Time() (time.Time, diag.Diagnostics) {
+	diags := knownValueDiags("RFC3339 Conversion Error", "RFC3339 string", r.state)
+
+	if diags.HasError() {
+		return time.Time{}, diags
+	}
+
+	t, err := time.Parse(time.RFC3339, r.value)
+
+	if err != nil {
+		diags.AddError(
+			"RFC3339 Conversion Error",
+			"Unable to parse RFC3339 string value: "+err.Error(),
+		)
+
+		return time.Time{}, diags
+	}
+
+	return t, diags
+}
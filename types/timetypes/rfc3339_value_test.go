@@ -0,0 +1,214 @@
+package timetypes_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/timetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestRFC3339ToTerraformValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		rfc3339  timetypes.RFC3339
+		expected tftypes.Value
+	}{
+		"known": {
+			rfc3339:  timetypes.RFC3339Value("2006-01-02T15:04:05Z"),
+			expected: tftypes.NewValue(tftypes.String, "2006-01-02T15:04:05Z"),
+		},
+		"known-time": {
+			rfc3339:  timetypes.RFC3339ValueFromTime(time.Date(2006, 1, 2, 15, 4, 5, 500000000, time.FixedZone("", 7*60*60))),
+			expected: tftypes.NewValue(tftypes.String, "2006-01-02T15:04:05.5+07:00"),
+		},
+		"null": {
+			rfc3339:  timetypes.RFC3339Null(),
+			expected: tftypes.NewValue(tftypes.String, nil),
+		},
+		"unknown": {
+			rfc3339:  timetypes.RFC3339Unknown(),
+			expected: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		},
+		"zero-value": {
+			rfc3339:  timetypes.RFC3339{},
+			expected: tftypes.NewValue(tftypes.String, nil),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.rfc3339.ToTerraformValue(context.Background())
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestRFC3339SemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		rfc3339       timetypes.RFC3339
+		other         attr.Value
+		expected      bool
+		expectedDiags diag.Diagnostics
+	}{
+		"identical": {
+			rfc3339:  timetypes.RFC3339Value("2006-01-02T15:04:05Z"),
+			other:    timetypes.RFC3339Value("2006-01-02T15:04:05Z"),
+			expected: true,
+		},
+		"utc-offset": {
+			rfc3339:  timetypes.RFC3339Value("2006-01-02T15:04:05Z"),
+			other:    timetypes.RFC3339Value("2006-01-02T15:04:05+00:00"),
+			expected: true,
+		},
+		"different-offset-same-instant": {
+			rfc3339:  timetypes.RFC3339Value("2006-01-02T15:04:05Z"),
+			other:    timetypes.RFC3339Value("2006-01-02T08:04:05-07:00"),
+			expected: true,
+		},
+		"fractional-seconds": {
+			rfc3339:  timetypes.RFC3339Value("2006-01-02T15:04:05Z"),
+			other:    timetypes.RFC3339Value("2006-01-02T15:04:05.000Z"),
+			expected: true,
+		},
+		"different-instant": {
+			rfc3339:  timetypes.RFC3339Value("2006-01-02T15:04:05Z"),
+			other:    timetypes.RFC3339Value("2006-01-02T15:04:05+01:00"),
+			expected: false,
+		},
+		"null-null": {
+			rfc3339:  timetypes.RFC3339Null(),
+			other:    timetypes.RFC3339Null(),
+			expected: true,
+		},
+		"known-unknown": {
+			rfc3339:  timetypes.RFC3339Value("2006-01-02T15:04:05Z"),
+			other:    timetypes.RFC3339Unknown(),
+			expected: false,
+		},
+		"invalid": {
+			rfc3339:  timetypes.RFC3339Value("2006-01-02T15:04:05Z"),
+			other:    timetypes.RFC3339Value("2006-01-02"),
+			expected: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected error occurred while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						`Error: parsing time "2006-01-02" as "2006-01-02T15:04:05Z07:00": cannot parse "" as "T"`,
+				),
+			},
+		},
+		"wrong-type": {
+			rfc3339:  timetypes.RFC3339Value("2006-01-02T15:04:05Z"),
+			other:    types.StringValue("2006-01-02T15:04:05Z"),
+			expected: false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Semantic Equality Check Error",
+					"An unexpected value type was received while performing semantic equality checks. "+
+						"Please report this to the provider developers.\n\n"+
+						"Expected Value Type: timetypes.RFC3339\n"+
+						"Got Value Type: types.String",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.rfc3339.SemanticEquals(context.Background(), testCase.other)
+
+			if got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestRFC3339ValueRFC3339Time(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		rfc3339       timetypes.RFC3339
+		expected      time.Time
+		expectedDiags diag.Diagnostics
+	}{
+		"known": {
+			rfc3339:  timetypes.RFC3339Value("2006-01-02T15:04:05Z"),
+			expected: time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC),
+		},
+		"null": {
+			rfc3339: timetypes.RFC3339Null(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"RFC3339 Conversion Error",
+					"RFC3339 string value is null",
+				),
+			},
+		},
+		"unknown": {
+			rfc3339: timetypes.RFC3339Unknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"RFC3339 Conversion Error",
+					"RFC3339 string value is unknown",
+				),
+			},
+		},
+		"invalid": {
+			rfc3339: timetypes.RFC3339Value("not-a-time"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"RFC3339 Conversion Error",
+					`Unable to parse RFC3339 string value: parsing time "not-a-time" as "2006-01-02T15:04:05Z07:00": cannot parse "not-a-time" as "2006"`,
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.rfc3339.ValueRFC3339Time()
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if !got.Equal(testCase.expected) {
+				t.Errorf("expected %s, got %s", testCase.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package timetypes
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// valueFromTerraform returns the state and string value of a tftypes.Value
+// for a string based time type. The description, such as "a Go duration
+// string", is used in errors.
+func valueFromTerraform(in tftypes.Value, description string) (attr.ValueState, string, error) {
+	if in.Type() == nil {
+		return attr.ValueStateNull, "", nil
+	}
+
+	if !in.Type().Equal(tftypes.String) {
+		return attr.ValueStateNull, "", fmt.Errorf("can't use %s as %s, expected tftypes.String", in.Type(), description)
+	}
+
+	if !in.IsKnown() {
+		return attr.ValueStateUnknown, "", nil
+	}
+
+	if in.IsNull() {
+		return attr.ValueStateNull, "", nil
+	}
+
+	var s string
+
+	if err := in.As(&s); err != nil {
+		return attr.ValueStateNull, "", err
+	}
+
+	return attr.ValueStateKnown, s, nil
+}
+
+// validate returns an error diagnostic if the value of a string based time
+// type is not a string or is not accepted by parse. The name, such as
+// "Go Duration", is used in diagnostic summaries, and invalidDetail is the
+// start of the diagnostic detail for values rejected by parse.
+func validate(in tftypes.Value, path path.Path, name string, parse func(string) error, invalidDetail string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if in.Type() == nil {
+		return diags
+	}
+
+	if !in.Type().Equal(tftypes.String) {
+		diags.AddAttributeError(
+			path,
+			name+" Type Validation Error",
+			"An unexpected error was encountered trying to validate an attribute value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("Expected String value, received %T with value: %v", in, in),
+		)
+		return diags
+	}
+
+	if !in.IsKnown() || in.IsNull() {
+		return diags
+	}
+
+	var s string
+
+	if err := in.As(&s); err != nil {
+		diags.AddAttributeError(
+			path,
+			name+" Type Validation Error",
+			"An unexpected error was encountered trying to validate an attribute value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("Cannot convert value to string: %s", err),
+		)
+		return diags
+	}
+
+	if err := parse(s); err != nil {
+		diags.AddAttributeError(
+			path,
+			"Invalid "+name+" String Value",
+			invalidDetail+": "+err.Error()+"\n\n"+
+				"Given Value: "+s,
+		)
+		return diags
+	}
+
+	return diags
+}
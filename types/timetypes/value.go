@@ -0,0 +1,99 @@
+package timetypes
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// toTerraformValue returns the tftypes.Value of a string based time value.
+// The name of the value type is used if the state is unhandled.
+func toTerraformValue(name string, state attr.ValueState, value string) (tftypes.Value, error) {
+	switch state {
+	case attr.ValueStateKnown:
+		if err := tftypes.ValidateValue(tftypes.String, value); err != nil {
+			return tftypes.NewValue(tftypes.String, tftypes.UnknownValue), err
+		}
+
+		return tftypes.NewValue(tftypes.String, value), nil
+	case attr.ValueStateNull:
+		return tftypes.NewValue(tftypes.String, nil), nil
+	case attr.ValueStateUnknown:
+		return tftypes.NewValue(tftypes.String, tftypes.UnknownValue), nil
+	default:
+		panic(fmt.Sprintf("unhandled %s state in ToTerraformValue: %s", name, state))
+	}
+}
+
+// equal returns true if two string based time values have the same state
+// and, if known, exactly the same string value.
+func equal(aState attr.ValueState, a string, bState attr.ValueState, b string) bool {
+	if aState != bState {
+		return false
+	}
+
+	if aState != attr.ValueStateKnown {
+		return true
+	}
+
+	return a == b
+}
+
+// valueString returns the human-readable representation of a string based
+// time value.
+func valueString(state attr.ValueState, value string) string {
+	switch state {
+	case attr.ValueStateNull:
+		return attr.NullValueString
+	case attr.ValueStateUnknown:
+		return attr.UnknownValueString
+	default:
+		return fmt.Sprintf("%q", value)
+	}
+}
+
+// knownValueDiags returns an error diagnostic if a string based time value is
+// null or unknown, and so cannot be converted to a Go time value. The
+// description, such as "Go duration string", is used in the diagnostic
+// detail.
+func knownValueDiags(summary string, description string, state attr.ValueState) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	switch state {
+	case attr.ValueStateNull:
+		diags.AddError(summary, description+" value is null")
+	case attr.ValueStateUnknown:
+		diags.AddError(summary, description+" value is unknown")
+	}
+
+	return diags
+}
+
+// semanticEqualsTypeDiags returns the error diagnostics for a semantic equality
+// check against a value of an unexpected type.
+func semanticEqualsTypeDiags(expected attr.Value, got attr.Value) diag.Diagnostics {
+	return diag.Diagnostics{
+		diag.NewErrorDiagnostic(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				fmt.Sprintf("Expected Value Type: %T\n", expected)+
+				fmt.Sprintf("Got Value Type: %T", got),
+		),
+	}
+}
+
+// semanticEqualsParseDiags returns the error diagnostics for a semantic
+// equality check of a value which cannot be parsed.
+func semanticEqualsParseDiags(err error) diag.Diagnostics {
+	return diag.Diagnostics{
+		diag.NewErrorDiagnostic(
+			"Semantic Equality Check Error",
+			"An unexpected error occurred while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		),
+	}
+}
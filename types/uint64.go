@@ -0,0 +1,213 @@
+package types
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ attr.Value = Uint64{}
+)
+
+func uint64Validate(_ context.Context, in tftypes.Value, path path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if in.Type() == nil {
+		return diags
+	}
+
+	if !in.Type().Equal(tftypes.Number) {
+		diags.AddAttributeError(
+			path,
+			"Uint64 Type Validation Error",
+			"An unexpected error was encountered trying to validate an attribute value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("Expected Number value, received %T with value: %v", in, in),
+		)
+		return diags
+	}
+
+	if !in.IsKnown() || in.IsNull() {
+		return diags
+	}
+
+	var value *big.Float
+	err := in.As(&value)
+
+	if err != nil {
+		diags.AddAttributeError(
+			path,
+			"Uint64 Type Validation Error",
+			"An unexpected error was encountered trying to validate an attribute value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("Cannot convert value to big.Float: %s", err),
+		)
+		return diags
+	}
+
+	if !value.IsInt() {
+		diags.AddAttributeError(
+			path,
+			"Uint64 Type Validation Error",
+			fmt.Sprintf("Value %s is not an integer.", value.Text('f', -1)),
+		)
+		return diags
+	}
+
+	_, accuracy := value.Uint64()
+
+	if accuracy != 0 {
+		diags.AddAttributeError(
+			path,
+			"Uint64 Type Validation Error",
+			fmt.Sprintf("Value %s cannot be represented as an unsigned 64-bit integer.", value.Text('f', -1)),
+		)
+		return diags
+	}
+
+	return diags
+}
+
+// Uint64Null creates a Uint64 with a null value. Determine whether the value
+// is null via the Uint64 type IsNull method.
+func Uint64Null() Uint64 {
+	return Uint64{
+		state: attr.ValueStateNull,
+	}
+}
+
+// Uint64Unknown creates a Uint64 with an unknown value. Determine whether
+// the value is unknown via the Uint64 type IsUnknown method.
+func Uint64Unknown() Uint64 {
+	return Uint64{
+		state: attr.ValueStateUnknown,
+	}
+}
+
+// Uint64Value creates a Uint64 with a known value. Access the value via
+// the Uint64 type ValueUint64 method.
+func Uint64Value(value uint64) Uint64 {
+	return Uint64{
+		state: attr.ValueStateKnown,
+		value: value,
+	}
+}
+
+func uint64ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	if !in.IsKnown() {
+		return Uint64Unknown(), nil
+	}
+
+	if in.IsNull() {
+		return Uint64Null(), nil
+	}
+
+	var bigF *big.Float
+	err := in.As(&bigF)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !bigF.IsInt() {
+		return nil, fmt.Errorf("Value %s is not an integer.", bigF.Text('f', -1))
+	}
+
+	i, accuracy := bigF.Uint64()
+
+	if accuracy != 0 {
+		return nil, fmt.Errorf("Value %s cannot be represented as an unsigned 64-bit integer.", bigF.Text('f', -1))
+	}
+
+	return Uint64Value(i), nil
+}
+
+// Uint64 represents an unsigned 64-bit integer value, exposed as a uint64.
+// The zero-value of Uint64 is null, use the Uint64Null, Uint64Unknown, and
+// Uint64Value functions to create a Uint64.
+type Uint64 struct {
+	// state represents whether the value is null, unknown, or known. The
+	// zero-value is null.
+	state attr.ValueState
+
+	// value contains the known value, if not null or unknown.
+	value uint64
+}
+
+// Equal returns true if `other` is a Uint64 and has the same value as `u`.
+func (u Uint64) Equal(other attr.Value) bool {
+	o, ok := other.(Uint64)
+
+	if !ok {
+		return false
+	}
+
+	if u.state != o.state {
+		return false
+	}
+
+	if u.state != attr.ValueStateKnown {
+		return true
+	}
+
+	return u.value == o.value
+}
+
+// ToTerraformValue returns the data contained in the Uint64 as a tftypes.Value.
+func (u Uint64) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	switch u.state {
+	case attr.ValueStateKnown:
+		bf := new(big.Float).SetUint64(u.value)
+		if err := tftypes.ValidateValue(tftypes.Number, bf); err != nil {
+			return tftypes.NewValue(tftypes.Number, tftypes.UnknownValue), err
+		}
+
+		return tftypes.NewValue(tftypes.Number, bf), nil
+	case attr.ValueStateNull:
+		return tftypes.NewValue(tftypes.Number, nil), nil
+	case attr.ValueStateUnknown:
+		return tftypes.NewValue(tftypes.Number, tftypes.UnknownValue), nil
+	default:
+		panic(fmt.Sprintf("unhandled Uint64 state in ToTerraformValue: %s", u.state))
+	}
+}
+
+// Type returns a Uint64Type.
+func (u Uint64) Type(ctx context.Context) attr.Type {
+	return Uint64Type
+}
+
+// IsNull returns true if the Uint64 represents a null value.
+func (u Uint64) IsNull() bool {
+	return u.state == attr.ValueStateNull
+}
+
+// IsUnknown returns true if the Uint64 represents a currently unknown value.
+func (u Uint64) IsUnknown() bool {
+	return u.state == attr.ValueStateUnknown
+}
+
+// String returns a human-readable representation of the Uint64 value.
+// The string returned here is not protected by any compatibility guarantees,
+// and is intended for logging and error reporting.
+func (u Uint64) String() string {
+	if u.IsUnknown() {
+		return attr.UnknownValueString
+	}
+
+	if u.IsNull() {
+		return attr.NullValueString
+	}
+
+	return fmt.Sprintf("%d", u.value)
+}
+
+// ValueUint64 returns the known uint64 value. If Uint64 is null or unknown,
+// returns 0.
+func (u Uint64) ValueUint64() uint64 {
+	return u.value
+}
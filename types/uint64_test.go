@@ -0,0 +1,328 @@
+package types
+
+import (
+	"context"
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestUint64ValueFromTerraform(t *testing.T) {
+	t.Parallel()
+
+	testUint64ValueFromTerraform(t, true)
+}
+
+func testUint64ValueFromTerraform(t *testing.T, direct bool) {
+	type testCase struct {
+		input       tftypes.Value
+		expectation attr.Value
+		expectedErr string
+	}
+	tests := map[string]testCase{
+		"value": {
+			input:       tftypes.NewValue(tftypes.Number, 123),
+			expectation: Uint64Value(123),
+		},
+		"unknown": {
+			input:       tftypes.NewValue(tftypes.Number, tftypes.UnknownValue),
+			expectation: Uint64Unknown(),
+		},
+		"null": {
+			input:       tftypes.NewValue(tftypes.Number, nil),
+			expectation: Uint64Null(),
+		},
+		"wrongType": {
+			input:       tftypes.NewValue(tftypes.String, "oops"),
+			expectedErr: "can't unmarshal tftypes.String into *big.Float, expected *big.Float",
+		},
+		"max-uint64": {
+			input:       tftypes.NewValue(tftypes.Number, new(big.Float).SetUint64(math.MaxUint64)),
+			expectation: Uint64Value(math.MaxUint64),
+		},
+		"overflow": {
+			input:       tftypes.NewValue(tftypes.Number, new(big.Float).Add(new(big.Float).SetUint64(math.MaxUint64), big.NewFloat(1))),
+			expectedErr: "Value 18446744073709551616 cannot be represented as an unsigned 64-bit integer.",
+		},
+		"negative": {
+			input:       tftypes.NewValue(tftypes.Number, -1),
+			expectedErr: "Value -1 cannot be represented as an unsigned 64-bit integer.",
+		},
+		"not-integer": {
+			input:       tftypes.NewValue(tftypes.Number, 123.456),
+			expectedErr: "Value 123.456 is not an integer.",
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+
+			f := Uint64Type.ValueFromTerraform
+			if direct {
+				f = uint64ValueFromTerraform
+			}
+			got, err := f(ctx, test.input)
+			if err != nil {
+				if test.expectedErr == "" {
+					t.Errorf("Unexpected error: %s", err)
+					return
+				}
+				if test.expectedErr != err.Error() {
+					t.Errorf("Expected error to be %q, got %q", test.expectedErr, err.Error())
+					return
+				}
+				// we have an error, and it matches our
+				// expectations, we're good
+				return
+			}
+			if err == nil && test.expectedErr != "" {
+				t.Errorf("Expected error to be %q, didn't get an error", test.expectedErr)
+				return
+			}
+			if !got.Equal(test.expectation) {
+				t.Errorf("Expected %+v, got %+v", test.expectation, got)
+			}
+			if test.expectation.IsNull() != test.input.IsNull() {
+				t.Errorf("Expected null-ness match: expected %t, got %t", test.expectation.IsNull(), test.input.IsNull())
+			}
+			if test.expectation.IsUnknown() != !test.input.IsKnown() {
+				t.Errorf("Expected unknown-ness match: expected %t, got %t", test.expectation.IsUnknown(), !test.input.IsKnown())
+			}
+		})
+	}
+}
+
+func TestUint64ToTerraformValue(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		input       Uint64
+		expectation interface{}
+	}
+	tests := map[string]testCase{
+		"value": {
+			input:       Uint64Value(123),
+			expectation: tftypes.NewValue(tftypes.Number, big.NewFloat(123)),
+		},
+		"unknown": {
+			input:       Uint64Unknown(),
+			expectation: tftypes.NewValue(tftypes.Number, tftypes.UnknownValue),
+		},
+		"null": {
+			input:       Uint64Null(),
+			expectation: tftypes.NewValue(tftypes.Number, nil),
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+
+			got, err := test.input.ToTerraformValue(ctx)
+			if err != nil {
+				t.Errorf("Unexpected error: %s", err)
+				return
+			}
+			if !cmp.Equal(got, test.expectation, cmp.Comparer(numberComparer)) {
+				t.Errorf("Expected %+v, got %+v", test.expectation, got)
+			}
+		})
+	}
+}
+
+func TestUint64Equal(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		input       Uint64
+		candidate   attr.Value
+		expectation bool
+	}
+	tests := map[string]testCase{
+		"value-value-same": {
+			input:       Uint64Value(123),
+			candidate:   Uint64Value(123),
+			expectation: true,
+		},
+		"value-value-diff": {
+			input:       Uint64Value(123),
+			candidate:   Uint64Value(456),
+			expectation: false,
+		},
+		"value-unknown": {
+			input:       Uint64Value(123),
+			candidate:   Uint64Unknown(),
+			expectation: false,
+		},
+		"value-null": {
+			input:       Uint64Value(123),
+			candidate:   Uint64Null(),
+			expectation: false,
+		},
+		"value-wrongType": {
+			input:       Uint64Value(123),
+			candidate:   StringValue("oops"),
+			expectation: false,
+		},
+		"value-nil": {
+			input:       Uint64Value(123),
+			candidate:   nil,
+			expectation: false,
+		},
+		"unknown-value": {
+			input:       Uint64Unknown(),
+			candidate:   Uint64Value(123),
+			expectation: false,
+		},
+		"unknown-unknown": {
+			input:       Uint64Unknown(),
+			candidate:   Uint64Unknown(),
+			expectation: true,
+		},
+		"unknown-null": {
+			input:       Uint64Unknown(),
+			candidate:   Uint64Null(),
+			expectation: false,
+		},
+		"unknown-wrongType": {
+			input:       Uint64Unknown(),
+			candidate:   StringValue("oops"),
+			expectation: false,
+		},
+		"unknown-nil": {
+			input:       Uint64Unknown(),
+			candidate:   nil,
+			expectation: false,
+		},
+		"null-value": {
+			input:       Uint64Null(),
+			candidate:   Uint64Value(123),
+			expectation: false,
+		},
+		"null-unknown": {
+			input:       Uint64Null(),
+			candidate:   Uint64Unknown(),
+			expectation: false,
+		},
+		"null-null": {
+			input:       Uint64Null(),
+			candidate:   Uint64Null(),
+			expectation: true,
+		},
+		"null-wrongType": {
+			input:       Uint64Null(),
+			candidate:   StringValue("oops"),
+			expectation: false,
+		},
+		"null-nil": {
+			input:       Uint64Null(),
+			candidate:   nil,
+			expectation: false,
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := test.input.Equal(test.candidate)
+			if !cmp.Equal(got, test.expectation) {
+				t.Errorf("Expected %v, got %v", test.expectation, got)
+			}
+		})
+	}
+}
+
+func TestUint64String(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		input       Uint64
+		expectation string
+	}
+	tests := map[string]testCase{
+		"one": {
+			input:       Uint64Value(1),
+			expectation: "1",
+		},
+		"more-than-one": {
+			input:       Uint64Value(92387938173219327),
+			expectation: "92387938173219327",
+		},
+		"zero": {
+			input:       Uint64Value(0),
+			expectation: "0",
+		},
+		"max-uint64": {
+			input:       Uint64Value(math.MaxUint64),
+			expectation: "18446744073709551615",
+		},
+		"unknown": {
+			input:       Uint64Unknown(),
+			expectation: "<unknown>",
+		},
+		"null": {
+			input:       Uint64Null(),
+			expectation: "<null>",
+		},
+		"zero-value": {
+			input:       Uint64{},
+			expectation: "<null>",
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := test.input.String()
+			if !cmp.Equal(got, test.expectation) {
+				t.Errorf("Expected %q, got %q", test.expectation, got)
+			}
+		})
+	}
+}
+
+func TestUint64ValueUint64(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    Uint64
+		expected uint64
+	}{
+		"known": {
+			input:    Uint64Value(24),
+			expected: 24,
+		},
+		"null": {
+			input:    Uint64Null(),
+			expected: 0,
+		},
+		"unknown": {
+			input:    Uint64Unknown(),
+			expected: 0,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.input.ValueUint64()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
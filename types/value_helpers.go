@@ -0,0 +1,98 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// copyElements returns a shallow copy of the given elements, so callers of
+// the creation functions and accessors cannot modify the value afterwards.
+func copyElements(elements []attr.Value) []attr.Value {
+	if elements == nil {
+		return nil
+	}
+
+	result := make([]attr.Value, len(elements))
+
+	copy(result, elements)
+
+	return result
+}
+
+// copyElementsMap returns a shallow copy of the given map elements, so callers
+// of the creation functions and accessors cannot modify the value afterwards.
+func copyElementsMap(elements map[string]attr.Value) map[string]attr.Value {
+	if elements == nil {
+		return nil
+	}
+
+	result := make(map[string]attr.Value, len(elements))
+
+	for key, element := range elements {
+		result[key] = element
+	}
+
+	return result
+}
+
+// copyAttributeTypes returns a shallow copy of the given attribute types.
+func copyAttributeTypes(attributeTypes map[string]attr.Type) map[string]attr.Type {
+	if attributeTypes == nil {
+		return nil
+	}
+
+	result := make(map[string]attr.Type, len(attributeTypes))
+
+	for name, attributeType := range attributeTypes {
+		result[name] = attributeType
+	}
+
+	return result
+}
+
+// sortedValueKeys returns the keys of the given values in sorted order, such
+// as for consistent diagnostics ordering.
+func sortedValueKeys(values map[string]attr.Value) []string {
+	keys := make([]string, 0, len(values))
+
+	for key := range values {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+// sortedTypeKeys returns the keys of the given types in sorted order, such as
+// for consistent diagnostics ordering.
+func sortedTypeKeys(types map[string]attr.Type) []string {
+	keys := make([]string, 0, len(types))
+
+	for key := range types {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+// diagsPanicMessage returns the panic message for the *ValueMust creation
+// functions, which includes the summary and detail of every diagnostic.
+func diagsPanicMessage(function string, diags diag.Diagnostics) string {
+	var b strings.Builder
+
+	b.WriteString(function)
+	b.WriteString(" received error(s):")
+
+	for _, d := range diags {
+		b.WriteString(fmt.Sprintf("\n\n%s: %s", d.Summary(), d.Detail()))
+	}
+
+	return b.String()
+}